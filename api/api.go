@@ -12,6 +12,7 @@ import (
 	"github.com/gin-contrib/static"
 	"github.com/gin-gonic/gin"
 	"github.com/gin-gonic/gin/binding"
+	"github.com/multiversx/mx-chain-core-go/core/check"
 	"github.com/multiversx/mx-chain-core-go/hashing"
 	"github.com/multiversx/mx-chain-core-go/hashing/factory"
 	"github.com/multiversx/mx-chain-core-go/hashing/sha256"
@@ -24,6 +25,19 @@ import (
 
 var log = logger.GetOrCreate("api")
 
+// readinessRoute is the route, mounted on both the public and the admin web servers, that reports whether the proxy still
+// accepts new requests. It is meant to be used as the readiness probe of the load balancers
+const readinessRoute = "/ready"
+
+const notReadyErrorMessage = "proxy is shutting down and does not accept new requests"
+
+// adminGroups holds the groups that are mounted exclusively on the admin web server, if it is enabled. The same applies
+// for the pprof routes
+var adminGroups = map[string]struct{}{
+	"/actions": {},
+	"/status":  {},
+}
+
 type validatorInput struct {
 	Name      string
 	Validator validator.Func
@@ -36,25 +50,115 @@ func CreateServer(
 	apiLoggingConfig config.ApiLoggingConfig,
 	credentialsConfig config.CredentialsConfig,
 	statusMetricsExtractor middleware.StatusMetricsExtractor,
+	readinessHandler middleware.ReadinessHandler,
+	rateLimitTimeWindowInSeconds int,
+	trustedProxies []string,
+	isProfileModeActivated bool,
+	shouldStartSwaggerUI bool,
+	isAdminServerEnabled bool,
+) (*http.Server, error) {
+	groupsFilter := func(path string) bool {
+		_, isAdminGroup := adminGroups[path]
+		return !isAdminServerEnabled || !isAdminGroup
+	}
+
+	return createServer(
+		versionsRegistry,
+		fmt.Sprintf(":%d", port),
+		apiLoggingConfig,
+		credentialsConfig,
+		statusMetricsExtractor,
+		readinessHandler,
+		rateLimitTimeWindowInSeconds,
+		trustedProxies,
+		isProfileModeActivated && !isAdminServerEnabled,
+		shouldStartSwaggerUI,
+		false,
+		false,
+		groupsFilter,
+	)
+}
+
+// CreateAdminServer creates a HTTP server that will only expose the administrative routes, on the given address.
+// The provided credentials are used instead of the public server's ones and, if shouldSecureAllRoutes is set, all the
+// routes will require authentication regardless of their Secured flag. The pprof routes always require authentication
+func CreateAdminServer(
+	versionsRegistry data.VersionsRegistryHandler,
+	listenAddress string,
+	apiLoggingConfig config.ApiLoggingConfig,
+	credentialsConfig config.CredentialsConfig,
+	statusMetricsExtractor middleware.StatusMetricsExtractor,
+	readinessHandler middleware.ReadinessHandler,
+	rateLimitTimeWindowInSeconds int,
+	trustedProxies []string,
+	isProfileModeActivated bool,
+	shouldSecureAllRoutes bool,
+) (*http.Server, error) {
+	groupsFilter := func(path string) bool {
+		_, isAdminGroup := adminGroups[path]
+		return isAdminGroup
+	}
+
+	return createServer(
+		versionsRegistry,
+		listenAddress,
+		apiLoggingConfig,
+		credentialsConfig,
+		statusMetricsExtractor,
+		readinessHandler,
+		rateLimitTimeWindowInSeconds,
+		trustedProxies,
+		isProfileModeActivated,
+		false,
+		shouldSecureAllRoutes,
+		true,
+		groupsFilter,
+	)
+}
+
+func createServer(
+	versionsRegistry data.VersionsRegistryHandler,
+	address string,
+	apiLoggingConfig config.ApiLoggingConfig,
+	credentialsConfig config.CredentialsConfig,
+	statusMetricsExtractor middleware.StatusMetricsExtractor,
+	readinessHandler middleware.ReadinessHandler,
 	rateLimitTimeWindowInSeconds int,
+	trustedProxies []string,
 	isProfileModeActivated bool,
 	shouldStartSwaggerUI bool,
+	shouldSecureAllRoutes bool,
+	shouldSecureProfileRoutes bool,
+	groupsFilter func(path string) bool,
 ) (*http.Server, error) {
+	if check.IfNil(readinessHandler) {
+		return nil, middleware.ErrNilReadinessHandler
+	}
+
 	ws := gin.Default()
+	// the client IP is read from the forwarding headers only if the request comes from a trusted proxy
+	err := ws.SetTrustedProxies(trustedProxies)
+	if err != nil {
+		return nil, err
+	}
+	// the readiness route is registered before any middleware so that the probes are neither rejected by the readiness
+	// middleware nor required to authenticate
+	ws.GET(readinessRoute, getReadinessRouteHandlerFunc(readinessHandler))
+	ws.Use(readinessHandler.MiddlewareHandlerFunc())
 	ws.Use(cors.Default())
 
-	err := registerValidators()
+	err = registerValidators()
 	if err != nil {
 		return nil, err
 	}
 
-	err = registerRoutes(ws, versionsRegistry, apiLoggingConfig, credentialsConfig, statusMetricsExtractor, rateLimitTimeWindowInSeconds, isProfileModeActivated, shouldStartSwaggerUI)
+	err = registerRoutes(ws, versionsRegistry, apiLoggingConfig, credentialsConfig, statusMetricsExtractor, rateLimitTimeWindowInSeconds, isProfileModeActivated, shouldStartSwaggerUI, shouldSecureAllRoutes, shouldSecureProfileRoutes, groupsFilter)
 	if err != nil {
 		return nil, err
 	}
 
 	httpServer := &http.Server{
-		Addr:    fmt.Sprintf(":%d", port),
+		Addr:    address,
 		Handler: ws,
 	}
 
@@ -85,12 +189,20 @@ func registerRoutes(
 	rateLimitTimeWindowInSeconds int,
 	isProfileModeActivated bool,
 	shouldStartSwaggerUI bool,
+	shouldSecureAllRoutes bool,
+	shouldSecureProfileRoutes bool,
+	groupsFilter func(path string) bool,
 ) error {
 	versionsMap, err := versionsRegistry.GetAllVersions()
 	if err != nil {
 		return err
 	}
 
+	authenticationFunc := getAuthenticationFunc(credentialsConfig)
+	if shouldSecureAllRoutes {
+		ws.Use(authenticationFunc)
+	}
+
 	if shouldStartSwaggerUI {
 		ws.Use(static.ServeRoot("/", "config/swagger"))
 	}
@@ -116,11 +228,15 @@ func registerRoutes(
 		startRateLimiterReset(rateLimitTimeWindowInSeconds, rateLimiter, version)
 		versionGroup := ws.Group(version)
 		for path, group := range versionData.ApiHandler.GetAllGroups() {
+			if !groupsFilter(path) {
+				continue
+			}
+
 			subGroup := versionGroup.Group(path)
 			group.RegisterRoutes(
 				subGroup,
 				versionData.ApiConfig,
-				getAuthenticationFunc(credentialsConfig),
+				authenticationFunc,
 				rateLimiter.MiddlewareHandlerFunc(),
 				metricsMiddleware.MiddlewareHandlerFunc(),
 			)
@@ -128,15 +244,50 @@ func registerRoutes(
 	}
 
 	if isProfileModeActivated {
-		pprof.Register(ws)
+		registerProfileRoutes(ws, authenticationFunc, shouldSecureProfileRoutes && !shouldSecureAllRoutes)
 	}
 
 	return nil
 }
 
+// registerProfileRoutes registers the pprof routes. If shouldAuthenticate is set, they will require authentication
+func registerProfileRoutes(ws *gin.Engine, authenticationFunc gin.HandlerFunc, shouldAuthenticate bool) {
+	if !shouldAuthenticate {
+		pprof.Register(ws)
+		return
+	}
+
+	pprof.RouteRegister(ws.Group("", authenticationFunc))
+}
+
+func getReadinessRouteHandlerFunc(readinessHandler middleware.ReadinessHandler) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if !readinessHandler.IsReady() {
+			// the client should not reuse this connection since the server is about to close it
+			c.Header("Connection", "close")
+			c.JSON(http.StatusServiceUnavailable, data.GenericAPIResponse{
+				Data:  gin.H{"ready": false},
+				Error: notReadyErrorMessage,
+				Code:  data.ReturnCodeInternalError,
+			})
+			return
+		}
+
+		c.JSON(http.StatusOK, data.GenericAPIResponse{
+			Data:  gin.H{"ready": true},
+			Error: "",
+			Code:  data.ReturnCodeSuccess,
+		})
+	}
+}
+
 func getAuthenticationFunc(credentialsConfig config.CredentialsConfig) gin.HandlerFunc {
 	if len(credentialsConfig.Credentials) == 0 {
 		return func(c *gin.Context) {
+			if hasVerifiedClientCertificate(c.Request) {
+				return
+			}
+
 			c.AbortWithStatusJSON(
 				http.StatusInternalServerError,
 				data.GenericAPIResponse{
@@ -162,6 +313,10 @@ func getAuthenticationFunc(credentialsConfig config.CredentialsConfig) gin.Handl
 	}
 
 	authenticationFunction := func(c *gin.Context) {
+		if hasVerifiedClientCertificate(c.Request) {
+			return
+		}
+
 		user, pass, ok := c.Request.BasicAuth()
 		if !ok {
 			c.AbortWithStatusJSON(http.StatusUnauthorized, data.GenericAPIResponse{
@@ -195,6 +350,15 @@ func getAuthenticationFunc(credentialsConfig config.CredentialsConfig) gin.Handl
 	return authenticationFunction
 }
 
+// hasVerifiedClientCertificate returns true if the request was made over a TLS connection with a client certificate
+// signed by one of the configured client certificate authorities. Such a request skips the Basic Authentication on
+// all the secured routes, the administrative ones included, and even when no credentials are configured: every
+// authority in the ClientCAFile is trusted to grant admin access. The chains are only verified when a ClientCAFile is
+// configured, so without it no request passes this check
+func hasVerifiedClientCertificate(request *http.Request) bool {
+	return request.TLS != nil && len(request.TLS.VerifiedChains) > 0
+}
+
 func getLimitsMapForVersion(versionData *data.VersionData) map[string]uint64 {
 	limitsMap := make(map[string]uint64)
 	for packageName, packageConfig := range versionData.ApiConfig.APIPackages {
@@ -104,6 +104,16 @@ func initBaseGroupsWithFacade(facade data.FacadeHandler) (map[string]data.GroupH
 		return nil, err
 	}
 
+	eventsGroup, err := groups.NewEventsGroup(facade)
+	if err != nil {
+		return nil, err
+	}
+
+	tokensGroup, err := groups.NewTokensGroup(facade)
+	if err != nil {
+		return nil, err
+	}
+
 	return map[string]data.GroupHandler{
 		"/actions":     actionsGroup,
 		"/address":     accountsGroup,
@@ -120,6 +130,8 @@ func initBaseGroupsWithFacade(facade data.FacadeHandler) (map[string]data.GroupH
 		"/vm-values":   vmValuesGroup,
 		"/proof":       proofGroup,
 		"/about":       aboutGroup,
+		"/events":      eventsGroup,
+		"/tokens":      tokensGroup,
 	}, nil
 }
 
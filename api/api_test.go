@@ -0,0 +1,276 @@
+package api
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/multiversx/mx-chain-proxy-go/api/middleware"
+	"github.com/multiversx/mx-chain-proxy-go/api/mock"
+	"github.com/multiversx/mx-chain-proxy-go/config"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/multiversx/mx-chain-proxy-go/versions"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createVersionsRegistryForTests(t *testing.T) data.VersionsRegistryHandler {
+	gin.SetMode(gin.TestMode)
+
+	facade := &mock.FacadeStub{
+		GetMetricsCalled: func() map[string]*data.EndpointMetrics {
+			return map[string]*data.EndpointMetrics{}
+		},
+	}
+	apiHandler, err := NewApiHandler(facade)
+	require.NoError(t, err)
+
+	versionsRegistry := versions.NewVersionsRegistry()
+	err = versionsRegistry.AddVersion("/v1.0", &data.VersionData{
+		Facade:     facade,
+		ApiHandler: apiHandler,
+	})
+	require.NoError(t, err)
+
+	return versionsRegistry
+}
+
+func getResponseCode(server *http.Server, path string) int {
+	resp := httptest.NewRecorder()
+	req, _ := http.NewRequest(http.MethodGet, path, nil)
+	server.Handler.ServeHTTP(resp, req)
+
+	return resp.Code
+}
+
+func TestCreateServer_NilReadinessHandlerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	server, err := CreateServer(
+		createVersionsRegistryForTests(t),
+		8080,
+		config.ApiLoggingConfig{},
+		config.CredentialsConfig{},
+		&mock.StatusMetricsExporterStub{},
+		nil,
+		1,
+		nil,
+		false,
+		false,
+		false,
+	)
+	require.Nil(t, server)
+	require.Equal(t, middleware.ErrNilReadinessHandler, err)
+}
+
+func TestCreateServer_InvalidTrustedProxiesShouldErr(t *testing.T) {
+	t.Parallel()
+
+	server, err := CreateServer(
+		createVersionsRegistryForTests(t),
+		8080,
+		config.ApiLoggingConfig{},
+		config.CredentialsConfig{},
+		&mock.StatusMetricsExporterStub{},
+		middleware.NewReadinessMiddleware(),
+		1,
+		[]string{"not an address"},
+		false,
+		false,
+		false,
+	)
+	require.Nil(t, server)
+	require.Error(t, err)
+}
+
+func TestCreateServer_AdminGroups(t *testing.T) {
+	t.Parallel()
+
+	t.Run("admin server disabled should expose the admin groups on the public server", func(t *testing.T) {
+		t.Parallel()
+
+		server, err := CreateServer(
+			createVersionsRegistryForTests(t),
+			8080,
+			config.ApiLoggingConfig{},
+			config.CredentialsConfig{},
+			&mock.StatusMetricsExporterStub{},
+			middleware.NewReadinessMiddleware(),
+			1,
+			nil,
+			false,
+			false,
+			false,
+		)
+		require.NoError(t, err)
+		assert.Equal(t, ":8080", server.Addr)
+		assert.Equal(t, http.StatusOK, getResponseCode(server, "/v1.0/status/metrics"))
+	})
+
+	t.Run("admin server enabled should only expose the admin groups on the admin server", func(t *testing.T) {
+		t.Parallel()
+
+		versionsRegistry := createVersionsRegistryForTests(t)
+		server, err := CreateServer(
+			versionsRegistry,
+			8080,
+			config.ApiLoggingConfig{},
+			config.CredentialsConfig{},
+			&mock.StatusMetricsExporterStub{},
+			middleware.NewReadinessMiddleware(),
+			1,
+			nil,
+			true,
+			false,
+			true,
+		)
+		require.NoError(t, err)
+
+		adminServer, err := CreateAdminServer(
+			versionsRegistry,
+			"127.0.0.1:8079",
+			config.ApiLoggingConfig{},
+			config.CredentialsConfig{},
+			&mock.StatusMetricsExporterStub{},
+			middleware.NewReadinessMiddleware(),
+			1,
+			nil,
+			true,
+			false,
+		)
+		require.NoError(t, err)
+		assert.Equal(t, "127.0.0.1:8079", adminServer.Addr)
+
+		assert.Equal(t, http.StatusNotFound, getResponseCode(server, "/v1.0/status/metrics"))
+		assert.Equal(t, http.StatusOK, getResponseCode(adminServer, "/v1.0/status/metrics"))
+		assert.Equal(t, http.StatusNotFound, getResponseCode(adminServer, "/v1.0/about"))
+		assert.Equal(t, http.StatusNotFound, getResponseCode(server, "/debug/pprof/"))
+		assert.Equal(t, http.StatusInternalServerError, getResponseCode(adminServer, "/debug/pprof/"))
+	})
+
+	t.Run("admin server should always require authentication for the pprof routes", func(t *testing.T) {
+		t.Parallel()
+
+		adminServer, err := CreateAdminServer(
+			createVersionsRegistryForTests(t),
+			"127.0.0.1:8079",
+			config.ApiLoggingConfig{},
+			config.CredentialsConfig{
+				Credentials: []data.Credential{{Username: "admin", Password: "8c6976e5b5410415bde908bd4dee15dfb167a9c873fc4bb8a81f6f2ab448a918"}},
+				Hasher:      config.TypeConfig{Type: "sha256"},
+			},
+			&mock.StatusMetricsExporterStub{},
+			middleware.NewReadinessMiddleware(),
+			1,
+			nil,
+			true,
+			false,
+		)
+		require.NoError(t, err)
+
+		assert.Equal(t, http.StatusOK, getResponseCode(adminServer, "/v1.0/status/metrics"))
+		assert.Equal(t, http.StatusUnauthorized, getResponseCode(adminServer, "/debug/pprof/"))
+
+		resp := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodGet, "/debug/pprof/", nil)
+		req.SetBasicAuth("admin", "admin")
+		adminServer.Handler.ServeHTTP(resp, req)
+		assert.Equal(t, http.StatusOK, resp.Code)
+	})
+
+	t.Run("public server should keep the pprof routes unauthenticated when the admin server is disabled", func(t *testing.T) {
+		t.Parallel()
+
+		server, err := CreateServer(
+			createVersionsRegistryForTests(t),
+			8080,
+			config.ApiLoggingConfig{},
+			config.CredentialsConfig{},
+			&mock.StatusMetricsExporterStub{},
+			middleware.NewReadinessMiddleware(),
+			1,
+			nil,
+			true,
+			false,
+			false,
+		)
+		require.NoError(t, err)
+
+		assert.Equal(t, http.StatusOK, getResponseCode(server, "/debug/pprof/"))
+	})
+
+	t.Run("admin server with all routes secured should require authentication", func(t *testing.T) {
+		t.Parallel()
+
+		adminServer, err := CreateAdminServer(
+			createVersionsRegistryForTests(t),
+			"127.0.0.1:8079",
+			config.ApiLoggingConfig{},
+			config.CredentialsConfig{
+				Credentials: []data.Credential{{Username: "admin", Password: "hashed"}},
+				Hasher:      config.TypeConfig{Type: "sha256"},
+			},
+			&mock.StatusMetricsExporterStub{},
+			middleware.NewReadinessMiddleware(),
+			1,
+			nil,
+			true,
+			true,
+		)
+		require.NoError(t, err)
+
+		assert.Equal(t, http.StatusUnauthorized, getResponseCode(adminServer, "/v1.0/status/metrics"))
+		assert.Equal(t, http.StatusUnauthorized, getResponseCode(adminServer, "/debug/pprof/"))
+	})
+}
+
+func TestCreateServer_ReadinessRoute(t *testing.T) {
+	t.Parallel()
+
+	readinessHandler := middleware.NewReadinessMiddleware()
+	server, err := CreateServer(
+		createVersionsRegistryForTests(t),
+		8080,
+		config.ApiLoggingConfig{},
+		config.CredentialsConfig{},
+		&mock.StatusMetricsExporterStub{},
+		readinessHandler,
+		1,
+		nil,
+		false,
+		false,
+		true,
+	)
+	require.NoError(t, err)
+
+	adminServer, err := CreateAdminServer(
+		createVersionsRegistryForTests(t),
+		"127.0.0.1:8079",
+		config.ApiLoggingConfig{},
+		config.CredentialsConfig{
+			Credentials: []data.Credential{{Username: "admin", Password: "hashed"}},
+			Hasher:      config.TypeConfig{Type: "sha256"},
+		},
+		&mock.StatusMetricsExporterStub{},
+		readinessHandler,
+		1,
+		nil,
+		false,
+		true,
+	)
+	require.NoError(t, err)
+
+	assert.Equal(t, http.StatusOK, getResponseCode(server, "/ready"))
+	assert.Equal(t, http.StatusOK, getResponseCode(adminServer, "/ready"))
+
+	readinessHandler.MarkNotReady()
+
+	resp := httptest.NewRecorder()
+	req, _ := http.NewRequest(http.MethodGet, "/ready", nil)
+	server.Handler.ServeHTTP(resp, req)
+	assert.Equal(t, http.StatusServiceUnavailable, resp.Code)
+	assert.Equal(t, "close", resp.Header().Get("Connection"))
+	assert.Contains(t, resp.Body.String(), `"ready":false`)
+	assert.Equal(t, http.StatusServiceUnavailable, getResponseCode(adminServer, "/ready"))
+}
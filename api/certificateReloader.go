@@ -0,0 +1,142 @@
+package api
+
+import (
+	"context"
+	"crypto/tls"
+	"os"
+	"sync"
+	"time"
+)
+
+// certificateReloader holds the TLS certificate used by the web server listeners and reloads it whenever the
+// certificate or the private key files change on disk, so the certificates can be rotated without restarting the proxy
+type certificateReloader struct {
+	certificateFile string
+	privateKeyFile  string
+	checkInterval   time.Duration
+
+	mutCertificate sync.RWMutex
+	certificate    *tls.Certificate
+	lastModTimes   [2]time.Time
+	cancelFunc     func()
+}
+
+// newCertificateReloader loads the certificate from the provided files and returns a new instance of certificateReloader
+func newCertificateReloader(certificateFile string, privateKeyFile string, checkInterval time.Duration) (*certificateReloader, error) {
+	if len(certificateFile) == 0 || len(privateKeyFile) == 0 {
+		return nil, ErrMissingCertificateFiles
+	}
+	if checkInterval <= 0 {
+		return nil, ErrInvalidCertificateReloadInterval
+	}
+
+	cr := &certificateReloader{
+		certificateFile: certificateFile,
+		privateKeyFile:  privateKeyFile,
+		checkInterval:   checkInterval,
+	}
+
+	modTimes, err := cr.readModTimes()
+	if err != nil {
+		return nil, err
+	}
+
+	err = cr.loadCertificate(modTimes)
+	if err != nil {
+		return nil, err
+	}
+
+	return cr, nil
+}
+
+// GetCertificate returns the currently loaded certificate. Its signature matches the tls.Config.GetCertificate field
+func (cr *certificateReloader) GetCertificate(_ *tls.ClientHelloInfo) (*tls.Certificate, error) {
+	cr.mutCertificate.RLock()
+	defer cr.mutCertificate.RUnlock()
+
+	return cr.certificate, nil
+}
+
+// startReloading will start the go routine that watches the certificate files for changes
+func (cr *certificateReloader) startReloading() {
+	var ctx context.Context
+	ctx, cr.cancelFunc = context.WithCancel(context.Background())
+
+	go func(ctx context.Context) {
+		timer := time.NewTimer(cr.checkInterval)
+		defer timer.Stop()
+
+		for {
+			timer.Reset(cr.checkInterval)
+
+			select {
+			case <-timer.C:
+				cr.reloadIfChanged()
+			case <-ctx.Done():
+				log.Debug("finishing certificate reloader...")
+				return
+			}
+		}
+	}(ctx)
+}
+
+func (cr *certificateReloader) reloadIfChanged() {
+	modTimes, err := cr.readModTimes()
+	if err != nil {
+		log.Warn("cannot check the TLS certificate files", "error", err)
+		return
+	}
+
+	cr.mutCertificate.RLock()
+	isChanged := modTimes != cr.lastModTimes
+	cr.mutCertificate.RUnlock()
+	if !isChanged {
+		return
+	}
+
+	// on error, the previous certificate is kept. This covers the case when the files are caught mid-rotation
+	err = cr.loadCertificate(modTimes)
+	if err != nil {
+		log.Warn("cannot reload the TLS certificate, will keep the old one", "error", err)
+		return
+	}
+
+	log.Info("reloaded the TLS certificate", "certificate file", cr.certificateFile)
+}
+
+func (cr *certificateReloader) loadCertificate(modTimes [2]time.Time) error {
+	certificate, err := tls.LoadX509KeyPair(cr.certificateFile, cr.privateKeyFile)
+	if err != nil {
+		return err
+	}
+
+	cr.mutCertificate.Lock()
+	cr.certificate = &certificate
+	cr.lastModTimes = modTimes
+	cr.mutCertificate.Unlock()
+
+	return nil
+}
+
+func (cr *certificateReloader) readModTimes() ([2]time.Time, error) {
+	modTimes := [2]time.Time{}
+	for i, file := range []string{cr.certificateFile, cr.privateKeyFile} {
+		info, err := os.Stat(file)
+		if err != nil {
+			return modTimes, err
+		}
+
+		modTimes[i] = info.ModTime()
+	}
+
+	return modTimes, nil
+}
+
+// Close will stop the certificate files watching
+func (cr *certificateReloader) Close() error {
+	if cr.cancelFunc != nil {
+		cr.cancelFunc()
+	}
+
+	return nil
+}
@@ -0,0 +1,124 @@
+package api
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"io/ioutil"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func writeSelfSignedCertificate(t *testing.T, dir string, commonName string) (string, string) {
+	privateKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: commonName},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+	}
+	certificateBytes, err := x509.CreateCertificate(rand.Reader, template, template, &privateKey.PublicKey, privateKey)
+	require.NoError(t, err)
+
+	privateKeyBytes, err := x509.MarshalECPrivateKey(privateKey)
+	require.NoError(t, err)
+
+	certificateFile := filepath.Join(dir, "server.crt")
+	privateKeyFile := filepath.Join(dir, "server.key")
+	err = ioutil.WriteFile(certificateFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: certificateBytes}), 0600)
+	require.NoError(t, err)
+	err = ioutil.WriteFile(privateKeyFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: privateKeyBytes}), 0600)
+	require.NoError(t, err)
+
+	return certificateFile, privateKeyFile
+}
+
+func getCommonName(t *testing.T, cr *certificateReloader) string {
+	certificate, err := cr.GetCertificate(nil)
+	require.NoError(t, err)
+
+	parsed, err := x509.ParseCertificate(certificate.Certificate[0])
+	require.NoError(t, err)
+
+	return parsed.Subject.CommonName
+}
+
+func TestNewCertificateReloader(t *testing.T) {
+	t.Parallel()
+
+	t.Run("missing files should err", func(t *testing.T) {
+		t.Parallel()
+
+		cr, err := newCertificateReloader("", "key", time.Second)
+		require.Nil(t, cr)
+		require.Equal(t, ErrMissingCertificateFiles, err)
+	})
+
+	t.Run("invalid interval should err", func(t *testing.T) {
+		t.Parallel()
+
+		cr, err := newCertificateReloader("cert", "key", 0)
+		require.Nil(t, cr)
+		require.Equal(t, ErrInvalidCertificateReloadInterval, err)
+	})
+
+	t.Run("non-existing files should err", func(t *testing.T) {
+		t.Parallel()
+
+		dir := t.TempDir()
+		cr, err := newCertificateReloader(filepath.Join(dir, "cert"), filepath.Join(dir, "key"), time.Second)
+		require.Nil(t, cr)
+		require.True(t, os.IsNotExist(err))
+	})
+
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		certificateFile, privateKeyFile := writeSelfSignedCertificate(t, t.TempDir(), "first")
+		cr, err := newCertificateReloader(certificateFile, privateKeyFile, time.Second)
+		require.NoError(t, err)
+		assert.Equal(t, "first", getCommonName(t, cr))
+	})
+}
+
+func TestCertificateReloader_ReloadIfChanged(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	certificateFile, privateKeyFile := writeSelfSignedCertificate(t, dir, "first")
+	cr, err := newCertificateReloader(certificateFile, privateKeyFile, time.Second)
+	require.NoError(t, err)
+
+	cr.reloadIfChanged()
+	assert.Equal(t, "first", getCommonName(t, cr))
+
+	_, _ = writeSelfSignedCertificate(t, dir, "second")
+	future := time.Now().Add(time.Minute)
+	require.NoError(t, os.Chtimes(certificateFile, future, future))
+
+	cr.reloadIfChanged()
+	assert.Equal(t, "second", getCommonName(t, cr))
+
+	// a broken key file should not replace the loaded certificate
+	require.NoError(t, ioutil.WriteFile(privateKeyFile, []byte("not a key"), 0600))
+	future = future.Add(time.Minute)
+	require.NoError(t, os.Chtimes(privateKeyFile, future, future))
+
+	cr.reloadIfChanged()
+	assert.Equal(t, "second", getCommonName(t, cr))
+	assert.Nil(t, cr.Close())
+}
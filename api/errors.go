@@ -13,3 +13,18 @@ var ErrGroupDoesNotExist = errors.New("group does not exist")
 
 // ErrNilFacade signals that a nil facade has been provided
 var ErrNilFacade = errors.New("nil facade")
+
+// ErrMissingCertificateFiles signals that the TLS certificate or private key file has not been provided
+var ErrMissingCertificateFiles = errors.New("missing TLS certificate or private key file")
+
+// ErrInvalidCertificateReloadInterval signals that an invalid interval for checking the certificate files has been provided
+var ErrInvalidCertificateReloadInterval = errors.New("invalid certificate reload interval")
+
+// ErrInvalidTLSVersion signals that an invalid TLS version has been provided
+var ErrInvalidTLSVersion = errors.New("invalid TLS version")
+
+// ErrInvalidCipherSuite signals that an unknown or insecure cipher suite has been provided
+var ErrInvalidCipherSuite = errors.New("invalid cipher suite")
+
+// ErrInvalidClientCAFile signals that no certificate could be read from the client CA file
+var ErrInvalidClientCAFile = errors.New("no valid certificate found in the client CA file")
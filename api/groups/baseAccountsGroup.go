@@ -1,6 +1,7 @@
 package groups
 
 import (
+	goErrors "errors"
 	"fmt"
 	"net/http"
 
@@ -69,14 +70,22 @@ func (group *accountsGroup) respondWithAccount(c *gin.Context, transform func(*d
 	shared.RespondWith(c, http.StatusOK, response, "", data.ReturnCodeSuccess)
 }
 
-func (group *accountsGroup) getTransactionsFromFacade(c *gin.Context) ([]data.DatabaseTransaction, int, error) {
+func (group *accountsGroup) getTransactionsFromFacade(c *gin.Context) (*data.TransactionsHistoryPage, int, error) {
 	addr := c.Param("address")
-	transactions, err := group.facade.GetTransactions(addr)
+	filter, err := parseTransactionsHistoryFilter(c)
+	if err != nil {
+		return nil, http.StatusBadRequest, fmt.Errorf("%w: %s", errors.ErrBadUrlParams, err.Error())
+	}
+
+	transactionsPage, err := group.facade.GetTransactions(addr, filter)
+	if goErrors.Is(err, data.ErrInvalidTransactionsHistoryCursor) {
+		return nil, http.StatusBadRequest, err
+	}
 	if err != nil {
 		return nil, http.StatusInternalServerError, err
 	}
 
-	return transactions, http.StatusOK, nil
+	return transactionsPage, http.StatusOK, nil
 }
 
 // getAccount returns an accountResponse containing information
@@ -128,13 +137,17 @@ func (group *accountsGroup) getCodeHash(c *gin.Context) {
 
 // getTransactions returns the transactions for the address parameter
 func (group *accountsGroup) getTransactions(c *gin.Context) {
-	transactions, status, err := group.getTransactionsFromFacade(c)
+	transactionsPage, status, err := group.getTransactionsFromFacade(c)
 	if err != nil {
-		shared.RespondWith(c, status, nil, err.Error(), data.ReturnCodeInternalError)
+		returnCode := data.ReturnCodeInternalError
+		if status == http.StatusBadRequest {
+			returnCode = data.ReturnCodeRequestError
+		}
+		shared.RespondWith(c, status, nil, err.Error(), returnCode)
 		return
 	}
 
-	shared.RespondWith(c, http.StatusOK, gin.H{"transactions": transactions}, "", data.ReturnCodeSuccess)
+	shared.RespondWith(c, http.StatusOK, transactionsPage, "", data.ReturnCodeSuccess)
 }
 
 // getKeyValuePairs returns the key-value pairs for the address parameter
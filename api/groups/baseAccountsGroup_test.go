@@ -8,6 +8,7 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/multiversx/mx-chain-core-go/core"
 	apiErrors "github.com/multiversx/mx-chain-proxy-go/api/errors"
 	"github.com/multiversx/mx-chain-proxy-go/api/groups"
 	"github.com/multiversx/mx-chain-proxy-go/api/mock"
@@ -240,6 +241,33 @@ func TestGetAccount_ReturnsSuccessfully(t *testing.T) {
 	assert.Empty(t, accountResponse.Error)
 }
 
+func TestGetAccount_ShouldParseTheQueryOptions(t *testing.T) {
+	t.Parallel()
+
+	var providedOptions common.AccountQueryOptions
+	facade := &mock.FacadeStub{
+		GetAccountHandler: func(address string, options common.AccountQueryOptions) (*data.AccountModel, error) {
+			providedOptions = options
+			return &data.AccountModel{}, nil
+		},
+	}
+	addressGroup, err := groups.NewAccountsGroup(facade)
+	require.NoError(t, err)
+	ws := startProxyServer(addressGroup, addressPath)
+
+	req, _ := http.NewRequest("GET", "/address/test?onStartOfEpoch=3&blockNonce=37&hintEpoch=2", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	expectedOptions := common.AccountQueryOptions{
+		OnStartOfEpoch: core.OptionalUint32{Value: 3, HasValue: true},
+		BlockNonce:     core.OptionalUint64{Value: 37, HasValue: true},
+		HintEpoch:      core.OptionalUint32{Value: 2, HasValue: true},
+	}
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, expectedOptions, providedOptions)
+}
+
 //------- GetBalance
 
 func TestGetBalance_ReturnsSuccessfully(t *testing.T) {
@@ -923,3 +951,123 @@ func TestGetCodeHash_ReturnsSuccessfully(t *testing.T) {
 	assert.Equal(t, expectedResponse, actualResponse)
 	assert.Empty(t, actualResponse.Error)
 }
+
+type transactionsHistoryResponse struct {
+	GeneralResponse
+	Data data.TransactionsHistoryPage `json:"data"`
+}
+
+func TestGetTransactions_InvalidUrlParamsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	facade := &mock.FacadeStub{
+		GetTransactionsHandler: func(address string, filter data.TransactionsHistoryFilter) (*data.TransactionsHistoryPage, error) {
+			require.Fail(t, "should have not been called")
+			return nil, nil
+		},
+	}
+	addressGroup, err := groups.NewAccountsGroup(facade)
+	require.NoError(t, err)
+	ws := startProxyServer(addressGroup, addressPath)
+
+	for _, query := range []string{"direction=sideways", "order=random", "limit=-1", "fromNonce=abc", "from=x"} {
+		req, _ := http.NewRequest("GET", "/address/erd1addr/transactions?"+query, nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := transactionsHistoryResponse{}
+		loadResponse(resp.Body, &response)
+		assert.Equal(t, http.StatusBadRequest, resp.Code, query)
+		assert.Contains(t, response.Error, apiErrors.ErrBadUrlParams.Error(), query)
+		assert.Equal(t, string(data.ReturnCodeRequestError), response.Code, query)
+	}
+}
+
+func TestGetTransactions_InvalidCursorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	facade := &mock.FacadeStub{
+		GetTransactionsHandler: func(address string, filter data.TransactionsHistoryFilter) (*data.TransactionsHistoryPage, error) {
+			return nil, data.ErrInvalidTransactionsHistoryCursor
+		},
+	}
+	addressGroup, err := groups.NewAccountsGroup(facade)
+	require.NoError(t, err)
+	ws := startProxyServer(addressGroup, addressPath)
+
+	req, _ := http.NewRequest("GET", "/address/erd1addr/transactions?cursor=bad", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := transactionsHistoryResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	assert.Equal(t, data.ErrInvalidTransactionsHistoryCursor.Error(), response.Error)
+}
+
+func TestGetTransactions_FacadeErrorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	facade := &mock.FacadeStub{
+		GetTransactionsHandler: func(address string, filter data.TransactionsHistoryFilter) (*data.TransactionsHistoryPage, error) {
+			return nil, expectedErr
+		},
+	}
+	addressGroup, err := groups.NewAccountsGroup(facade)
+	require.NoError(t, err)
+	ws := startProxyServer(addressGroup, addressPath)
+
+	req, _ := http.NewRequest("GET", "/address/erd1addr/transactions", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := transactionsHistoryResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.Equal(t, expectedErr.Error(), response.Error)
+}
+
+func TestGetTransactions_ReturnsSuccessfully(t *testing.T) {
+	t.Parallel()
+
+	expectedFilter := data.TransactionsHistoryFilter{
+		Direction: data.TransactionsDirectionIn,
+		Status:    "success",
+		Token:     "TKN-123456",
+		Function:  "claim",
+		From:      100,
+		To:        200,
+		FromNonce: core.OptionalUint64{Value: 1, HasValue: true},
+		ToNonce:   core.OptionalUint64{Value: 9, HasValue: true},
+		Order:     data.SortOrderAscending,
+		Limit:     10,
+		Cursor:    "cursor",
+	}
+	expectedPage := &data.TransactionsHistoryPage{
+		Transactions: []data.DatabaseTransaction{{Hash: "hash", Fee: "10"}},
+		NextCursor:   "next",
+	}
+	facade := &mock.FacadeStub{
+		GetTransactionsHandler: func(address string, filter data.TransactionsHistoryFilter) (*data.TransactionsHistoryPage, error) {
+			assert.Equal(t, "erd1addr", address)
+			assert.Equal(t, expectedFilter, filter)
+			return expectedPage, nil
+		},
+	}
+	addressGroup, err := groups.NewAccountsGroup(facade)
+	require.NoError(t, err)
+	ws := startProxyServer(addressGroup, addressPath)
+
+	url := "/address/erd1addr/transactions?direction=in&status=success&token=TKN-123456&function=claim" +
+		"&from=100&to=200&fromNonce=1&toNonce=9&order=asc&limit=10&cursor=cursor"
+	req, _ := http.NewRequest("GET", url, nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := transactionsHistoryResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Empty(t, response.Error)
+	assert.Equal(t, *expectedPage, response.Data)
+}
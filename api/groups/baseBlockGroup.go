@@ -31,6 +31,7 @@ func NewBlockGroup(facadeHandler data.FacadeHandler) (*blockGroup, error) {
 		{Path: "/:shard/by-hash/:hash", Handler: bg.byHashHandler, Method: http.MethodGet},
 		{Path: "/:shard/altered-accounts/by-nonce/:nonce", Handler: bg.alteredAccountsByNonceHandler, Method: http.MethodGet},
 		{Path: "/:shard/altered-accounts/by-hash/:hash", Handler: bg.alteredAccountsByHashHandler, Method: http.MethodGet},
+		{Path: "/:shard/range", Handler: bg.rangeHandler, Method: http.MethodGet},
 	}
 	bg.baseGroup.endpoints = baseRoutesHandlers
 
@@ -174,3 +175,36 @@ func (group *blockGroup) alteredAccountsByHashHandler(c *gin.Context) {
 
 	c.JSON(http.StatusOK, blockByHashResponse)
 }
+
+// rangeHandler streams the blocks of a shard between the "from" and "to" nonces, both included, as newline-delimited JSON
+func (group *blockGroup) rangeHandler(c *gin.Context) {
+	shardID, err := shared.FetchShardIDFromRequest(c)
+	if err != nil {
+		shared.RespondWith(
+			c,
+			http.StatusBadRequest,
+			nil,
+			apiErrors.ErrCannotParseShardID.Error(),
+			data.ReturnCodeRequestError,
+		)
+		return
+	}
+
+	from, to, err := parseNoncesRange(c)
+	if err != nil {
+		shared.RespondWithValidationError(c, apiErrors.ErrBadUrlParams, err)
+		return
+	}
+
+	options, err := parseBlockQueryOptions(c)
+	if err != nil {
+		shared.RespondWithValidationError(c, apiErrors.ErrBadUrlParams, err)
+		return
+	}
+
+	writer := newNDJSONWriter(c)
+	err = group.facade.GetBlocksRange(shardID, from, to, options, writer.writeAndFlush)
+	if err != nil {
+		writer.fail(err, data.ErrInvalidBlocksRange)
+	}
+}
@@ -1,6 +1,7 @@
 package groups_test
 
 import (
+	"encoding/json"
 	"errors"
 	"net/http"
 	"net/http/httptest"
@@ -470,3 +471,67 @@ func TestGetAlteredAccountsByHash(t *testing.T) {
 		require.Equal(t, expectedApiResponse, apiResp)
 	})
 }
+
+func TestGetBlocksRange(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid shard should err", func(t *testing.T) {
+		t.Parallel()
+
+		blockGroup, _ := groups.NewBlockGroup(&mock.FacadeStub{})
+		ws := startProxyServer(blockGroup, blockPath)
+
+		req, _ := http.NewRequest("GET", "/block/invalid_shard_id/range?from=1&to=2", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		apiResp := data.GenericAPIResponse{}
+		loadResponse(resp.Body, &apiResp)
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.Equal(t, apiErrors.ErrCannotParseShardID.Error(), apiResp.Error)
+	})
+	t.Run("missing range should err", func(t *testing.T) {
+		t.Parallel()
+
+		blockGroup, _ := groups.NewBlockGroup(&mock.FacadeStub{})
+		ws := startProxyServer(blockGroup, blockPath)
+
+		req, _ := http.NewRequest("GET", "/block/0/range?from=1", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+	})
+	t.Run("should stream the blocks as newline-delimited JSON", func(t *testing.T) {
+		t.Parallel()
+
+		blockGroup, _ := groups.NewBlockGroup(&mock.FacadeStub{
+			GetBlocksRangeCalled: func(shardID uint32, from uint64, to uint64, options common.BlockQueryOptions, handler data.BlocksRangeItemHandler) error {
+				require.Equal(t, uint32(1), shardID)
+				require.True(t, options.WithTransactions)
+				for nonce := from; nonce <= to; nonce++ {
+					err := handler(&data.BlockApiResponsePayload{Block: api.Block{Nonce: nonce}})
+					require.Nil(t, err)
+				}
+
+				return nil
+			},
+		})
+		ws := startProxyServer(blockGroup, blockPath)
+
+		req, _ := http.NewRequest("GET", "/block/1/range?from=3&to=4&withTxs=true", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, "application/x-ndjson", resp.Header().Get("Content-Type"))
+
+		decoder := json.NewDecoder(resp.Body)
+		for _, expectedNonce := range []uint64{3, 4} {
+			item := data.BlockApiResponsePayload{}
+			require.Nil(t, decoder.Decode(&item))
+			assert.Equal(t, expectedNonce, item.Block.Nonce)
+		}
+		assert.False(t, decoder.More())
+	})
+}
@@ -39,7 +39,7 @@ func (bbp *blocksGroup) byRoundHandler(c *gin.Context) {
 		return
 	}
 
-	options, err := parseBlockQueryOptions(c)
+	options, err := parseBlocksQueryOptions(c)
 	if err != nil {
 		shared.RespondWithValidationError(c, apiErrors.ErrBadUrlParams, err)
 		return
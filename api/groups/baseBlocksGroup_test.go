@@ -69,7 +69,7 @@ func TestGetBlocksByRound_InvalidFacadeGetBlocksByRound_ExpectFail(t *testing.T)
 
 	expectedErr := errors.New("local error")
 	bg, _ := groups.NewBlocksGroup(&mock.FacadeStub{
-		GetBlocksByRoundCalled: func(round uint64, options common.BlockQueryOptions) (*data.BlocksApiResponse, error) {
+		GetBlocksByRoundCalled: func(round uint64, options common.BlocksQueryOptions) (*data.BlocksApiResponse, error) {
 			return &data.BlocksApiResponse{}, expectedErr
 		},
 	})
@@ -127,7 +127,7 @@ func TestGetBlocksByRound_ExpectSuccessful(t *testing.T) {
 
 	errGetBlockByRound := errors.New("could not get block by round")
 	bg, _ := groups.NewBlocksGroup(&mock.FacadeStub{
-		GetBlocksByRoundCalled: func(round uint64, _ common.BlockQueryOptions) (*data.BlocksApiResponse, error) {
+		GetBlocksByRoundCalled: func(round uint64, _ common.BlocksQueryOptions) (*data.BlocksApiResponse, error) {
 			if round == 4 {
 				return &data.BlocksApiResponse{
 					Data: data.BlocksApiResponsePayload{
@@ -187,7 +187,7 @@ func TestGetBlocksByRound_DifferentWithTxsQueryParams_ExpectWithTxsFlagIsSetCorr
 
 	for _, currTest := range tests {
 		bg, _ := groups.NewBlocksGroup(&mock.FacadeStub{
-			GetBlocksByRoundCalled: func(_ uint64, options common.BlockQueryOptions) (*data.BlocksApiResponse, error) {
+			GetBlocksByRoundCalled: func(_ uint64, options common.BlocksQueryOptions) (*data.BlocksApiResponse, error) {
 				require.Equal(t, options.WithTransactions, currTest.withTxs)
 				return &data.BlocksApiResponse{}, nil
 			},
@@ -207,3 +207,50 @@ func TestGetBlocksByRound_DifferentWithTxsQueryParams_ExpectWithTxsFlagIsSetCorr
 		require.Empty(t, apiResp.Error)
 	}
 }
+
+func TestGetBlocksByRound_VerifyQueryParam(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid verify should err", func(t *testing.T) {
+		t.Parallel()
+
+		bg, _ := groups.NewBlocksGroup(&mock.FacadeStub{})
+		proxyServer := startProxyServer(bg, blocksPath)
+
+		request, _ := http.NewRequest("GET", "/blocks/by-round/0?verify=invalid_bool", nil)
+		response := httptest.NewRecorder()
+		proxyServer.ServeHTTP(response, request)
+
+		require.Equal(t, http.StatusBadRequest, response.Code)
+	})
+	t.Run("verify should be passed to the facade", func(t *testing.T) {
+		t.Parallel()
+
+		var providedOptions common.BlocksQueryOptions
+		bg, _ := groups.NewBlocksGroup(&mock.FacadeStub{
+			GetBlocksByRoundCalled: func(_ uint64, options common.BlocksQueryOptions) (*data.BlocksApiResponse, error) {
+				providedOptions = options
+				return &data.BlocksApiResponse{
+					Data: data.BlocksApiResponsePayload{
+						Blocks:        []*api.Block{{Hash: "hash"}},
+						MissingShards: []uint32{1},
+						Disagreements: []*data.ShardBlocksDisagreement{{ShardID: 0}},
+					},
+				}, nil
+			},
+		})
+		proxyServer := startProxyServer(bg, blocksPath)
+
+		request, _ := http.NewRequest("GET", "/blocks/by-round/0?withTxs=true&verify=true", nil)
+		response := httptest.NewRecorder()
+		proxyServer.ServeHTTP(response, request)
+
+		apiResp := data.BlocksApiResponse{}
+		loadResponse(response.Body, &apiResp)
+
+		require.Equal(t, http.StatusOK, response.Code)
+		require.Equal(t, common.BlocksQueryOptions{BlockQueryOptions: common.BlockQueryOptions{WithTransactions: true}, Verify: true}, providedOptions)
+		require.Equal(t, []uint32{1}, apiResp.Data.MissingShards)
+		require.Len(t, apiResp.Data.Disagreements, 1)
+	})
+}
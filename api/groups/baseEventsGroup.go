@@ -0,0 +1,108 @@
+package groups
+
+import (
+	goErrors "errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/multiversx/mx-chain-proxy-go/api/errors"
+	"github.com/multiversx/mx-chain-proxy-go/api/shared"
+	"github.com/multiversx/mx-chain-proxy-go/common"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+// numStreamedEventsPerPage is the number of events fetched at once while streaming, unless a limit is provided
+const numStreamedEventsPerPage = 100
+
+type eventsGroup struct {
+	facade EventsFacadeHandler
+	*baseGroup
+}
+
+// NewEventsGroup returns a new instance of eventsGroup
+func NewEventsGroup(facadeHandler data.FacadeHandler) (*eventsGroup, error) {
+	facade, ok := facadeHandler.(EventsFacadeHandler)
+	if !ok {
+		return nil, ErrWrongTypeAssertion
+	}
+
+	eg := &eventsGroup{
+		facade:    facade,
+		baseGroup: &baseGroup{},
+	}
+
+	baseRoutesHandlers := []*data.EndpointHandlerData{
+		{Path: "", Handler: eg.getEvents, Method: http.MethodGet},
+	}
+	eg.baseGroup.endpoints = baseRoutesHandlers
+
+	return eg, nil
+}
+
+// getEvents returns a page of the events matching the filter or, when requested, streams all of them as
+// newline-delimited JSON, fetching them page by page
+func (group *eventsGroup) getEvents(c *gin.Context) {
+	query, err := parseEventsQuery(c)
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrBadUrlParams, err)
+		return
+	}
+
+	stream, err := parseBoolUrlParam(c, common.UrlParameterStream)
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrBadUrlParams, err)
+		return
+	}
+	if stream {
+		group.streamEvents(c, query)
+		return
+	}
+
+	eventsPage, err := group.facade.GetEvents(query)
+	if err != nil {
+		respondWithEventsError(c, err)
+		return
+	}
+
+	shared.RespondWith(c, http.StatusOK, eventsPage, "", data.ReturnCodeSuccess)
+}
+
+func (group *eventsGroup) streamEvents(c *gin.Context, query data.EventsQuery) {
+	if query.Filter.Limit == 0 {
+		query.Filter.Limit = numStreamedEventsPerPage
+	}
+
+	// the first page is fetched before writing anything, so that an invalid filter still gets a regular error response
+	writer := newNDJSONWriter(c)
+	for {
+		eventsPage, err := group.facade.GetEvents(query)
+		if err != nil {
+			writer.fail(err, data.ErrInvalidEventsFilter, data.ErrInvalidEventsCursor)
+			return
+		}
+
+		writer.start()
+		for i := range eventsPage.Events {
+			err = writer.write(&eventsPage.Events[i])
+			if err != nil {
+				return
+			}
+		}
+
+		err = writer.flush()
+		if err != nil || len(eventsPage.NextCursor) == 0 {
+			return
+		}
+
+		query.Filter.Cursor = eventsPage.NextCursor
+	}
+}
+
+func respondWithEventsError(c *gin.Context, err error) {
+	if goErrors.Is(err, data.ErrInvalidEventsFilter) || goErrors.Is(err, data.ErrInvalidEventsCursor) {
+		shared.RespondWith(c, http.StatusBadRequest, nil, err.Error(), data.ReturnCodeRequestError)
+		return
+	}
+
+	shared.RespondWith(c, http.StatusInternalServerError, nil, err.Error(), data.ReturnCodeInternalError)
+}
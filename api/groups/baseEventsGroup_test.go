@@ -0,0 +1,191 @@
+package groups_test
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	apiErrors "github.com/multiversx/mx-chain-proxy-go/api/errors"
+	"github.com/multiversx/mx-chain-proxy-go/api/groups"
+	"github.com/multiversx/mx-chain-proxy-go/api/mock"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/stretchr/testify/require"
+)
+
+const eventsPath = "/events"
+
+type eventsPageResponse struct {
+	Data  data.EventsPage `json:"data"`
+	Error string          `json:"error"`
+	Code  string          `json:"code"`
+}
+
+func TestNewEventsGroup_WrongFacadeShouldErr(t *testing.T) {
+	t.Parallel()
+
+	eg, err := groups.NewEventsGroup(&mock.WrongFacade{})
+	require.Nil(t, eg)
+	require.Equal(t, groups.ErrWrongTypeAssertion, err)
+}
+
+func TestEventsGroup_GetEvents(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid url parameters should err", func(t *testing.T) {
+		t.Parallel()
+
+		eg, _ := groups.NewEventsGroup(&mock.FacadeStub{})
+		ws := startProxyServer(eg, eventsPath)
+
+		for _, params := range []string{"fromNonce=a", "order=sideways", "limit=-1", "stream=maybe"} {
+			req, _ := http.NewRequest("GET", "/events?"+params, nil)
+			resp := httptest.NewRecorder()
+			ws.ServeHTTP(resp, req)
+
+			response := eventsPageResponse{}
+			loadResponse(resp.Body, &response)
+			require.Equal(t, http.StatusBadRequest, resp.Code)
+			require.True(t, strings.Contains(response.Error, apiErrors.ErrBadUrlParams.Error()))
+		}
+	})
+	t.Run("invalid filter should return bad request", func(t *testing.T) {
+		t.Parallel()
+
+		eg, _ := groups.NewEventsGroup(&mock.FacadeStub{
+			GetEventsCalled: func(query data.EventsQuery) (*data.EventsPage, error) {
+				return nil, fmt.Errorf("%w: invalid topic", data.ErrInvalidEventsFilter)
+			},
+		})
+		ws := startProxyServer(eg, eventsPath)
+
+		req, _ := http.NewRequest("GET", "/events?topics=zz", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := eventsPageResponse{}
+		loadResponse(resp.Body, &response)
+		require.Equal(t, http.StatusBadRequest, resp.Code)
+		require.Equal(t, string(data.ReturnCodeRequestError), response.Code)
+	})
+	t.Run("facade error should return internal error", func(t *testing.T) {
+		t.Parallel()
+
+		eg, _ := groups.NewEventsGroup(&mock.FacadeStub{
+			GetEventsCalled: func(query data.EventsQuery) (*data.EventsPage, error) {
+				return nil, errors.New("expected error")
+			},
+		})
+		ws := startProxyServer(eg, eventsPath)
+
+		req, _ := http.NewRequest("GET", "/events", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := eventsPageResponse{}
+		loadResponse(resp.Body, &response)
+		require.Equal(t, http.StatusInternalServerError, resp.Code)
+		require.Equal(t, "expected error", response.Error)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		var providedQuery data.EventsQuery
+		eg, _ := groups.NewEventsGroup(&mock.FacadeStub{
+			GetEventsCalled: func(query data.EventsQuery) (*data.EventsPage, error) {
+				providedQuery = query
+				return &data.EventsPage{
+					Events:     []data.DatabaseEvent{{TxHash: "h1", Identifier: "deposit"}},
+					NextCursor: "next",
+				}, nil
+			},
+		})
+		ws := startProxyServer(eg, eventsPath)
+
+		req, _ := http.NewRequest("GET", "/events?address=erd1contract&identifier=deposit&topics=&topics=str:a,b&topics=0a&fromNonce=5&toNonce=10&order=desc&limit=3&cursor=c", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := eventsPageResponse{}
+		loadResponse(resp.Body, &response)
+		require.Equal(t, http.StatusOK, resp.Code)
+		require.Equal(t, "next", response.Data.NextCursor)
+		require.Len(t, response.Data.Events, 1)
+		require.Equal(t, "h1", response.Data.Events[0].TxHash)
+
+		expectedQuery := data.EventsQuery{
+			Topics: []string{"", "str:a,b", "0a"},
+			Filter: data.EventsFilter{
+				Address:    "erd1contract",
+				Identifier: "deposit",
+				FromBlock:  core.OptionalUint64{Value: 5, HasValue: true},
+				ToBlock:    core.OptionalUint64{Value: 10, HasValue: true},
+				Order:      data.SortOrderDescending,
+				Limit:      3,
+				Cursor:     "c",
+			},
+		}
+		require.Equal(t, expectedQuery, providedQuery)
+	})
+	t.Run("stream should write all the pages as newline-delimited JSON", func(t *testing.T) {
+		t.Parallel()
+
+		providedCursors := make([]string, 0)
+		eg, _ := groups.NewEventsGroup(&mock.FacadeStub{
+			GetEventsCalled: func(query data.EventsQuery) (*data.EventsPage, error) {
+				require.Equal(t, 100, query.Filter.Limit)
+				providedCursors = append(providedCursors, query.Filter.Cursor)
+				switch query.Filter.Cursor {
+				case "":
+					return &data.EventsPage{Events: []data.DatabaseEvent{{TxHash: "h1"}, {TxHash: "h2"}}, NextCursor: "c1"}, nil
+				case "c1":
+					return &data.EventsPage{Events: []data.DatabaseEvent{{TxHash: "h3"}}, NextCursor: "c2"}, nil
+				default:
+					return nil, errors.New("expected error")
+				}
+			},
+		})
+		ws := startProxyServer(eg, eventsPath)
+
+		req, _ := http.NewRequest("GET", "/events?stream=true", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		require.Equal(t, http.StatusOK, resp.Code)
+		require.Equal(t, "application/x-ndjson", resp.Header().Get("Content-Type"))
+		require.Equal(t, []string{"", "c1", "c2"}, providedCursors)
+
+		lines := make([]string, 0)
+		scanner := bufio.NewScanner(resp.Body)
+		for scanner.Scan() {
+			lines = append(lines, scanner.Text())
+		}
+		require.Len(t, lines, 4)
+		require.True(t, strings.Contains(lines[0], `"txHash":"h1"`))
+		require.True(t, strings.Contains(lines[2], `"txHash":"h3"`))
+		require.Equal(t, `{"error":"expected error"}`, lines[3])
+	})
+	t.Run("stream with invalid filter should return bad request", func(t *testing.T) {
+		t.Parallel()
+
+		eg, _ := groups.NewEventsGroup(&mock.FacadeStub{
+			GetEventsCalled: func(query data.EventsQuery) (*data.EventsPage, error) {
+				return nil, data.ErrInvalidEventsCursor
+			},
+		})
+		ws := startProxyServer(eg, eventsPath)
+
+		req, _ := http.NewRequest("GET", "/events?stream=true&cursor=bad", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := eventsPageResponse{}
+		loadResponse(resp.Body, &response)
+		require.Equal(t, http.StatusBadRequest, resp.Code)
+		require.Equal(t, data.ErrInvalidEventsCursor.Error(), response.Error)
+	})
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/gin-gonic/gin"
 	apiErrors "github.com/multiversx/mx-chain-proxy-go/api/errors"
 	"github.com/multiversx/mx-chain-proxy-go/api/shared"
+	"github.com/multiversx/mx-chain-proxy-go/common"
 	"github.com/multiversx/mx-chain-proxy-go/data"
 )
 
@@ -30,6 +31,8 @@ func NewHyperBlockGroup(facadeHandler data.FacadeHandler) (*hyperBlockGroup, err
 	baseRoutesHandlers := []*data.EndpointHandlerData{
 		{Path: "/by-hash/:hash", Handler: hbg.hyperBlockByHashHandler, Method: http.MethodGet},
 		{Path: "/by-nonce/:nonce", Handler: hbg.hyperBlockByNonceHandler, Method: http.MethodGet},
+		{Path: "/range", Handler: hbg.hyperBlocksRangeHandler, Method: http.MethodGet},
+		{Path: "/reorgs", Handler: hbg.hyperBlockReorgsHandler, Method: http.MethodGet},
 	}
 	hbg.baseGroup.endpoints = baseRoutesHandlers
 
@@ -82,3 +85,61 @@ func (group *hyperBlockGroup) hyperBlockByNonceHandler(c *gin.Context) {
 
 	c.JSON(http.StatusOK, blockByNonceResponse)
 }
+
+// hyperBlocksRangeHandler streams the hyperblocks between the "from" and "to" nonces, both included, as newline-delimited JSON
+func (group *hyperBlockGroup) hyperBlocksRangeHandler(c *gin.Context) {
+	from, to, err := parseNoncesRange(c)
+	if err != nil {
+		shared.RespondWithValidationError(c, apiErrors.ErrBadUrlParams, err)
+		return
+	}
+
+	options, err := parseHyperblockQueryOptions(c)
+	if err != nil {
+		shared.RespondWithValidationError(c, apiErrors.ErrBadUrlParams, err)
+		return
+	}
+
+	writer := newNDJSONWriter(c)
+	err = group.facade.GetHyperBlocksRange(from, to, options, writer.writeAndFlush)
+	if err != nil {
+		writer.fail(err, data.ErrInvalidBlocksRange)
+	}
+}
+
+// hyperBlockReorgsHandler returns the most recently detected hyperblock reorgs or, when requested, streams the reorgs
+// detected from now on as newline-delimited JSON, until the client goes away or the proxy shuts down
+func (group *hyperBlockGroup) hyperBlockReorgsHandler(c *gin.Context) {
+	stream, err := parseBoolUrlParam(c, common.UrlParameterStream)
+	if err != nil {
+		shared.RespondWithValidationError(c, apiErrors.ErrBadUrlParams, err)
+		return
+	}
+	if stream {
+		group.streamHyperBlockReorgs(c)
+		return
+	}
+
+	reorgs := group.facade.GetHyperblockReorgs()
+	shared.RespondWith(c, http.StatusOK, data.HyperblockReorgsApiResponsePayload{Reorgs: reorgs}, "", data.ReturnCodeSuccess)
+}
+
+func (group *hyperBlockGroup) streamHyperBlockReorgs(c *gin.Context) {
+	reorgs, unsubscribe := group.facade.SubscribeToHyperblockReorgs()
+	defer unsubscribe()
+
+	writer := newNDJSONWriter(c)
+	writer.start()
+	err := writer.flush()
+	for err == nil {
+		select {
+		case reorg, ok := <-reorgs:
+			if !ok {
+				return
+			}
+			err = writer.writeAndFlush(reorg)
+		case <-c.Request.Context().Done():
+			return
+		}
+	}
+}
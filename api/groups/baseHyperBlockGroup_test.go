@@ -1,16 +1,24 @@
 package groups_test
 
 import (
+	"bufio"
+	"errors"
 	"fmt"
+	"io/ioutil"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/multiversx/mx-chain-core-go/data/api"
+	apiErrors "github.com/multiversx/mx-chain-proxy-go/api/errors"
 	"github.com/multiversx/mx-chain-proxy-go/api/groups"
 	"github.com/multiversx/mx-chain-proxy-go/api/mock"
 	"github.com/multiversx/mx-chain-proxy-go/common"
 	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/multiversx/mx-chain-proxy-go/process"
+	processMock "github.com/multiversx/mx-chain-proxy-go/process/mock"
 	"github.com/stretchr/testify/require"
 )
 
@@ -108,3 +116,211 @@ func doGet(t *testing.T, facade interface{}, url string, response interface{}) i
 	loadResponse(responseRecorder.Body, &response)
 	return responseRecorder.Code
 }
+
+func TestGetHyperblocksRange(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid url parameters should err", func(t *testing.T) {
+		t.Parallel()
+
+		group, _ := groups.NewHyperBlockGroup(&mock.FacadeStub{})
+		ws := startProxyServer(group, hyperBlockPath)
+
+		for _, params := range []string{"", "from=1", "to=1", "from=a&to=2", "from=1&to=2&withLogs=maybe"} {
+			req, _ := http.NewRequest("GET", "/hyperblock/range?"+params, nil)
+			resp := httptest.NewRecorder()
+			ws.ServeHTTP(resp, req)
+
+			response := data.GenericAPIResponse{}
+			loadResponse(resp.Body, &response)
+			require.Equal(t, http.StatusBadRequest, resp.Code)
+			require.True(t, strings.Contains(response.Error, apiErrors.ErrBadUrlParams.Error()))
+		}
+	})
+	t.Run("invalid range should return bad request", func(t *testing.T) {
+		t.Parallel()
+
+		group, _ := groups.NewHyperBlockGroup(&mock.FacadeStub{
+			GetHyperBlocksRangeCalled: func(from uint64, to uint64, options common.HyperblockQueryOptions, handler data.BlocksRangeItemHandler) error {
+				return fmt.Errorf("%w: too large", data.ErrInvalidBlocksRange)
+			},
+		})
+		ws := startProxyServer(group, hyperBlockPath)
+
+		req, _ := http.NewRequest("GET", "/hyperblock/range?from=1&to=1000", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := data.GenericAPIResponse{}
+		loadResponse(resp.Body, &response)
+		require.Equal(t, http.StatusBadRequest, resp.Code)
+		require.Equal(t, data.ReturnCodeRequestError, response.Code)
+	})
+	t.Run("error before the first hyperblock should return internal error", func(t *testing.T) {
+		t.Parallel()
+
+		group, _ := groups.NewHyperBlockGroup(&mock.FacadeStub{
+			GetHyperBlocksRangeCalled: func(from uint64, to uint64, options common.HyperblockQueryOptions, handler data.BlocksRangeItemHandler) error {
+				return errors.New("expected error")
+			},
+		})
+		ws := startProxyServer(group, hyperBlockPath)
+
+		req, _ := http.NewRequest("GET", "/hyperblock/range?from=1&to=2", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := data.GenericAPIResponse{}
+		loadResponse(resp.Body, &response)
+		require.Equal(t, http.StatusInternalServerError, resp.Code)
+		require.Equal(t, "expected error", response.Error)
+	})
+	t.Run("should stream the hyperblocks as newline-delimited JSON", func(t *testing.T) {
+		t.Parallel()
+
+		var providedOptions common.HyperblockQueryOptions
+		group, _ := groups.NewHyperBlockGroup(&mock.FacadeStub{
+			GetHyperBlocksRangeCalled: func(from uint64, to uint64, options common.HyperblockQueryOptions, handler data.BlocksRangeItemHandler) error {
+				require.Equal(t, uint64(5), from)
+				require.Equal(t, uint64(7), to)
+				providedOptions = options
+
+				for nonce := from; nonce < to; nonce++ {
+					err := handler(&data.HyperblockApiResponsePayload{Hyperblock: api.Hyperblock{Nonce: nonce}})
+					require.Nil(t, err)
+				}
+
+				return errors.New("expected error")
+			},
+		})
+		ws := startProxyServer(group, hyperBlockPath)
+
+		req, _ := http.NewRequest("GET", "/hyperblock/range?from=5&to=7&withLogs=true&withAlteredAccounts=true&tokens=TKN-123456", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		require.Equal(t, http.StatusOK, resp.Code)
+		require.Equal(t, "application/x-ndjson", resp.Header().Get("Content-Type"))
+		require.True(t, providedOptions.WithLogs)
+		require.True(t, providedOptions.WithAlteredAccounts)
+		require.Equal(t, "TKN-123456", providedOptions.AlteredAccountsOptions.TokensFilter)
+
+		lines := make([]string, 0)
+		scanner := bufio.NewScanner(resp.Body)
+		for scanner.Scan() {
+			lines = append(lines, scanner.Text())
+		}
+		require.Len(t, lines, 3)
+		require.True(t, strings.Contains(lines[0], `"nonce":5`))
+		require.True(t, strings.Contains(lines[1], `"nonce":6`))
+		require.Equal(t, `{"error":"expected error"}`, lines[2])
+	})
+}
+
+func TestGetHyperblockReorgs(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should return the feed", func(t *testing.T) {
+		t.Parallel()
+
+		group, _ := groups.NewHyperBlockGroup(&mock.FacadeStub{
+			GetHyperblockReorgsCalled: func() []*data.HyperblockReorg {
+				return []*data.HyperblockReorg{{Nonce: 7, PreviousHash: "aa", NewHash: "bb"}}
+			},
+		})
+		ws := startProxyServer(group, hyperBlockPath)
+
+		req, _ := http.NewRequest("GET", "/hyperblock/reorgs", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := struct {
+			Data data.HyperblockReorgsApiResponsePayload `json:"data"`
+		}{}
+		loadResponse(resp.Body, &response)
+		require.Equal(t, http.StatusOK, resp.Code)
+		require.Equal(t, []*data.HyperblockReorg{{Nonce: 7, PreviousHash: "aa", NewHash: "bb"}}, response.Data.Reorgs)
+	})
+	t.Run("invalid stream parameter should err", func(t *testing.T) {
+		t.Parallel()
+
+		group, _ := groups.NewHyperBlockGroup(&mock.FacadeStub{})
+		ws := startProxyServer(group, hyperBlockPath)
+
+		req, _ := http.NewRequest("GET", "/hyperblock/reorgs?stream=maybe", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		require.Equal(t, http.StatusBadRequest, resp.Code)
+	})
+	t.Run("open stream should return when the reorg detector is closed at shutdown", func(t *testing.T) {
+		t.Parallel()
+
+		hrd, _ := process.NewHyperblockReorgDetector(process.ArgsHyperblockReorgDetector{
+			HyperblockProvider:    &processMock.HyperblockProviderStub{},
+			NonceProvider:         &processMock.LatestHyperblockNonceProviderStub{},
+			MaxTrackedHyperblocks: 1,
+			MaxReorgs:             1,
+		})
+		group, _ := groups.NewHyperBlockGroup(&mock.FacadeStub{
+			SubscribeToHyperblockReorgsCalled: hrd.SubscribeToHyperblockReorgs,
+		})
+		server := httptest.NewServer(startProxyServer(group, hyperBlockPath))
+		defer server.Close()
+
+		resp, err := http.Get(server.URL + "/hyperblock/reorgs?stream=true")
+		require.Nil(t, err)
+		defer func() {
+			_ = resp.Body.Close()
+		}()
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+
+		chanStreamEnded := make(chan struct{})
+		go func() {
+			_, _ = ioutil.ReadAll(resp.Body)
+			close(chanStreamEnded)
+		}()
+
+		require.Nil(t, hrd.Close())
+		select {
+		case <-chanStreamEnded:
+		case <-time.After(5 * time.Second):
+			require.Fail(t, "the stream did not end after the reorg detector was closed")
+		}
+	})
+	t.Run("stream should write the reorgs until the subscription ends", func(t *testing.T) {
+		t.Parallel()
+
+		unsubscribed := false
+		group, _ := groups.NewHyperBlockGroup(&mock.FacadeStub{
+			SubscribeToHyperblockReorgsCalled: func() (<-chan *data.HyperblockReorg, func()) {
+				reorgs := make(chan *data.HyperblockReorg, 2)
+				reorgs <- &data.HyperblockReorg{Nonce: 1}
+				reorgs <- &data.HyperblockReorg{Nonce: 2}
+				close(reorgs)
+
+				return reorgs, func() {
+					unsubscribed = true
+				}
+			},
+		})
+		ws := startProxyServer(group, hyperBlockPath)
+
+		req, _ := http.NewRequest("GET", "/hyperblock/reorgs?stream=true", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		require.Equal(t, http.StatusOK, resp.Code)
+		require.Equal(t, "application/x-ndjson", resp.Header().Get("Content-Type"))
+		require.True(t, unsubscribed)
+
+		lines := make([]string, 0)
+		scanner := bufio.NewScanner(resp.Body)
+		for scanner.Scan() {
+			lines = append(lines, scanner.Text())
+		}
+		require.Len(t, lines, 2)
+		require.True(t, strings.Contains(lines[0], `"nonce":1`))
+		require.True(t, strings.Contains(lines[1], `"nonce":2`))
+	})
+}
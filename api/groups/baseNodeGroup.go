@@ -43,7 +43,15 @@ func (group *nodeGroup) getHeartbeatData(c *gin.Context) {
 		return
 	}
 
-	shared.RespondWith(c, http.StatusOK, gin.H{"heartbeats": heartbeatResults.Heartbeats}, "", data.ReturnCodeSuccess)
+	response := gin.H{"heartbeats": heartbeatResults.Heartbeats}
+	if len(heartbeatResults.MissingShards) > 0 {
+		response["missingShards"] = heartbeatResults.MissingShards
+	}
+	if heartbeatResults.CacheAgeSec > 0 {
+		response["cacheAgeSec"] = heartbeatResults.CacheAgeSec
+	}
+
+	shared.RespondWith(c, http.StatusOK, response, "", data.ReturnCodeSuccess)
 }
 
 func (group *nodeGroup) isOldStorageForToken(c *gin.Context) {
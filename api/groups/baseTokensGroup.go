@@ -0,0 +1,96 @@
+package groups
+
+import (
+	goErrors "errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/multiversx/mx-chain-proxy-go/api/errors"
+	"github.com/multiversx/mx-chain-proxy-go/api/shared"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+type tokensGroup struct {
+	facade TokensFacadeHandler
+	*baseGroup
+}
+
+// NewTokensGroup returns a new instance of tokensGroup
+func NewTokensGroup(facadeHandler data.FacadeHandler) (*tokensGroup, error) {
+	facade, ok := facadeHandler.(TokensFacadeHandler)
+	if !ok {
+		return nil, ErrWrongTypeAssertion
+	}
+
+	tg := &tokensGroup{
+		facade:    facade,
+		baseGroup: &baseGroup{},
+	}
+
+	baseRoutesHandlers := []*data.EndpointHandlerData{
+		{Path: "/:identifier", Handler: tg.getTokenProperties, Method: http.MethodGet},
+		{Path: "/:identifier/holders", Handler: tg.getTokenHolders, Method: http.MethodGet},
+		{Path: "/:identifier/transfers", Handler: tg.getTokenTransfers, Method: http.MethodGet},
+	}
+	tg.baseGroup.endpoints = baseRoutesHandlers
+
+	return tg, nil
+}
+
+// getTokenProperties returns the properties and the special roles of the token
+func (group *tokensGroup) getTokenProperties(c *gin.Context) {
+	properties, err := group.facade.GetTokenProperties(c.Param("identifier"))
+	if err != nil {
+		respondWithTokensError(c, err)
+		return
+	}
+
+	shared.RespondWith(c, http.StatusOK, gin.H{"token": properties}, "", data.ReturnCodeSuccess)
+}
+
+// getTokenHolders returns a page of the holders of the token, the highest balance first
+func (group *tokensGroup) getTokenHolders(c *gin.Context) {
+	filter, err := parseTokenHoldersFilter(c)
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrBadUrlParams, err)
+		return
+	}
+
+	holdersPage, err := group.facade.GetTokenHolders(c.Param("identifier"), filter)
+	if err != nil {
+		respondWithTokensError(c, err)
+		return
+	}
+
+	shared.RespondWith(c, http.StatusOK, holdersPage, "", data.ReturnCodeSuccess)
+}
+
+// getTokenTransfers returns a page of the transfers of the token
+func (group *tokensGroup) getTokenTransfers(c *gin.Context) {
+	filter, err := parseTokenTransfersFilter(c)
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrBadUrlParams, err)
+		return
+	}
+
+	transfersPage, err := group.facade.GetTokenTransfers(c.Param("identifier"), filter)
+	if err != nil {
+		respondWithTokensError(c, err)
+		return
+	}
+
+	shared.RespondWith(c, http.StatusOK, transfersPage, "", data.ReturnCodeSuccess)
+}
+
+func respondWithTokensError(c *gin.Context, err error) {
+	switch {
+	case goErrors.Is(err, data.ErrTokenNotFound):
+		shared.RespondWith(c, http.StatusNotFound, nil, err.Error(), data.ReturnCodeRequestError)
+	case goErrors.Is(err, data.ErrInvalidTokenIdentifier),
+		goErrors.Is(err, data.ErrInvalidTokenFilter),
+		goErrors.Is(err, data.ErrInvalidTokenCursor):
+		shared.RespondWith(c, http.StatusBadRequest, nil, err.Error(), data.ReturnCodeRequestError)
+	default:
+		shared.RespondWith(c, http.StatusInternalServerError, nil, err.Error(), data.ReturnCodeInternalError)
+	}
+}
@@ -0,0 +1,279 @@
+package groups_test
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	apiErrors "github.com/multiversx/mx-chain-proxy-go/api/errors"
+	"github.com/multiversx/mx-chain-proxy-go/api/groups"
+	"github.com/multiversx/mx-chain-proxy-go/api/mock"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/stretchr/testify/require"
+)
+
+const tokensPath = "/tokens"
+
+type tokenPropertiesResponseData struct {
+	Token data.TokenProperties `json:"token"`
+}
+
+type tokenPropertiesResponse struct {
+	Data  tokenPropertiesResponseData `json:"data"`
+	Error string                      `json:"error"`
+	Code  string                      `json:"code"`
+}
+
+type tokenHoldersPageResponse struct {
+	Data  data.TokenHoldersPage `json:"data"`
+	Error string                `json:"error"`
+	Code  string                `json:"code"`
+}
+
+type tokenTransfersPageResponse struct {
+	Data  data.TokenTransfersPage `json:"data"`
+	Error string                  `json:"error"`
+	Code  string                  `json:"code"`
+}
+
+func TestNewTokensGroup_WrongFacadeShouldErr(t *testing.T) {
+	t.Parallel()
+
+	tg, err := groups.NewTokensGroup(&mock.WrongFacade{})
+	require.Nil(t, tg)
+	require.Equal(t, groups.ErrWrongTypeAssertion, err)
+}
+
+func TestTokensGroup_GetTokenProperties(t *testing.T) {
+	t.Parallel()
+
+	t.Run("token not found should return not found", func(t *testing.T) {
+		t.Parallel()
+
+		tg, _ := groups.NewTokensGroup(&mock.FacadeStub{
+			GetTokenPropertiesCalled: func(identifier string) (*data.TokenProperties, error) {
+				return nil, data.ErrTokenNotFound
+			},
+		})
+		ws := startProxyServer(tg, tokensPath)
+
+		req, _ := http.NewRequest("GET", "/tokens/TKN-123456", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := tokenPropertiesResponse{}
+		loadResponse(resp.Body, &response)
+		require.Equal(t, http.StatusNotFound, resp.Code)
+		require.Equal(t, data.ErrTokenNotFound.Error(), response.Error)
+	})
+	t.Run("invalid identifier should return bad request", func(t *testing.T) {
+		t.Parallel()
+
+		tg, _ := groups.NewTokensGroup(&mock.FacadeStub{
+			GetTokenPropertiesCalled: func(identifier string) (*data.TokenProperties, error) {
+				return nil, fmt.Errorf("%w: %s", data.ErrInvalidTokenIdentifier, identifier)
+			},
+		})
+		ws := startProxyServer(tg, tokensPath)
+
+		req, _ := http.NewRequest("GET", "/tokens/invalid", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := tokenPropertiesResponse{}
+		loadResponse(resp.Body, &response)
+		require.Equal(t, http.StatusBadRequest, resp.Code)
+		require.Equal(t, string(data.ReturnCodeRequestError), response.Code)
+	})
+	t.Run("facade error should return internal error", func(t *testing.T) {
+		t.Parallel()
+
+		tg, _ := groups.NewTokensGroup(&mock.FacadeStub{
+			GetTokenPropertiesCalled: func(identifier string) (*data.TokenProperties, error) {
+				return nil, errors.New("expected error")
+			},
+		})
+		ws := startProxyServer(tg, tokensPath)
+
+		req, _ := http.NewRequest("GET", "/tokens/TKN-123456", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := tokenPropertiesResponse{}
+		loadResponse(resp.Body, &response)
+		require.Equal(t, http.StatusInternalServerError, resp.Code)
+		require.Equal(t, "expected error", response.Error)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		expectedProperties := data.TokenProperties{
+			Identifier: "TKN-123456",
+			Name:       "Token",
+			Type:       "FungibleESDT",
+			Decimals:   6,
+			Properties: map[string]string{"CanMint": "true"},
+			Roles:      map[string][]string{"erd1minter": {"ESDTRoleLocalMint"}},
+		}
+		tg, _ := groups.NewTokensGroup(&mock.FacadeStub{
+			GetTokenPropertiesCalled: func(identifier string) (*data.TokenProperties, error) {
+				require.Equal(t, "TKN-123456", identifier)
+				return &expectedProperties, nil
+			},
+		})
+		ws := startProxyServer(tg, tokensPath)
+
+		req, _ := http.NewRequest("GET", "/tokens/TKN-123456", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := tokenPropertiesResponse{}
+		loadResponse(resp.Body, &response)
+		require.Equal(t, http.StatusOK, resp.Code)
+		require.Equal(t, expectedProperties, response.Data.Token)
+	})
+}
+
+func TestTokensGroup_GetTokenHolders(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid url parameters should err", func(t *testing.T) {
+		t.Parallel()
+
+		tg, _ := groups.NewTokensGroup(&mock.FacadeStub{})
+		ws := startProxyServer(tg, tokensPath)
+
+		req, _ := http.NewRequest("GET", "/tokens/TKN-123456/holders?limit=a", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := tokenHoldersPageResponse{}
+		loadResponse(resp.Body, &response)
+		require.Equal(t, http.StatusBadRequest, resp.Code)
+		require.True(t, strings.Contains(response.Error, apiErrors.ErrBadUrlParams.Error()))
+	})
+	t.Run("invalid cursor should return bad request", func(t *testing.T) {
+		t.Parallel()
+
+		tg, _ := groups.NewTokensGroup(&mock.FacadeStub{
+			GetTokenHoldersCalled: func(identifier string, filter data.TokenHoldersFilter) (*data.TokenHoldersPage, error) {
+				return nil, data.ErrInvalidTokenCursor
+			},
+		})
+		ws := startProxyServer(tg, tokensPath)
+
+		req, _ := http.NewRequest("GET", "/tokens/TKN-123456/holders?cursor=bad", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		require.Equal(t, http.StatusBadRequest, resp.Code)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		var providedFilter data.TokenHoldersFilter
+		tg, _ := groups.NewTokensGroup(&mock.FacadeStub{
+			GetTokenHoldersCalled: func(identifier string, filter data.TokenHoldersFilter) (*data.TokenHoldersPage, error) {
+				require.Equal(t, "TKN-123456", identifier)
+				providedFilter = filter
+				return &data.TokenHoldersPage{
+					Holders:    []data.TokenHolder{{Address: "erd1alice", Balance: "1500000", Amount: "1.5"}},
+					NextCursor: "next",
+				}, nil
+			},
+		})
+		ws := startProxyServer(tg, tokensPath)
+
+		req, _ := http.NewRequest("GET", "/tokens/TKN-123456/holders?limit=5&cursor=c", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := tokenHoldersPageResponse{}
+		loadResponse(resp.Body, &response)
+		require.Equal(t, http.StatusOK, resp.Code)
+		require.Equal(t, "next", response.Data.NextCursor)
+		require.Equal(t, []data.TokenHolder{{Address: "erd1alice", Balance: "1500000", Amount: "1.5"}}, response.Data.Holders)
+		require.Equal(t, data.TokenHoldersFilter{Limit: 5, Cursor: "c"}, providedFilter)
+	})
+}
+
+func TestTokensGroup_GetTokenTransfers(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid url parameters should err", func(t *testing.T) {
+		t.Parallel()
+
+		tg, _ := groups.NewTokensGroup(&mock.FacadeStub{})
+		ws := startProxyServer(tg, tokensPath)
+
+		for _, params := range []string{"fromNonce=a", "toNonce=-1", "order=sideways", "limit=-1"} {
+			req, _ := http.NewRequest("GET", "/tokens/TKN-123456/transfers?"+params, nil)
+			resp := httptest.NewRecorder()
+			ws.ServeHTTP(resp, req)
+
+			response := tokenTransfersPageResponse{}
+			loadResponse(resp.Body, &response)
+			require.Equal(t, http.StatusBadRequest, resp.Code)
+			require.True(t, strings.Contains(response.Error, apiErrors.ErrBadUrlParams.Error()))
+		}
+	})
+	t.Run("invalid filter should return bad request", func(t *testing.T) {
+		t.Parallel()
+
+		tg, _ := groups.NewTokensGroup(&mock.FacadeStub{
+			GetTokenTransfersCalled: func(identifier string, filter data.TokenTransfersFilter) (*data.TokenTransfersPage, error) {
+				return nil, data.ErrInvalidTokenFilter
+			},
+		})
+		ws := startProxyServer(tg, tokensPath)
+
+		req, _ := http.NewRequest("GET", "/tokens/TKN-123456/transfers?fromNonce=10&toNonce=5", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		require.Equal(t, http.StatusBadRequest, resp.Code)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		var providedFilter data.TokenTransfersFilter
+		tg, _ := groups.NewTokensGroup(&mock.FacadeStub{
+			GetTokenTransfersCalled: func(identifier string, filter data.TokenTransfersFilter) (*data.TokenTransfersPage, error) {
+				require.Equal(t, "NFT-abcdef-05", identifier)
+				providedFilter = filter
+				return &data.TokenTransfersPage{
+					Transfers: []data.TokenTransfer{
+						{DatabaseTokenTransfer: data.DatabaseTokenTransfer{TxHash: "h1", Token: "NFT-abcdef", Nonce: 5, Value: "1"}, Amount: "1"},
+					},
+					NextCursor: "next",
+				}, nil
+			},
+		})
+		ws := startProxyServer(tg, tokensPath)
+
+		req, _ := http.NewRequest("GET", "/tokens/NFT-abcdef-05/transfers?fromNonce=5&toNonce=10&order=desc&limit=3&cursor=c", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := tokenTransfersPageResponse{}
+		loadResponse(resp.Body, &response)
+		require.Equal(t, http.StatusOK, resp.Code)
+		require.Equal(t, "next", response.Data.NextCursor)
+		require.Len(t, response.Data.Transfers, 1)
+		require.Equal(t, "h1", response.Data.Transfers[0].TxHash)
+		require.Equal(t, "1", response.Data.Transfers[0].Amount)
+
+		expectedFilter := data.TokenTransfersFilter{
+			FromBlock: core.OptionalUint64{Value: 5, HasValue: true},
+			ToBlock:   core.OptionalUint64{Value: 10, HasValue: true},
+			Order:     data.SortOrderDescending,
+			Limit:     3,
+			Cursor:    "c",
+		}
+		require.Equal(t, expectedFilter, providedFilter)
+	})
+}
@@ -1,6 +1,7 @@
 package groups
 
 import (
+	goErrors "errors"
 	"fmt"
 	"net/http"
 	"strconv"
@@ -34,7 +35,10 @@ func NewTransactionGroup(facadeHandler data.FacadeHandler) (*transactionGroup, e
 		{Path: "/simulate", Handler: tg.simulateTransaction, Method: http.MethodPost},
 		{Path: "/send-multiple", Handler: tg.sendMultipleTransactions, Method: http.MethodPost},
 		{Path: "/send-user-funds", Handler: tg.sendUserFunds, Method: http.MethodPost},
+		{Path: "/faucet/disbursements", Handler: tg.getFaucetDisbursements, Method: http.MethodGet},
+		{Path: "/faucet/tokens", Handler: tg.getFaucetTokens, Method: http.MethodGet},
 		{Path: "/cost", Handler: tg.requestTransactionCost, Method: http.MethodPost},
+		{Path: "/fee-estimate", Handler: tg.getTransactionFeeEstimate, Method: http.MethodGet},
 		{Path: "/:txhash/status", Handler: tg.getTransactionStatus, Method: http.MethodGet},
 		{Path: "/:txhash/process-status", Handler: tg.getProcessedTransactionStatus, Method: http.MethodGet},
 		{Path: "/:txhash", Handler: tg.getTransaction, Method: http.MethodGet},
@@ -95,11 +99,11 @@ func (group *transactionGroup) sendUserFunds(c *gin.Context) {
 		return
 	}
 
-	err = group.facade.SendUserFunds(gtx.Receiver, gtx.Value)
+	txHash, err := group.facade.SendUserFunds(&gtx, c.ClientIP())
 	if err != nil {
 		shared.RespondWith(
 			c,
-			http.StatusInternalServerError,
+			getSendUserFundsErrorStatusCode(err),
 			nil,
 			fmt.Sprintf("%s: %s", errors.ErrTxGenerationFailed.Error(), err.Error()),
 			data.ReturnCodeRequestError,
@@ -107,7 +111,69 @@ func (group *transactionGroup) sendUserFunds(c *gin.Context) {
 		return
 	}
 
-	shared.RespondWith(c, http.StatusOK, gin.H{"message": "ok"}, "", data.ReturnCodeSuccess)
+	shared.RespondWith(c, http.StatusOK, gin.H{"message": "ok", "txHash": txHash}, "", data.ReturnCodeSuccess)
+}
+
+func getSendUserFundsErrorStatusCode(err error) int {
+	switch {
+	case goErrors.Is(err, data.ErrFaucetRateLimited):
+		return http.StatusTooManyRequests
+	case goErrors.Is(err, data.ErrFaucetRequestRejected):
+		return http.StatusBadRequest
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+// getFaucetTokens returns the tokens dispensed by the faucet, along with the amount sent for each request
+func (group *transactionGroup) getFaucetTokens(c *gin.Context) {
+	if !group.facade.IsFaucetEnabled() {
+		shared.RespondWith(
+			c,
+			http.StatusBadRequest,
+			nil,
+			errors.ErrFaucetNotEnabled.Error(),
+			data.ReturnCodeRequestError,
+		)
+		return
+	}
+
+	shared.RespondWith(c, http.StatusOK, gin.H{"tokens": group.facade.GetFaucetTokens()}, "", data.ReturnCodeSuccess)
+}
+
+// getFaucetDisbursements returns the disbursements recorded by the faucet, optionally filtered by receiver, client IP
+// and time interval
+func (group *transactionGroup) getFaucetDisbursements(c *gin.Context) {
+	if !group.facade.IsFaucetEnabled() {
+		shared.RespondWith(
+			c,
+			http.StatusBadRequest,
+			nil,
+			errors.ErrFaucetNotEnabled.Error(),
+			data.ReturnCodeRequestError,
+		)
+		return
+	}
+
+	filter, err := parseFaucetDisbursementsFilter(c)
+	if err != nil {
+		shared.RespondWith(
+			c,
+			http.StatusBadRequest,
+			nil,
+			fmt.Sprintf("%s: %s", errors.ErrBadUrlParams.Error(), err.Error()),
+			data.ReturnCodeRequestError,
+		)
+		return
+	}
+
+	disbursements, err := group.facade.GetFaucetDisbursements(filter)
+	if err != nil {
+		shared.RespondWith(c, http.StatusInternalServerError, nil, err.Error(), data.ReturnCodeInternalError)
+		return
+	}
+
+	shared.RespondWith(c, http.StatusOK, gin.H{"disbursements": disbursements}, "", data.ReturnCodeSuccess)
 }
 
 // sendMultipleTransactions will send multiple transactions at once
@@ -206,6 +272,55 @@ func (group *transactionGroup) requestTransactionCost(c *gin.Context) {
 	shared.RespondWith(c, http.StatusOK, cost, "", data.ReturnCodeSuccess)
 }
 
+// getTransactionFeeEstimate will return the suggested gas prices and the corresponding fees for a transaction
+func (group *transactionGroup) getTransactionFeeEstimate(c *gin.Context) {
+	var request = data.TransactionFeeEstimateRequest{}
+	err := c.ShouldBindQuery(&request)
+	if err != nil {
+		shared.RespondWith(
+			c,
+			http.StatusBadRequest,
+			nil,
+			fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), err.Error()),
+			data.ReturnCodeRequestError,
+		)
+		return
+	}
+	if len(request.Sender) == 0 || len(request.Receiver) == 0 {
+		shared.RespondWith(
+			c,
+			http.StatusBadRequest,
+			nil,
+			fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), errors.ErrEmptyAddress.Error()),
+			data.ReturnCodeRequestError,
+		)
+		return
+	}
+
+	tx := &data.Transaction{
+		Sender:       request.Sender,
+		Receiver:     request.Receiver,
+		Value:        request.Value,
+		Data:         []byte(request.Data),
+		GuardianAddr: request.Guardian,
+	}
+	if len(tx.Value) == 0 {
+		tx.Value = "0"
+	}
+
+	feeEstimate, err := group.facade.GetTransactionFeeEstimate(tx)
+	if goErrors.Is(err, data.ErrCannotEstimateTransactionFee) {
+		shared.RespondWith(c, http.StatusBadRequest, nil, err.Error(), data.ReturnCodeRequestError)
+		return
+	}
+	if err != nil {
+		shared.RespondWith(c, http.StatusInternalServerError, nil, err.Error(), data.ReturnCodeInternalError)
+		return
+	}
+
+	shared.RespondWith(c, http.StatusOK, feeEstimate, "", data.ReturnCodeSuccess)
+}
+
 // getTransactionStatus will return the transaction's status
 func (group *transactionGroup) getTransactionStatus(c *gin.Context) {
 	txHash := c.Param("txhash")
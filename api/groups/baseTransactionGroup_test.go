@@ -406,8 +406,8 @@ func TestSendUserFunds_ErrorWhenFacadeSendUserFundsError(t *testing.T) {
 	errorString := "send user funds error"
 
 	facade := &mock.FacadeStub{
-		SendUserFundsCalled: func(receiver string, value *big.Int) error {
-			return errors.New(errorString)
+		SendUserFundsCalled: func(request *data.FundsRequest, clientIP string) (string, error) {
+			return "", errors.New(errorString)
 		},
 	}
 
@@ -436,8 +436,8 @@ func TestSendUserFunds_ReturnsSuccessfully(t *testing.T) {
 	receiver := "05702a5fd947a9ddb861ce7ffebfea86c2ca8906df3065ae295f283477ae4e43"
 
 	facade := &mock.FacadeStub{
-		SendUserFundsCalled: func(receiver string, value *big.Int) error {
-			return nil
+		SendUserFundsCalled: func(request *data.FundsRequest, clientIP string) (string, error) {
+			return "txHash", nil
 		},
 	}
 
@@ -453,11 +453,18 @@ func TestSendUserFunds_ReturnsSuccessfully(t *testing.T) {
 	resp := httptest.NewRecorder()
 	ws.ServeHTTP(resp, req)
 
-	response := GeneralResponse{}
+	type sendUserFundsResponse struct {
+		Data struct {
+			TxHash string `json:"txHash"`
+		} `json:"data"`
+		Error string `json:"error"`
+	}
+	response := sendUserFundsResponse{}
 	loadResponse(resp.Body, &response)
 
 	assert.Equal(t, http.StatusOK, resp.Code)
 	assert.Equal(t, response.Error, "")
+	assert.Equal(t, "txHash", response.Data.TxHash)
 }
 
 func TestSendUserFunds_NilValue(t *testing.T) {
@@ -467,9 +474,9 @@ func TestSendUserFunds_NilValue(t *testing.T) {
 
 	var callValue *big.Int
 	facade := &mock.FacadeStub{
-		SendUserFundsCalled: func(receiver string, value *big.Int) error {
-			callValue = value
-			return nil
+		SendUserFundsCalled: func(request *data.FundsRequest, clientIP string) (string, error) {
+			callValue = request.Value
+			return "", nil
 		},
 	}
 
@@ -498,9 +505,9 @@ func TestSendUserFunds_CorrectValue(t *testing.T) {
 
 	var callValue *big.Int
 	facade := &mock.FacadeStub{
-		SendUserFundsCalled: func(receiver string, value *big.Int) error {
-			callValue = value
-			return nil
+		SendUserFundsCalled: func(request *data.FundsRequest, clientIP string) (string, error) {
+			callValue = request.Value
+			return "", nil
 		},
 	}
 	transactionsGroup, err := groups.NewTransactionGroup(facade)
@@ -522,6 +529,324 @@ func TestSendUserFunds_CorrectValue(t *testing.T) {
 	assert.Equal(t, expectedValue, callValue)
 }
 
+func TestSendUserFunds_ShouldForwardTheChallengeTokenAndTheClientIP(t *testing.T) {
+	t.Parallel()
+
+	receiver := "05702a5fd947a9ddb861ce7ffebfea86c2ca8906df3065ae295f283477ae4e43"
+
+	var callRequest *data.FundsRequest
+	var callClientIP string
+	facade := &mock.FacadeStub{
+		SendUserFundsCalled: func(request *data.FundsRequest, clientIP string) (string, error) {
+			callRequest = request
+			callClientIP = clientIP
+			return "", nil
+		},
+	}
+	transactionsGroup, err := groups.NewTransactionGroup(facade)
+	require.NoError(t, err)
+	ws := startProxyServer(transactionsGroup, transactionsPath)
+
+	jsonStr := fmt.Sprintf(`{"receiver":"%s", "challengeToken": "token"}`, receiver)
+	req, _ := http.NewRequest("POST", "/transaction/send-user-funds", bytes.NewBuffer([]byte(jsonStr)))
+	req.RemoteAddr = "10.0.0.1:1234"
+
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, receiver, callRequest.Receiver)
+	assert.Equal(t, "token", callRequest.ChallengeToken)
+	assert.Equal(t, "10.0.0.1", callClientIP)
+}
+
+func TestSendUserFunds_RejectedRequestsShouldReturnTheProperStatusCode(t *testing.T) {
+	t.Parallel()
+
+	receiver := "05702a5fd947a9ddb861ce7ffebfea86c2ca8906df3065ae295f283477ae4e43"
+	testStatusCode := func(sendErr error, expectedStatusCode int) {
+		facade := &mock.FacadeStub{
+			SendUserFundsCalled: func(request *data.FundsRequest, clientIP string) (string, error) {
+				return "", sendErr
+			},
+		}
+		transactionsGroup, err := groups.NewTransactionGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(transactionsGroup, transactionsPath)
+
+		jsonStr := fmt.Sprintf(`{"receiver":"%s"}`, receiver)
+		req, _ := http.NewRequest("POST", "/transaction/send-user-funds", bytes.NewBuffer([]byte(jsonStr)))
+
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := GeneralResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, expectedStatusCode, resp.Code)
+		assert.Contains(t, response.Error, sendErr.Error())
+	}
+
+	testStatusCode(fmt.Errorf("%w: receiver funded too recently", data.ErrFaucetRateLimited), http.StatusTooManyRequests)
+	testStatusCode(fmt.Errorf("%w: invalid challenge token", data.ErrFaucetRequestRejected), http.StatusBadRequest)
+}
+
+func TestGetFaucetDisbursements(t *testing.T) {
+	t.Parallel()
+
+	t.Run("faucet not enabled should err", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			IsFaucetEnabledHandler: func() bool {
+				return false
+			},
+		}
+		transactionsGroup, err := groups.NewTransactionGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(transactionsGroup, transactionsPath)
+
+		req, _ := http.NewRequest("GET", "/transaction/faucet/disbursements", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := GeneralResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.Equal(t, apiErrors.ErrFaucetNotEnabled.Error(), response.Error)
+	})
+
+	t.Run("invalid url params should err", func(t *testing.T) {
+		t.Parallel()
+
+		transactionsGroup, err := groups.NewTransactionGroup(&mock.FacadeStub{})
+		require.NoError(t, err)
+		ws := startProxyServer(transactionsGroup, transactionsPath)
+
+		req, _ := http.NewRequest("GET", "/transaction/faucet/disbursements?from=yesterday", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := GeneralResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.Contains(t, response.Error, apiErrors.ErrBadUrlParams.Error())
+	})
+
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		expectedFilter := data.FaucetDisbursementsFilter{
+			Receiver: "erd1receiver",
+			ClientIP: "10.0.0.1",
+			From:     100,
+			To:       200,
+			Limit:    5,
+		}
+		disbursements := []*data.FaucetDisbursement{
+			{Timestamp: 150, Receiver: "erd1receiver", ClientIP: "10.0.0.1", Value: "10", Sender: "erd1sender", TxHash: "hash"},
+		}
+		facade := &mock.FacadeStub{
+			GetFaucetDisbursementsCalled: func(filter data.FaucetDisbursementsFilter) ([]*data.FaucetDisbursement, error) {
+				assert.Equal(t, expectedFilter, filter)
+				return disbursements, nil
+			},
+		}
+		transactionsGroup, err := groups.NewTransactionGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(transactionsGroup, transactionsPath)
+
+		req, _ := http.NewRequest("GET", "/transaction/faucet/disbursements?receiver=erd1receiver&ip=10.0.0.1&from=100&to=200&limit=5", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		type disbursementsResponse struct {
+			Data struct {
+				Disbursements []*data.FaucetDisbursement `json:"disbursements"`
+			} `json:"data"`
+			Error string `json:"error"`
+		}
+		response := disbursementsResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, disbursements, response.Data.Disbursements)
+	})
+}
+
+func TestGetFaucetTokens(t *testing.T) {
+	t.Parallel()
+
+	t.Run("faucet not enabled should err", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			IsFaucetEnabledHandler: func() bool {
+				return false
+			},
+		}
+		transactionsGroup, err := groups.NewTransactionGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(transactionsGroup, transactionsPath)
+
+		req, _ := http.NewRequest("GET", "/transaction/faucet/tokens", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := GeneralResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.Equal(t, apiErrors.ErrFaucetNotEnabled.Error(), response.Error)
+	})
+
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		tokens := []*data.FaucetToken{
+			{Identifier: "TST-abcdef", TokenIdentifier: "TST-abcdef", Amount: "1000"},
+			{Identifier: "SFT-abcdef-01", TokenIdentifier: "SFT-abcdef", Nonce: 1, Amount: "10"},
+		}
+		facade := &mock.FacadeStub{
+			GetFaucetTokensCalled: func() []*data.FaucetToken {
+				return tokens
+			},
+		}
+		transactionsGroup, err := groups.NewTransactionGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(transactionsGroup, transactionsPath)
+
+		req, _ := http.NewRequest("GET", "/transaction/faucet/tokens", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		type tokensResponse struct {
+			Data struct {
+				Tokens []*data.FaucetToken `json:"tokens"`
+			} `json:"data"`
+			Error string `json:"error"`
+		}
+		response := tokensResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, tokens, response.Data.Tokens)
+	})
+}
+
+func TestGetTransactionFeeEstimate(t *testing.T) {
+	t.Parallel()
+
+	t.Run("missing receiver should err", func(t *testing.T) {
+		t.Parallel()
+
+		transactionsGroup, err := groups.NewTransactionGroup(&mock.FacadeStub{})
+		require.NoError(t, err)
+		ws := startProxyServer(transactionsGroup, transactionsPath)
+
+		req, _ := http.NewRequest("GET", "/transaction/fee-estimate?sender=erd1sender", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := GeneralResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.Contains(t, response.Error, apiErrors.ErrEmptyAddress.Error())
+	})
+
+	t.Run("facade error should err", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		facade := &mock.FacadeStub{
+			GetTransactionFeeEstimateCalled: func(tx *data.Transaction) (*data.TransactionFeeEstimate, error) {
+				return nil, expectedErr
+			},
+		}
+		transactionsGroup, err := groups.NewTransactionGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(transactionsGroup, transactionsPath)
+
+		req, _ := http.NewRequest("GET", "/transaction/fee-estimate?sender=erd1sender&receiver=erd1receiver", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := GeneralResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusInternalServerError, resp.Code)
+		assert.Equal(t, expectedErr.Error(), response.Error)
+	})
+
+	t.Run("transaction fee not estimable should return bad request", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			GetTransactionFeeEstimateCalled: func(tx *data.Transaction) (*data.TransactionFeeEstimate, error) {
+				return nil, fmt.Errorf("%w: invalid sender", data.ErrCannotEstimateTransactionFee)
+			},
+		}
+		transactionsGroup, err := groups.NewTransactionGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(transactionsGroup, transactionsPath)
+
+		req, _ := http.NewRequest("GET", "/transaction/fee-estimate?sender=erd1sender&receiver=erd1receiver", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := GeneralResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.Contains(t, response.Error, data.ErrCannotEstimateTransactionFee.Error())
+	})
+
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		suggestion := &data.GasPriceSuggestion{GasPrice: 1000000000, Fee: "50000000000000", FeeDenominated: "0.00005"}
+		feeEstimate := &data.TransactionFeeEstimate{
+			GasUnits:         50000,
+			EstimationMethod: data.TxCostEstimationMethodLocal,
+			Low:              suggestion,
+			Normal:           suggestion,
+			Fast:             suggestion,
+		}
+		facade := &mock.FacadeStub{
+			GetTransactionFeeEstimateCalled: func(tx *data.Transaction) (*data.TransactionFeeEstimate, error) {
+				assert.Equal(t, &data.Transaction{
+					Sender:       "erd1sender",
+					Receiver:     "erd1receiver",
+					Value:        "0",
+					Data:         []byte("hello"),
+					GuardianAddr: "erd1guardian",
+				}, tx)
+				return feeEstimate, nil
+			},
+		}
+		transactionsGroup, err := groups.NewTransactionGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(transactionsGroup, transactionsPath)
+
+		req, _ := http.NewRequest("GET", "/transaction/fee-estimate?sender=erd1sender&receiver=erd1receiver&data=hello&guardian=erd1guardian", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		type feeEstimateResponse struct {
+			Data  *data.TransactionFeeEstimate `json:"data"`
+			Error string                       `json:"error"`
+		}
+		response := feeEstimateResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, feeEstimate, response.Data)
+	})
+}
+
 func TestSendUserFunds_FaucetNotEnabled(t *testing.T) {
 	t.Parallel()
 
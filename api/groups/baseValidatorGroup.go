@@ -41,5 +41,10 @@ func (group *validatorGroup) statistics(c *gin.Context) {
 		return
 	}
 
-	shared.RespondWith(c, http.StatusOK, gin.H{"statistics": validatorStatistics}, "", data.ReturnCodeSuccess)
+	response := gin.H{"statistics": validatorStatistics.Statistics}
+	if validatorStatistics.CacheAgeSec > 0 {
+		response["cacheAgeSec"] = validatorStatistics.CacheAgeSec
+	}
+
+	shared.RespondWith(c, http.StatusOK, response, "", data.ReturnCodeSuccess)
 }
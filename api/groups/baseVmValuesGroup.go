@@ -9,6 +9,7 @@ import (
 	"github.com/multiversx/mx-chain-core-go/data/vm"
 	apiErrors "github.com/multiversx/mx-chain-proxy-go/api/errors"
 	"github.com/multiversx/mx-chain-proxy-go/api/shared"
+	"github.com/multiversx/mx-chain-proxy-go/common"
 	"github.com/multiversx/mx-chain-proxy-go/data"
 )
 
@@ -45,6 +46,11 @@ func NewVmValuesGroup(facadeHandler data.FacadeHandler) (*vmValuesGroup, error)
 		{Path: "/string", Handler: vvg.getString, Method: http.MethodPost},
 		{Path: "/int", Handler: vvg.getInt, Method: http.MethodPost},
 		{Path: "/query", Handler: vvg.executeQuery, Method: http.MethodPost},
+		{Path: "/query-multiple", Handler: vvg.executeQueries, Method: http.MethodPost},
+		{Path: "/abi/query", Handler: vvg.executeABIQuery, Method: http.MethodPost},
+		{Path: "/abi/encode", Handler: vvg.encodeABIArguments, Method: http.MethodPost},
+		{Path: "/abi/call-data", Handler: vvg.buildABICallData, Method: http.MethodPost},
+		{Path: "/abi/decode", Handler: vvg.decodeABIResults, Method: http.MethodPost},
 	}
 	vvg.baseGroup.endpoints = baseRoutesHandlers
 
@@ -67,7 +73,7 @@ func (group *vmValuesGroup) getInt(context *gin.Context) {
 }
 
 func (group *vmValuesGroup) doGetVMValue(context *gin.Context, asType vm.ReturnDataKind) {
-	vmOutput, err := group.doExecuteQuery(context)
+	vmOutput, blockInfo, err := group.doExecuteQuery(context)
 
 	if err != nil {
 		returnBadRequest(context, "doGetVMValue", err)
@@ -80,38 +86,166 @@ func (group *vmValuesGroup) doGetVMValue(context *gin.Context, asType vm.ReturnD
 		return
 	}
 
-	returnOkResponse(context, returnData)
+	returnOkResponseWithBlockInfo(context, returnData, blockInfo)
 }
 
 // executeQuery returns the data as string
 func (group *vmValuesGroup) executeQuery(context *gin.Context) {
-	vmOutput, err := group.doExecuteQuery(context)
+	vmOutput, blockInfo, err := group.doExecuteQuery(context)
 	if err != nil {
 		returnBadRequest(context, "executeQuery", err)
 		return
 	}
 
-	returnOkResponse(context, vmOutput)
+	returnOkResponseWithBlockInfo(context, vmOutput, blockInfo)
 }
 
-func (group *vmValuesGroup) doExecuteQuery(context *gin.Context) (*vm.VMOutputApi, error) {
+// executeQueries executes a batch of queries and returns the results, or the errors, in the same order. The historical
+// options of the URL apply to all the queries
+func (group *vmValuesGroup) executeQueries(context *gin.Context) {
+	var requests []*VMValueRequest
+	err := context.ShouldBindJSON(&requests)
+	if err != nil {
+		returnBadRequest(context, "executeQueries", apiErrors.ErrInvalidJSONRequest)
+		return
+	}
+
+	options, err := parseVmQueryOptions(context)
+	if err != nil {
+		returnBadRequest(context, "executeQueries", fmt.Errorf("%w: %s", apiErrors.ErrBadUrlParams, err.Error()))
+		return
+	}
+
+	queries := make([]*data.SCQuery, 0, len(requests))
+	for idx, request := range requests {
+		if request == nil {
+			returnBadRequest(context, "executeQueries", fmt.Errorf("query %d: %w", idx, apiErrors.ErrInvalidJSONRequest))
+			return
+		}
+
+		query, errCreate := createSCQuery(request)
+		if errCreate != nil {
+			returnBadRequest(context, "executeQueries", fmt.Errorf("query %d: %w", idx, errCreate))
+			return
+		}
+
+		applyVmQueryOptions(query, options)
+		queries = append(queries, query)
+	}
+
+	results, err := group.facade.ExecuteSCQueries(queries)
+	if err != nil {
+		returnBadRequest(context, "executeQueries", err)
+		return
+	}
+
+	shared.RespondWith(context, http.StatusOK, gin.H{"results": results}, "", data.ReturnCodeSuccess)
+}
+
+// executeABIQuery executes a query whose arguments and results are encoded based on the contract's ABI
+func (group *vmValuesGroup) executeABIQuery(context *gin.Context) {
+	request := &data.ABIQueryRequest{}
+	err := context.ShouldBindJSON(request)
+	if err != nil {
+		returnBadRequest(context, "executeABIQuery", apiErrors.ErrInvalidJSONRequest)
+		return
+	}
+
+	options, err := parseVmQueryOptions(context)
+	if err != nil {
+		returnBadRequest(context, "executeABIQuery", fmt.Errorf("%w: %s", apiErrors.ErrBadUrlParams, err.Error()))
+		return
+	}
+
+	response, blockInfo, err := group.facade.ExecuteABIQuery(request, options)
+	if err != nil {
+		returnBadRequest(context, "executeABIQuery", err)
+		return
+	}
+
+	returnOkResponseWithBlockInfo(context, response, blockInfo)
+}
+
+// encodeABIArguments returns the hex encoded arguments of a smart contract endpoint
+func (group *vmValuesGroup) encodeABIArguments(context *gin.Context) {
+	request := &data.ABIArgumentsRequest{}
+	err := context.ShouldBindJSON(request)
+	if err != nil {
+		returnBadRequest(context, "encodeABIArguments", apiErrors.ErrInvalidJSONRequest)
+		return
+	}
+
+	args, err := group.facade.EncodeABIArguments(request)
+	if err != nil {
+		returnBadRequest(context, "encodeABIArguments", err)
+		return
+	}
+
+	shared.RespondWith(context, http.StatusOK, gin.H{"args": args}, "", data.ReturnCodeSuccess)
+}
+
+// buildABICallData returns the data field of a transaction calling a smart contract endpoint
+func (group *vmValuesGroup) buildABICallData(context *gin.Context) {
+	request := &data.ABIArgumentsRequest{}
+	err := context.ShouldBindJSON(request)
+	if err != nil {
+		returnBadRequest(context, "buildABICallData", apiErrors.ErrInvalidJSONRequest)
+		return
+	}
+
+	callData, err := group.facade.BuildABICallData(request)
+	if err != nil {
+		returnBadRequest(context, "buildABICallData", err)
+		return
+	}
+
+	shared.RespondWith(context, http.StatusOK, gin.H{"data": callData}, "", data.ReturnCodeSuccess)
+}
+
+// decodeABIResults decodes the raw return data of a smart contract endpoint
+func (group *vmValuesGroup) decodeABIResults(context *gin.Context) {
+	request := &data.ABIResultsRequest{}
+	err := context.ShouldBindJSON(request)
+	if err != nil {
+		returnBadRequest(context, "decodeABIResults", apiErrors.ErrInvalidJSONRequest)
+		return
+	}
+
+	results, err := group.facade.DecodeABIResults(request)
+	if err != nil {
+		returnBadRequest(context, "decodeABIResults", err)
+		return
+	}
+
+	shared.RespondWith(context, http.StatusOK, gin.H{"results": results}, "", data.ReturnCodeSuccess)
+}
+
+func (group *vmValuesGroup) doExecuteQuery(context *gin.Context) (*vm.VMOutputApi, data.BlockInfo, error) {
 	request := VMValueRequest{}
 	err := context.ShouldBindJSON(&request)
 	if err != nil {
-		return nil, apiErrors.ErrInvalidJSONRequest
+		return nil, data.BlockInfo{}, apiErrors.ErrInvalidJSONRequest
 	}
 
 	command, err := createSCQuery(&request)
 	if err != nil {
-		return nil, err
+		return nil, data.BlockInfo{}, err
 	}
 
-	vmOutput, err := group.facade.ExecuteSCQuery(command)
+	options, err := parseVmQueryOptions(context)
 	if err != nil {
-		return nil, err
+		return nil, data.BlockInfo{}, fmt.Errorf("%w: %s", apiErrors.ErrBadUrlParams, err.Error())
 	}
+	applyVmQueryOptions(command, options)
 
-	return vmOutput, nil
+	return group.facade.ExecuteSCQuery(command)
+}
+
+func applyVmQueryOptions(query *data.SCQuery, options common.VmQueryOptions) {
+	query.BlockNonce = options.BlockNonce
+	query.BlockHash = options.BlockHash
+	query.BlockRootHash = options.BlockRootHash
+	query.HintEpoch = options.HintEpoch
 }
 
 func createSCQuery(request *VMValueRequest) (*data.SCQuery, error) {
@@ -141,6 +275,11 @@ func returnBadRequest(context *gin.Context, errScope string, err error) {
 	shared.RespondWith(context, http.StatusBadRequest, nil, message, data.ReturnCodeRequestError)
 }
 
-func returnOkResponse(context *gin.Context, dataToReturn interface{}) {
-	shared.RespondWith(context, http.StatusOK, gin.H{"data": dataToReturn}, "", data.ReturnCodeSuccess)
+func returnOkResponseWithBlockInfo(context *gin.Context, dataToReturn interface{}, blockInfo data.BlockInfo) {
+	responseData := gin.H{"data": dataToReturn}
+	if blockInfo != (data.BlockInfo{}) {
+		responseData["blockInfo"] = blockInfo
+	}
+
+	shared.RespondWith(context, http.StatusOK, responseData, "", data.ReturnCodeSuccess)
 }
@@ -11,10 +11,12 @@ import (
 	"net/http/httptest"
 	"testing"
 
+	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/data/vm"
 	apiErrors "github.com/multiversx/mx-chain-proxy-go/api/errors"
 	"github.com/multiversx/mx-chain-proxy-go/api/groups"
 	"github.com/multiversx/mx-chain-proxy-go/api/mock"
+	"github.com/multiversx/mx-chain-proxy-go/common"
 	"github.com/multiversx/mx-chain-proxy-go/data"
 	"github.com/stretchr/testify/require"
 )
@@ -29,7 +31,8 @@ type simpleResponse struct {
 }
 
 type vmOutputResponse struct {
-	Data *vm.VMOutputApi `json:"data"`
+	Data      *vm.VMOutputApi `json:"data"`
+	BlockInfo data.BlockInfo  `json:"blockInfo"`
 }
 
 type vmOutputGenericResponse struct {
@@ -37,6 +40,34 @@ type vmOutputGenericResponse struct {
 	Error string           `json:"error"`
 }
 
+type vmOutputsResponse struct {
+	Results []*data.SCQueryResult `json:"results"`
+}
+
+type vmOutputsGenericResponse struct {
+	Data  vmOutputsResponse `json:"data"`
+	Error string            `json:"error"`
+}
+
+type abiQueryResponse struct {
+	Data      *data.ABIQueryResponse `json:"data"`
+	BlockInfo data.BlockInfo         `json:"blockInfo"`
+}
+
+type abiQueryGenericResponse struct {
+	Data  abiQueryResponse `json:"data"`
+	Error string           `json:"error"`
+}
+
+type abiGenericResponse struct {
+	Data struct {
+		Args    []string      `json:"args"`
+		Data    string        `json:"data"`
+		Results []interface{} `json:"results"`
+	} `json:"data"`
+	Error string `json:"error"`
+}
+
 const vmValuesPath = "/vm-values"
 const DummyScAddress = "erd1l453hd0gt5gzdp7czpuall8ggt2dcv5zwmfdf3sd3lguxseux2fsmsgldz"
 
@@ -53,10 +84,10 @@ func TestGetHex_ShouldWork(t *testing.T) {
 	valueBuff, _ := hex.DecodeString("DEADBEEF")
 
 	facade := &mock.FacadeStub{
-		ExecuteSCQueryHandler: func(query *data.SCQuery) (vmOutput *vm.VMOutputApi, e error) {
+		ExecuteSCQueryHandler: func(query *data.SCQuery) (*vm.VMOutputApi, data.BlockInfo, error) {
 			return &vm.VMOutputApi{
 				ReturnData: [][]byte{valueBuff},
-			}, nil
+			}, data.BlockInfo{}, nil
 		},
 	}
 
@@ -80,10 +111,10 @@ func TestGetString_ShouldWork(t *testing.T) {
 	valueBuff := "DEADBEEF"
 
 	facade := &mock.FacadeStub{
-		ExecuteSCQueryHandler: func(query *data.SCQuery) (vmOutput *vm.VMOutputApi, e error) {
+		ExecuteSCQueryHandler: func(query *data.SCQuery) (*vm.VMOutputApi, data.BlockInfo, error) {
 			return &vm.VMOutputApi{
 				ReturnData: [][]byte{[]byte(valueBuff)},
-			}, nil
+			}, data.BlockInfo{}, nil
 		},
 	}
 
@@ -107,12 +138,12 @@ func TestGetInt_ShouldWork(t *testing.T) {
 	value := "1234567"
 
 	facade := &mock.FacadeStub{
-		ExecuteSCQueryHandler: func(query *data.SCQuery) (vmOutput *vm.VMOutputApi, e error) {
+		ExecuteSCQueryHandler: func(query *data.SCQuery) (*vm.VMOutputApi, data.BlockInfo, error) {
 			returnData := big.NewInt(0)
 			returnData.SetString(value, 10)
 			return &vm.VMOutputApi{
 				ReturnData: [][]byte{returnData.Bytes()},
-			}, nil
+			}, data.BlockInfo{}, nil
 		},
 	}
 
@@ -134,11 +165,11 @@ func TestQuery_ShouldWork(t *testing.T) {
 	t.Parallel()
 
 	facade := &mock.FacadeStub{
-		ExecuteSCQueryHandler: func(query *data.SCQuery) (vmOutput *vm.VMOutputApi, e error) {
+		ExecuteSCQueryHandler: func(query *data.SCQuery) (*vm.VMOutputApi, data.BlockInfo, error) {
 
 			return &vm.VMOutputApi{
 				ReturnData: [][]byte{big.NewInt(42).Bytes()},
-			}, nil
+			}, data.BlockInfo{}, nil
 		},
 	}
 
@@ -173,8 +204,8 @@ func TestAllRoutes_FacadeErrorsShouldErr(t *testing.T) {
 
 	errExpected := errors.New("some random error")
 	facade := &mock.FacadeStub{
-		ExecuteSCQueryHandler: func(query *data.SCQuery) (vmOutput *vm.VMOutputApi, e error) {
-			return nil, errExpected
+		ExecuteSCQueryHandler: func(query *data.SCQuery) (*vm.VMOutputApi, data.BlockInfo, error) {
+			return nil, data.BlockInfo{}, errExpected
 		},
 	}
 
@@ -192,8 +223,8 @@ func TestAllRoutes_WhenBadArgumentsShouldErr(t *testing.T) {
 
 	errExpected := errors.New("not a valid hex string")
 	facade := &mock.FacadeStub{
-		ExecuteSCQueryHandler: func(query *data.SCQuery) (vmOutput *vm.VMOutputApi, e error) {
-			return &vm.VMOutputApi{}, nil
+		ExecuteSCQueryHandler: func(query *data.SCQuery) (*vm.VMOutputApi, data.BlockInfo, error) {
+			return &vm.VMOutputApi{}, data.BlockInfo{}, nil
 		},
 	}
 
@@ -211,8 +242,8 @@ func TestAllRoutes_WhenNoVMReturnDataShouldErr(t *testing.T) {
 
 	errExpected := errors.New("no return data")
 	facade := mock.FacadeStub{
-		ExecuteSCQueryHandler: func(query *data.SCQuery) (vmOutput *vm.VMOutputApi, e error) {
-			return &vm.VMOutputApi{}, nil
+		ExecuteSCQueryHandler: func(query *data.SCQuery) (*vm.VMOutputApi, data.BlockInfo, error) {
+			return &vm.VMOutputApi{}, data.BlockInfo{}, nil
 		},
 	}
 
@@ -229,8 +260,8 @@ func TestAllRoutes_WhenBadJsonShouldErr(t *testing.T) {
 	t.Parallel()
 
 	facade := mock.FacadeStub{
-		ExecuteSCQueryHandler: func(query *data.SCQuery) (vmOutput *vm.VMOutputApi, e error) {
-			return &vm.VMOutputApi{}, nil
+		ExecuteSCQueryHandler: func(query *data.SCQuery) (*vm.VMOutputApi, data.BlockInfo, error) {
+			return &vm.VMOutputApi{}, data.BlockInfo{}, nil
 		},
 	}
 
@@ -241,10 +272,10 @@ func TestAllRoutes_WithSameScStateAndShouldBySyncedFilled(t *testing.T) {
 	t.Parallel()
 
 	facade := &mock.FacadeStub{
-		ExecuteSCQueryHandler: func(query *data.SCQuery) (vmOutput *vm.VMOutputApi, e error) {
+		ExecuteSCQueryHandler: func(query *data.SCQuery) (*vm.VMOutputApi, data.BlockInfo, error) {
 			require.True(t, query.ShouldBeSynced)
 			require.True(t, query.SameScState)
-			return &vm.VMOutputApi{}, nil
+			return &vm.VMOutputApi{}, data.BlockInfo{}, nil
 		},
 	}
 
@@ -260,6 +291,344 @@ func TestAllRoutes_WithSameScStateAndShouldBySyncedFilled(t *testing.T) {
 	_ = doPost(t, facade, "/vm-values/query", &request, &response)
 }
 
+func TestExecuteQuery_AtPastBlockShouldWork(t *testing.T) {
+	t.Parallel()
+
+	providedBlockInfo := data.BlockInfo{
+		Nonce:    37,
+		Hash:     "abcd",
+		RootHash: "ef01",
+	}
+	facade := &mock.FacadeStub{
+		ExecuteSCQueryHandler: func(query *data.SCQuery) (*vm.VMOutputApi, data.BlockInfo, error) {
+			require.Equal(t, core.OptionalUint64{Value: 37, HasValue: true}, query.BlockNonce)
+			require.Equal(t, []byte{0xab, 0xcd}, query.BlockHash)
+			require.Equal(t, core.OptionalUint32{Value: 2, HasValue: true}, query.HintEpoch)
+			require.Empty(t, query.BlockRootHash)
+			return &vm.VMOutputApi{ReturnCode: "ok"}, providedBlockInfo, nil
+		},
+	}
+
+	request := groups.VMValueRequest{
+		ScAddress: DummyScAddress,
+		FuncName:  "function",
+		Args:      []string{},
+	}
+
+	response := vmOutputGenericResponse{}
+	statusCode := doPost(t, facade, "/vm-values/query?blockNonce=37&blockHash=abcd&hintEpoch=2", &request, &response)
+	require.Equal(t, http.StatusOK, statusCode)
+	require.Equal(t, "ok", response.Data.Data.ReturnCode)
+	require.Equal(t, providedBlockInfo, response.Data.BlockInfo)
+}
+
+func TestExecuteQuery_EmptyBlockInfoShouldBeOmitted(t *testing.T) {
+	t.Parallel()
+
+	facade := &mock.FacadeStub{
+		ExecuteSCQueryHandler: func(query *data.SCQuery) (*vm.VMOutputApi, data.BlockInfo, error) {
+			return &vm.VMOutputApi{ReturnCode: "ok"}, data.BlockInfo{}, nil
+		},
+	}
+
+	request := groups.VMValueRequest{
+		ScAddress: DummyScAddress,
+		FuncName:  "function",
+		Args:      []string{},
+	}
+
+	response := struct {
+		Data map[string]interface{} `json:"data"`
+	}{}
+	statusCode := doPost(t, facade, "/vm-values/query", &request, &response)
+	require.Equal(t, http.StatusOK, statusCode)
+	require.Contains(t, response.Data, "data")
+	require.NotContains(t, response.Data, "blockInfo")
+}
+
+func TestExecuteQuery_InvalidBlockCoordinatesShouldErr(t *testing.T) {
+	t.Parallel()
+
+	facade := &mock.FacadeStub{
+		ExecuteSCQueryHandler: func(query *data.SCQuery) (*vm.VMOutputApi, data.BlockInfo, error) {
+			require.Fail(t, "should have not been called")
+			return nil, data.BlockInfo{}, nil
+		},
+	}
+
+	request := groups.VMValueRequest{
+		ScAddress: DummyScAddress,
+		FuncName:  "function",
+		Args:      []string{},
+	}
+
+	response := simpleResponse{}
+	statusCode := doPost(t, facade, "/vm-values/query?blockHash=not-hex", &request, &response)
+	require.Equal(t, http.StatusBadRequest, statusCode)
+	require.Contains(t, response.Error, apiErrors.ErrBadUrlParams.Error())
+
+	statusCode = doPost(t, facade, "/vm-values/int?blockNonce=-1", &request, &response)
+	require.Equal(t, http.StatusBadRequest, statusCode)
+	require.Contains(t, response.Error, apiErrors.ErrBadUrlParams.Error())
+}
+
+func TestExecuteQueries(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid JSON should err", func(t *testing.T) {
+		t.Parallel()
+
+		response := simpleResponse{}
+		statusCode := doPost(t, &mock.FacadeStub{}, "/vm-values/query-multiple", []byte("dummy"), &response)
+		require.Equal(t, http.StatusBadRequest, statusCode)
+		require.Contains(t, response.Error, apiErrors.ErrInvalidJSONRequest.Error())
+	})
+
+	t.Run("invalid arguments should err", func(t *testing.T) {
+		t.Parallel()
+
+		requests := []*groups.VMValueRequest{
+			{ScAddress: DummyScAddress, FuncName: "function", Args: []string{"AA"}},
+			{ScAddress: DummyScAddress, FuncName: "function", Args: []string{"ZZ"}},
+		}
+
+		response := simpleResponse{}
+		statusCode := doPost(t, &mock.FacadeStub{}, "/vm-values/query-multiple", requests, &response)
+		require.Equal(t, http.StatusBadRequest, statusCode)
+		require.Contains(t, response.Error, "query 1")
+	})
+
+	t.Run("facade error should err", func(t *testing.T) {
+		t.Parallel()
+
+		errExpected := errors.New("expected error")
+		facade := &mock.FacadeStub{
+			ExecuteSCQueriesHandler: func(queries []*data.SCQuery) ([]*data.SCQueryResult, error) {
+				return nil, errExpected
+			},
+		}
+
+		response := simpleResponse{}
+		statusCode := doPost(t, facade, "/vm-values/query-multiple", []*groups.VMValueRequest{}, &response)
+		require.Equal(t, http.StatusBadRequest, statusCode)
+		require.Contains(t, response.Error, errExpected.Error())
+	})
+
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			ExecuteSCQueriesHandler: func(queries []*data.SCQuery) ([]*data.SCQueryResult, error) {
+				require.Equal(t, 2, len(queries))
+				require.Equal(t, "first", queries[0].FuncName)
+				require.Equal(t, [][]byte{{0xaa}}, queries[0].Arguments)
+				require.Equal(t, "second", queries[1].FuncName)
+
+				return []*data.SCQueryResult{
+					{Data: &vm.VMOutputApi{ReturnCode: "ok"}, BlockInfo: data.BlockInfo{Nonce: 37}},
+					{Error: "execution failed"},
+				}, nil
+			},
+		}
+
+		requests := []*groups.VMValueRequest{
+			{ScAddress: DummyScAddress, FuncName: "first", Args: []string{"AA"}},
+			{ScAddress: DummyScAddress, FuncName: "second"},
+		}
+
+		response := vmOutputsGenericResponse{}
+		statusCode := doPost(t, facade, "/vm-values/query-multiple", requests, &response)
+		require.Equal(t, http.StatusOK, statusCode)
+		require.Equal(t, 2, len(response.Data.Results))
+		require.Equal(t, "ok", response.Data.Results[0].Data.ReturnCode)
+		require.Equal(t, uint64(37), response.Data.Results[0].BlockInfo.Nonce)
+		require.Equal(t, "execution failed", response.Data.Results[1].Error)
+	})
+
+	t.Run("invalid block coordinates should err", func(t *testing.T) {
+		t.Parallel()
+
+		response := simpleResponse{}
+		statusCode := doPost(t, &mock.FacadeStub{}, "/vm-values/query-multiple?blockNonce=abc", []*groups.VMValueRequest{}, &response)
+		require.Equal(t, http.StatusBadRequest, statusCode)
+		require.Contains(t, response.Error, apiErrors.ErrBadUrlParams.Error())
+	})
+
+	t.Run("historical options should apply to all the queries", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			ExecuteSCQueriesHandler: func(queries []*data.SCQuery) ([]*data.SCQueryResult, error) {
+				require.Equal(t, 2, len(queries))
+				for _, query := range queries {
+					require.Equal(t, core.OptionalUint64{Value: 37, HasValue: true}, query.BlockNonce)
+					require.Equal(t, core.OptionalUint32{Value: 2, HasValue: true}, query.HintEpoch)
+				}
+
+				return []*data.SCQueryResult{{}, {}}, nil
+			},
+		}
+
+		requests := []*groups.VMValueRequest{
+			{ScAddress: DummyScAddress, FuncName: "first"},
+			{ScAddress: DummyScAddress, FuncName: "second"},
+		}
+
+		response := vmOutputsGenericResponse{}
+		statusCode := doPost(t, facade, "/vm-values/query-multiple?blockNonce=37&hintEpoch=2", requests, &response)
+		require.Equal(t, http.StatusOK, statusCode)
+	})
+}
+
+func TestExecuteABIQuery(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid JSON should err", func(t *testing.T) {
+		t.Parallel()
+
+		response := abiQueryGenericResponse{}
+		statusCode := doPost(t, &mock.FacadeStub{}, "/vm-values/abi/query", []byte("dummy"), &response)
+		require.Equal(t, http.StatusBadRequest, statusCode)
+		require.Contains(t, response.Error, apiErrors.ErrInvalidJSONRequest.Error())
+	})
+
+	t.Run("invalid block coordinates should err", func(t *testing.T) {
+		t.Parallel()
+
+		response := abiQueryGenericResponse{}
+		statusCode := doPost(t, &mock.FacadeStub{}, "/vm-values/abi/query?blockNonce=abc", &data.ABIQueryRequest{}, &response)
+		require.Equal(t, http.StatusBadRequest, statusCode)
+		require.Contains(t, response.Error, apiErrors.ErrBadUrlParams.Error())
+	})
+
+	t.Run("facade error should err", func(t *testing.T) {
+		t.Parallel()
+
+		errExpected := errors.New("expected error")
+		facade := &mock.FacadeStub{
+			ExecuteABIQueryCalled: func(request *data.ABIQueryRequest, options common.VmQueryOptions) (*data.ABIQueryResponse, data.BlockInfo, error) {
+				return nil, data.BlockInfo{}, errExpected
+			},
+		}
+
+		response := abiQueryGenericResponse{}
+		statusCode := doPost(t, facade, "/vm-values/abi/query", &data.ABIQueryRequest{}, &response)
+		require.Equal(t, http.StatusBadRequest, statusCode)
+		require.Contains(t, response.Error, errExpected.Error())
+	})
+
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			ExecuteABIQueryCalled: func(request *data.ABIQueryRequest, options common.VmQueryOptions) (*data.ABIQueryResponse, data.BlockInfo, error) {
+				require.Equal(t, "sample", request.ABIName)
+				require.Equal(t, "getSum", request.Endpoint)
+				require.Equal(t, core.OptionalUint64{Value: 37, HasValue: true}, options.BlockNonce)
+
+				return &data.ABIQueryResponse{
+					Results:    []interface{}{"1000"},
+					ReturnCode: "ok",
+				}, data.BlockInfo{Nonce: 37}, nil
+			},
+		}
+
+		request := &data.ABIQueryRequest{
+			ABIName:   "sample",
+			ScAddress: DummyScAddress,
+			Endpoint:  "getSum",
+		}
+		response := abiQueryGenericResponse{}
+		statusCode := doPost(t, facade, "/vm-values/abi/query?blockNonce=37", request, &response)
+		require.Equal(t, http.StatusOK, statusCode)
+		require.Equal(t, []interface{}{"1000"}, response.Data.Data.Results)
+		require.Equal(t, "ok", response.Data.Data.ReturnCode)
+		require.Equal(t, uint64(37), response.Data.BlockInfo.Nonce)
+	})
+}
+
+func TestABIEncodingRoutes(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid JSON should err", func(t *testing.T) {
+		t.Parallel()
+
+		for _, path := range []string{"/vm-values/abi/encode", "/vm-values/abi/call-data", "/vm-values/abi/decode"} {
+			response := abiGenericResponse{}
+			statusCode := doPost(t, &mock.FacadeStub{}, path, []byte("dummy"), &response)
+			require.Equal(t, http.StatusBadRequest, statusCode, path)
+			require.Contains(t, response.Error, apiErrors.ErrInvalidJSONRequest.Error(), path)
+		}
+	})
+
+	t.Run("facade errors should err", func(t *testing.T) {
+		t.Parallel()
+
+		errExpected := errors.New("expected error")
+		facade := &mock.FacadeStub{
+			EncodeABIArgumentsCalled: func(request *data.ABIArgumentsRequest) ([]string, error) {
+				return nil, errExpected
+			},
+			BuildABICallDataCalled: func(request *data.ABIArgumentsRequest) (string, error) {
+				return "", errExpected
+			},
+			DecodeABIResultsCalled: func(request *data.ABIResultsRequest) ([]interface{}, error) {
+				return nil, errExpected
+			},
+		}
+
+		for _, path := range []string{"/vm-values/abi/encode", "/vm-values/abi/call-data", "/vm-values/abi/decode"} {
+			response := abiGenericResponse{}
+			statusCode := doPost(t, facade, path, &data.ABIArgumentsRequest{}, &response)
+			require.Equal(t, http.StatusBadRequest, statusCode, path)
+			require.Contains(t, response.Error, errExpected.Error(), path)
+		}
+	})
+
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			EncodeABIArgumentsCalled: func(request *data.ABIArgumentsRequest) ([]string, error) {
+				require.Equal(t, "add", request.Endpoint)
+				return []string{"03e8"}, nil
+			},
+			BuildABICallDataCalled: func(request *data.ABIArgumentsRequest) (string, error) {
+				return "add@03e8", nil
+			},
+			DecodeABIResultsCalled: func(request *data.ABIResultsRequest) ([]interface{}, error) {
+				require.Equal(t, [][]byte{{3, 232}}, request.ReturnData)
+				return []interface{}{"1000"}, nil
+			},
+		}
+
+		argumentsRequest := &data.ABIArgumentsRequest{
+			ABIName:  "sample",
+			Endpoint: "add",
+			Args:     []json.RawMessage{json.RawMessage(`"1000"`)},
+		}
+		response := abiGenericResponse{}
+		statusCode := doPost(t, facade, "/vm-values/abi/encode", argumentsRequest, &response)
+		require.Equal(t, http.StatusOK, statusCode)
+		require.Equal(t, []string{"03e8"}, response.Data.Args)
+
+		response = abiGenericResponse{}
+		statusCode = doPost(t, facade, "/vm-values/abi/call-data", argumentsRequest, &response)
+		require.Equal(t, http.StatusOK, statusCode)
+		require.Equal(t, "add@03e8", response.Data.Data)
+
+		resultsRequest := &data.ABIResultsRequest{
+			ABIName:    "sample",
+			Endpoint:   "getSum",
+			ReturnData: [][]byte{{3, 232}},
+		}
+		response = abiGenericResponse{}
+		statusCode = doPost(t, facade, "/vm-values/abi/decode", resultsRequest, &response)
+		require.Equal(t, http.StatusOK, statusCode)
+		require.Equal(t, []interface{}{"1000"}, response.Data.Results)
+	})
+}
+
 func doPost(t *testing.T, facade interface{}, url string, request interface{}, response interface{}) int {
 	// Serialize if not already
 	requestAsBytes, ok := request.([]byte)
@@ -1,8 +1,6 @@
 package groups
 
 import (
-	"math/big"
-
 	"github.com/multiversx/mx-chain-core-go/data/transaction"
 	"github.com/multiversx/mx-chain-core-go/data/vm"
 	"github.com/multiversx/mx-chain-proxy-go/common"
@@ -13,7 +11,7 @@ import (
 type AccountsFacadeHandler interface {
 	GetAccount(address string, options common.AccountQueryOptions) (*data.AccountModel, error)
 	GetCodeHash(address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error)
-	GetTransactions(address string) ([]data.DatabaseTransaction, error)
+	GetTransactions(address string, filter data.TransactionsHistoryFilter) (*data.TransactionsHistoryPage, error)
 	GetShardIDForAddress(address string) (uint32, error)
 	GetValueForKey(address string, key string, options common.AccountQueryOptions) (string, error)
 	GetAllESDTTokens(address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error)
@@ -32,11 +30,12 @@ type BlockFacadeHandler interface {
 	GetBlockByHash(shardID uint32, hash string, options common.BlockQueryOptions) (*data.BlockApiResponse, error)
 	GetAlteredAccountsByNonce(shardID uint32, nonce uint64, options common.GetAlteredAccountsForBlockOptions) (*data.AlteredAccountsApiResponse, error)
 	GetAlteredAccountsByHash(shardID uint32, hash string, options common.GetAlteredAccountsForBlockOptions) (*data.AlteredAccountsApiResponse, error)
+	GetBlocksRange(shardID uint32, from uint64, to uint64, options common.BlockQueryOptions, handler data.BlocksRangeItemHandler) error
 }
 
 // BlocksFacadeHandler interface defines methods that can be used from the facade
 type BlocksFacadeHandler interface {
-	GetBlocksByRound(round uint64, options common.BlockQueryOptions) (*data.BlocksApiResponse, error)
+	GetBlocksByRound(round uint64, options common.BlocksQueryOptions) (*data.BlocksApiResponse, error)
 }
 
 // InternalFacadeHandler interface defines methods that can be used from facade context variable
@@ -57,6 +56,9 @@ type BlockAtlasFacadeHandler interface {
 type HyperBlockFacadeHandler interface {
 	GetHyperBlockByNonce(nonce uint64, options common.HyperblockQueryOptions) (*data.HyperblockApiResponse, error)
 	GetHyperBlockByHash(hash string, options common.HyperblockQueryOptions) (*data.HyperblockApiResponse, error)
+	GetHyperBlocksRange(from uint64, to uint64, options common.HyperblockQueryOptions, handler data.BlocksRangeItemHandler) error
+	GetHyperblockReorgs() []*data.HyperblockReorg
+	SubscribeToHyperblockReorgs() (<-chan *data.HyperblockReorg, func())
 }
 
 // NetworkFacadeHandler interface defines methods that can be used from the facade
@@ -94,8 +96,11 @@ type TransactionFacadeHandler interface {
 	SendMultipleTransactions(txs []*data.Transaction) (data.MultipleTransactionsResponseData, error)
 	SimulateTransaction(tx *data.Transaction, checkSignature bool) (*data.GenericAPIResponse, error)
 	IsFaucetEnabled() bool
-	SendUserFunds(receiver string, value *big.Int) error
+	SendUserFunds(request *data.FundsRequest, clientIP string) (string, error)
+	GetFaucetDisbursements(filter data.FaucetDisbursementsFilter) ([]*data.FaucetDisbursement, error)
+	GetFaucetTokens() []*data.FaucetToken
 	TransactionCostRequest(tx *data.Transaction) (*data.TxCostResponseData, error)
+	GetTransactionFeeEstimate(tx *data.Transaction) (*data.TransactionFeeEstimate, error)
 	GetTransactionStatus(txHash string, sender string) (string, error)
 	GetProcessedTransactionStatus(txHash string) (string, error)
 	GetTransaction(txHash string, withResults bool) (*transaction.ApiTransactionResult, error)
@@ -117,12 +122,17 @@ type ProofFacadeHandler interface {
 
 // ValidatorFacadeHandler interface defines methods that can be used from the facade
 type ValidatorFacadeHandler interface {
-	ValidatorStatistics() (map[string]*data.ValidatorApiResponse, error)
+	ValidatorStatistics() (*data.ValidatorStatisticsResponse, error)
 }
 
 // VmValuesFacadeHandler interface defines methods that can be used from the facade
 type VmValuesFacadeHandler interface {
-	ExecuteSCQuery(*data.SCQuery) (*vm.VMOutputApi, error)
+	ExecuteSCQuery(*data.SCQuery) (*vm.VMOutputApi, data.BlockInfo, error)
+	ExecuteSCQueries(queries []*data.SCQuery) ([]*data.SCQueryResult, error)
+	ExecuteABIQuery(request *data.ABIQueryRequest, options common.VmQueryOptions) (*data.ABIQueryResponse, data.BlockInfo, error)
+	EncodeABIArguments(request *data.ABIArgumentsRequest) ([]string, error)
+	BuildABICallData(request *data.ABIArgumentsRequest) (string, error)
+	DecodeABIResults(request *data.ABIResultsRequest) ([]interface{}, error)
 }
 
 // ActionsFacadeHandler interface defines methods that can be used from the facade
@@ -136,3 +146,15 @@ type AboutFacadeHandler interface {
 	GetAboutInfo() (*data.GenericAPIResponse, error)
 	GetNodesVersions() (*data.GenericAPIResponse, error)
 }
+
+// EventsFacadeHandler defines the methods that can be used from the facade
+type EventsFacadeHandler interface {
+	GetEvents(query data.EventsQuery) (*data.EventsPage, error)
+}
+
+// TokensFacadeHandler defines the methods that can be used from the facade
+type TokensFacadeHandler interface {
+	GetTokenProperties(identifier string) (*data.TokenProperties, error)
+	GetTokenHolders(identifier string, filter data.TokenHoldersFilter) (*data.TokenHoldersPage, error)
+	GetTokenTransfers(identifier string, filter data.TokenTransfersFilter) (*data.TokenTransfersPage, error)
+}
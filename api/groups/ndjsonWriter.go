@@ -0,0 +1,81 @@
+package groups
+
+import (
+	"encoding/json"
+	goErrors "errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/multiversx/mx-chain-proxy-go/api/shared"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+const ndjsonContentType = "application/x-ndjson"
+
+// ndjsonWriter streams items as newline-delimited JSON. The status and the headers are only sent along with the first
+// item, so a failure occurring before anything was written can still get a regular error response
+type ndjsonWriter struct {
+	c       *gin.Context
+	encoder *json.Encoder
+	started bool
+}
+
+func newNDJSONWriter(c *gin.Context) *ndjsonWriter {
+	return &ndjsonWriter{
+		c:       c,
+		encoder: json.NewEncoder(c.Writer),
+	}
+}
+
+// start sends the status and the headers of the stream, if not already sent
+func (writer *ndjsonWriter) start() {
+	if writer.started {
+		return
+	}
+
+	writer.c.Header("Content-Type", ndjsonContentType)
+	writer.c.Status(http.StatusOK)
+	writer.started = true
+}
+
+// write encodes the item as a new line of the stream
+func (writer *ndjsonWriter) write(item interface{}) error {
+	writer.start()
+
+	return writer.encoder.Encode(item)
+}
+
+// writeAndFlush writes the item and sends it right away to the client
+func (writer *ndjsonWriter) writeAndFlush(item interface{}) error {
+	err := writer.write(item)
+	if err != nil {
+		return err
+	}
+
+	return writer.flush()
+}
+
+// flush sends the lines written so far to the client and returns an error if the client has gone away
+func (writer *ndjsonWriter) flush() error {
+	writer.c.Writer.Flush()
+
+	return writer.c.Request.Context().Err()
+}
+
+// fail responds with the error, if the stream has not started yet. Otherwise, since the status has already been sent,
+// the error is reported as the last line of the stream
+func (writer *ndjsonWriter) fail(err error, badRequestErrors ...error) {
+	if writer.started {
+		_ = writer.encoder.Encode(gin.H{"error": err.Error()})
+		return
+	}
+
+	for _, badRequestErr := range badRequestErrors {
+		if goErrors.Is(err, badRequestErr) {
+			shared.RespondWith(writer.c, http.StatusBadRequest, nil, err.Error(), data.ReturnCodeRequestError)
+			return
+		}
+	}
+
+	shared.RespondWith(writer.c, http.StatusInternalServerError, nil, err.Error(), data.ReturnCodeInternalError)
+}
@@ -2,11 +2,13 @@ package groups
 
 import (
 	"encoding/hex"
+	"fmt"
 	"strconv"
 
 	"github.com/gin-gonic/gin"
 	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-proxy-go/common"
+	"github.com/multiversx/mx-chain-proxy-go/data"
 )
 
 func parseBlockQueryOptions(c *gin.Context) (common.BlockQueryOptions, error) {
@@ -24,6 +26,23 @@ func parseBlockQueryOptions(c *gin.Context) (common.BlockQueryOptions, error) {
 	return options, nil
 }
 
+func parseBlocksQueryOptions(c *gin.Context) (common.BlocksQueryOptions, error) {
+	blockQueryOptions, err := parseBlockQueryOptions(c)
+	if err != nil {
+		return common.BlocksQueryOptions{}, err
+	}
+
+	verify, err := parseBoolUrlParam(c, common.UrlParameterVerify)
+	if err != nil {
+		return common.BlocksQueryOptions{}, err
+	}
+
+	return common.BlocksQueryOptions{
+		BlockQueryOptions: blockQueryOptions,
+		Verify:            verify,
+	}, nil
+}
+
 func parseHyperblockQueryOptions(c *gin.Context) (common.HyperblockQueryOptions, error) {
 	withLogs, err := parseBoolUrlParam(c, common.UrlParameterWithLogs)
 	if err != nil {
@@ -82,7 +101,7 @@ func parseAccountQueryOptions(c *gin.Context) (common.AccountQueryOptions, error
 		return common.AccountQueryOptions{}, err
 	}
 
-	hintEpoch, err := parseUint32UrlParam(c, common.UrlParameterOnStartOfEpoch)
+	hintEpoch, err := parseUint32UrlParam(c, common.UrlParameterHintEpoch)
 	if err != nil {
 		return common.AccountQueryOptions{}, err
 	}
@@ -99,6 +118,37 @@ func parseAccountQueryOptions(c *gin.Context) (common.AccountQueryOptions, error
 	return options, nil
 }
 
+func parseVmQueryOptions(c *gin.Context) (common.VmQueryOptions, error) {
+	blockNonce, err := parseUint64UrlParam(c, common.UrlParameterBlockNonce)
+	if err != nil {
+		return common.VmQueryOptions{}, err
+	}
+
+	blockHash, err := parseHexBytesUrlParam(c, common.UrlParameterBlockHash)
+	if err != nil {
+		return common.VmQueryOptions{}, err
+	}
+
+	blockRootHash, err := parseHexBytesUrlParam(c, common.UrlParameterBlockRootHash)
+	if err != nil {
+		return common.VmQueryOptions{}, err
+	}
+
+	hintEpoch, err := parseUint32UrlParam(c, common.UrlParameterHintEpoch)
+	if err != nil {
+		return common.VmQueryOptions{}, err
+	}
+
+	options := common.VmQueryOptions{
+		BlockNonce:    blockNonce,
+		BlockHash:     blockHash,
+		BlockRootHash: blockRootHash,
+		HintEpoch:     hintEpoch,
+	}
+
+	return options, nil
+}
+
 func parseTransactionQueryOptions(c *gin.Context) (common.TransactionQueryOptions, error) {
 	withResults, err := parseBoolUrlParam(c, common.UrlParameterWithResults)
 	if err != nil {
@@ -170,6 +220,24 @@ func parseUint64UrlParam(c *gin.Context, name string) (core.OptionalUint64, erro
 	}, nil
 }
 
+func parseNoncesRange(c *gin.Context) (uint64, uint64, error) {
+	from, err := parseUint64UrlParam(c, common.UrlParameterFrom)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	to, err := parseUint64UrlParam(c, common.UrlParameterTo)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	if !from.HasValue || !to.HasValue {
+		return 0, 0, fmt.Errorf("both %s and %s parameters are required", common.UrlParameterFrom, common.UrlParameterTo)
+	}
+
+	return from.Value, to.Value, nil
+}
+
 func parseHexBytesUrlParam(c *gin.Context, name string) ([]byte, error) {
 	param := c.Request.URL.Query().Get(name)
 	if param == "" {
@@ -211,3 +279,159 @@ func parseAlteredAccountOptions(c *gin.Context) (common.GetAlteredAccountsForBlo
 		TokensFilter: tokensFilter,
 	}, nil
 }
+
+func parseTransactionsHistoryFilter(c *gin.Context) (data.TransactionsHistoryFilter, error) {
+	direction := parseStringUrlParam(c, common.UrlParameterDirection)
+	if direction != "" && direction != data.TransactionsDirectionIn && direction != data.TransactionsDirectionOut {
+		return data.TransactionsHistoryFilter{}, fmt.Errorf("invalid %s: %s", common.UrlParameterDirection, direction)
+	}
+
+	order := parseStringUrlParam(c, common.UrlParameterOrder)
+	if order != "" && order != data.SortOrderAscending && order != data.SortOrderDescending {
+		return data.TransactionsHistoryFilter{}, fmt.Errorf("invalid %s: %s", common.UrlParameterOrder, order)
+	}
+
+	from, err := parseUint64UrlParam(c, common.UrlParameterFrom)
+	if err != nil {
+		return data.TransactionsHistoryFilter{}, err
+	}
+
+	to, err := parseUint64UrlParam(c, common.UrlParameterTo)
+	if err != nil {
+		return data.TransactionsHistoryFilter{}, err
+	}
+
+	fromNonce, err := parseUint64UrlParam(c, common.UrlParameterFromNonce)
+	if err != nil {
+		return data.TransactionsHistoryFilter{}, err
+	}
+
+	toNonce, err := parseUint64UrlParam(c, common.UrlParameterToNonce)
+	if err != nil {
+		return data.TransactionsHistoryFilter{}, err
+	}
+
+	limit, err := parseUint32UrlParam(c, common.UrlParameterLimit)
+	if err != nil {
+		return data.TransactionsHistoryFilter{}, err
+	}
+
+	return data.TransactionsHistoryFilter{
+		Direction: direction,
+		Status:    parseStringUrlParam(c, common.UrlParameterStatus),
+		Token:     parseStringUrlParam(c, common.UrlParameterToken),
+		Function:  parseStringUrlParam(c, common.UrlParameterFunction),
+		From:      int64(from.Value),
+		To:        int64(to.Value),
+		FromNonce: fromNonce,
+		ToNonce:   toNonce,
+		Order:     order,
+		Limit:     int(limit.Value),
+		Cursor:    parseStringUrlParam(c, common.UrlParameterCursor),
+	}, nil
+}
+
+func parseEventsQuery(c *gin.Context) (data.EventsQuery, error) {
+	order := parseStringUrlParam(c, common.UrlParameterOrder)
+	if order != "" && order != data.SortOrderAscending && order != data.SortOrderDescending {
+		return data.EventsQuery{}, fmt.Errorf("invalid %s: %s", common.UrlParameterOrder, order)
+	}
+
+	fromBlock, err := parseUint64UrlParam(c, common.UrlParameterFromNonce)
+	if err != nil {
+		return data.EventsQuery{}, err
+	}
+
+	toBlock, err := parseUint64UrlParam(c, common.UrlParameterToNonce)
+	if err != nil {
+		return data.EventsQuery{}, err
+	}
+
+	limit, err := parseUint32UrlParam(c, common.UrlParameterLimit)
+	if err != nil {
+		return data.EventsQuery{}, err
+	}
+
+	// each topic is passed as a separate parameter, in order, so that the encoded values can hold any character
+	topics := c.Request.URL.Query()[common.UrlParameterTopics]
+
+	return data.EventsQuery{
+		Topics: topics,
+		Filter: data.EventsFilter{
+			Address:    parseStringUrlParam(c, common.UrlParameterAddress),
+			Identifier: parseStringUrlParam(c, common.UrlParameterIdentifier),
+			FromBlock:  fromBlock,
+			ToBlock:    toBlock,
+			Order:      order,
+			Limit:      int(limit.Value),
+			Cursor:     parseStringUrlParam(c, common.UrlParameterCursor),
+		},
+	}, nil
+}
+
+func parseTokenHoldersFilter(c *gin.Context) (data.TokenHoldersFilter, error) {
+	limit, err := parseUint32UrlParam(c, common.UrlParameterLimit)
+	if err != nil {
+		return data.TokenHoldersFilter{}, err
+	}
+
+	return data.TokenHoldersFilter{
+		Limit:  int(limit.Value),
+		Cursor: parseStringUrlParam(c, common.UrlParameterCursor),
+	}, nil
+}
+
+func parseTokenTransfersFilter(c *gin.Context) (data.TokenTransfersFilter, error) {
+	order := parseStringUrlParam(c, common.UrlParameterOrder)
+	if order != "" && order != data.SortOrderAscending && order != data.SortOrderDescending {
+		return data.TokenTransfersFilter{}, fmt.Errorf("invalid %s: %s", common.UrlParameterOrder, order)
+	}
+
+	fromBlock, err := parseUint64UrlParam(c, common.UrlParameterFromNonce)
+	if err != nil {
+		return data.TokenTransfersFilter{}, err
+	}
+
+	toBlock, err := parseUint64UrlParam(c, common.UrlParameterToNonce)
+	if err != nil {
+		return data.TokenTransfersFilter{}, err
+	}
+
+	limit, err := parseUint32UrlParam(c, common.UrlParameterLimit)
+	if err != nil {
+		return data.TokenTransfersFilter{}, err
+	}
+
+	return data.TokenTransfersFilter{
+		FromBlock: fromBlock,
+		ToBlock:   toBlock,
+		Order:     order,
+		Limit:     int(limit.Value),
+		Cursor:    parseStringUrlParam(c, common.UrlParameterCursor),
+	}, nil
+}
+
+func parseFaucetDisbursementsFilter(c *gin.Context) (data.FaucetDisbursementsFilter, error) {
+	from, err := parseUint64UrlParam(c, common.UrlParameterFrom)
+	if err != nil {
+		return data.FaucetDisbursementsFilter{}, err
+	}
+
+	to, err := parseUint64UrlParam(c, common.UrlParameterTo)
+	if err != nil {
+		return data.FaucetDisbursementsFilter{}, err
+	}
+
+	limit, err := parseUint32UrlParam(c, common.UrlParameterLimit)
+	if err != nil {
+		return data.FaucetDisbursementsFilter{}, err
+	}
+
+	return data.FaucetDisbursementsFilter{
+		Receiver: parseStringUrlParam(c, common.UrlParameterReceiver),
+		ClientIP: parseStringUrlParam(c, common.UrlParameterClientIP),
+		From:     int64(from.Value),
+		To:       int64(to.Value),
+		Limit:    int(limit.Value),
+	}, nil
+}
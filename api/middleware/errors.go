@@ -7,3 +7,6 @@ var ErrNilLimitsMapForEndpoints = errors.New("nil limits map")
 
 // ErrNilStatusMetricsExtractor signals that a nil status metrics extractor has been provided
 var ErrNilStatusMetricsExtractor = errors.New("nil status metrics extractor")
+
+// ErrNilReadinessHandler signals that a nil readiness handler has been provided
+var ErrNilReadinessHandler = errors.New("nil readiness handler")
@@ -12,6 +12,13 @@ type RateLimiterHandler interface {
 	ResetMap(version string)
 }
 
+// ReadinessHandler defines the actions that an implementation of readiness handler should do
+type ReadinessHandler interface {
+	MiddlewareProcessor
+	MarkNotReady()
+	IsReady() bool
+}
+
 // StatusMetricsExtractor defines what a status metrics extractor should do
 type StatusMetricsExtractor interface {
 	AddRequestData(path string, withError bool, duration time.Duration)
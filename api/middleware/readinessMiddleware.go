@@ -0,0 +1,52 @@
+package middleware
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/multiversx/mx-chain-core-go/core/atomic"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+const notReadyErrorMessage = "proxy is shutting down and does not accept new requests"
+
+type readinessMiddleware struct {
+	isNotReady atomic.Flag
+}
+
+// NewReadinessMiddleware returns a new instance of readinessMiddleware. The proxy is considered ready at creation time
+func NewReadinessMiddleware() *readinessMiddleware {
+	return &readinessMiddleware{}
+}
+
+// MiddlewareHandlerFunc returns the gin middleware that rejects the new requests once the proxy was marked as not ready
+func (rm *readinessMiddleware) MiddlewareHandlerFunc() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if !rm.isNotReady.IsSet() {
+			return
+		}
+
+		// the client should not reuse this connection since the server is about to close it
+		c.Header("Connection", "close")
+		c.AbortWithStatusJSON(http.StatusServiceUnavailable, data.GenericAPIResponse{
+			Data:  nil,
+			Error: notReadyErrorMessage,
+			Code:  data.ReturnCodeInternalError,
+		})
+	}
+}
+
+// MarkNotReady will cause all the new requests to be rejected. The requests already in progress are not affected
+func (rm *readinessMiddleware) MarkNotReady() {
+	rm.isNotReady.SetValue(true)
+}
+
+// IsReady returns true if the proxy still accepts new requests
+func (rm *readinessMiddleware) IsReady() bool {
+	return !rm.isNotReady.IsSet()
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (rm *readinessMiddleware) IsInterfaceNil() bool {
+	return rm == nil
+}
@@ -0,0 +1,69 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-proxy-go/api/groups"
+	"github.com/multiversx/mx-chain-proxy-go/api/mock"
+	"github.com/multiversx/mx-chain-proxy-go/common"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func startApiServerReadiness(handler groups.AccountsFacadeHandler, rm *readinessMiddleware) *gin.Engine {
+	ws := gin.New()
+	ws.Use(rm.MiddlewareHandlerFunc())
+	accGr, _ := groups.NewAccountsGroup(handler)
+
+	group := ws.Group("/address")
+	accGr.RegisterRoutes(group, data.ApiRoutesConfig{}, emptyGinHandler, emptyGinHandler, emptyGinHandler)
+	return ws
+}
+
+func TestNewReadinessMiddleware(t *testing.T) {
+	t.Parallel()
+
+	rm := NewReadinessMiddleware()
+	assert.False(t, check.IfNil(rm))
+	assert.True(t, rm.IsReady())
+}
+
+func TestReadinessMiddleware_MiddlewareHandlerFunc(t *testing.T) {
+	t.Parallel()
+
+	numCalls := 0
+	facade := &mock.FacadeStub{
+		GetAccountHandler: func(address string, _ common.AccountQueryOptions) (*data.AccountModel, error) {
+			numCalls++
+			return &data.AccountModel{
+				Account: data.Account{
+					Address: address,
+				},
+			}, nil
+		},
+	}
+
+	rm := NewReadinessMiddleware()
+	ws := startApiServerReadiness(facade, rm)
+
+	resp := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/address/test", nil)
+	ws.ServeHTTP(resp, req)
+	require.Equal(t, http.StatusOK, resp.Code)
+	require.Equal(t, 1, numCalls)
+
+	rm.MarkNotReady()
+	require.False(t, rm.IsReady())
+
+	resp = httptest.NewRecorder()
+	req, _ = http.NewRequest("GET", "/address/test", nil)
+	ws.ServeHTTP(resp, req)
+	assert.Equal(t, http.StatusServiceUnavailable, resp.Code)
+	assert.Equal(t, "close", resp.Header().Get("Connection"))
+	assert.Equal(t, 1, numCalls)
+}
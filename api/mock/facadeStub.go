@@ -1,8 +1,6 @@
 package mock
 
 import (
-	"math/big"
-
 	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/data/transaction"
 	"github.com/multiversx/mx-chain-core-go/data/vm"
@@ -22,7 +20,11 @@ type FacadeStub struct {
 	GetESDTsWithRoleCalled                       func(address string, role string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error)
 	GetNFTTokenIDsRegisteredByAddressCalled      func(address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error)
 	GetAllESDTTokensCalled                       func(address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error)
-	GetTransactionsHandler                       func(address string) ([]data.DatabaseTransaction, error)
+	GetTransactionsHandler                       func(address string, filter data.TransactionsHistoryFilter) (*data.TransactionsHistoryPage, error)
+	GetEventsCalled                              func(query data.EventsQuery) (*data.EventsPage, error)
+	GetTokenPropertiesCalled                     func(identifier string) (*data.TokenProperties, error)
+	GetTokenHoldersCalled                        func(identifier string, filter data.TokenHoldersFilter) (*data.TokenHoldersPage, error)
+	GetTokenTransfersCalled                      func(identifier string, filter data.TokenTransfersFilter) (*data.TokenTransfersPage, error)
 	GetTransactionHandler                        func(txHash string, withResults bool) (*transaction.ApiTransactionResult, error)
 	GetTransactionsPoolHandler                   func(fields string) (*data.TransactionsPool, error)
 	GetTransactionsPoolForShardHandler           func(shardID uint32, fields string) (*data.TransactionsPool, error)
@@ -32,11 +34,19 @@ type FacadeStub struct {
 	SendTransactionHandler                       func(tx *data.Transaction) (int, string, error)
 	SendMultipleTransactionsHandler              func(txs []*data.Transaction) (data.MultipleTransactionsResponseData, error)
 	SimulateTransactionHandler                   func(tx *data.Transaction, checkSignature bool) (*data.GenericAPIResponse, error)
-	SendUserFundsCalled                          func(receiver string, value *big.Int) error
-	ExecuteSCQueryHandler                        func(query *data.SCQuery) (*vm.VMOutputApi, error)
+	SendUserFundsCalled                          func(request *data.FundsRequest, clientIP string) (string, error)
+	GetFaucetDisbursementsCalled                 func(filter data.FaucetDisbursementsFilter) ([]*data.FaucetDisbursement, error)
+	GetFaucetTokensCalled                        func() []*data.FaucetToken
+	ExecuteSCQueryHandler                        func(query *data.SCQuery) (*vm.VMOutputApi, data.BlockInfo, error)
+	ExecuteSCQueriesHandler                      func(queries []*data.SCQuery) ([]*data.SCQueryResult, error)
+	ExecuteABIQueryCalled                        func(request *data.ABIQueryRequest, options common.VmQueryOptions) (*data.ABIQueryResponse, data.BlockInfo, error)
+	EncodeABIArgumentsCalled                     func(request *data.ABIArgumentsRequest) ([]string, error)
+	BuildABICallDataCalled                       func(request *data.ABIArgumentsRequest) (string, error)
+	DecodeABIResultsCalled                       func(request *data.ABIResultsRequest) ([]interface{}, error)
 	GetHeartbeatDataHandler                      func() (*data.HeartbeatResponse, error)
-	ValidatorStatisticsHandler                   func() (map[string]*data.ValidatorApiResponse, error)
+	ValidatorStatisticsHandler                   func() (*data.ValidatorStatisticsResponse, error)
 	TransactionCostRequestHandler                func(tx *data.Transaction) (*data.TxCostResponseData, error)
+	GetTransactionFeeEstimateCalled              func(tx *data.Transaction) (*data.TransactionFeeEstimate, error)
 	GetTransactionStatusHandler                  func(txHash string, sender string) (string, error)
 	GetProcessedTransactionStatusHandler         func(txHash string) (string, error)
 	GetConfigMetricsHandler                      func() (*data.GenericAPIResponse, error)
@@ -51,7 +61,7 @@ type FacadeStub struct {
 	GetTransactionByHashAndSenderAddressHandler  func(txHash string, sndAddr string, withResults bool) (*transaction.ApiTransactionResult, int, error)
 	GetBlockByHashCalled                         func(shardID uint32, hash string, options common.BlockQueryOptions) (*data.BlockApiResponse, error)
 	GetBlockByNonceCalled                        func(shardID uint32, nonce uint64, options common.BlockQueryOptions) (*data.BlockApiResponse, error)
-	GetBlocksByRoundCalled                       func(round uint64, options common.BlockQueryOptions) (*data.BlocksApiResponse, error)
+	GetBlocksByRoundCalled                       func(round uint64, options common.BlocksQueryOptions) (*data.BlocksApiResponse, error)
 	GetInternalBlockByHashCalled                 func(shardID uint32, hash string, format common.OutputFormat) (*data.InternalBlockApiResponse, error)
 	GetInternalBlockByNonceCalled                func(shardID uint32, nonce uint64, format common.OutputFormat) (*data.InternalBlockApiResponse, error)
 	GetInternalMiniBlockByHashCalled             func(shardID uint32, hash string, epoch uint32, format common.OutputFormat) (*data.InternalMiniBlockApiResponse, error)
@@ -76,6 +86,10 @@ type FacadeStub struct {
 	GetNodesVersionsCalled                       func() (*data.GenericAPIResponse, error)
 	GetAlteredAccountsByNonceCalled              func(shardID uint32, nonce uint64, options common.GetAlteredAccountsForBlockOptions) (*data.AlteredAccountsApiResponse, error)
 	GetAlteredAccountsByHashCalled               func(shardID uint32, hash string, options common.GetAlteredAccountsForBlockOptions) (*data.AlteredAccountsApiResponse, error)
+	GetBlocksRangeCalled                         func(shardID uint32, from uint64, to uint64, options common.BlockQueryOptions, handler data.BlocksRangeItemHandler) error
+	GetHyperBlocksRangeCalled                    func(from uint64, to uint64, options common.HyperblockQueryOptions, handler data.BlocksRangeItemHandler) error
+	GetHyperblockReorgsCalled                    func() []*data.HyperblockReorg
+	SubscribeToHyperblockReorgsCalled            func() (<-chan *data.HyperblockReorg, func())
 	GetTriesStatisticsCalled                     func(shardID uint32) (*data.TrieStatisticsAPIResponse, error)
 	GetEpochStartDataCalled                      func(epoch uint32, shardID uint32) (*data.GenericAPIResponse, error)
 	GetCodeHashCalled                            func(address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error)
@@ -246,7 +260,7 @@ func (f *FacadeStub) GetESDTSupply(token string) (*data.ESDTSupplyResponse, erro
 }
 
 // ValidatorStatistics -
-func (f *FacadeStub) ValidatorStatistics() (map[string]*data.ValidatorApiResponse, error) {
+func (f *FacadeStub) ValidatorStatistics() (*data.ValidatorStatisticsResponse, error) {
 	return f.ValidatorStatisticsHandler()
 }
 
@@ -312,8 +326,44 @@ func (f *FacadeStub) IsOldStorageForToken(tokenID string, nonce uint64) (bool, e
 }
 
 // GetTransactions -
-func (f *FacadeStub) GetTransactions(address string) ([]data.DatabaseTransaction, error) {
-	return f.GetTransactionsHandler(address)
+func (f *FacadeStub) GetTransactions(address string, filter data.TransactionsHistoryFilter) (*data.TransactionsHistoryPage, error) {
+	return f.GetTransactionsHandler(address, filter)
+}
+
+// GetEvents -
+func (f *FacadeStub) GetEvents(query data.EventsQuery) (*data.EventsPage, error) {
+	if f.GetEventsCalled != nil {
+		return f.GetEventsCalled(query)
+	}
+
+	return &data.EventsPage{}, nil
+}
+
+// GetTokenProperties -
+func (f *FacadeStub) GetTokenProperties(identifier string) (*data.TokenProperties, error) {
+	if f.GetTokenPropertiesCalled != nil {
+		return f.GetTokenPropertiesCalled(identifier)
+	}
+
+	return &data.TokenProperties{}, nil
+}
+
+// GetTokenHolders -
+func (f *FacadeStub) GetTokenHolders(identifier string, filter data.TokenHoldersFilter) (*data.TokenHoldersPage, error) {
+	if f.GetTokenHoldersCalled != nil {
+		return f.GetTokenHoldersCalled(identifier, filter)
+	}
+
+	return &data.TokenHoldersPage{}, nil
+}
+
+// GetTokenTransfers -
+func (f *FacadeStub) GetTokenTransfers(identifier string, filter data.TokenTransfersFilter) (*data.TokenTransfersPage, error) {
+	if f.GetTokenTransfersCalled != nil {
+		return f.GetTokenTransfersCalled(identifier, filter)
+	}
+
+	return &data.TokenTransfersPage{}, nil
 }
 
 // GetTransactionByHashAndSenderAddress -
@@ -396,6 +446,15 @@ func (f *FacadeStub) TransactionCostRequest(tx *data.Transaction) (*data.TxCostR
 	return f.TransactionCostRequestHandler(tx)
 }
 
+// GetTransactionFeeEstimate -
+func (f *FacadeStub) GetTransactionFeeEstimate(tx *data.Transaction) (*data.TransactionFeeEstimate, error) {
+	if f.GetTransactionFeeEstimateCalled != nil {
+		return f.GetTransactionFeeEstimateCalled(tx)
+	}
+
+	return nil, nil
+}
+
 // GetTransactionStatus -
 func (f *FacadeStub) GetTransactionStatus(txHash string, sender string) (string, error) {
 	return f.GetTransactionStatusHandler(txHash, sender)
@@ -407,15 +466,78 @@ func (f *FacadeStub) GetProcessedTransactionStatus(txHash string) (string, error
 }
 
 // SendUserFunds -
-func (f *FacadeStub) SendUserFunds(receiver string, value *big.Int) error {
-	return f.SendUserFundsCalled(receiver, value)
+func (f *FacadeStub) SendUserFunds(request *data.FundsRequest, clientIP string) (string, error) {
+	return f.SendUserFundsCalled(request, clientIP)
+}
+
+// GetFaucetTokens -
+func (f *FacadeStub) GetFaucetTokens() []*data.FaucetToken {
+	if f.GetFaucetTokensCalled != nil {
+		return f.GetFaucetTokensCalled()
+	}
+
+	return make([]*data.FaucetToken, 0)
+}
+
+// GetFaucetDisbursements -
+func (f *FacadeStub) GetFaucetDisbursements(filter data.FaucetDisbursementsFilter) ([]*data.FaucetDisbursement, error) {
+	if f.GetFaucetDisbursementsCalled != nil {
+		return f.GetFaucetDisbursementsCalled(filter)
+	}
+
+	return make([]*data.FaucetDisbursement, 0), nil
 }
 
 // ExecuteSCQuery -
-func (f *FacadeStub) ExecuteSCQuery(query *data.SCQuery) (*vm.VMOutputApi, error) {
+func (f *FacadeStub) ExecuteSCQuery(query *data.SCQuery) (*vm.VMOutputApi, data.BlockInfo, error) {
 	return f.ExecuteSCQueryHandler(query)
 }
 
+// ExecuteSCQueries -
+func (f *FacadeStub) ExecuteSCQueries(queries []*data.SCQuery) ([]*data.SCQueryResult, error) {
+	if f.ExecuteSCQueriesHandler != nil {
+		return f.ExecuteSCQueriesHandler(queries)
+	}
+
+	return make([]*data.SCQueryResult, 0), nil
+}
+
+// ExecuteABIQuery -
+func (f *FacadeStub) ExecuteABIQuery(request *data.ABIQueryRequest, options common.VmQueryOptions) (*data.ABIQueryResponse, data.BlockInfo, error) {
+	if f.ExecuteABIQueryCalled != nil {
+		return f.ExecuteABIQueryCalled(request, options)
+	}
+
+	return &data.ABIQueryResponse{}, data.BlockInfo{}, nil
+}
+
+// EncodeABIArguments -
+func (f *FacadeStub) EncodeABIArguments(request *data.ABIArgumentsRequest) ([]string, error) {
+	if f.EncodeABIArgumentsCalled != nil {
+		return f.EncodeABIArgumentsCalled(request)
+	}
+
+	return make([]string, 0), nil
+}
+
+// BuildABICallData -
+func (f *FacadeStub) BuildABICallData(request *data.ABIArgumentsRequest) (string, error) {
+	if f.BuildABICallDataCalled != nil {
+		return f.BuildABICallDataCalled(request)
+	}
+
+	return "", nil
+}
+
+// DecodeABIResults -
+func (f *FacadeStub) DecodeABIResults(request *data.ABIResultsRequest) ([]interface{}, error) {
+	if f.DecodeABIResultsCalled != nil {
+		return f.DecodeABIResultsCalled(request)
+	}
+
+	return make([]interface{}, 0), nil
+}
+
 // GetHeartbeatData -
 func (f *FacadeStub) GetHeartbeatData() (*data.HeartbeatResponse, error) {
 	return f.GetHeartbeatDataHandler()
@@ -437,7 +559,7 @@ func (f *FacadeStub) GetBlockByNonce(shardID uint32, nonce uint64, options commo
 }
 
 // GetBlocksByRound -
-func (f *FacadeStub) GetBlocksByRound(round uint64, options common.BlockQueryOptions) (*data.BlocksApiResponse, error) {
+func (f *FacadeStub) GetBlocksByRound(round uint64, options common.BlocksQueryOptions) (*data.BlocksApiResponse, error) {
 	if f.GetBlocksByRoundCalled != nil {
 		return f.GetBlocksByRoundCalled(round, options)
 	}
@@ -521,6 +643,42 @@ func (f *FacadeStub) GetAlteredAccountsByHash(shardID uint32, hash string, optio
 	return nil, nil
 }
 
+// GetBlocksRange -
+func (f *FacadeStub) GetBlocksRange(shardID uint32, from uint64, to uint64, options common.BlockQueryOptions, handler data.BlocksRangeItemHandler) error {
+	if f.GetBlocksRangeCalled != nil {
+		return f.GetBlocksRangeCalled(shardID, from, to, options, handler)
+	}
+
+	return nil
+}
+
+// GetHyperBlocksRange -
+func (f *FacadeStub) GetHyperBlocksRange(from uint64, to uint64, options common.HyperblockQueryOptions, handler data.BlocksRangeItemHandler) error {
+	if f.GetHyperBlocksRangeCalled != nil {
+		return f.GetHyperBlocksRangeCalled(from, to, options, handler)
+	}
+
+	return nil
+}
+
+// GetHyperblockReorgs -
+func (f *FacadeStub) GetHyperblockReorgs() []*data.HyperblockReorg {
+	if f.GetHyperblockReorgsCalled != nil {
+		return f.GetHyperblockReorgsCalled()
+	}
+
+	return nil
+}
+
+// SubscribeToHyperblockReorgs -
+func (f *FacadeStub) SubscribeToHyperblockReorgs() (<-chan *data.HyperblockReorg, func()) {
+	if f.SubscribeToHyperblockReorgsCalled != nil {
+		return f.SubscribeToHyperblockReorgsCalled()
+	}
+
+	return make(chan *data.HyperblockReorg), func() {}
+}
+
 // GetTriesStatistics -
 func (f *FacadeStub) GetTriesStatistics(shardID uint32) (*data.TrieStatisticsAPIResponse, error) {
 	if f.GetTriesStatisticsCalled != nil {
@@ -0,0 +1,128 @@
+package api
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"time"
+
+	"github.com/multiversx/mx-chain-proxy-go/config"
+)
+
+const defaultCertificateReloadInterval = time.Minute
+
+// tlsVersions holds the accepted minimum TLS versions. The versions older than 1.2 are deprecated and not accepted
+var tlsVersions = map[string]uint16{
+	"1.2": tls.VersionTLS12,
+	"1.3": tls.VersionTLS13,
+}
+
+// CreateTLSConfig creates the TLS configuration to be used by the web server listeners. The returned closer stops
+// the certificate files watching and has to be closed when the proxy shuts down
+func CreateTLSConfig(tlsConfig config.TLSConfig) (*tls.Config, io.Closer, error) {
+	minVersion, err := parseTLSVersion(tlsConfig.MinVersion)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	cipherSuites, err := parseCipherSuites(tlsConfig.CipherSuites)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	reloadInterval := time.Duration(tlsConfig.CertificateReloadIntervalSec) * time.Second
+	if tlsConfig.CertificateReloadIntervalSec == 0 {
+		reloadInterval = defaultCertificateReloadInterval
+	}
+
+	reloader, err := newCertificateReloader(tlsConfig.CertificateFile, tlsConfig.PrivateKeyFile, reloadInterval)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	serverTLSConfig := &tls.Config{
+		MinVersion:     minVersion,
+		CipherSuites:   cipherSuites,
+		GetCertificate: reloader.GetCertificate,
+	}
+
+	if len(tlsConfig.ClientCAFile) > 0 {
+		clientCAs, errLoad := loadCertificatesPool(tlsConfig.ClientCAFile)
+		if errLoad != nil {
+			return nil, nil, errLoad
+		}
+
+		// the client certificate is optional at handshake level. The secured routes are the ones requiring
+		// either a verified client certificate or the basic authentication credentials
+		serverTLSConfig.ClientCAs = clientCAs
+		serverTLSConfig.ClientAuth = tls.VerifyClientCertIfGiven
+	}
+
+	reloader.startReloading()
+
+	return serverTLSConfig, reloader, nil
+}
+
+// ConfigureServerTLS will set the TLS configuration on the provided server. HTTP/2 is negotiated only over TLS and
+// only if enabled
+func ConfigureServerTLS(server *http.Server, serverTLSConfig *tls.Config, enableHTTP2 bool) {
+	server.TLSConfig = serverTLSConfig.Clone()
+	if !enableHTTP2 {
+		// a non-nil empty map disables the automatic HTTP/2 configuration of the http server
+		server.TLSNextProto = make(map[string]func(*http.Server, *tls.Conn, http.Handler))
+	}
+}
+
+func parseTLSVersion(version string) (uint16, error) {
+	if len(version) == 0 {
+		return tls.VersionTLS12, nil
+	}
+
+	tlsVersion, ok := tlsVersions[version]
+	if !ok {
+		return 0, fmt.Errorf("%w: %s", ErrInvalidTLSVersion, version)
+	}
+
+	return tlsVersion, nil
+}
+
+func parseCipherSuites(names []string) ([]uint16, error) {
+	if len(names) == 0 {
+		// the default cipher suites of the tls package will be used
+		return nil, nil
+	}
+
+	availableSuites := make(map[string]uint16)
+	for _, suite := range tls.CipherSuites() {
+		availableSuites[suite.Name] = suite.ID
+	}
+
+	cipherSuites := make([]uint16, 0, len(names))
+	for _, name := range names {
+		id, ok := availableSuites[name]
+		if !ok {
+			return nil, fmt.Errorf("%w: %s", ErrInvalidCipherSuite, name)
+		}
+
+		cipherSuites = append(cipherSuites, id)
+	}
+
+	return cipherSuites, nil
+}
+
+func loadCertificatesPool(file string) (*x509.CertPool, error) {
+	pemBytes, err := ioutil.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(pemBytes) {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidClientCAFile, file)
+	}
+
+	return pool, nil
+}
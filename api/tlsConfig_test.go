@@ -0,0 +1,104 @@
+package api
+
+import (
+	"crypto/tls"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/multiversx/mx-chain-proxy-go/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateTLSConfig(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid min version should err", func(t *testing.T) {
+		t.Parallel()
+
+		for _, version := range []string{"2.0", "1.1", "1.0"} {
+			tlsConfig, closer, err := CreateTLSConfig(config.TLSConfig{MinVersion: version})
+			require.Nil(t, tlsConfig)
+			require.Nil(t, closer)
+			require.True(t, errors.Is(err, ErrInvalidTLSVersion))
+		}
+	})
+
+	t.Run("invalid cipher suite should err", func(t *testing.T) {
+		t.Parallel()
+
+		tlsConfig, closer, err := CreateTLSConfig(config.TLSConfig{CipherSuites: []string{"TLS_RSA_WITH_RC4_128_SHA"}})
+		require.Nil(t, tlsConfig)
+		require.Nil(t, closer)
+		require.True(t, errors.Is(err, ErrInvalidCipherSuite))
+	})
+
+	t.Run("invalid client CA file should err", func(t *testing.T) {
+		t.Parallel()
+
+		dir := t.TempDir()
+		certificateFile, privateKeyFile := writeSelfSignedCertificate(t, dir, "server")
+		tlsConfig, closer, err := CreateTLSConfig(config.TLSConfig{
+			CertificateFile: certificateFile,
+			PrivateKeyFile:  privateKeyFile,
+			ClientCAFile:    privateKeyFile,
+		})
+		require.Nil(t, tlsConfig)
+		require.Nil(t, closer)
+		require.True(t, errors.Is(err, ErrInvalidClientCAFile))
+	})
+
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		dir := t.TempDir()
+		certificateFile, privateKeyFile := writeSelfSignedCertificate(t, dir, "server")
+		tlsConfig, closer, err := CreateTLSConfig(config.TLSConfig{
+			CertificateFile: certificateFile,
+			PrivateKeyFile:  privateKeyFile,
+			MinVersion:      "1.3",
+			CipherSuites:    []string{"TLS_ECDHE_ECDSA_WITH_AES_128_GCM_SHA256"},
+			ClientCAFile:    certificateFile,
+		})
+		require.NoError(t, err)
+		defer func() {
+			_ = closer.Close()
+		}()
+
+		assert.Equal(t, uint16(tls.VersionTLS13), tlsConfig.MinVersion)
+		assert.Equal(t, []uint16{tls.TLS_ECDHE_ECDSA_WITH_AES_128_GCM_SHA256}, tlsConfig.CipherSuites)
+		assert.Equal(t, tls.VerifyClientCertIfGiven, tlsConfig.ClientAuth)
+		assert.NotNil(t, tlsConfig.ClientCAs)
+
+		certificate, err := tlsConfig.GetCertificate(nil)
+		require.NoError(t, err)
+		assert.NotNil(t, certificate)
+	})
+}
+
+func TestConfigureServerTLS(t *testing.T) {
+	t.Parallel()
+
+	serverTLSConfig := &tls.Config{MinVersion: tls.VersionTLS12}
+
+	server := &http.Server{}
+	ConfigureServerTLS(server, serverTLSConfig, true)
+	assert.Equal(t, uint16(tls.VersionTLS12), server.TLSConfig.MinVersion)
+	assert.Nil(t, server.TLSNextProto)
+
+	server = &http.Server{}
+	ConfigureServerTLS(server, serverTLSConfig, false)
+	assert.NotNil(t, server.TLSNextProto)
+	assert.Empty(t, server.TLSNextProto)
+}
+
+func TestHasVerifiedClientCertificate(t *testing.T) {
+	t.Parallel()
+
+	request, _ := http.NewRequest(http.MethodGet, "/status", nil)
+	assert.False(t, hasVerifiedClientCertificate(request))
+
+	request.TLS = &tls.ConnectionState{}
+	assert.False(t, hasVerifiedClientCertificate(request))
+}
@@ -5,10 +5,13 @@ import (
 	"fmt"
 	"io"
 	"math/big"
+	"net"
 	"net/http"
 	"os"
 	"os/signal"
 	"runtime"
+	"sync"
+	"syscall"
 	"time"
 
 	"github.com/multiversx/mx-chain-core-go/core"
@@ -20,15 +23,17 @@ import (
 	logger "github.com/multiversx/mx-chain-logger-go"
 	"github.com/multiversx/mx-chain-logger-go/file"
 	"github.com/multiversx/mx-chain-proxy-go/api"
+	"github.com/multiversx/mx-chain-proxy-go/api/middleware"
 	"github.com/multiversx/mx-chain-proxy-go/common"
 	"github.com/multiversx/mx-chain-proxy-go/config"
 	"github.com/multiversx/mx-chain-proxy-go/data"
 	"github.com/multiversx/mx-chain-proxy-go/metrics"
 	"github.com/multiversx/mx-chain-proxy-go/observer"
 	"github.com/multiversx/mx-chain-proxy-go/process"
+	"github.com/multiversx/mx-chain-proxy-go/process/abi"
 	"github.com/multiversx/mx-chain-proxy-go/process/cache"
-	"github.com/multiversx/mx-chain-proxy-go/process/database"
 	processFactory "github.com/multiversx/mx-chain-proxy-go/process/factory"
+	"github.com/multiversx/mx-chain-proxy-go/process/txcost"
 	"github.com/multiversx/mx-chain-proxy-go/testing"
 	versionsFactory "github.com/multiversx/mx-chain-proxy-go/versions/factory"
 	"github.com/urfave/cli"
@@ -39,6 +44,14 @@ const (
 	logFilePrefix        = "mx-chain-proxy-go"
 	logFileLifeSpanInSec = 86400
 	logFileMaxSizeInMB   = 1024
+
+	economicMetricsSnapshotKey = "economicMetrics"
+
+	// defaultMaxVmQueriesPerBatch is used when the config file does not set the MaxVmQueriesPerBatch option
+	defaultMaxVmQueriesPerBatch = 50
+
+	// defaultShutdownTimeoutSec is used when the config file does not set the ShutdownTimeoutSec option
+	defaultShutdownTimeoutSec = 30
 )
 
 // commitID and appVersion should be populated at build time using ldflags
@@ -73,7 +86,8 @@ VERSION:
 	log = logger.GetOrCreate("proxy")
 
 	// profileMode defines a flag for profiling the binary
-	// If enabled, it will open the pprof routes over the default gin rest webserver.
+	// If enabled, it will open the pprof routes over the default gin rest webserver, or over the admin webserver if
+	// the latter is enabled.
 	// There are several routes that will be available for profiling (profiling can be analyzed with: go tool pprof):
 	//  /debug/pprof/ (can be accessed in the browser, will list the available options)
 	//  /debug/pprof/goroutine
@@ -274,6 +288,8 @@ func startProxy(ctx *cli.Context) error {
 	}
 
 	closableComponents := data.NewClosableComponentsHandler()
+	// the streaming components are closed as soon as the shutdown starts, so the long-lived streaming requests end
+	streamingComponents := data.NewClosableComponentsHandler()
 
 	credentialsConfigurationFileName := ctx.GlobalString(credentialsConfigFile.Name)
 	credentialsConfig, err := loadCredentialsConfig(credentialsConfigurationFileName)
@@ -284,17 +300,28 @@ func startProxy(ctx *cli.Context) error {
 	statusMetricsProvider := metrics.NewStatusMetrics()
 
 	shouldStartSwaggerUI := ctx.GlobalBool(startSwaggerUI.Name)
-	versionsRegistry, err := createVersionsRegistryTestOrProduction(ctx, generalConfig, configurationFileName, externalConfig, statusMetricsProvider, closableComponents)
+	versionsRegistry, err := createVersionsRegistryTestOrProduction(ctx, generalConfig, configurationFileName, externalConfig, statusMetricsProvider, closableComponents, streamingComponents)
+	if err != nil {
+		return err
+	}
+
+	shutdownTimeout, err := getShutdownTimeout(generalConfig)
 	if err != nil {
 		return err
 	}
 
-	httpServer, err := startWebServer(versionsRegistry, generalConfig, *credentialsConfig, statusMetricsProvider, isProfileModeActivated, shouldStartSwaggerUI)
+	shutdownDrainDelay, err := getShutdownDrainDelay(generalConfig)
 	if err != nil {
 		return err
 	}
 
-	waitForServerShutdown(httpServer, closableComponents)
+	readinessHandler := middleware.NewReadinessMiddleware()
+	httpServers, chanServerErrors, err := startWebServers(versionsRegistry, generalConfig, *credentialsConfig, statusMetricsProvider, readinessHandler, closableComponents, isProfileModeActivated, shouldStartSwaggerUI)
+	if err != nil {
+		return err
+	}
+
+	waitForServerShutdown(httpServers, chanServerErrors, readinessHandler, closableComponents, streamingComponents, shutdownDrainDelay, shutdownTimeout)
 
 	log.Debug("closing proxy")
 	if !check.IfNilReflect(fileLogging) {
@@ -331,6 +358,7 @@ func createVersionsRegistryTestOrProduction(
 	exCfg *config.ExternalConfig,
 	statusMetricsHandler data.StatusMetricsProvider,
 	closableComponents *data.ClosableComponentsHandler,
+	streamingComponents *data.ClosableComponentsHandler,
 ) (data.VersionsRegistryHandler, error) {
 
 	var testHTTPServerEnabled bool
@@ -349,6 +377,7 @@ func createVersionsRegistryTestOrProduction(
 				HeartbeatCacheValidityDurationSec:        60,
 				ValStatsCacheValidityDurationSec:         60,
 				EconomicsMetricsCacheValidityDurationSec: 6,
+				GasConfigsCacheValidityDurationSec:       60,
 				FaucetValue:                              "10000000000",
 			},
 			ApiLogging: config.ApiLoggingConfig{
@@ -383,6 +412,13 @@ func createVersionsRegistryTestOrProduction(
 					Address: testServer.URL(),
 				},
 			},
+			Faucet: config.FaucetConfig{
+				LedgerPath:          "./db/faucet-test",
+				SenderWaitTimeoutMs: 30000,
+				Challenge: config.FaucetChallengeConfig{
+					Type: "none",
+				},
+			},
 			AddressPubkeyConverter: cfg.AddressPubkeyConverter,
 			Marshalizer:            config.TypeConfig{Type: "json"},
 			Hasher:                 config.TypeConfig{Type: "sha256"},
@@ -396,6 +432,7 @@ func createVersionsRegistryTestOrProduction(
 			ctx.GlobalString(walletKeyPemFile.Name),
 			ctx.GlobalString(apiConfigDirectory.Name),
 			closableComponents,
+			streamingComponents,
 		)
 	}
 
@@ -407,6 +444,7 @@ func createVersionsRegistryTestOrProduction(
 		ctx.GlobalString(walletKeyPemFile.Name),
 		ctx.GlobalString(apiConfigDirectory.Name),
 		closableComponents,
+		streamingComponents,
 	)
 }
 
@@ -418,6 +456,7 @@ func createVersionsRegistry(
 	pemFileLocation string,
 	apiConfigDirectoryPath string,
 	closableComponents *data.ClosableComponentsHandler,
+	streamingComponents *data.ClosableComponentsHandler,
 ) (data.VersionsRegistryHandler, error) {
 	pubKeyConverter, err := pubkeyConverter.NewBech32PubkeyConverter(cfg.AddressPubkeyConverter.Length, log)
 	if err != nil {
@@ -455,19 +494,29 @@ func createVersionsRegistry(
 		}
 	}
 
+	shardsFanOut, err := process.NewShardsFanOut(process.ArgsShardsFanOut{
+		MaxParallelRequests:  cfg.ShardsFanOut.MaxParallelRequests,
+		PerShardTimeout:      time.Duration(cfg.ShardsFanOut.PerShardTimeoutSec) * time.Second,
+		PartialResultsPolicy: cfg.ShardsFanOut.PartialResultsPolicy,
+	})
+	if err != nil {
+		return nil, err
+	}
+
 	bp, err := process.NewBaseProcessor(
 		cfg.GeneralSettings.RequestTimeoutSec,
 		shardCoord,
 		observersProvider,
 		fullHistoryNodesProvider,
 		pubKeyConverter,
+		shardsFanOut,
 	)
 	if err != nil {
 		return nil, err
 	}
 	bp.StartNodesSyncStateChecks()
 
-	connector, err := createElasticSearchConnector(exCfg)
+	connector, err := createHistoryBackend(exCfg)
 	if err != nil {
 		return nil, err
 	}
@@ -477,12 +526,19 @@ func createVersionsRegistry(
 		return nil, err
 	}
 
+	gasConfigsCacheValidity := time.Duration(cfg.GeneralSettings.GasConfigsCacheValidityDurationSec) * time.Second
+	gasConfigsCache, err := txcost.NewGasConfigsCache(bp, gasConfigsCacheValidity)
+	if err != nil {
+		return nil, err
+	}
+
 	faucetValue := big.NewInt(0)
 	faucetValue.SetString(cfg.GeneralSettings.FaucetValue, 10)
-	faucetProc, err := processFactory.CreateFaucetProcessor(bp, shardCoord, faucetValue, pubKeyConverter, pemFileLocation)
+	faucetProc, err := processFactory.CreateFaucetProcessor(bp, shardCoord, faucetValue, pubKeyConverter, gasConfigsCache, pemFileLocation, cfg.Faucet)
 	if err != nil {
 		return nil, err
 	}
+	closableComponents.Add(faucetProc)
 
 	txProc, err := processFactory.CreateTransactionProcessor(
 		bp,
@@ -490,17 +546,22 @@ func createVersionsRegistry(
 		hasher,
 		marshalizer,
 		cfg.GeneralSettings.AllowEntireTxPoolFetch,
+		gasConfigsCache,
 	)
 	if err != nil {
 		return nil, err
 	}
 
-	scQueryProc, err := process.NewSCQueryProcessor(bp, pubKeyConverter)
+	scQueryProc, err := createSCQueryService(cfg.VmQueryCache, cfg.GeneralSettings.MaxVmQueriesPerBatch, bp, pubKeyConverter)
+	if err != nil {
+		return nil, err
+	}
+
+	htbCacher, valStatsCacher, economicMetricsCacher, snapshotStorer, err := createCachers(cfg.PersistentCache)
 	if err != nil {
 		return nil, err
 	}
 
-	htbCacher := cache.NewHeartbeatMemoryCacher()
 	cacheValidity := time.Duration(cfg.GeneralSettings.HeartbeatCacheValidityDurationSec) * time.Second
 
 	nodeGroupProc, err := process.NewNodeGroupProcessor(bp, htbCacher, cacheValidity)
@@ -508,7 +569,6 @@ func createVersionsRegistry(
 		return nil, err
 	}
 
-	valStatsCacher := cache.NewValidatorsStatsMemoryCacher()
 	cacheValidity = time.Duration(cfg.GeneralSettings.ValStatsCacheValidityDurationSec) * time.Second
 
 	valStatsProc, err := process.NewValidatorStatisticsProcessor(bp, valStatsCacher, cacheValidity)
@@ -516,7 +576,6 @@ func createVersionsRegistry(
 		return nil, err
 	}
 
-	economicMetricsCacher := cache.NewGenericApiResponseMemoryCacher()
 	cacheValidity = time.Duration(cfg.GeneralSettings.EconomicsMetricsCacheValidityDurationSec) * time.Second
 
 	nodeStatusProc, err := process.NewNodeStatusProcessor(bp, economicMetricsCacher, cacheValidity)
@@ -525,16 +584,37 @@ func createVersionsRegistry(
 	}
 
 	closableComponents.Add(nodeGroupProc, valStatsProc, nodeStatusProc, bp)
+	if snapshotStorer != nil {
+		// added after the processors so the cache updates are stopped before the snapshots database is closed
+		closableComponents.Add(snapshotStorer)
+	}
 
 	nodeGroupProc.StartCacheUpdate()
 	valStatsProc.StartCacheUpdate()
 	nodeStatusProc.StartCacheUpdate()
 
-	blockProc, err := process.NewBlockProcessor(connector, bp)
+	shardBlocksCache, err := processFactory.CreateShardBlocksCache(cfg.ShardBlocksCache)
 	if err != nil {
 		return nil, err
 	}
 
+	blockProc, err := process.NewBlockProcessor(connector, bp, shardBlocksCache)
+	if err != nil {
+		return nil, err
+	}
+
+	hyperblockIngester, err := processFactory.CreateHyperblockIngester(exCfg.HyperblockIngester, connector, blockProc, nodeStatusProc, pubKeyConverter)
+	if err != nil {
+		return nil, err
+	}
+	// added before the history backend so the ingestion is stopped before the backend is closed
+	closableComponents.Add(hyperblockIngester)
+	closableConnector, ok := connector.(io.Closer)
+	if ok {
+		closableComponents.Add(closableConnector)
+	}
+	hyperblockIngester.StartIngesting()
+
 	blocksPrc, err := process.NewBlocksProcessor(bp)
 	if err != nil {
 		return nil, err
@@ -550,7 +630,7 @@ func createVersionsRegistry(
 		return nil, err
 	}
 
-	statusProc, err := process.NewStatusProcessor(bp, statusMetricsHandler)
+	statusProc, err := process.NewStatusProcessor(bp, statusMetricsHandler, hyperblockIngester)
 	if err != nil {
 		return nil, err
 	}
@@ -560,6 +640,57 @@ func createVersionsRegistry(
 		return nil, err
 	}
 
+	abiRegistry, err := abi.NewRegistry(cfg.ContractsABI.Directory)
+	if err != nil {
+		return nil, err
+	}
+
+	abiCodec, err := abi.NewCodec(pubKeyConverter)
+	if err != nil {
+		return nil, err
+	}
+
+	abiProc, err := process.NewABIProcessor(scQueryProc, abiRegistry, abiCodec)
+	if err != nil {
+		return nil, err
+	}
+
+	eventsProc, err := process.NewEventsProcessor(connector, pubKeyConverter)
+	if err != nil {
+		return nil, err
+	}
+
+	hyperblockReorgDetector, err := process.NewHyperblockReorgDetector(process.ArgsHyperblockReorgDetector{
+		HyperblockProvider:    blockProc,
+		NonceProvider:         nodeStatusProc,
+		MaxTrackedHyperblocks: cfg.HyperblockReorgs.MaxTrackedHyperblocks,
+		MaxReorgs:             cfg.HyperblockReorgs.MaxReorgs,
+		FinalNonceValidity:    time.Duration(cfg.HyperblockReorgs.FinalNonceValidityMs) * time.Millisecond,
+	})
+	if err != nil {
+		return nil, err
+	}
+	streamingComponents.Add(hyperblockReorgDetector)
+
+	blocksRangeProc, err := process.NewBlocksRangeProcessor(process.ArgsBlocksRangeProcessor{
+		HyperblockProvider:  hyperblockReorgDetector,
+		BlockProvider:       blockProc,
+		MaxRangeSize:        cfg.BlocksRange.MaxRangeSize,
+		MaxParallelRequests: cfg.BlocksRange.MaxParallelRequests,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	tokensProc, err := process.NewTokensProcessor(process.ArgsTokensProcessor{
+		SCQueryService:  scQueryProc,
+		DBReader:        connector,
+		PubKeyConverter: pubKeyConverter,
+	})
+	if err != nil {
+		return nil, err
+	}
+
 	facadeArgs := versionsFactory.FacadeArgs{
 		ActionsProcessor:             bp,
 		AccountProcessor:             accntProc,
@@ -576,6 +707,11 @@ func createVersionsRegistry(
 		ESDTSuppliesProcessor:        esdtSuppliesProc,
 		StatusProcessor:              statusProc,
 		AboutInfoProcessor:           aboutInfoProc,
+		ABIProcessor:                 abiProc,
+		EventsProcessor:              eventsProc,
+		BlocksRangeProcessor:         blocksRangeProc,
+		HyperblockProcessor:          hyperblockReorgDetector,
+		TokensProcessor:              tokensProc,
 	}
 
 	apiConfigParser, err := versionsFactory.NewApiConfigParser(apiConfigDirectoryPath)
@@ -586,16 +722,76 @@ func createVersionsRegistry(
 	return versionsFactory.CreateVersionsRegistry(facadeArgs, apiConfigParser)
 }
 
-func createElasticSearchConnector(exCfg *config.ExternalConfig) (process.ExternalStorageConnector, error) {
-	if !exCfg.ElasticSearchConnector.Enabled {
-		return database.NewDisabledElasticSearchConnector(), nil
+func createHistoryBackend(exCfg *config.ExternalConfig) (process.ExternalStorageConnector, error) {
+	return processFactory.CreateHistoryBackend(*exCfg)
+}
+
+func createSCQueryService(
+	vmQueryCacheConfig config.VmQueryCacheConfig,
+	maxQueriesPerBatch int,
+	bp process.Processor,
+	pubKeyConverter core.PubkeyConverter,
+) (process.SCQueryService, error) {
+	if maxQueriesPerBatch == 0 {
+		maxQueriesPerBatch = defaultMaxVmQueriesPerBatch
 	}
 
-	return database.NewElasticSearchConnector(
-		exCfg.ElasticSearchConnector.URL,
-		exCfg.ElasticSearchConnector.Username,
-		exCfg.ElasticSearchConnector.Password,
-	)
+	scQueryProc, err := process.NewSCQueryProcessor(bp, pubKeyConverter, maxQueriesPerBatch)
+	if err != nil {
+		return nil, err
+	}
+	if !vmQueryCacheConfig.Enabled {
+		return scQueryProc, nil
+	}
+
+	return process.NewCachedSCQueryProcessor(process.ArgsCachedSCQueryProcessor{
+		SCQueryService:       scQueryProc,
+		Processor:            bp,
+		PubKeyConverter:      pubKeyConverter,
+		MaxEntriesPerShard:   vmQueryCacheConfig.MaxEntriesPerShard,
+		NonceRefreshInterval: time.Duration(vmQueryCacheConfig.NonceRefreshIntervalMs) * time.Millisecond,
+	})
+}
+
+func createCachers(persistentCacheConfig config.PersistentCacheConfig) (
+	process.HeartbeatCacheHandler,
+	process.ValidatorStatisticsCacheHandler,
+	process.GenericApiResponseCacheHandler,
+	cache.SnapshotStorer,
+	error,
+) {
+	if !persistentCacheConfig.Enabled {
+		return cache.NewHeartbeatMemoryCacher(),
+			cache.NewValidatorsStatsMemoryCacher(),
+			cache.NewGenericApiResponseMemoryCacher(),
+			nil,
+			nil
+	}
+
+	snapshotStorer, err := cache.NewLevelDBSnapshotStorer(persistentCacheConfig.Path)
+	if err != nil {
+		return nil, nil, nil, nil, err
+	}
+
+	htbCacher, err := cache.NewHeartbeatPersistentCacher(snapshotStorer)
+	if err != nil {
+		log.LogIfError(snapshotStorer.Close())
+		return nil, nil, nil, nil, err
+	}
+
+	valStatsCacher, err := cache.NewValidatorStatsPersistentCacher(snapshotStorer)
+	if err != nil {
+		log.LogIfError(snapshotStorer.Close())
+		return nil, nil, nil, nil, err
+	}
+
+	economicMetricsCacher, err := cache.NewGenericApiResponsePersistentCacher(snapshotStorer, economicMetricsSnapshotKey)
+	if err != nil {
+		log.LogIfError(snapshotStorer.Close())
+		return nil, nil, nil, nil, err
+	}
+
+	return htbCacher, valStatsCacher, economicMetricsCacher, snapshotStorer, nil
 }
 
 func getShardCoordinator(cfg *config.Config) (common.Coordinator, error) {
@@ -616,59 +812,209 @@ func getShardCoordinator(cfg *config.Config) (common.Coordinator, error) {
 	return shardCoordinator, nil
 }
 
-func startWebServer(
+func getShutdownTimeout(generalConfig *config.Config) (time.Duration, error) {
+	shutdownTimeoutSec := generalConfig.GeneralSettings.ShutdownTimeoutSec
+	if shutdownTimeoutSec == 0 {
+		shutdownTimeoutSec = defaultShutdownTimeoutSec
+	}
+	if shutdownTimeoutSec < 0 {
+		return 0, fmt.Errorf("invalid value %d for ShutdownTimeoutSec. It must be greater "+
+			"than zero", shutdownTimeoutSec)
+	}
+
+	return time.Duration(shutdownTimeoutSec) * time.Second, nil
+}
+
+func getShutdownDrainDelay(generalConfig *config.Config) (time.Duration, error) {
+	shutdownDrainDelaySec := generalConfig.GeneralSettings.ShutdownDrainDelaySec
+	if shutdownDrainDelaySec < 0 {
+		return 0, fmt.Errorf("invalid value %d for ShutdownDrainDelaySec. It must not be "+
+			"negative", shutdownDrainDelaySec)
+	}
+
+	return time.Duration(shutdownDrainDelaySec) * time.Second, nil
+}
+
+func startWebServers(
 	versionsRegistry data.VersionsRegistryHandler,
 	generalConfig *config.Config,
 	credentialsConfig config.CredentialsConfig,
 	statusMetricsProvider data.StatusMetricsProvider,
+	readinessHandler middleware.ReadinessHandler,
+	closableComponents *data.ClosableComponentsHandler,
 	isProfileModeActivated bool,
 	shouldStartSwaggerUI bool,
-) (*http.Server, error) {
-	var err error
-	var httpServer *http.Server
-
+) ([]*http.Server, <-chan error, error) {
 	port := generalConfig.GeneralSettings.ServerPort
 
 	if generalConfig.GeneralSettings.RateLimitWindowDurationSeconds <= 0 {
-		return nil, fmt.Errorf("invalid value %d for RateLimitWindowDurationSeconds. It must be greater "+
+		return nil, nil, fmt.Errorf("invalid value %d for RateLimitWindowDurationSeconds. It must be greater "+
 			"than zero", generalConfig.GeneralSettings.RateLimitWindowDurationSeconds)
 	}
-	httpServer, err = api.CreateServer(
+	httpServer, err := api.CreateServer(
 		versionsRegistry,
 		port,
 		generalConfig.ApiLogging,
 		credentialsConfig,
 		statusMetricsProvider,
+		readinessHandler,
 		generalConfig.GeneralSettings.RateLimitWindowDurationSeconds,
+		generalConfig.GeneralSettings.TrustedProxies,
 		isProfileModeActivated,
 		shouldStartSwaggerUI,
+		generalConfig.AdminServer.Enabled,
 	)
-
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
-	go func() {
-		err = httpServer.ListenAndServe()
-		if err != nil {
-			log.Error("cannot ListenAndServe()", "err", err)
-			os.Exit(1)
+
+	httpServers := []*http.Server{httpServer}
+	if generalConfig.AdminServer.Enabled {
+		adminCredentialsConfig, errLoad := getAdminCredentialsConfig(generalConfig.AdminServer, credentialsConfig)
+		if errLoad != nil {
+			return nil, nil, errLoad
 		}
-	}()
 
-	return httpServer, nil
+		adminServer, errCreate := api.CreateAdminServer(
+			versionsRegistry,
+			generalConfig.AdminServer.ListenAddress,
+			generalConfig.ApiLogging,
+			adminCredentialsConfig,
+			statusMetricsProvider,
+			readinessHandler,
+			generalConfig.GeneralSettings.RateLimitWindowDurationSeconds,
+			generalConfig.GeneralSettings.TrustedProxies,
+			isProfileModeActivated,
+			generalConfig.AdminServer.ShouldSecureAllRoutes,
+		)
+		if errCreate != nil {
+			return nil, nil, errCreate
+		}
+
+		httpServers = append(httpServers, adminServer)
+	}
+
+	if generalConfig.TLS.Enabled {
+		tlsConfig, certificateReloader, errTLS := api.CreateTLSConfig(generalConfig.TLS)
+		if errTLS != nil {
+			return nil, nil, errTLS
+		}
+		closableComponents.Add(certificateReloader)
+
+		for _, server := range httpServers {
+			api.ConfigureServerTLS(server, tlsConfig, generalConfig.TLS.EnableHTTP2)
+		}
+	}
+
+	// the listeners are created here so the binding errors (such as the port being already in use) are reported
+	// directly to the caller instead of being discovered inside the serving go routines
+	listeners := make([]net.Listener, 0, len(httpServers))
+	for _, server := range httpServers {
+		listener, errListen := net.Listen("tcp", server.Addr)
+		if errListen != nil {
+			closeListeners(listeners)
+			return nil, nil, errListen
+		}
+
+		listeners = append(listeners, listener)
+	}
+
+	chanServerErrors := make(chan error, len(httpServers))
+	for i, server := range httpServers {
+		go serve(server, listeners[i], chanServerErrors)
+		log.Info("web server started", "address", listeners[i].Addr().String(), "TLS", server.TLSConfig != nil)
+	}
+
+	return httpServers, chanServerErrors, nil
 }
 
-func waitForServerShutdown(httpServer *http.Server, closableComponents *data.ClosableComponentsHandler) {
-	quit := make(chan os.Signal)
-	signal.Notify(quit, os.Interrupt, os.Kill)
-	<-quit
+func getAdminCredentialsConfig(adminServerConfig config.AdminServerConfig, credentialsConfig config.CredentialsConfig) (config.CredentialsConfig, error) {
+	if len(adminServerConfig.CredentialsFile) == 0 {
+		return credentialsConfig, nil
+	}
 
-	closableComponents.Close()
+	adminCredentialsConfig, err := loadCredentialsConfig(adminServerConfig.CredentialsFile)
+	if err != nil {
+		return config.CredentialsConfig{}, err
+	}
+
+	return *adminCredentialsConfig, nil
+}
+
+func serve(server *http.Server, listener net.Listener, chanServerErrors chan<- error) {
+	var err error
+	if server.TLSConfig != nil {
+		// the certificate is provided by the TLS config, so no files are needed here
+		err = server.ServeTLS(listener, "", "")
+	} else {
+		err = server.Serve(listener)
+	}
 
-	shutdownContext, cancel := context.WithTimeout(context.Background(), time.Second)
+	if err != nil && err != http.ErrServerClosed {
+		chanServerErrors <- fmt.Errorf("%w for the web server on %s", err, server.Addr)
+	}
+}
+
+func closeListeners(listeners []net.Listener) {
+	for _, listener := range listeners {
+		log.LogIfError(listener.Close())
+	}
+}
+
+func waitForServerShutdown(
+	httpServers []*http.Server,
+	chanServerErrors <-chan error,
+	readinessHandler middleware.ReadinessHandler,
+	closableComponents *data.ClosableComponentsHandler,
+	streamingComponents *data.ClosableComponentsHandler,
+	shutdownDrainDelay time.Duration,
+	shutdownTimeout time.Duration,
+) {
+	quit := make(chan os.Signal, 1)
+	signal.Notify(quit, os.Interrupt, syscall.SIGTERM)
+
+	select {
+	case sig := <-quit:
+		log.Info("received shutdown signal", "signal", sig.String())
+	case err := <-chanServerErrors:
+		log.Error("web server stopped serving requests", "error", err)
+	}
+
+	// new requests are rejected from this point on, while the ones in progress are allowed to finish
+	readinessHandler.MarkNotReady()
+
+	// the streaming requests never end by themselves, so they are ended now instead of being cut at the timeout
+	streamingComponents.Close()
+
+	// the load balancers are given time to notice the failing readiness probe (the /ready route) and stop routing new
+	// requests to the proxy
+	if shutdownDrainDelay > 0 {
+		log.Info("waiting for the load balancers to stop routing requests", "delay", shutdownDrainDelay)
+		time.Sleep(shutdownDrainDelay)
+	}
+
+	log.Info("draining the in-flight requests", "timeout", shutdownTimeout)
+	shutdownContext, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
 	defer cancel()
-	_ = httpServer.Shutdown(shutdownContext)
-	_ = httpServer.Close()
+
+	wg := sync.WaitGroup{}
+	wg.Add(len(httpServers))
+	for _, server := range httpServers {
+		go func(server *http.Server) {
+			defer wg.Done()
+
+			err := server.Shutdown(shutdownContext)
+			if err != nil {
+				log.Warn("the in-flight requests were not drained in time, closing the remaining connections",
+					"address", server.Addr, "error", err)
+				log.LogIfError(server.Close())
+			}
+		}(server)
+	}
+	wg.Wait()
+
+	// the cache updaters and the nodes sync state checks are stopped only after no request can use them anymore
+	closableComponents.Close()
 }
 
 func removeLogColors() {
@@ -45,6 +45,42 @@ const (
 	UrlParameterTokensFilter = "tokens"
 	// UrlParameterWithAlteredAccounts represents the name of an URL parameter
 	UrlParameterWithAlteredAccounts = "withAlteredAccounts"
+	// UrlParameterReceiver represents the name of an URL parameter
+	UrlParameterReceiver = "receiver"
+	// UrlParameterClientIP represents the name of an URL parameter
+	UrlParameterClientIP = "ip"
+	// UrlParameterFrom represents the name of an URL parameter
+	UrlParameterFrom = "from"
+	// UrlParameterTo represents the name of an URL parameter
+	UrlParameterTo = "to"
+	// UrlParameterLimit represents the name of an URL parameter
+	UrlParameterLimit = "limit"
+	// UrlParameterCursor represents the name of an URL parameter
+	UrlParameterCursor = "cursor"
+	// UrlParameterOrder represents the name of an URL parameter
+	UrlParameterOrder = "order"
+	// UrlParameterDirection represents the name of an URL parameter
+	UrlParameterDirection = "direction"
+	// UrlParameterStatus represents the name of an URL parameter
+	UrlParameterStatus = "status"
+	// UrlParameterToken represents the name of an URL parameter
+	UrlParameterToken = "token"
+	// UrlParameterFunction represents the name of an URL parameter
+	UrlParameterFunction = "function"
+	// UrlParameterFromNonce represents the name of an URL parameter
+	UrlParameterFromNonce = "fromNonce"
+	// UrlParameterToNonce represents the name of an URL parameter
+	UrlParameterToNonce = "toNonce"
+	// UrlParameterAddress represents the name of an URL parameter
+	UrlParameterAddress = "address"
+	// UrlParameterIdentifier represents the name of an URL parameter
+	UrlParameterIdentifier = "identifier"
+	// UrlParameterTopics represents the name of an URL parameter
+	UrlParameterTopics = "topics"
+	// UrlParameterStream represents the name of an URL parameter
+	UrlParameterStream = "stream"
+	// UrlParameterVerify represents the name of an URL parameter
+	UrlParameterVerify = "verify"
 )
 
 // BlockQueryOptions holds options for block queries
@@ -53,6 +89,13 @@ type BlockQueryOptions struct {
 	WithLogs         bool
 }
 
+// BlocksQueryOptions holds options for the queries of the blocks of all the shards. When verifying, the blocks of all
+// the observers are compared, instead of using the first one received for each shard
+type BlocksQueryOptions struct {
+	BlockQueryOptions
+	Verify bool
+}
+
 // HyperblockQueryOptions holds options for hyperblock queries
 type HyperblockQueryOptions struct {
 	WithLogs               bool
@@ -139,6 +182,14 @@ func BuildUrlWithAccountQueryOptions(path string, options AccountQueryOptions) s
 	return u.String()
 }
 
+// VmQueryOptions holds the coordinates of the block a smart contract query should be executed against
+type VmQueryOptions struct {
+	BlockNonce    core.OptionalUint64
+	BlockHash     []byte
+	BlockRootHash []byte
+	HintEpoch     core.OptionalUint32
+}
+
 // BuildUrlWithAlteredAccountsQueryOptions builds an URL with altered accounts parameters
 func BuildUrlWithAlteredAccountsQueryOptions(path string, options GetAlteredAccountsForBlockOptions) string {
 	u := url.URL{Path: path}
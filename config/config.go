@@ -11,11 +11,16 @@ type GeneralSettingsConfig struct {
 	HeartbeatCacheValidityDurationSec        int
 	ValStatsCacheValidityDurationSec         int
 	EconomicsMetricsCacheValidityDurationSec int
+	GasConfigsCacheValidityDurationSec       int
 	FaucetValue                              string
 	RateLimitWindowDurationSeconds           int
+	ShutdownTimeoutSec                       int
+	ShutdownDrainDelaySec                    int
 	BalancedObservers                        bool
 	BalancedFullHistoryNodes                 bool
 	AllowEntireTxPoolFetch                   bool
+	MaxVmQueriesPerBatch                     int
+	TrustedProxies                           []string
 }
 
 // Config will hold the whole config file's data
@@ -25,6 +30,16 @@ type Config struct {
 	Marshalizer            TypeConfig
 	Hasher                 TypeConfig
 	ApiLogging             ApiLoggingConfig
+	TLS                    TLSConfig
+	AdminServer            AdminServerConfig
+	ShardsFanOut           ShardsFanOutConfig
+	PersistentCache        PersistentCacheConfig
+	ContractsABI           ContractsABIConfig
+	VmQueryCache           VmQueryCacheConfig
+	BlocksRange            BlocksRangeConfig
+	HyperblockReorgs       HyperblockReorgsConfig
+	ShardBlocksCache       ShardBlocksCacheConfig
+	Faucet                 FaucetConfig
 	Observers              []*data.NodeData
 	FullHistoryNodes       []*data.NodeData
 }
@@ -47,6 +62,101 @@ type ApiLoggingConfig struct {
 	ThresholdInMicroSeconds int
 }
 
+// TLSConfig holds the configuration related to the TLS termination on the web server listeners
+type TLSConfig struct {
+	Enabled                      bool
+	CertificateFile              string
+	PrivateKeyFile               string
+	CertificateReloadIntervalSec int
+	MinVersion                   string
+	CipherSuites                 []string
+	ClientCAFile                 string
+	EnableHTTP2                  bool
+}
+
+// AdminServerConfig holds the configuration of the web server that exposes the administrative routes
+type AdminServerConfig struct {
+	Enabled               bool
+	ListenAddress         string
+	CredentialsFile       string
+	ShouldSecureAllRoutes bool
+}
+
+// ShardsFanOutConfig holds the configuration related to the requests that aggregate data from multiple shards
+type ShardsFanOutConfig struct {
+	MaxParallelRequests  int
+	PerShardTimeoutSec   int
+	PartialResultsPolicy string
+}
+
+// PersistentCacheConfig holds the configuration of the disk-backed cache used for the heartbeats, the validator
+// statistics and the economics metrics
+type PersistentCacheConfig struct {
+	Enabled bool
+	Path    string
+}
+
+// ContractsABIConfig holds the configuration of the smart contracts ABIs used by the ABI-aware VM query endpoints
+type ContractsABIConfig struct {
+	Directory string
+}
+
+// VmQueryCacheConfig holds the configuration of the cache holding the results of the smart contracts queries
+type VmQueryCacheConfig struct {
+	Enabled                bool
+	MaxEntriesPerShard     int
+	NonceRefreshIntervalMs int
+}
+
+// BlocksRangeConfig holds the configuration of the endpoints streaming a range of blocks or hyperblocks
+type BlocksRangeConfig struct {
+	MaxRangeSize        int
+	MaxParallelRequests int
+}
+
+// HyperblockReorgsConfig holds the configuration of the hyperblocks finality and reorgs detection
+type HyperblockReorgsConfig struct {
+	MaxTrackedHyperblocks int
+	MaxReorgs             int
+	FinalNonceValidityMs  int
+}
+
+// ShardBlocksCacheConfig holds the configuration of the cache of the shard blocks notarized by hyperblocks
+type ShardBlocksCacheConfig struct {
+	Enabled    bool
+	TTLMs      int
+	MaxEntries int
+}
+
+// FaucetConfig holds the configuration of the rules applied to the faucet requests
+type FaucetConfig struct {
+	ReceiverCooldownSec int
+	ClientIPCooldownSec int
+	MaxValuePerRequest  string
+	MaxValuePerDay      string
+	LedgerPath          string
+	SenderWaitTimeoutMs int
+	Challenge           FaucetChallengeConfig
+	Tokens              []FaucetTokenConfig
+}
+
+// FaucetTokenConfig holds the configuration of a token dispensed by the faucet
+type FaucetTokenConfig struct {
+	Identifier      string
+	Nonce           uint64
+	Amount          string
+	MaxAmountPerDay string
+}
+
+// FaucetChallengeConfig holds the configuration of the challenge a faucet request has to solve
+type FaucetChallengeConfig struct {
+	Type             string
+	PoWDifficulty    int
+	PoWWindowSec     int
+	CaptchaVerifyURL string
+	CaptchaSecret    string
+}
+
 // CredentialsConfig holds the credential pairs
 type CredentialsConfig struct {
 	Credentials []data.Credential
@@ -55,7 +165,15 @@ type CredentialsConfig struct {
 
 // ExternalConfig will hold the configurations for external tools, such as Explorer or Elastic Search
 type ExternalConfig struct {
+	HistoryBackend         HistoryBackendConfig
 	ElasticSearchConnector ElasticSearchConfig
+	SQLiteConnector        SQLiteConfig
+	HyperblockIngester     HyperblockIngesterConfig
+}
+
+// HistoryBackendConfig will hold the type of the storage serving the transactions history and the atlas blocks
+type HistoryBackendConfig struct {
+	Type string
 }
 
 // ElasticSearchConfig will hold the configuration for the elastic search
@@ -65,3 +183,17 @@ type ElasticSearchConfig struct {
 	Username string
 	Password string
 }
+
+// SQLiteConfig will hold the configuration for the embedded SQLite history storage
+type SQLiteConfig struct {
+	Path string
+}
+
+// HyperblockIngesterConfig will hold the configuration for the hyperblock ingester feeding the history storage
+type HyperblockIngesterConfig struct {
+	Enabled            bool
+	StartNonce         uint64
+	PollingIntervalMs  uint64
+	FinalityMargin     uint64
+	IndexTokenBalances bool
+}
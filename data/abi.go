@@ -0,0 +1,38 @@
+package data
+
+import "encoding/json"
+
+// ABIArgumentsRequest holds the JSON arguments of a smart contract endpoint, to be encoded based on the contract's ABI.
+// The ABI is either provided inline or as the name of one of the ABIs loaded from the configured directory
+type ABIArgumentsRequest struct {
+	ABIName  string            `json:"abiName"`
+	ABI      json.RawMessage   `json:"abi"`
+	Endpoint string            `json:"endpoint"`
+	Args     []json.RawMessage `json:"args"`
+}
+
+// ABIResultsRequest holds the raw results of a smart contract endpoint, to be decoded based on the contract's ABI
+type ABIResultsRequest struct {
+	ABIName    string          `json:"abiName"`
+	ABI        json.RawMessage `json:"abi"`
+	Endpoint   string          `json:"endpoint"`
+	ReturnData [][]byte        `json:"returnData"`
+}
+
+// ABIQueryRequest holds a smart contract query whose arguments and results are encoded based on the contract's ABI
+type ABIQueryRequest struct {
+	ABIName   string            `json:"abiName"`
+	ABI       json.RawMessage   `json:"abi"`
+	ScAddress string            `json:"scAddress"`
+	Endpoint  string            `json:"endpoint"`
+	Caller    string            `json:"caller"`
+	Value     string            `json:"value"`
+	Args      []json.RawMessage `json:"args"`
+}
+
+// ABIQueryResponse holds the decoded results of a smart contract query
+type ABIQueryResponse struct {
+	Results       []interface{} `json:"results"`
+	ReturnCode    string        `json:"returnCode"`
+	ReturnMessage string        `json:"returnMessage"`
+}
@@ -41,7 +41,8 @@ type ValidatorApiResponse struct {
 
 // ValidatorStatisticsResponse respects the format the validator statistics are received from the observers
 type ValidatorStatisticsResponse struct {
-	Statistics map[string]*ValidatorApiResponse `json:"statistics"`
+	Statistics  map[string]*ValidatorApiResponse `json:"statistics"`
+	CacheAgeSec uint64                           `json:"cacheAgeSec,omitempty"`
 }
 
 // ValidatorStatisticsApiResponse respects the format the validator statistics are received from the observers
@@ -1,6 +1,7 @@
 package data
 
 import (
+	"encoding/json"
 	"time"
 
 	"github.com/gin-gonic/gin"
@@ -16,10 +17,14 @@ type GenericAPIResponse struct {
 // NetworkConfig is a dto that will keep information about the network config
 type NetworkConfig struct {
 	Config struct {
-		ChainID               string `json:"erd_chain_id"`
-		MinGasLimit           uint64 `json:"erd_min_gas_limit"`
-		MinGasPrice           uint64 `json:"erd_min_gas_price"`
-		MinTransactionVersion uint32 `json:"erd_min_transaction_version"`
+		ChainID                string      `json:"erd_chain_id"`
+		MinGasLimit            uint64      `json:"erd_min_gas_limit"`
+		MinGasPrice            uint64      `json:"erd_min_gas_price"`
+		MinTransactionVersion  uint32      `json:"erd_min_transaction_version"`
+		GasPerDataByte         uint64      `json:"erd_gas_per_data_byte"`
+		ExtraGasLimitGuardedTx uint64      `json:"erd_extra_gas_limit_guarded_tx"`
+		GasPriceModifier       json.Number `json:"erd_gas_price_modifier"`
+		RoundDuration          uint64      `json:"erd_round_duration"`
 	} `json:"config"`
 }
 
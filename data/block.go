@@ -44,7 +44,23 @@ func NewHyperblockApiResponse(hyperblock api.Hyperblock) *HyperblockApiResponse
 
 // HyperblockApiResponsePayload wraps a hyperblock
 type HyperblockApiResponsePayload struct {
-	Hyperblock api.Hyperblock `json:"hyperblock"`
+	Hyperblock    api.Hyperblock `json:"hyperblock"`
+	MissingShards []uint32       `json:"missingShards,omitempty"`
+	IsFinal       bool           `json:"isFinal"`
+}
+
+// HyperblockReorg holds the details of a previously served hyperblock nonce whose hash has changed
+type HyperblockReorg struct {
+	Nonce            uint64 `json:"nonce"`
+	PreviousHash     string `json:"previousHash"`
+	NewHash          string `json:"newHash"`
+	PreviousWasFinal bool   `json:"previousWasFinal"`
+	DetectedAt       int64  `json:"detectedAt"`
+}
+
+// HyperblockReorgsApiResponsePayload wraps the most recent hyperblock reorgs
+type HyperblockReorgsApiResponsePayload struct {
+	Reorgs []*HyperblockReorg `json:"reorgs"`
 }
 
 // InternalBlockApiResponse is a response holding an internal block
@@ -94,3 +110,7 @@ type AlteredAccountsApiResponse struct {
 type AlteredAccountsPayload struct {
 	Accounts []*outport.AlteredAccount `json:"accounts"`
 }
+
+// BlocksRangeItemHandler is called, in order, for each block or hyperblock of a requested range. Returning an error
+// stops the fetching of the range
+type BlocksRangeItemHandler func(item interface{}) error
@@ -9,7 +9,24 @@ type BlocksApiResponse struct {
 	Code  ReturnCode               `json:"code"`
 }
 
-// BlocksApiResponsePayload wraps a block
+// BlocksApiResponsePayload wraps a block. The missing shards are the ones none of the observers returned a block for,
+// while the disagreements are only reported when the blocks of all the observers were compared
 type BlocksApiResponsePayload struct {
-	Blocks []*api.Block `json:"blocks"`
+	Blocks        []*api.Block               `json:"blocks"`
+	MissingShards []uint32                   `json:"missingShards"`
+	Disagreements []*ShardBlocksDisagreement `json:"disagreements,omitempty"`
+}
+
+// ShardBlocksDisagreement holds the different blocks returned for the same round by the observers of a shard, which
+// signals a fork or observers lagging behind
+type ShardBlocksDisagreement struct {
+	ShardID  uint32                  `json:"shardId"`
+	Versions []*ObservedBlockVersion `json:"versions"`
+}
+
+// ObservedBlockVersion is a version of a block along with the observers which returned it
+type ObservedBlockVersion struct {
+	Hash      string   `json:"hash"`
+	Nonce     uint64   `json:"nonce"`
+	Observers []string `json:"observers"`
 }
@@ -3,9 +3,24 @@ package data
 import (
 	"math/big"
 
+	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-es-indexer-go/data"
 )
 
+const (
+	// TransactionsDirectionIn selects the transactions received by an address
+	TransactionsDirectionIn = "in"
+
+	// TransactionsDirectionOut selects the transactions sent by an address
+	TransactionsDirectionOut = "out"
+
+	// SortOrderAscending sorts the results from the oldest to the newest
+	SortOrderAscending = "asc"
+
+	// SortOrderDescending sorts the results from the newest to the oldest
+	SortOrderDescending = "desc"
+)
+
 // DatabaseTransaction extends indexer.Transaction with the 'hash' field that is not ignored in json schema
 type DatabaseTransaction struct {
 	Hash string `json:"hash"`
@@ -21,3 +36,158 @@ func (dt *DatabaseTransaction) CalculateFee() string {
 
 	return fee.String()
 }
+
+// TransactionsHistoryFilter holds the criteria used to select the transactions of an address from the history storage.
+// The zero values of the fields mean that the corresponding criterion is not applied. The cursor is the one returned
+// along with the previous page of results, when requested with the same criteria
+type TransactionsHistoryFilter struct {
+	Direction string
+	Status    string
+	Token     string
+	Function  string
+	From      int64
+	To        int64
+	FromNonce core.OptionalUint64
+	ToNonce   core.OptionalUint64
+	Order     string
+	Limit     int
+	Cursor    string
+}
+
+// TransactionsHistoryPage holds a page of transactions of an address along with the cursor of the next page, which is
+// empty when there are no more results
+type TransactionsHistoryPage struct {
+	Transactions []DatabaseTransaction `json:"transactions"`
+	NextCursor   string                `json:"nextCursor,omitempty"`
+}
+
+// DatabaseEvent is an event generated by a transaction or by a smart contract result, as stored in the history storage
+type DatabaseEvent struct {
+	TxHash     string   `json:"txHash"`
+	Index      int      `json:"index"`
+	Address    string   `json:"address"`
+	Identifier string   `json:"identifier"`
+	Topics     [][]byte `json:"topics"`
+	Data       []byte   `json:"data"`
+	BlockNonce uint64   `json:"hyperblockNonce"`
+	Timestamp  int64    `json:"timestamp"`
+}
+
+// EventsFilter holds the criteria used to select events from the history storage. The topics are matched by position,
+// a nil topic matching any value. The zero values of the other fields mean that the corresponding criterion is not
+// applied. The cursor is the one returned along with the previous page of results, when requested with the same criteria
+type EventsFilter struct {
+	Address    string
+	Identifier string
+	Topics     [][]byte
+	FromBlock  core.OptionalUint64
+	ToBlock    core.OptionalUint64
+	Order      string
+	Limit      int
+	Cursor     string
+}
+
+// EventsQuery holds an events search request, as received from the API. The topics are not decoded yet: each one is
+// either empty, matching any value, or an encoded value optionally prefixed by its encoding (hex:, bech32: or str:)
+type EventsQuery struct {
+	Topics []string
+	Filter EventsFilter
+}
+
+// EventsPage holds a page of events along with the cursor of the next page, which is empty when there are no more
+// results
+type EventsPage struct {
+	Events     []DatabaseEvent `json:"events"`
+	NextCursor string          `json:"nextCursor,omitempty"`
+}
+
+// DatabaseTokenTransfer is a fungible, semi-fungible or non-fungible token transfer, as extracted from the events of a
+// transaction. The nonce is 0 for the fungible tokens
+type DatabaseTokenTransfer struct {
+	TxHash     string `json:"txHash"`
+	EventIndex int    `json:"eventIndex"`
+	Index      int    `json:"index"`
+	Token      string `json:"token"`
+	Nonce      uint64 `json:"nonce"`
+	Sender     string `json:"sender"`
+	Receiver   string `json:"receiver"`
+	Value      string `json:"value"`
+	BlockNonce uint64 `json:"hyperblockNonce"`
+	Timestamp  int64  `json:"timestamp"`
+}
+
+// DatabaseTokenBalance is the balance of a token held by an address, as provided by the altered accounts of a
+// hyperblock. The nonce is 0 for the fungible tokens
+type DatabaseTokenBalance struct {
+	Token      string
+	Nonce      uint64
+	Address    string
+	Balance    string
+	BlockNonce uint64
+}
+
+// TokenHoldersFilter holds the criteria used to select the holders of a token from the history storage. When the nonce
+// is not set, the holders of all the tokens of a collection are selected. The cursor is the one returned along with the
+// previous page of results, when requested with the same criteria
+type TokenHoldersFilter struct {
+	Token  string
+	Nonce  core.OptionalUint64
+	Limit  int
+	Cursor string
+}
+
+// TokenHolder is an address holding a token, along with its balance, also provided as a decimal number according to
+// the decimals of the token, and the nonce of the hyperblock which last altered it
+type TokenHolder struct {
+	Address    string `json:"address"`
+	Nonce      uint64 `json:"nonce"`
+	Balance    string `json:"balance"`
+	Amount     string `json:"amount"`
+	BlockNonce uint64 `json:"hyperblockNonce"`
+}
+
+// TokenHoldersPage holds a page of token holders, sorted by balance, along with the cursor of the next page, which is
+// empty when there are no more results. The page is incomplete when the history storage did not index the hyperblocks
+// since the genesis, in which case the holders whose balances were not altered since the indexing started are missing
+type TokenHoldersPage struct {
+	Holders    []TokenHolder `json:"holders"`
+	NextCursor string        `json:"nextCursor,omitempty"`
+	Incomplete bool          `json:"incomplete,omitempty"`
+}
+
+// TokenTransfersFilter holds the criteria used to select the transfers of a token from the history storage. When the
+// nonce is not set, the transfers of all the tokens of a collection are selected. The zero values of the other fields
+// mean that the corresponding criterion is not applied
+type TokenTransfersFilter struct {
+	Token     string
+	Nonce     core.OptionalUint64
+	FromBlock core.OptionalUint64
+	ToBlock   core.OptionalUint64
+	Order     string
+	Limit     int
+	Cursor    string
+}
+
+// TokenTransfer is a token transfer along with its value as a decimal number, according to the decimals of the token
+type TokenTransfer struct {
+	DatabaseTokenTransfer
+	Amount string `json:"amount"`
+}
+
+// TokenTransfersPage holds a page of token transfers along with the cursor of the next page, which is empty when there
+// are no more results
+type TokenTransfersPage struct {
+	Transfers  []TokenTransfer `json:"transfers"`
+	NextCursor string          `json:"nextCursor,omitempty"`
+}
+
+// IndexedHyperblock holds the data extracted from a hyperblock by the hyperblock ingester, in order to be stored in
+// the history storage
+type IndexedHyperblock struct {
+	Nonce          uint64
+	Hash           string
+	Transactions   []DatabaseTransaction
+	Events         []DatabaseEvent
+	TokenTransfers []DatabaseTokenTransfer
+	TokenBalances  []DatabaseTokenBalance
+}
@@ -7,3 +7,43 @@ var ErrNilTransaction = errors.New("nil transaction")
 
 // ErrNilPubKeyConverter signals that a nil pub key converter has been provided
 var ErrNilPubKeyConverter = errors.New("nil pub key converter")
+
+// ErrFaucetRequestRejected signals that a faucet request was rejected because it does not comply with the faucet's rules
+var ErrFaucetRequestRejected = errors.New("faucet request rejected")
+
+// ErrFaucetRateLimited signals that a faucet request was rejected because of the faucet's cooldowns or daily limits
+var ErrFaucetRateLimited = errors.New("faucet request rate limited")
+
+// ErrInvalidTransactionsHistoryCursor signals that the provided transactions history cursor is not valid
+var ErrInvalidTransactionsHistoryCursor = errors.New("invalid transactions history cursor")
+
+// ErrInvalidEventsCursor signals that the provided events cursor is not valid
+var ErrInvalidEventsCursor = errors.New("invalid events cursor")
+
+// ErrInvalidEventsFilter signals that the provided events filter is not valid
+var ErrInvalidEventsFilter = errors.New("invalid events filter")
+
+// ErrInvalidBlocksRange signals that the provided blocks range is not valid
+var ErrInvalidBlocksRange = errors.New("invalid blocks range")
+
+// ErrAtlasBlockNotFound signals that the requested block is not stored by the history backend
+var ErrAtlasBlockNotFound = errors.New("cannot find blocks in database")
+
+// ErrDatabaseConnectionIsDisabled signals that the history backend is disabled
+var ErrDatabaseConnectionIsDisabled = errors.New("database connection is disabled")
+
+// ErrInvalidTokenIdentifier signals that the provided token identifier is not valid
+var ErrInvalidTokenIdentifier = errors.New("invalid token identifier")
+
+// ErrTokenNotFound signals that the requested token does not exist
+var ErrTokenNotFound = errors.New("token not found")
+
+// ErrInvalidTokenFilter signals that the provided token holders or transfers filter is not valid
+var ErrInvalidTokenFilter = errors.New("invalid token filter")
+
+// ErrInvalidTokenCursor signals that the provided token holders or transfers cursor is not valid
+var ErrInvalidTokenCursor = errors.New("invalid token cursor")
+
+// ErrCannotEstimateTransactionFee signals that the cost of the transaction could not be computed, the transaction being
+// rejected by the network or its sender not being valid
+var ErrCannotEstimateTransactionFee = errors.New("cannot estimate the transaction fee")
@@ -18,10 +18,25 @@ type ESDTSupplyResponse struct {
 
 // ESDTSupply is a DTO holding esdt supply
 type ESDTSupply struct {
-	Supply        string `json:"supply"`
-	Minted        string `json:"minted"`
-	Burned        string `json:"burned"`
-	InitialMinted string `json:"initialMinted"`
+	Supply        string   `json:"supply"`
+	Minted        string   `json:"minted"`
+	Burned        string   `json:"burned"`
+	InitialMinted string   `json:"initialMinted"`
+	MissingShards []uint32 `json:"missingShards,omitempty"`
+}
+
+// TokenProperties holds the properties of a token, as stored by the ESDT system smart contract, along with the roles
+// of the addresses holding special roles. The properties hold the flags of the token, such as CanMint or IsPaused
+type TokenProperties struct {
+	Identifier    string              `json:"identifier"`
+	Name          string              `json:"name"`
+	Type          string              `json:"type"`
+	Owner         string              `json:"owner"`
+	Decimals      int                 `json:"decimals"`
+	InitialMinted string              `json:"initialMinted"`
+	Burned        string              `json:"burned"`
+	Properties    map[string]string   `json:"properties"`
+	Roles         map[string][]string `json:"roles"`
 }
 
 // IsValidEsdtPath returns true if the provided path is a valid esdt token type
@@ -0,0 +1,52 @@
+package data
+
+// FaucetDisbursement holds the details of a transfer made by the faucet
+type FaucetDisbursement struct {
+	Timestamp int64          `json:"timestamp"`
+	Receiver  string         `json:"receiver"`
+	ClientIP  string         `json:"clientIP"`
+	Value     string         `json:"value"`
+	Sender    string         `json:"sender,omitempty"`
+	TxHash    string         `json:"txHash,omitempty"`
+	Tokens    []*FaucetToken `json:"tokens,omitempty"`
+}
+
+// FaucetToken holds the details of an ESDT (fungible token or SFT) dispensed by the faucet. The identifier is the one
+// used in the funds requests: the token identifier, followed by the hex encoded nonce in the case of SFTs. The maximum
+// amount per day, if set, is the amount that can be disbursed to the same receiver or IP over 24 hours
+type FaucetToken struct {
+	Identifier      string `json:"identifier"`
+	TokenIdentifier string `json:"tokenIdentifier"`
+	Nonce           uint64 `json:"nonce"`
+	Amount          string `json:"amount"`
+	MaxAmountPerDay string `json:"maxAmountPerDay,omitempty"`
+}
+
+// ESDTTokenDataApiResponse matches the data field of the ESDT token data responses, when only the balance is needed
+type ESDTTokenDataApiResponse struct {
+	TokenData struct {
+		Balance string `json:"balance"`
+	} `json:"tokenData"`
+}
+
+// FaucetDisbursementsFilter holds the criteria used to select the faucet disbursements from the ledger. The zero values
+// of the fields mean that the corresponding criterion is not applied
+type FaucetDisbursementsFilter struct {
+	Receiver string
+	ClientIP string
+	From     int64
+	To       int64
+	Limit    int
+}
+
+// AccountNonce matches the data field of an observer's account nonce response
+type AccountNonce struct {
+	Nonce uint64 `json:"nonce"`
+}
+
+// AccountNonceApiResponse matches the output of an observer's account nonce endpoint
+type AccountNonceApiResponse struct {
+	Data  AccountNonce `json:"data"`
+	Error string       `json:"error"`
+	Code  string       `json:"code"`
+}
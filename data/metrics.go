@@ -10,3 +10,18 @@ type EndpointMetrics struct {
 	LowestResponseTime  time.Duration `json:"lowest_response_time"`
 	HighestResponseTime time.Duration `json:"highest_response_time"`
 }
+
+// RequestsCoalescingMetrics holds statistics about the coalescing of the identical requests sent to the nodes
+type RequestsCoalescingMetrics struct {
+	NumUpstreamCalls     uint64 `json:"num_upstream_calls"`
+	NumDeduplicatedCalls uint64 `json:"num_deduplicated_calls"`
+}
+
+// HyperblockIngesterMetrics holds the progress of the hyperblock ingester. The lag is the number of hyperblocks
+// which are fully synchronized by the observers, but not yet indexed
+type HyperblockIngesterMetrics struct {
+	Enabled          bool   `json:"enabled"`
+	LastIndexedNonce uint64 `json:"last_indexed_nonce"`
+	HeadNonce        uint64 `json:"head_nonce"`
+	Lag              uint64 `json:"lag"`
+}
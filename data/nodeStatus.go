@@ -4,7 +4,9 @@ import "time"
 
 // HeartbeatResponse matches the output structure the data field for an heartbeat response
 type HeartbeatResponse struct {
-	Heartbeats []PubKeyHeartbeat `json:"heartbeats"`
+	Heartbeats    []PubKeyHeartbeat `json:"heartbeats"`
+	MissingShards []uint32          `json:"missingShards,omitempty"`
+	CacheAgeSec   uint64            `json:"cacheAgeSec,omitempty"`
 }
 
 // HeartbeatApiResponse matches the output of an observer's heartbeat endpoint
@@ -0,0 +1,12 @@
+package data
+
+// ShardQueryHandler defines the function executed for each of the shards queried concurrently. The index is the
+// position of the shard in the queried slice, so the same shard can be queried multiple times for different items
+type ShardQueryHandler func(index int, shardID uint32) (interface{}, error)
+
+// ShardsQueryResponse holds the outcome of a concurrent multi-shard query. The responses are in the same order as
+// the queried shards, the positions of the shards that did not respond being nil
+type ShardsQueryResponse struct {
+	Responses     []interface{}
+	MissingShards []uint32
+}
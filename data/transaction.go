@@ -152,11 +152,46 @@ type ResponseMultipleTransactions struct {
 	Code  string                           `json:"code"`
 }
 
+const (
+	// TxCostEstimationMethodLocal signals that the transaction cost was computed by the proxy, from the gas configs
+	TxCostEstimationMethodLocal = "local"
+
+	// TxCostEstimationMethodObservers signals that the transaction cost was computed by the observers
+	TxCostEstimationMethodObservers = "observers"
+)
+
 // TxCostResponseData follows the format of the data field of a transaction cost request
 type TxCostResponseData struct {
-	TxCost     uint64                                     `json:"txGasUnits"`
-	RetMessage string                                     `json:"returnMessage"`
-	ScResults  map[string]*ExtendedApiSmartContractResult `json:"smartContractResults"`
+	TxCost           uint64                                     `json:"txGasUnits"`
+	RetMessage       string                                     `json:"returnMessage"`
+	ScResults        map[string]*ExtendedApiSmartContractResult `json:"smartContractResults"`
+	EstimationMethod string                                     `json:"estimationMethod,omitempty"`
+}
+
+// TxCostGasConfigs holds the network's gas settings needed for computing the cost of the simple transactions
+type TxCostGasConfigs struct {
+	MinGasLimit            uint64
+	GasPerDataByte         uint64
+	ExtraGasLimitGuardedTx uint64
+	BuiltInCost            map[string]uint64
+}
+
+// GasConfigsApiResponse follows the format of the gas configs response of an observer
+type GasConfigsApiResponse struct {
+	Data struct {
+		GasConfigs struct {
+			BuiltInCost map[string]uint64 `json:"builtInCost"`
+		} `json:"gasConfigs"`
+	} `json:"data"`
+	Error string `json:"error"`
+	Code  string `json:"code"`
+}
+
+// NetworkConfigApiResponse follows the format of the network config response of an observer
+type NetworkConfigApiResponse struct {
+	Data  NetworkConfig `json:"data"`
+	Error string        `json:"error"`
+	Code  string        `json:"code"`
 }
 
 // ExtendedApiSmartContractResult extends the structure transaction.ApiSmartContractResult with an extra field
@@ -179,9 +214,11 @@ type ResponseTxStatus struct {
 
 // FundsRequest represents the data structure needed as input for sending funds from a node to an address
 type FundsRequest struct {
-	Receiver string   `form:"receiver" json:"receiver"`
-	Value    *big.Int `form:"value" json:"value,omitempty"`
-	TxCount  int      `form:"txCount" json:"txCount,omitempty"`
+	Receiver       string   `form:"receiver" json:"receiver"`
+	Value          *big.Int `form:"value" json:"value,omitempty"`
+	TxCount        int      `form:"txCount" json:"txCount,omitempty"`
+	ChallengeToken string   `form:"challengeToken" json:"challengeToken,omitempty"`
+	Tokens         []string `form:"tokens" json:"tokens,omitempty"`
 }
 
 // ResponseFunds defines the response structure for the node's generate-and-send-multiple endpoint
@@ -199,6 +236,7 @@ type TransactionsPool struct {
 	RegularTransactions  []WrappedTransaction `json:"regularTransactions"`
 	SmartContractResults []WrappedTransaction `json:"smartContractResults"`
 	Rewards              []WrappedTransaction `json:"rewards"`
+	MissingShards        []uint32             `json:"missingShards,omitempty"`
 }
 
 // TransactionsPoolResponseData matches the data field of get tx pool response
@@ -266,3 +304,30 @@ type TransactionsPoolNonceGapsForSenderApiResponse struct {
 	Error string                                         `json:"error"`
 	Code  string                                         `json:"code"`
 }
+
+// TransactionFeeEstimateRequest holds the fields of the transaction whose fee is estimated
+type TransactionFeeEstimateRequest struct {
+	Sender   string `form:"sender"`
+	Receiver string `form:"receiver"`
+	Value    string `form:"value"`
+	Data     string `form:"data"`
+	Guardian string `form:"guardian"`
+}
+
+// GasPriceSuggestion holds a suggested gas price and the fee paid by the transaction when using it
+type GasPriceSuggestion struct {
+	GasPrice       uint64 `json:"gasPrice"`
+	Fee            string `json:"fee"`
+	FeeDenominated string `json:"feeDenominated"`
+}
+
+// TransactionFeeEstimate holds the gas units needed by a transaction and the suggested gas prices, depending on the
+// occupancy of the sender shard's transactions pool
+type TransactionFeeEstimate struct {
+	GasUnits         uint64              `json:"txGasUnits"`
+	EstimationMethod string              `json:"estimationMethod"`
+	PoolSize         int                 `json:"poolSize"`
+	Low              *GasPriceSuggestion `json:"low"`
+	Normal           *GasPriceSuggestion `json:"normal"`
+	Fast             *GasPriceSuggestion `json:"fast"`
+}
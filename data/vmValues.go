@@ -1,12 +1,14 @@
 package data
 
 import (
+	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/data/vm"
 )
 
 // VmValuesResponseData follows the format of the data field in an API response for a VM values query
 type VmValuesResponseData struct {
-	Data *vm.VMOutputApi `json:"data"`
+	Data      *vm.VMOutputApi `json:"data"`
+	BlockInfo BlockInfo       `json:"blockInfo"`
 }
 
 // ResponseVmValue defines a wrapper over string containing returned data in hex format
@@ -36,4 +38,24 @@ type SCQuery struct {
 	SameScState    bool `json:"sameScState"`
 	ShouldBeSynced bool `json:"shouldBeSynced"`
 	Arguments      [][]byte
+	BlockNonce     core.OptionalUint64
+	BlockHash      []byte
+	BlockRootHash  []byte
+	HintEpoch      core.OptionalUint32
+}
+
+// IsHistorical returns true if the query should be executed against a past block, identified by its nonce, hash or
+// root hash
+func (query *SCQuery) IsHistorical() bool {
+	return query.BlockNonce.HasValue || len(query.BlockHash) > 0 || len(query.BlockRootHash) > 0
+}
+
+// SCQueryResult holds the outcome of one of the smart contract queries executed in a batch. The unpinned flag is set
+// when the query could not be executed at the block nonce of the other queries of its shard, being executed on the
+// latest state instead
+type SCQueryResult struct {
+	Data      *vm.VMOutputApi `json:"data,omitempty"`
+	BlockInfo BlockInfo       `json:"blockInfo"`
+	Error     string          `json:"error,omitempty"`
+	Unpinned  bool            `json:"unpinned,omitempty"`
 }
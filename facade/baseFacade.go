@@ -2,16 +2,21 @@ package facade
 
 import (
 	"encoding/json"
+	"fmt"
 	"math/big"
 
 	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/data/transaction"
 	"github.com/multiversx/mx-chain-core-go/data/vm"
+	crypto "github.com/multiversx/mx-chain-crypto-go"
+	logger "github.com/multiversx/mx-chain-logger-go"
 	"github.com/multiversx/mx-chain-proxy-go/api/groups"
 	"github.com/multiversx/mx-chain-proxy-go/common"
 	"github.com/multiversx/mx-chain-proxy-go/data"
 )
 
+var log = logger.GetOrCreate("facade")
+
 // interfaces assertions. verifies that all API endpoint have their corresponding methods in the facade
 var _ groups.ActionsFacadeHandler = (*ProxyFacade)(nil)
 var _ groups.AccountsFacadeHandler = (*ProxyFacade)(nil)
@@ -25,6 +30,8 @@ var _ groups.TransactionFacadeHandler = (*ProxyFacade)(nil)
 var _ groups.ValidatorFacadeHandler = (*ProxyFacade)(nil)
 var _ groups.VmValuesFacadeHandler = (*ProxyFacade)(nil)
 var _ groups.ProofFacadeHandler = (*ProxyFacade)(nil)
+var _ groups.EventsFacadeHandler = (*ProxyFacade)(nil)
+var _ groups.TokensFacadeHandler = (*ProxyFacade)(nil)
 
 // ProxyFacade implements the facade used in api calls
 type ProxyFacade struct {
@@ -44,6 +51,11 @@ type ProxyFacade struct {
 
 	pubKeyConverter core.PubkeyConverter
 	aboutInfoProc   AboutInfoProcessor
+	abiProc         ABIProcessor
+	eventsProc      EventsProcessor
+	blocksRangeProc BlocksRangeProcessor
+	hyperblockProc  HyperblockProcessor
+	tokensProc      TokensProcessor
 }
 
 // NewProxyFacade creates a new ProxyFacade instance
@@ -63,6 +75,11 @@ func NewProxyFacade(
 	esdtSuppliesProc ESDTSupplyProcessor,
 	statusProc StatusProcessor,
 	aboutInfoProc AboutInfoProcessor,
+	abiProc ABIProcessor,
+	eventsProc EventsProcessor,
+	blocksRangeProc BlocksRangeProcessor,
+	hyperblockProc HyperblockProcessor,
+	tokensProc TokensProcessor,
 ) (*ProxyFacade, error) {
 	if actionsProc == nil {
 		return nil, ErrNilActionsProcessor
@@ -106,6 +123,21 @@ func NewProxyFacade(
 	if aboutInfoProc == nil {
 		return nil, ErrNilAboutInfoProcessor
 	}
+	if abiProc == nil {
+		return nil, ErrNilABIProcessor
+	}
+	if eventsProc == nil {
+		return nil, ErrNilEventsProcessor
+	}
+	if blocksRangeProc == nil {
+		return nil, ErrNilBlocksRangeProcessor
+	}
+	if hyperblockProc == nil {
+		return nil, ErrNilHyperblockProcessor
+	}
+	if tokensProc == nil {
+		return nil, ErrNilTokensProcessor
+	}
 
 	return &ProxyFacade{
 		actionsProc:      actionsProc,
@@ -123,6 +155,11 @@ func NewProxyFacade(
 		esdtSuppliesProc: esdtSuppliesProc,
 		statusProc:       statusProc,
 		aboutInfoProc:    aboutInfoProc,
+		abiProc:          abiProc,
+		eventsProc:       eventsProc,
+		blocksRangeProc:  blocksRangeProc,
+		hyperblockProc:   hyperblockProc,
+		tokensProc:       tokensProc,
 	}, nil
 }
 
@@ -157,8 +194,28 @@ func (epf *ProxyFacade) GetShardIDForAddress(address string) (uint32, error) {
 }
 
 // GetTransactions returns transactions by address
-func (epf *ProxyFacade) GetTransactions(address string) ([]data.DatabaseTransaction, error) {
-	return epf.accountProc.GetTransactions(address)
+func (epf *ProxyFacade) GetTransactions(address string, filter data.TransactionsHistoryFilter) (*data.TransactionsHistoryPage, error) {
+	return epf.accountProc.GetTransactions(address, filter)
+}
+
+// GetEvents returns a page of the events matching the query
+func (epf *ProxyFacade) GetEvents(query data.EventsQuery) (*data.EventsPage, error) {
+	return epf.eventsProc.GetEvents(query)
+}
+
+// GetTokenProperties returns the properties and the special roles of the token
+func (epf *ProxyFacade) GetTokenProperties(identifier string) (*data.TokenProperties, error) {
+	return epf.tokensProc.GetTokenProperties(identifier)
+}
+
+// GetTokenHolders returns a page of the holders of the token
+func (epf *ProxyFacade) GetTokenHolders(identifier string, filter data.TokenHoldersFilter) (*data.TokenHoldersPage, error) {
+	return epf.tokensProc.GetTokenHolders(identifier, filter)
+}
+
+// GetTokenTransfers returns a page of the transfers of the token
+func (epf *ProxyFacade) GetTokenTransfers(identifier string, filter data.TokenTransfersFilter) (*data.TokenTransfersPage, error) {
+	return epf.tokensProc.GetTokenTransfers(identifier, filter)
 }
 
 // GetESDTTokenData returns the token data for a given token name
@@ -211,6 +268,11 @@ func (epf *ProxyFacade) TransactionCostRequest(tx *data.Transaction) (*data.TxCo
 	return epf.txProc.TransactionCostRequest(tx)
 }
 
+// GetTransactionFeeEstimate returns the suggested gas prices and the corresponding fees for the given transaction
+func (epf *ProxyFacade) GetTransactionFeeEstimate(tx *data.Transaction) (*data.TransactionFeeEstimate, error) {
+	return epf.txProc.GetTransactionFeeEstimate(tx)
+}
+
 // GetTransactionStatus should return transaction status
 func (epf *ProxyFacade) GetTransactionStatus(txHash string, sender string) (string, error) {
 	return epf.txProc.GetTransactionStatus(txHash, sender)
@@ -246,37 +308,142 @@ func (epf *ProxyFacade) IsFaucetEnabled() bool {
 	return epf.faucetProc.IsEnabled()
 }
 
-// SendUserFunds should send a transaction to load one user's account with extra funds from an account in the pem file
-func (epf *ProxyFacade) SendUserFunds(receiver string, value *big.Int) error {
-	senderSk, senderPk, err := epf.faucetProc.SenderDetailsFromPem(receiver)
+// SendUserFunds should send a transaction to load one user's account with extra funds from an account in the pem file.
+// The request is checked against the faucet's rules and the disbursement is recorded in the faucet's ledger. Returns
+// the hash of the transaction
+func (epf *ProxyFacade) SendUserFunds(request *data.FundsRequest, clientIP string) (string, error) {
+	disbursement, err := epf.faucetProc.ReserveFunds(request, clientIP)
 	if err != nil {
-		return err
+		return "", err
 	}
 
-	senderAccount, err := epf.accountProc.GetAccount(senderPk, common.AccountQueryOptions{})
+	senderPk, txHash, err := epf.sendReservedFunds(disbursement)
 	if err != nil {
-		return err
+		epf.faucetProc.CancelFunds(disbursement)
+		return "", err
+	}
+
+	disbursement.Sender = senderPk
+	disbursement.TxHash = txHash
+	err = epf.faucetProc.ConfirmFunds(disbursement)
+	if err != nil {
+		// the funds were already sent, so the request should not fail
+		log.Error("cannot record the faucet disbursement", "receiver", disbursement.Receiver, "tx hash", txHash, "error", err.Error())
+	}
+
+	return txHash, nil
+}
+
+func (epf *ProxyFacade) sendReservedFunds(disbursement *data.FaucetDisbursement) (string, string, error) {
+	value, ok := big.NewInt(0).SetString(disbursement.Value, 10)
+	if !ok {
+		return "", "", ErrInvalidFaucetValue
 	}
 
 	networkCfg, err := epf.getNetworkConfig()
 	if err != nil {
-		return err
+		return "", "", err
+	}
+
+	senderSk, senderPk, senderNonce, err := epf.faucetProc.AcquireSender(disbursement.Receiver)
+	if err != nil {
+		return "", "", err
+	}
+
+	txHash, err := epf.sendFaucetTransaction(senderSk, senderPk, senderNonce, disbursement, value, networkCfg)
+	epf.faucetProc.ReleaseSender(senderPk, err == nil)
+	if err != nil {
+		return "", "", err
+	}
+
+	return senderPk, txHash, nil
+}
+
+func (epf *ProxyFacade) sendFaucetTransaction(
+	senderSk crypto.PrivateKey,
+	senderPk string,
+	senderNonce uint64,
+	disbursement *data.FaucetDisbursement,
+	value *big.Int,
+	networkCfg *data.NetworkConfig,
+) (string, error) {
+	var tx *data.Transaction
+	var err error
+	if len(disbursement.Tokens) > 0 {
+		err = epf.checkFaucetTokensBalance(senderPk, disbursement.Tokens)
+		if err != nil {
+			return "", err
+		}
+
+		tx, err = epf.faucetProc.GenerateTxForSendUserTokens(senderSk, senderPk, senderNonce, disbursement.Receiver, disbursement.Tokens, networkCfg)
+	} else {
+		tx, err = epf.faucetProc.GenerateTxForSendUserFunds(senderSk, senderPk, senderNonce, disbursement.Receiver, value, networkCfg)
+	}
+	if err != nil {
+		return "", err
+	}
+
+	_, txHash, err := epf.txProc.SendTransaction(tx)
+
+	return txHash, err
+}
+
+func (epf *ProxyFacade) checkFaucetTokensBalance(senderPk string, tokens []*data.FaucetToken) error {
+	for _, token := range tokens {
+		var response *data.GenericAPIResponse
+		var err error
+		if token.Nonce == 0 {
+			response, err = epf.accountProc.GetESDTTokenData(senderPk, token.TokenIdentifier, common.AccountQueryOptions{})
+		} else {
+			response, err = epf.accountProc.GetESDTNftTokenData(senderPk, token.TokenIdentifier, token.Nonce, common.AccountQueryOptions{})
+		}
+		if err != nil {
+			return err
+		}
+
+		balance, err := getESDTBalance(response)
+		if err != nil {
+			return err
+		}
+
+		amount, _ := big.NewInt(0).SetString(token.Amount, 10)
+		if balance.Cmp(amount) < 0 {
+			return fmt.Errorf("%w: %s", ErrInsufficientFaucetTokenBalance, token.Identifier)
+		}
+	}
+
+	return nil
+}
+
+func getESDTBalance(response *data.GenericAPIResponse) (*big.Int, error) {
+	responseBytes, err := json.Marshal(&response.Data)
+	if err != nil {
+		return nil, err
 	}
 
-	tx, err := epf.faucetProc.GenerateTxForSendUserFunds(
-		senderSk,
-		senderPk,
-		senderAccount.Account.Nonce,
-		receiver,
-		value,
-		networkCfg,
-	)
+	tokenData := &data.ESDTTokenDataApiResponse{}
+	err = json.Unmarshal(responseBytes, tokenData)
 	if err != nil {
-		return err
+		return nil, err
+	}
+
+	balance, ok := big.NewInt(0).SetString(tokenData.TokenData.Balance, 10)
+	if !ok {
+		// the observers return an empty token data for the accounts not holding the token
+		return big.NewInt(0), nil
 	}
 
-	_, _, err = epf.txProc.SendTransaction(tx)
-	return err
+	return balance, nil
+}
+
+// GetFaucetTokens returns the tokens dispensed by the faucet
+func (epf *ProxyFacade) GetFaucetTokens() []*data.FaucetToken {
+	return epf.faucetProc.GetTokens()
+}
+
+// GetFaucetDisbursements returns the disbursements from the faucet's ledger matching the provided filter
+func (epf *ProxyFacade) GetFaucetDisbursements(filter data.FaucetDisbursementsFilter) ([]*data.FaucetDisbursement, error) {
+	return epf.faucetProc.GetDisbursements(filter)
 }
 
 func (epf *ProxyFacade) getNetworkConfig() (*data.NetworkConfig, error) {
@@ -297,10 +464,35 @@ func (epf *ProxyFacade) getNetworkConfig() (*data.NetworkConfig, error) {
 }
 
 // ExecuteSCQuery retrieves data from existing SC trie through the use of a VM
-func (epf *ProxyFacade) ExecuteSCQuery(query *data.SCQuery) (*vm.VMOutputApi, error) {
+func (epf *ProxyFacade) ExecuteSCQuery(query *data.SCQuery) (*vm.VMOutputApi, data.BlockInfo, error) {
 	return epf.scQueryService.ExecuteQuery(query)
 }
 
+// ExecuteABIQuery executes a smart contract query whose arguments and results are encoded based on the contract's ABI
+func (epf *ProxyFacade) ExecuteABIQuery(request *data.ABIQueryRequest, options common.VmQueryOptions) (*data.ABIQueryResponse, data.BlockInfo, error) {
+	return epf.abiProc.ExecuteQuery(request, options)
+}
+
+// EncodeABIArguments returns the hex encoded arguments of a smart contract endpoint
+func (epf *ProxyFacade) EncodeABIArguments(request *data.ABIArgumentsRequest) ([]string, error) {
+	return epf.abiProc.EncodeArguments(request)
+}
+
+// BuildABICallData returns the data field of a transaction calling a smart contract endpoint
+func (epf *ProxyFacade) BuildABICallData(request *data.ABIArgumentsRequest) (string, error) {
+	return epf.abiProc.BuildCallData(request)
+}
+
+// DecodeABIResults decodes the raw return data of a smart contract endpoint
+func (epf *ProxyFacade) DecodeABIResults(request *data.ABIResultsRequest) ([]interface{}, error) {
+	return epf.abiProc.DecodeResults(request)
+}
+
+// ExecuteSCQueries executes a batch of smart contract queries, returning the results in the same order
+func (epf *ProxyFacade) ExecuteSCQueries(queries []*data.SCQuery) ([]*data.SCQueryResult, error) {
+	return epf.scQueryService.ExecuteQueries(queries)
+}
+
 // GetHeartbeatData retrieves the heartbeat status from one observer
 func (epf *ProxyFacade) GetHeartbeatData() (*data.HeartbeatResponse, error) {
 	return epf.nodeGroupProc.GetHeartbeatData()
@@ -362,7 +554,7 @@ func (epf *ProxyFacade) GetBlockByNonce(shardID uint32, nonce uint64, options co
 }
 
 // GetBlocksByRound retrieves the blocks for a given round
-func (epf *ProxyFacade) GetBlocksByRound(round uint64, options common.BlockQueryOptions) (*data.BlocksApiResponse, error) {
+func (epf *ProxyFacade) GetBlocksByRound(round uint64, options common.BlocksQueryOptions) (*data.BlocksApiResponse, error) {
 	return epf.blocksProc.GetBlocksByRound(round, options)
 }
 
@@ -388,22 +580,38 @@ func (epf *ProxyFacade) GetInternalMiniBlockByHash(shardID uint32, hash string,
 
 // GetHyperBlockByHash retrieves the hyperblock by hash
 func (epf *ProxyFacade) GetHyperBlockByHash(hash string, options common.HyperblockQueryOptions) (*data.HyperblockApiResponse, error) {
-	return epf.blockProc.GetHyperBlockByHash(hash, options)
+	return epf.hyperblockProc.GetHyperBlockByHash(hash, options)
+}
+
+// GetHyperblockReorgs returns the most recently detected hyperblock reorgs
+func (epf *ProxyFacade) GetHyperblockReorgs() []*data.HyperblockReorg {
+	return epf.hyperblockProc.GetHyperblockReorgs()
+}
+
+// SubscribeToHyperblockReorgs returns a channel receiving the hyperblock reorgs detected from now on, along with the
+// function ending the subscription
+func (epf *ProxyFacade) SubscribeToHyperblockReorgs() (<-chan *data.HyperblockReorg, func()) {
+	return epf.hyperblockProc.SubscribeToHyperblockReorgs()
+}
+
+// GetHyperBlocksRange calls the handler, in order, with the hyperblocks between the provided nonces
+func (epf *ProxyFacade) GetHyperBlocksRange(from uint64, to uint64, options common.HyperblockQueryOptions, handler data.BlocksRangeItemHandler) error {
+	return epf.blocksRangeProc.GetHyperBlocksRange(from, to, options, handler)
+}
+
+// GetBlocksRange calls the handler, in order, with the blocks of the given shard between the provided nonces
+func (epf *ProxyFacade) GetBlocksRange(shardID uint32, from uint64, to uint64, options common.BlockQueryOptions, handler data.BlocksRangeItemHandler) error {
+	return epf.blocksRangeProc.GetBlocksRange(shardID, from, to, options, handler)
 }
 
 // GetHyperBlockByNonce retrieves the block by nonce
 func (epf *ProxyFacade) GetHyperBlockByNonce(nonce uint64, options common.HyperblockQueryOptions) (*data.HyperblockApiResponse, error) {
-	return epf.blockProc.GetHyperBlockByNonce(nonce, options)
+	return epf.hyperblockProc.GetHyperBlockByNonce(nonce, options)
 }
 
 // ValidatorStatistics will return the statistics from an observer
-func (epf *ProxyFacade) ValidatorStatistics() (map[string]*data.ValidatorApiResponse, error) {
-	valStats, err := epf.valStatsProc.GetValidatorStatistics()
-	if err != nil {
-		return nil, err
-	}
-
-	return valStats.Statistics, nil
+func (epf *ProxyFacade) ValidatorStatistics() (*data.ValidatorStatisticsResponse, error) {
+	return epf.valStatsProc.GetValidatorStatistics()
 }
 
 // GetAtlasBlockByShardIDAndNonce returns block by shardID and nonce in a BlockAtlas-friendly-format
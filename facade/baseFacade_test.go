@@ -46,6 +46,11 @@ func TestNewProxyFacade_NilActionsProcShouldErr(t *testing.T) {
 		&mock.ESDTSuppliesProcessorStub{},
 		&mock.StatusProcessorStub{},
 		&mock.AboutInfoProcessorStub{},
+		&mock.ABIProcessorStub{},
+		&mock.EventsProcessorStub{},
+		&mock.BlocksRangeProcessorStub{},
+		&mock.HyperblockProcessorStub{},
+		&mock.TokensProcessorStub{},
 	)
 
 	assert.Nil(t, epf)
@@ -71,6 +76,11 @@ func TestNewProxyFacade_NilAccountProcShouldErr(t *testing.T) {
 		&mock.ESDTSuppliesProcessorStub{},
 		&mock.StatusProcessorStub{},
 		&mock.AboutInfoProcessorStub{},
+		&mock.ABIProcessorStub{},
+		&mock.EventsProcessorStub{},
+		&mock.BlocksRangeProcessorStub{},
+		&mock.HyperblockProcessorStub{},
+		&mock.TokensProcessorStub{},
 	)
 
 	assert.Nil(t, epf)
@@ -96,6 +106,11 @@ func TestNewProxyFacade_NilTransactionProcShouldErr(t *testing.T) {
 		&mock.ESDTSuppliesProcessorStub{},
 		&mock.StatusProcessorStub{},
 		&mock.AboutInfoProcessorStub{},
+		&mock.ABIProcessorStub{},
+		&mock.EventsProcessorStub{},
+		&mock.BlocksRangeProcessorStub{},
+		&mock.HyperblockProcessorStub{},
+		&mock.TokensProcessorStub{},
 	)
 
 	assert.Nil(t, epf)
@@ -121,6 +136,11 @@ func TestNewProxyFacade_NilGetValuesProcShouldErr(t *testing.T) {
 		&mock.ESDTSuppliesProcessorStub{},
 		&mock.StatusProcessorStub{},
 		&mock.AboutInfoProcessorStub{},
+		&mock.ABIProcessorStub{},
+		&mock.EventsProcessorStub{},
+		&mock.BlocksRangeProcessorStub{},
+		&mock.HyperblockProcessorStub{},
+		&mock.TokensProcessorStub{},
 	)
 
 	assert.Nil(t, epf)
@@ -146,6 +166,11 @@ func TestNewProxyFacade_NilNodeGroupProcShouldErr(t *testing.T) {
 		&mock.ESDTSuppliesProcessorStub{},
 		&mock.StatusProcessorStub{},
 		&mock.AboutInfoProcessorStub{},
+		&mock.ABIProcessorStub{},
+		&mock.EventsProcessorStub{},
+		&mock.BlocksRangeProcessorStub{},
+		&mock.HyperblockProcessorStub{},
+		&mock.TokensProcessorStub{},
 	)
 
 	assert.Nil(t, epf)
@@ -171,6 +196,11 @@ func TestNewProxyFacade_NilValStatsProcShouldErr(t *testing.T) {
 		&mock.ESDTSuppliesProcessorStub{},
 		&mock.StatusProcessorStub{},
 		&mock.AboutInfoProcessorStub{},
+		&mock.ABIProcessorStub{},
+		&mock.EventsProcessorStub{},
+		&mock.BlocksRangeProcessorStub{},
+		&mock.HyperblockProcessorStub{},
+		&mock.TokensProcessorStub{},
 	)
 
 	assert.Nil(t, epf)
@@ -196,6 +226,11 @@ func TestNewProxyFacade_NilFaucetProcShouldErr(t *testing.T) {
 		&mock.ESDTSuppliesProcessorStub{},
 		&mock.StatusProcessorStub{},
 		&mock.AboutInfoProcessorStub{},
+		&mock.ABIProcessorStub{},
+		&mock.EventsProcessorStub{},
+		&mock.BlocksRangeProcessorStub{},
+		&mock.HyperblockProcessorStub{},
+		&mock.TokensProcessorStub{},
 	)
 
 	assert.Nil(t, epf)
@@ -221,6 +256,11 @@ func TestNewProxyFacade_NilNodeProcessor(t *testing.T) {
 		&mock.ESDTSuppliesProcessorStub{},
 		&mock.StatusProcessorStub{},
 		&mock.AboutInfoProcessorStub{},
+		&mock.ABIProcessorStub{},
+		&mock.EventsProcessorStub{},
+		&mock.BlocksRangeProcessorStub{},
+		&mock.HyperblockProcessorStub{},
+		&mock.TokensProcessorStub{},
 	)
 
 	assert.Nil(t, epf)
@@ -246,6 +286,11 @@ func TestNewProxyFacade_NilBlocksProcessor(t *testing.T) {
 		&mock.ESDTSuppliesProcessorStub{},
 		&mock.StatusProcessorStub{},
 		&mock.AboutInfoProcessorStub{},
+		&mock.ABIProcessorStub{},
+		&mock.EventsProcessorStub{},
+		&mock.BlocksRangeProcessorStub{},
+		&mock.HyperblockProcessorStub{},
+		&mock.TokensProcessorStub{},
 	)
 
 	assert.Nil(t, epf)
@@ -271,6 +316,11 @@ func TestNewProxyFacade_NilProofProcessor(t *testing.T) {
 		&mock.ESDTSuppliesProcessorStub{},
 		&mock.StatusProcessorStub{},
 		&mock.AboutInfoProcessorStub{},
+		&mock.ABIProcessorStub{},
+		&mock.EventsProcessorStub{},
+		&mock.BlocksRangeProcessorStub{},
+		&mock.HyperblockProcessorStub{},
+		&mock.TokensProcessorStub{},
 	)
 
 	assert.Nil(t, epf)
@@ -296,6 +346,11 @@ func TestNewProxyFacade_NilStatusProcessorShouldErr(t *testing.T) {
 		&mock.ESDTSuppliesProcessorStub{},
 		nil,
 		&mock.AboutInfoProcessorStub{},
+		&mock.ABIProcessorStub{},
+		&mock.EventsProcessorStub{},
+		&mock.BlocksRangeProcessorStub{},
+		&mock.HyperblockProcessorStub{},
+		&mock.TokensProcessorStub{},
 	)
 
 	assert.Nil(t, epf)
@@ -321,12 +376,167 @@ func TestNewProxyFacade_NilAboutInfoProcessorShouldErr(t *testing.T) {
 		&mock.ESDTSuppliesProcessorStub{},
 		&mock.StatusProcessorStub{},
 		nil,
+		&mock.ABIProcessorStub{},
+		&mock.EventsProcessorStub{},
+		&mock.BlocksRangeProcessorStub{},
+		&mock.HyperblockProcessorStub{},
+		&mock.TokensProcessorStub{},
 	)
 
 	assert.Nil(t, epf)
 	assert.Equal(t, facade.ErrNilAboutInfoProcessor, err)
 }
 
+func TestNewProxyFacade_NilABIProcessorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	epf, err := facade.NewProxyFacade(
+		&mock.ActionsProcessorStub{},
+		&mock.AccountProcessorStub{},
+		&mock.TransactionProcessorStub{},
+		&mock.SCQueryServiceStub{},
+		&mock.NodeGroupProcessorStub{},
+		&mock.ValidatorStatisticsProcessorStub{},
+		&mock.FaucetProcessorStub{},
+		&mock.NodeStatusProcessorStub{},
+		&mock.BlockProcessorStub{},
+		&mock.BlocksProcessorStub{},
+		&mock.ProofProcessorStub{},
+		publicKeyConverter,
+		&mock.ESDTSuppliesProcessorStub{},
+		&mock.StatusProcessorStub{},
+		&mock.AboutInfoProcessorStub{},
+		nil,
+		&mock.EventsProcessorStub{},
+		&mock.BlocksRangeProcessorStub{},
+		&mock.HyperblockProcessorStub{},
+		&mock.TokensProcessorStub{},
+	)
+
+	assert.Nil(t, epf)
+	assert.Equal(t, facade.ErrNilABIProcessor, err)
+}
+
+func TestNewProxyFacade_NilEventsProcessorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	epf, err := facade.NewProxyFacade(
+		&mock.ActionsProcessorStub{},
+		&mock.AccountProcessorStub{},
+		&mock.TransactionProcessorStub{},
+		&mock.SCQueryServiceStub{},
+		&mock.NodeGroupProcessorStub{},
+		&mock.ValidatorStatisticsProcessorStub{},
+		&mock.FaucetProcessorStub{},
+		&mock.NodeStatusProcessorStub{},
+		&mock.BlockProcessorStub{},
+		&mock.BlocksProcessorStub{},
+		&mock.ProofProcessorStub{},
+		publicKeyConverter,
+		&mock.ESDTSuppliesProcessorStub{},
+		&mock.StatusProcessorStub{},
+		&mock.AboutInfoProcessorStub{},
+		&mock.ABIProcessorStub{},
+		nil,
+		&mock.BlocksRangeProcessorStub{},
+		&mock.HyperblockProcessorStub{},
+		&mock.TokensProcessorStub{},
+	)
+
+	assert.Nil(t, epf)
+	assert.Equal(t, facade.ErrNilEventsProcessor, err)
+}
+
+func TestNewProxyFacade_NilBlocksRangeProcessorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	epf, err := facade.NewProxyFacade(
+		&mock.ActionsProcessorStub{},
+		&mock.AccountProcessorStub{},
+		&mock.TransactionProcessorStub{},
+		&mock.SCQueryServiceStub{},
+		&mock.NodeGroupProcessorStub{},
+		&mock.ValidatorStatisticsProcessorStub{},
+		&mock.FaucetProcessorStub{},
+		&mock.NodeStatusProcessorStub{},
+		&mock.BlockProcessorStub{},
+		&mock.BlocksProcessorStub{},
+		&mock.ProofProcessorStub{},
+		publicKeyConverter,
+		&mock.ESDTSuppliesProcessorStub{},
+		&mock.StatusProcessorStub{},
+		&mock.AboutInfoProcessorStub{},
+		&mock.ABIProcessorStub{},
+		&mock.EventsProcessorStub{},
+		nil,
+		&mock.HyperblockProcessorStub{},
+		&mock.TokensProcessorStub{},
+	)
+
+	assert.Nil(t, epf)
+	assert.Equal(t, facade.ErrNilBlocksRangeProcessor, err)
+}
+
+func TestNewProxyFacade_NilHyperblockProcessorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	epf, err := facade.NewProxyFacade(
+		&mock.ActionsProcessorStub{},
+		&mock.AccountProcessorStub{},
+		&mock.TransactionProcessorStub{},
+		&mock.SCQueryServiceStub{},
+		&mock.NodeGroupProcessorStub{},
+		&mock.ValidatorStatisticsProcessorStub{},
+		&mock.FaucetProcessorStub{},
+		&mock.NodeStatusProcessorStub{},
+		&mock.BlockProcessorStub{},
+		&mock.BlocksProcessorStub{},
+		&mock.ProofProcessorStub{},
+		publicKeyConverter,
+		&mock.ESDTSuppliesProcessorStub{},
+		&mock.StatusProcessorStub{},
+		&mock.AboutInfoProcessorStub{},
+		&mock.ABIProcessorStub{},
+		&mock.EventsProcessorStub{},
+		&mock.BlocksRangeProcessorStub{},
+		nil,
+		&mock.TokensProcessorStub{},
+	)
+
+	assert.Nil(t, epf)
+	assert.Equal(t, facade.ErrNilHyperblockProcessor, err)
+}
+
+func TestNewProxyFacade_NilTokensProcessorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	epf, err := facade.NewProxyFacade(
+		&mock.ActionsProcessorStub{},
+		&mock.AccountProcessorStub{},
+		&mock.TransactionProcessorStub{},
+		&mock.SCQueryServiceStub{},
+		&mock.NodeGroupProcessorStub{},
+		&mock.ValidatorStatisticsProcessorStub{},
+		&mock.FaucetProcessorStub{},
+		&mock.NodeStatusProcessorStub{},
+		&mock.BlockProcessorStub{},
+		&mock.BlocksProcessorStub{},
+		&mock.ProofProcessorStub{},
+		publicKeyConverter,
+		&mock.ESDTSuppliesProcessorStub{},
+		&mock.StatusProcessorStub{},
+		&mock.AboutInfoProcessorStub{},
+		&mock.ABIProcessorStub{},
+		&mock.EventsProcessorStub{},
+		&mock.BlocksRangeProcessorStub{},
+		&mock.HyperblockProcessorStub{},
+		nil,
+	)
+
+	assert.Nil(t, epf)
+	assert.Equal(t, facade.ErrNilTokensProcessor, err)
+}
+
 func TestNewProxyFacade_ShouldWork(t *testing.T) {
 	t.Parallel()
 
@@ -346,6 +556,11 @@ func TestNewProxyFacade_ShouldWork(t *testing.T) {
 		&mock.ESDTSuppliesProcessorStub{},
 		&mock.StatusProcessorStub{},
 		&mock.AboutInfoProcessorStub{},
+		&mock.ABIProcessorStub{},
+		&mock.EventsProcessorStub{},
+		&mock.BlocksRangeProcessorStub{},
+		&mock.HyperblockProcessorStub{},
+		&mock.TokensProcessorStub{},
 	)
 
 	assert.NotNil(t, epf)
@@ -382,7 +597,7 @@ func TestNewProxyFacade_GetBlocksByRound(t *testing.T) {
 		&mock.NodeStatusProcessorStub{},
 		&mock.BlockProcessorStub{},
 		&mock.BlocksProcessorStub{
-			GetBlocksByRoundCalled: func(round uint64, _ common.BlockQueryOptions) (*data.BlocksApiResponse, error) {
+			GetBlocksByRoundCalled: func(round uint64, _ common.BlocksQueryOptions) (*data.BlocksApiResponse, error) {
 				if round == 4 {
 					return expectedResponse, nil
 				}
@@ -394,14 +609,19 @@ func TestNewProxyFacade_GetBlocksByRound(t *testing.T) {
 		&mock.ESDTSuppliesProcessorStub{},
 		&mock.StatusProcessorStub{},
 		&mock.AboutInfoProcessorStub{},
+		&mock.ABIProcessorStub{},
+		&mock.EventsProcessorStub{},
+		&mock.BlocksRangeProcessorStub{},
+		&mock.HyperblockProcessorStub{},
+		&mock.TokensProcessorStub{},
 	)
 	require.NoError(t, err)
 
-	ret, err := epf.GetBlocksByRound(3, common.BlockQueryOptions{WithTransactions: true})
+	ret, err := epf.GetBlocksByRound(3, common.BlocksQueryOptions{BlockQueryOptions: common.BlockQueryOptions{WithTransactions: true}})
 	require.Equal(t, errGetBlockByRound, err)
 	require.Nil(t, ret)
 
-	ret, err = epf.GetBlocksByRound(4, common.BlockQueryOptions{WithTransactions: true})
+	ret, err = epf.GetBlocksByRound(4, common.BlocksQueryOptions{BlockQueryOptions: common.BlockQueryOptions{WithTransactions: true}})
 	require.Nil(t, err)
 	require.Equal(t, expectedResponse, ret)
 }
@@ -431,6 +651,11 @@ func TestProxyFacade_GetAccount(t *testing.T) {
 		&mock.ESDTSuppliesProcessorStub{},
 		&mock.StatusProcessorStub{},
 		&mock.AboutInfoProcessorStub{},
+		&mock.ABIProcessorStub{},
+		&mock.EventsProcessorStub{},
+		&mock.BlocksRangeProcessorStub{},
+		&mock.HyperblockProcessorStub{},
+		&mock.TokensProcessorStub{},
 	)
 
 	_, _ = epf.GetAccount("", common.AccountQueryOptions{})
@@ -464,6 +689,11 @@ func TestProxyFacade_SendTransaction(t *testing.T) {
 		&mock.ESDTSuppliesProcessorStub{},
 		&mock.StatusProcessorStub{},
 		&mock.AboutInfoProcessorStub{},
+		&mock.ABIProcessorStub{},
+		&mock.EventsProcessorStub{},
+		&mock.BlocksRangeProcessorStub{},
+		&mock.HyperblockProcessorStub{},
+		&mock.TokensProcessorStub{},
 	)
 
 	_, _, _ = epf.SendTransaction(&data.Transaction{})
@@ -496,6 +726,11 @@ func TestProxyFacade_SimulateTransaction(t *testing.T) {
 		&mock.ESDTSuppliesProcessorStub{},
 		&mock.StatusProcessorStub{},
 		&mock.AboutInfoProcessorStub{},
+		&mock.ABIProcessorStub{},
+		&mock.EventsProcessorStub{},
+		&mock.BlocksRangeProcessorStub{},
+		&mock.HyperblockProcessorStub{},
+		&mock.TokensProcessorStub{},
 	)
 
 	_, _ = epf.SimulateTransaction(&data.Transaction{}, false)
@@ -507,33 +742,40 @@ func TestProxyFacade_SendUserFunds(t *testing.T) {
 	t.Parallel()
 
 	wasCalled := false
+	wasConfirmed := false
+	wasReleased := false
 	epf, _ := facade.NewProxyFacade(
 		&mock.ActionsProcessorStub{},
-		&mock.AccountProcessorStub{
-			GetAccountCalled: func(address string, options common.AccountQueryOptions) (*data.AccountModel, error) {
-				return &data.AccountModel{
-					Account: data.Account{
-						Nonce: uint64(0),
-					},
-				}, nil
-			},
-		},
+		&mock.AccountProcessorStub{},
 		&mock.TransactionProcessorStub{
 			SendTransactionCalled: func(tx *data.Transaction) (int, string, error) {
 				wasCalled = true
-				return 0, "", nil
+				return 0, "txHash", nil
 			},
 		},
 		&mock.SCQueryServiceStub{},
 		&mock.NodeGroupProcessorStub{},
 		&mock.ValidatorStatisticsProcessorStub{},
 		&mock.FaucetProcessorStub{
-			SenderDetailsFromPemCalled: func(receiver string) (crypto.PrivateKey, string, error) {
-				return getPrivKey(), "rcvr", nil
+			AcquireSenderCalled: func(receiver string) (crypto.PrivateKey, string, uint64, error) {
+				return getPrivKey(), "sndr", 7, nil
 			},
 			GenerateTxForSendUserFundsCalled: func(senderSk crypto.PrivateKey, senderPk string, senderNonce uint64, receiver string, value *big.Int, config *data.NetworkConfig) (*data.Transaction, error) {
+				assert.Equal(t, "sndr", senderPk)
+				assert.Equal(t, uint64(7), senderNonce)
 				return &data.Transaction{}, nil
 			},
+			ReleaseSenderCalled: func(sender string, txSent bool) {
+				wasReleased = true
+				assert.Equal(t, "sndr", sender)
+				assert.True(t, txSent)
+			},
+			ConfirmFundsCalled: func(disbursement *data.FaucetDisbursement) error {
+				wasConfirmed = true
+				assert.Equal(t, "sndr", disbursement.Sender)
+				assert.Equal(t, "txHash", disbursement.TxHash)
+				return nil
+			},
 		},
 		&mock.NodeStatusProcessorStub{
 			GetConfigMetricsCalled: func() (*data.GenericAPIResponse, error) {
@@ -554,11 +796,257 @@ func TestProxyFacade_SendUserFunds(t *testing.T) {
 		&mock.ESDTSuppliesProcessorStub{},
 		&mock.StatusProcessorStub{},
 		&mock.AboutInfoProcessorStub{},
+		&mock.ABIProcessorStub{},
+		&mock.EventsProcessorStub{},
+		&mock.BlocksRangeProcessorStub{},
+		&mock.HyperblockProcessorStub{},
+		&mock.TokensProcessorStub{},
 	)
 
-	_ = epf.SendUserFunds("", big.NewInt(0))
+	txHash, err := epf.SendUserFunds(&data.FundsRequest{Receiver: "rcvr"}, "127.0.0.1")
 
+	assert.Nil(t, err)
+	assert.Equal(t, "txHash", txHash)
 	assert.True(t, wasCalled)
+	assert.True(t, wasReleased)
+	assert.True(t, wasConfirmed)
+}
+
+func TestProxyFacade_SendUserFundsReserveFailsShouldNotSend(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	epf, _ := facade.NewProxyFacade(
+		&mock.ActionsProcessorStub{},
+		&mock.AccountProcessorStub{},
+		&mock.TransactionProcessorStub{
+			SendTransactionCalled: func(tx *data.Transaction) (int, string, error) {
+				assert.Fail(t, "should have not been called")
+				return 0, "", nil
+			},
+		},
+		&mock.SCQueryServiceStub{},
+		&mock.NodeGroupProcessorStub{},
+		&mock.ValidatorStatisticsProcessorStub{},
+		&mock.FaucetProcessorStub{
+			ReserveFundsCalled: func(request *data.FundsRequest, clientIP string) (*data.FaucetDisbursement, error) {
+				assert.Equal(t, "127.0.0.1", clientIP)
+				assert.Equal(t, "token", request.ChallengeToken)
+				return nil, expectedErr
+			},
+		},
+		&mock.NodeStatusProcessorStub{},
+		&mock.BlockProcessorStub{},
+		&mock.BlocksProcessorStub{},
+		&mock.ProofProcessorStub{},
+		publicKeyConverter,
+		&mock.ESDTSuppliesProcessorStub{},
+		&mock.StatusProcessorStub{},
+		&mock.AboutInfoProcessorStub{},
+		&mock.ABIProcessorStub{},
+		&mock.EventsProcessorStub{},
+		&mock.BlocksRangeProcessorStub{},
+		&mock.HyperblockProcessorStub{},
+		&mock.TokensProcessorStub{},
+	)
+
+	_, err := epf.SendUserFunds(&data.FundsRequest{Receiver: "rcvr", ChallengeToken: "token"}, "127.0.0.1")
+	assert.Equal(t, expectedErr, err)
+}
+
+func TestProxyFacade_SendUserFundsSendFailsShouldCancelTheReservation(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	wasCanceled := false
+	wasReleased := false
+	epf, _ := facade.NewProxyFacade(
+		&mock.ActionsProcessorStub{},
+		&mock.AccountProcessorStub{},
+		&mock.TransactionProcessorStub{
+			SendTransactionCalled: func(tx *data.Transaction) (int, string, error) {
+				return 0, "", expectedErr
+			},
+		},
+		&mock.SCQueryServiceStub{},
+		&mock.NodeGroupProcessorStub{},
+		&mock.ValidatorStatisticsProcessorStub{},
+		&mock.FaucetProcessorStub{
+			AcquireSenderCalled: func(receiver string) (crypto.PrivateKey, string, uint64, error) {
+				return getPrivKey(), "sndr", 7, nil
+			},
+			GenerateTxForSendUserFundsCalled: func(senderSk crypto.PrivateKey, senderPk string, senderNonce uint64, receiver string, value *big.Int, config *data.NetworkConfig) (*data.Transaction, error) {
+				return &data.Transaction{}, nil
+			},
+			ReleaseSenderCalled: func(sender string, txSent bool) {
+				wasReleased = true
+				assert.False(t, txSent)
+			},
+			CancelFundsCalled: func(disbursement *data.FaucetDisbursement) {
+				wasCanceled = true
+			},
+			ConfirmFundsCalled: func(disbursement *data.FaucetDisbursement) error {
+				assert.Fail(t, "should have not been called")
+				return nil
+			},
+		},
+		&mock.NodeStatusProcessorStub{},
+		&mock.BlockProcessorStub{},
+		&mock.BlocksProcessorStub{},
+		&mock.ProofProcessorStub{},
+		publicKeyConverter,
+		&mock.ESDTSuppliesProcessorStub{},
+		&mock.StatusProcessorStub{},
+		&mock.AboutInfoProcessorStub{},
+		&mock.ABIProcessorStub{},
+		&mock.EventsProcessorStub{},
+		&mock.BlocksRangeProcessorStub{},
+		&mock.HyperblockProcessorStub{},
+		&mock.TokensProcessorStub{},
+	)
+
+	_, err := epf.SendUserFunds(&data.FundsRequest{Receiver: "rcvr"}, "")
+	assert.Equal(t, expectedErr, err)
+	assert.True(t, wasReleased)
+	assert.True(t, wasCanceled)
+}
+
+func TestProxyFacade_SendUserFundsWithTokens(t *testing.T) {
+	t.Parallel()
+
+	tokens := []*data.FaucetToken{
+		{Identifier: "TST-abcdef", TokenIdentifier: "TST-abcdef", Amount: "1000"},
+		{Identifier: "SFT-abcdef-01", TokenIdentifier: "SFT-abcdef", Nonce: 1, Amount: "10"},
+	}
+	createFacade := func(sftBalance string, txProc *mock.TransactionProcessorStub, faucetProc *mock.FaucetProcessorStub) *facade.ProxyFacade {
+		faucetProc.ReserveFundsCalled = func(request *data.FundsRequest, clientIP string) (*data.FaucetDisbursement, error) {
+			return &data.FaucetDisbursement{Receiver: request.Receiver, Value: "0", Tokens: tokens}, nil
+		}
+		faucetProc.AcquireSenderCalled = func(receiver string) (crypto.PrivateKey, string, uint64, error) {
+			return getPrivKey(), "sndr", 7, nil
+		}
+		faucetProc.GenerateTxForSendUserFundsCalled = func(senderSk crypto.PrivateKey, senderPk string, senderNonce uint64, receiver string, value *big.Int, config *data.NetworkConfig) (*data.Transaction, error) {
+			assert.Fail(t, "should have not been called")
+			return nil, nil
+		}
+
+		epf, _ := facade.NewProxyFacade(
+			&mock.ActionsProcessorStub{},
+			&mock.AccountProcessorStub{
+				GetESDTTokenDataCalled: func(address string, key string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
+					assert.Equal(t, "sndr", address)
+					assert.Equal(t, "TST-abcdef", key)
+					return &data.GenericAPIResponse{
+						Data: map[string]interface{}{
+							"tokenData": map[string]interface{}{"balance": "5000"},
+						},
+					}, nil
+				},
+				GetESDTNftTokenDataCalled: func(address string, key string, nonce uint64, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
+					assert.Equal(t, "SFT-abcdef", key)
+					assert.Equal(t, uint64(1), nonce)
+					return &data.GenericAPIResponse{
+						Data: map[string]interface{}{
+							"tokenData": map[string]interface{}{"balance": sftBalance},
+						},
+					}, nil
+				},
+			},
+			txProc,
+			&mock.SCQueryServiceStub{},
+			&mock.NodeGroupProcessorStub{},
+			&mock.ValidatorStatisticsProcessorStub{},
+			faucetProc,
+			&mock.NodeStatusProcessorStub{
+				GetConfigMetricsCalled: func() (*data.GenericAPIResponse, error) {
+					return &data.GenericAPIResponse{
+						Data: map[string]interface{}{
+							"config": map[string]interface{}{
+								"erd_chain_id":                "chainID",
+								"erd_min_transaction_version": 1.0,
+							},
+						},
+					}, nil
+				},
+			},
+			&mock.BlockProcessorStub{},
+			&mock.BlocksProcessorStub{},
+			&mock.ProofProcessorStub{},
+			publicKeyConverter,
+			&mock.ESDTSuppliesProcessorStub{},
+			&mock.StatusProcessorStub{},
+			&mock.AboutInfoProcessorStub{},
+			&mock.ABIProcessorStub{},
+			&mock.EventsProcessorStub{},
+			&mock.BlocksRangeProcessorStub{},
+			&mock.HyperblockProcessorStub{},
+			&mock.TokensProcessorStub{},
+		)
+
+		return epf
+	}
+
+	t.Run("insufficient token balance should err", func(t *testing.T) {
+		t.Parallel()
+
+		wasCanceled := false
+		wasReleased := false
+		txProc := &mock.TransactionProcessorStub{
+			SendTransactionCalled: func(tx *data.Transaction) (int, string, error) {
+				assert.Fail(t, "should have not been called")
+				return 0, "", nil
+			},
+		}
+		faucetProc := &mock.FaucetProcessorStub{
+			ReleaseSenderCalled: func(sender string, txSent bool) {
+				wasReleased = true
+				assert.False(t, txSent)
+			},
+			CancelFundsCalled: func(disbursement *data.FaucetDisbursement) {
+				wasCanceled = true
+			},
+		}
+		epf := createFacade("", txProc, faucetProc)
+
+		_, err := epf.SendUserFunds(&data.FundsRequest{Receiver: "rcvr", Tokens: []string{"TST-abcdef", "SFT-abcdef-01"}}, "")
+		assert.True(t, errors.Is(err, facade.ErrInsufficientFaucetTokenBalance))
+		assert.True(t, wasReleased)
+		assert.True(t, wasCanceled)
+	})
+
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		wasGenerated := false
+		wasConfirmed := false
+		txProc := &mock.TransactionProcessorStub{
+			SendTransactionCalled: func(tx *data.Transaction) (int, string, error) {
+				return 0, "txHash", nil
+			},
+		}
+		faucetProc := &mock.FaucetProcessorStub{
+			GenerateTxForSendUserTokensCalled: func(senderSk crypto.PrivateKey, senderPk string, senderNonce uint64, receiver string, tokensToSend []*data.FaucetToken, config *data.NetworkConfig) (*data.Transaction, error) {
+				wasGenerated = true
+				assert.Equal(t, "rcvr", receiver)
+				assert.Equal(t, tokens, tokensToSend)
+				return &data.Transaction{}, nil
+			},
+			ReleaseSenderCalled: func(sender string, txSent bool) {
+				assert.True(t, txSent)
+			},
+			ConfirmFundsCalled: func(disbursement *data.FaucetDisbursement) error {
+				wasConfirmed = true
+				return nil
+			},
+		}
+		epf := createFacade("10", txProc, faucetProc)
+
+		txHash, err := epf.SendUserFunds(&data.FundsRequest{Receiver: "rcvr", Tokens: []string{"TST-abcdef", "SFT-abcdef-01"}}, "")
+		assert.Nil(t, err)
+		assert.Equal(t, "txHash", txHash)
+		assert.True(t, wasGenerated)
+		assert.True(t, wasConfirmed)
+	})
 }
 
 func TestProxyFacade_GetDataValue(t *testing.T) {
@@ -570,9 +1058,46 @@ func TestProxyFacade_GetDataValue(t *testing.T) {
 		&mock.AccountProcessorStub{},
 		&mock.TransactionProcessorStub{},
 		&mock.SCQueryServiceStub{
-			ExecuteQueryCalled: func(query *data.SCQuery) (*vm.VMOutputApi, error) {
+			ExecuteQueryCalled: func(query *data.SCQuery) (*vm.VMOutputApi, data.BlockInfo, error) {
+				wasCalled = true
+				return &vm.VMOutputApi{}, data.BlockInfo{}, nil
+			},
+		},
+		&mock.NodeGroupProcessorStub{},
+		&mock.ValidatorStatisticsProcessorStub{},
+		&mock.FaucetProcessorStub{},
+		&mock.NodeStatusProcessorStub{},
+		&mock.BlockProcessorStub{},
+		&mock.BlocksProcessorStub{},
+		&mock.ProofProcessorStub{},
+		publicKeyConverter,
+		&mock.ESDTSuppliesProcessorStub{},
+		&mock.StatusProcessorStub{},
+		&mock.AboutInfoProcessorStub{},
+		&mock.ABIProcessorStub{},
+		&mock.EventsProcessorStub{},
+		&mock.BlocksRangeProcessorStub{},
+		&mock.HyperblockProcessorStub{},
+		&mock.TokensProcessorStub{},
+	)
+
+	_, _, _ = epf.ExecuteSCQuery(nil)
+
+	assert.True(t, wasCalled)
+}
+
+func TestProxyFacade_ExecuteSCQueries(t *testing.T) {
+	t.Parallel()
+
+	wasCalled := false
+	epf, _ := facade.NewProxyFacade(
+		&mock.ActionsProcessorStub{},
+		&mock.AccountProcessorStub{},
+		&mock.TransactionProcessorStub{},
+		&mock.SCQueryServiceStub{
+			ExecuteQueriesCalled: func(queries []*data.SCQuery) ([]*data.SCQueryResult, error) {
 				wasCalled = true
-				return &vm.VMOutputApi{}, nil
+				return make([]*data.SCQueryResult, 0), nil
 			},
 		},
 		&mock.NodeGroupProcessorStub{},
@@ -586,9 +1111,14 @@ func TestProxyFacade_GetDataValue(t *testing.T) {
 		&mock.ESDTSuppliesProcessorStub{},
 		&mock.StatusProcessorStub{},
 		&mock.AboutInfoProcessorStub{},
+		&mock.ABIProcessorStub{},
+		&mock.EventsProcessorStub{},
+		&mock.BlocksRangeProcessorStub{},
+		&mock.HyperblockProcessorStub{},
+		&mock.TokensProcessorStub{},
 	)
 
-	_, _ = epf.ExecuteSCQuery(nil)
+	_, _ = epf.ExecuteSCQueries(nil)
 
 	assert.True(t, wasCalled)
 }
@@ -624,6 +1154,11 @@ func TestProxyFacade_GetHeartbeatData(t *testing.T) {
 		&mock.ESDTSuppliesProcessorStub{},
 		&mock.StatusProcessorStub{},
 		&mock.AboutInfoProcessorStub{},
+		&mock.ABIProcessorStub{},
+		&mock.EventsProcessorStub{},
+		&mock.BlocksRangeProcessorStub{},
+		&mock.HyperblockProcessorStub{},
+		&mock.TokensProcessorStub{},
 	)
 
 	actualResult, _ := epf.GetHeartbeatData()
@@ -659,6 +1194,11 @@ func TestProxyFacade_ReloadObservers(t *testing.T) {
 		&mock.ESDTSuppliesProcessorStub{},
 		&mock.StatusProcessorStub{},
 		&mock.AboutInfoProcessorStub{},
+		&mock.ABIProcessorStub{},
+		&mock.EventsProcessorStub{},
+		&mock.BlocksRangeProcessorStub{},
+		&mock.HyperblockProcessorStub{},
+		&mock.TokensProcessorStub{},
 	)
 
 	actualResult := epf.ReloadObservers()
@@ -694,6 +1234,11 @@ func TestProxyFacade_ReloadFullHistoryObservers(t *testing.T) {
 		&mock.ESDTSuppliesProcessorStub{},
 		&mock.StatusProcessorStub{},
 		&mock.AboutInfoProcessorStub{},
+		&mock.ABIProcessorStub{},
+		&mock.EventsProcessorStub{},
+		&mock.BlocksRangeProcessorStub{},
+		&mock.HyperblockProcessorStub{},
+		&mock.TokensProcessorStub{},
 	)
 
 	actualResult := epf.ReloadFullHistoryObservers()
@@ -733,6 +1278,11 @@ func TestProxyFacade_GetBlockByHash(t *testing.T) {
 		&mock.ESDTSuppliesProcessorStub{},
 		&mock.StatusProcessorStub{},
 		&mock.AboutInfoProcessorStub{},
+		&mock.ABIProcessorStub{},
+		&mock.EventsProcessorStub{},
+		&mock.BlocksRangeProcessorStub{},
+		&mock.HyperblockProcessorStub{},
+		&mock.TokensProcessorStub{},
 	)
 
 	actualResult, err := epf.GetBlockByHash(0, "aaaa", common.BlockQueryOptions{})
@@ -773,6 +1323,11 @@ func TestProxyFacade_GetBlockByNonce(t *testing.T) {
 		&mock.ESDTSuppliesProcessorStub{},
 		&mock.StatusProcessorStub{},
 		&mock.AboutInfoProcessorStub{},
+		&mock.ABIProcessorStub{},
+		&mock.EventsProcessorStub{},
+		&mock.BlocksRangeProcessorStub{},
+		&mock.HyperblockProcessorStub{},
+		&mock.TokensProcessorStub{},
 	)
 
 	actualResult, err := epf.GetBlockByNonce(0, 10, common.BlockQueryOptions{})
@@ -815,6 +1370,11 @@ func TestProxyFacade_GetInternalBlockByHash(t *testing.T) {
 		&mock.ESDTSuppliesProcessorStub{},
 		&mock.StatusProcessorStub{},
 		&mock.AboutInfoProcessorStub{},
+		&mock.ABIProcessorStub{},
+		&mock.EventsProcessorStub{},
+		&mock.BlocksRangeProcessorStub{},
+		&mock.HyperblockProcessorStub{},
+		&mock.TokensProcessorStub{},
 	)
 
 	actualResult, err := epf.GetInternalBlockByHash(0, "aaaa", common.Internal)
@@ -855,6 +1415,11 @@ func TestProxyFacade_GetInternalBlockByNonce(t *testing.T) {
 		&mock.ESDTSuppliesProcessorStub{},
 		&mock.StatusProcessorStub{},
 		&mock.AboutInfoProcessorStub{},
+		&mock.ABIProcessorStub{},
+		&mock.EventsProcessorStub{},
+		&mock.BlocksRangeProcessorStub{},
+		&mock.HyperblockProcessorStub{},
+		&mock.TokensProcessorStub{},
 	)
 
 	actualResult, err := epf.GetInternalBlockByNonce(0, 10, common.Internal)
@@ -895,6 +1460,11 @@ func TestProxyFacade_GetInternalMiniBlockByHash(t *testing.T) {
 		&mock.ESDTSuppliesProcessorStub{},
 		&mock.StatusProcessorStub{},
 		&mock.AboutInfoProcessorStub{},
+		&mock.ABIProcessorStub{},
+		&mock.EventsProcessorStub{},
+		&mock.BlocksRangeProcessorStub{},
+		&mock.HyperblockProcessorStub{},
+		&mock.TokensProcessorStub{},
 	)
 
 	actualResult, err := epf.GetInternalMiniBlockByHash(0, "aaaa", 1, common.Internal)
@@ -933,6 +1503,11 @@ func TestProxyFacade_GetRatingsConfig(t *testing.T) {
 		&mock.ESDTSuppliesProcessorStub{},
 		&mock.StatusProcessorStub{},
 		&mock.AboutInfoProcessorStub{},
+		&mock.ABIProcessorStub{},
+		&mock.EventsProcessorStub{},
+		&mock.BlocksRangeProcessorStub{},
+		&mock.HyperblockProcessorStub{},
+		&mock.TokensProcessorStub{},
 	)
 
 	actualResult, err := epf.GetRatingsConfig()
@@ -998,6 +1573,11 @@ func TestProxyFacade_GetTransactionsPool(t *testing.T) {
 		&mock.ESDTSuppliesProcessorStub{},
 		&mock.StatusProcessorStub{},
 		&mock.AboutInfoProcessorStub{},
+		&mock.ABIProcessorStub{},
+		&mock.EventsProcessorStub{},
+		&mock.BlocksRangeProcessorStub{},
+		&mock.HyperblockProcessorStub{},
+		&mock.TokensProcessorStub{},
 	)
 
 	actualTxPool, err := epf.GetTransactionsPool("")
@@ -1052,6 +1632,11 @@ func TestProxyFacade_GetGasConfigs(t *testing.T) {
 		&mock.ESDTSuppliesProcessorStub{},
 		&mock.StatusProcessorStub{},
 		&mock.AboutInfoProcessorStub{},
+		&mock.ABIProcessorStub{},
+		&mock.EventsProcessorStub{},
+		&mock.BlocksRangeProcessorStub{},
+		&mock.HyperblockProcessorStub{},
+		&mock.TokensProcessorStub{},
 	)
 
 	actualResult, err := epf.GetGasConfigs()
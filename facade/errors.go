@@ -43,3 +43,24 @@ var ErrNilStatusProcessor = errors.New("nil status processor")
 
 // ErrNilAboutInfoProcessor signals that a nil about info processor has been provided
 var ErrNilAboutInfoProcessor = errors.New("nil about info processor")
+
+// ErrNilABIProcessor signals that a nil ABI processor has been provided
+var ErrNilABIProcessor = errors.New("nil ABI processor")
+
+// ErrNilEventsProcessor signals that a nil events processor has been provided
+var ErrNilEventsProcessor = errors.New("nil events processor")
+
+// ErrNilBlocksRangeProcessor signals that a nil blocks range processor has been provided
+var ErrNilBlocksRangeProcessor = errors.New("nil blocks range processor")
+
+// ErrInvalidFaucetValue signals that the value reserved by the faucet is invalid
+var ErrInvalidFaucetValue = errors.New("invalid faucet value")
+
+// ErrInsufficientFaucetTokenBalance signals that the faucet's sender does not hold enough tokens
+var ErrInsufficientFaucetTokenBalance = errors.New("insufficient faucet token balance")
+
+// ErrNilHyperblockProcessor signals that a nil hyperblock processor has been provided
+var ErrNilHyperblockProcessor = errors.New("nil hyperblock processor")
+
+// ErrNilTokensProcessor signals that a nil tokens processor has been provided
+var ErrNilTokensProcessor = errors.New("nil tokens processor")
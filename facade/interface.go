@@ -21,7 +21,7 @@ type AccountProcessor interface {
 	GetAccount(address string, options common.AccountQueryOptions) (*data.AccountModel, error)
 	GetShardIDForAddress(address string) (uint32, error)
 	GetValueForKey(address string, key string, options common.AccountQueryOptions) (string, error)
-	GetTransactions(address string) ([]data.DatabaseTransaction, error)
+	GetTransactions(address string, filter data.TransactionsHistoryFilter) (*data.TransactionsHistoryPage, error)
 	GetAllESDTTokens(address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error)
 	GetKeyValuePairs(address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error)
 	GetESDTTokenData(address string, key string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error)
@@ -39,6 +39,7 @@ type TransactionProcessor interface {
 	SendMultipleTransactions(txs []*data.Transaction) (data.MultipleTransactionsResponseData, error)
 	SimulateTransaction(tx *data.Transaction, checkSignature bool) (*data.GenericAPIResponse, error)
 	TransactionCostRequest(tx *data.Transaction) (*data.TxCostResponseData, error)
+	GetTransactionFeeEstimate(tx *data.Transaction) (*data.TransactionFeeEstimate, error)
 	GetTransactionStatus(txHash string, sender string) (string, error)
 	GetTransaction(txHash string, withEvents bool) (*transaction.ApiTransactionResult, error)
 	GetProcessedTransactionStatus(txHash string) (string, error)
@@ -61,7 +62,8 @@ type ProofProcessor interface {
 
 // SCQueryService defines how data should be get from a SC account
 type SCQueryService interface {
-	ExecuteQuery(query *data.SCQuery) (*vm.VMOutputApi, error)
+	ExecuteQuery(query *data.SCQuery) (*vm.VMOutputApi, data.BlockInfo, error)
+	ExecuteQueries(queries []*data.SCQuery) ([]*data.SCQueryResult, error)
 }
 
 // NodeGroupProcessor defines what a node group processor should do
@@ -99,7 +101,7 @@ type NodeStatusProcessor interface {
 
 // BlocksProcessor defines what a blocks processor should do
 type BlocksProcessor interface {
-	GetBlocksByRound(round uint64, options common.BlockQueryOptions) (*data.BlocksApiResponse, error)
+	GetBlocksByRound(round uint64, options common.BlocksQueryOptions) (*data.BlocksApiResponse, error)
 }
 
 // BlockProcessor defines what a block processor should do
@@ -107,8 +109,6 @@ type BlockProcessor interface {
 	GetAtlasBlockByShardIDAndNonce(shardID uint32, nonce uint64) (data.AtlasBlock, error)
 	GetBlockByHash(shardID uint32, hash string, options common.BlockQueryOptions) (*data.BlockApiResponse, error)
 	GetBlockByNonce(shardID uint32, nonce uint64, options common.BlockQueryOptions) (*data.BlockApiResponse, error)
-	GetHyperBlockByHash(hash string, options common.HyperblockQueryOptions) (*data.HyperblockApiResponse, error)
-	GetHyperBlockByNonce(nonce uint64, options common.HyperblockQueryOptions) (*data.HyperblockApiResponse, error)
 
 	GetInternalBlockByHash(shardID uint32, hash string, format common.OutputFormat) (*data.InternalBlockApiResponse, error)
 	GetInternalBlockByNonce(shardID uint32, nonce uint64, format common.OutputFormat) (*data.InternalBlockApiResponse, error)
@@ -123,7 +123,12 @@ type BlockProcessor interface {
 // FaucetProcessor defines what a component which will handle faucets should do
 type FaucetProcessor interface {
 	IsEnabled() bool
-	SenderDetailsFromPem(receiver string) (crypto.PrivateKey, string, error)
+	ReserveFunds(request *data.FundsRequest, clientIP string) (*data.FaucetDisbursement, error)
+	ConfirmFunds(disbursement *data.FaucetDisbursement) error
+	CancelFunds(disbursement *data.FaucetDisbursement)
+	GetDisbursements(filter data.FaucetDisbursementsFilter) ([]*data.FaucetDisbursement, error)
+	AcquireSender(receiver string) (crypto.PrivateKey, string, uint64, error)
+	ReleaseSender(sender string, txSent bool)
 	GenerateTxForSendUserFunds(
 		senderSk crypto.PrivateKey,
 		senderPk string,
@@ -132,6 +137,16 @@ type FaucetProcessor interface {
 		value *big.Int,
 		networkConfig *data.NetworkConfig,
 	) (*data.Transaction, error)
+	GenerateTxForSendUserTokens(
+		senderSk crypto.PrivateKey,
+		senderPk string,
+		senderNonce uint64,
+		receiver string,
+		tokens []*data.FaucetToken,
+		networkConfig *data.NetworkConfig,
+	) (*data.Transaction, error)
+	GetTokens() []*data.FaucetToken
+	Close() error
 }
 
 // StatusProcessor defines what a component which will handle status request should do
@@ -145,3 +160,37 @@ type AboutInfoProcessor interface {
 	GetAboutInfo() *data.GenericAPIResponse
 	GetNodesVersions() (*data.GenericAPIResponse, error)
 }
+
+// ABIProcessor defines what an ABI processor should be able to do
+type ABIProcessor interface {
+	ExecuteQuery(request *data.ABIQueryRequest, options common.VmQueryOptions) (*data.ABIQueryResponse, data.BlockInfo, error)
+	EncodeArguments(request *data.ABIArgumentsRequest) ([]string, error)
+	BuildCallData(request *data.ABIArgumentsRequest) (string, error)
+	DecodeResults(request *data.ABIResultsRequest) ([]interface{}, error)
+}
+
+// EventsProcessor defines what an events processor should be able to do
+type EventsProcessor interface {
+	GetEvents(query data.EventsQuery) (*data.EventsPage, error)
+}
+
+// BlocksRangeProcessor defines what a blocks range processor should be able to do
+type BlocksRangeProcessor interface {
+	GetHyperBlocksRange(from uint64, to uint64, options common.HyperblockQueryOptions, handler data.BlocksRangeItemHandler) error
+	GetBlocksRange(shardID uint32, from uint64, to uint64, options common.BlockQueryOptions, handler data.BlocksRangeItemHandler) error
+}
+
+// HyperblockProcessor defines what a hyperblock processor, aware of the hyperblocks finality and reorgs, should be able to do
+type HyperblockProcessor interface {
+	GetHyperBlockByHash(hash string, options common.HyperblockQueryOptions) (*data.HyperblockApiResponse, error)
+	GetHyperBlockByNonce(nonce uint64, options common.HyperblockQueryOptions) (*data.HyperblockApiResponse, error)
+	GetHyperblockReorgs() []*data.HyperblockReorg
+	SubscribeToHyperblockReorgs() (<-chan *data.HyperblockReorg, func())
+}
+
+// TokensProcessor defines what a tokens processor should be able to do
+type TokensProcessor interface {
+	GetTokenProperties(identifier string) (*data.TokenProperties, error)
+	GetTokenHolders(identifier string, filter data.TokenHoldersFilter) (*data.TokenHoldersPage, error)
+	GetTokenTransfers(identifier string, filter data.TokenTransfersFilter) (*data.TokenTransfersPage, error)
+}
@@ -0,0 +1,50 @@
+package mock
+
+import (
+	"github.com/multiversx/mx-chain-proxy-go/common"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+// ABIProcessorStub -
+type ABIProcessorStub struct {
+	ExecuteQueryCalled    func(request *data.ABIQueryRequest, options common.VmQueryOptions) (*data.ABIQueryResponse, data.BlockInfo, error)
+	EncodeArgumentsCalled func(request *data.ABIArgumentsRequest) ([]string, error)
+	BuildCallDataCalled   func(request *data.ABIArgumentsRequest) (string, error)
+	DecodeResultsCalled   func(request *data.ABIResultsRequest) ([]interface{}, error)
+}
+
+// ExecuteQuery -
+func (stub *ABIProcessorStub) ExecuteQuery(request *data.ABIQueryRequest, options common.VmQueryOptions) (*data.ABIQueryResponse, data.BlockInfo, error) {
+	if stub.ExecuteQueryCalled != nil {
+		return stub.ExecuteQueryCalled(request, options)
+	}
+
+	return &data.ABIQueryResponse{}, data.BlockInfo{}, nil
+}
+
+// EncodeArguments -
+func (stub *ABIProcessorStub) EncodeArguments(request *data.ABIArgumentsRequest) ([]string, error) {
+	if stub.EncodeArgumentsCalled != nil {
+		return stub.EncodeArgumentsCalled(request)
+	}
+
+	return make([]string, 0), nil
+}
+
+// BuildCallData -
+func (stub *ABIProcessorStub) BuildCallData(request *data.ABIArgumentsRequest) (string, error) {
+	if stub.BuildCallDataCalled != nil {
+		return stub.BuildCallDataCalled(request)
+	}
+
+	return "", nil
+}
+
+// DecodeResults -
+func (stub *ABIProcessorStub) DecodeResults(request *data.ABIResultsRequest) ([]interface{}, error) {
+	if stub.DecodeResultsCalled != nil {
+		return stub.DecodeResultsCalled(request)
+	}
+
+	return make([]interface{}, 0), nil
+}
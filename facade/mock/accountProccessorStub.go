@@ -10,7 +10,7 @@ type AccountProcessorStub struct {
 	GetAccountCalled                        func(address string, options common.AccountQueryOptions) (*data.AccountModel, error)
 	GetValueForKeyCalled                    func(address string, key string, options common.AccountQueryOptions) (string, error)
 	GetShardIDForAddressCalled              func(address string) (uint32, error)
-	GetTransactionsCalled                   func(address string) ([]data.DatabaseTransaction, error)
+	GetTransactionsCalled                   func(address string, filter data.TransactionsHistoryFilter) (*data.TransactionsHistoryPage, error)
 	ValidatorStatisticsCalled               func() (map[string]*data.ValidatorApiResponse, error)
 	GetAllESDTTokensCalled                  func(address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error)
 	GetESDTTokenDataCalled                  func(address string, key string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error)
@@ -83,8 +83,8 @@ func (aps *AccountProcessorStub) GetShardIDForAddress(address string) (uint32, e
 }
 
 // GetTransactions -
-func (aps *AccountProcessorStub) GetTransactions(address string) ([]data.DatabaseTransaction, error) {
-	return aps.GetTransactionsCalled(address)
+func (aps *AccountProcessorStub) GetTransactions(address string, filter data.TransactionsHistoryFilter) (*data.TransactionsHistoryPage, error) {
+	return aps.GetTransactionsCalled(address, filter)
 }
 
 // GetCodeHash -
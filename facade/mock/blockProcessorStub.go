@@ -10,8 +10,6 @@ type BlockProcessorStub struct {
 	GetBlockByShardIDAndNonceCalled             func(shardID uint32, nonce uint64) (data.AtlasBlock, error)
 	GetBlockByHashCalled                        func(shardID uint32, hash string, options common.BlockQueryOptions) (*data.BlockApiResponse, error)
 	GetBlockByNonceCalled                       func(shardID uint32, nonce uint64, options common.BlockQueryOptions) (*data.BlockApiResponse, error)
-	GetHyperBlockByHashCalled                   func(hash string, options common.HyperblockQueryOptions) (*data.HyperblockApiResponse, error)
-	GetHyperBlockByNonceCalled                  func(nonce uint64, options common.HyperblockQueryOptions) (*data.HyperblockApiResponse, error)
 	GetInternalBlockByHashCalled                func(shardID uint32, hash string, format common.OutputFormat) (*data.InternalBlockApiResponse, error)
 	GetInternalBlockByNonceCalled               func(shardID uint32, round uint64, format common.OutputFormat) (*data.InternalBlockApiResponse, error)
 	GetInternalMiniBlockByHashCalled            func(shardID uint32, hash string, epoch uint32, format common.OutputFormat) (*data.InternalMiniBlockApiResponse, error)
@@ -32,24 +30,6 @@ func (bps *BlockProcessorStub) GetAtlasBlockByShardIDAndNonce(shardID uint32, no
 	return bps.GetBlockByShardIDAndNonceCalled(shardID, nonce)
 }
 
-// GetHyperBlockByHash -
-func (bps *BlockProcessorStub) GetHyperBlockByHash(hash string, options common.HyperblockQueryOptions) (*data.HyperblockApiResponse, error) {
-	if bps.GetHyperBlockByHashCalled != nil {
-		return bps.GetHyperBlockByHashCalled(hash, options)
-	}
-
-	panic("not implemented: GetHyperBlockByHash")
-}
-
-// GetHyperBlockByNonce -
-func (bps *BlockProcessorStub) GetHyperBlockByNonce(nonce uint64, options common.HyperblockQueryOptions) (*data.HyperblockApiResponse, error) {
-	if bps.GetHyperBlockByNonceCalled != nil {
-		return bps.GetHyperBlockByNonceCalled(nonce, options)
-	}
-
-	panic("not implemented: GetHyperBlockByNonce")
-}
-
 // GetInternalBlockByHash -
 func (bps *BlockProcessorStub) GetInternalBlockByHash(shardID uint32, hash string, format common.OutputFormat) (*data.InternalBlockApiResponse, error) {
 	return bps.GetInternalBlockByHashCalled(shardID, hash, format)
@@ -7,11 +7,11 @@ import (
 
 // BlocksProcessorStub -
 type BlocksProcessorStub struct {
-	GetBlocksByRoundCalled func(round uint64, options common.BlockQueryOptions) (*data.BlocksApiResponse, error)
+	GetBlocksByRoundCalled func(round uint64, options common.BlocksQueryOptions) (*data.BlocksApiResponse, error)
 }
 
 // GetBlocksByRound -
-func (bps *BlocksProcessorStub) GetBlocksByRound(round uint64, options common.BlockQueryOptions) (*data.BlocksApiResponse, error) {
+func (bps *BlocksProcessorStub) GetBlocksByRound(round uint64, options common.BlocksQueryOptions) (*data.BlocksApiResponse, error) {
 	if bps.GetBlocksByRoundCalled != nil {
 		return bps.GetBlocksByRoundCalled(round, options)
 	}
@@ -0,0 +1,30 @@
+package mock
+
+import (
+	"github.com/multiversx/mx-chain-proxy-go/common"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+// BlocksRangeProcessorStub -
+type BlocksRangeProcessorStub struct {
+	GetHyperBlocksRangeCalled func(from uint64, to uint64, options common.HyperblockQueryOptions, handler data.BlocksRangeItemHandler) error
+	GetBlocksRangeCalled      func(shardID uint32, from uint64, to uint64, options common.BlockQueryOptions, handler data.BlocksRangeItemHandler) error
+}
+
+// GetHyperBlocksRange -
+func (stub *BlocksRangeProcessorStub) GetHyperBlocksRange(from uint64, to uint64, options common.HyperblockQueryOptions, handler data.BlocksRangeItemHandler) error {
+	if stub.GetHyperBlocksRangeCalled != nil {
+		return stub.GetHyperBlocksRangeCalled(from, to, options, handler)
+	}
+
+	return nil
+}
+
+// GetBlocksRange -
+func (stub *BlocksRangeProcessorStub) GetBlocksRange(shardID uint32, from uint64, to uint64, options common.BlockQueryOptions, handler data.BlocksRangeItemHandler) error {
+	if stub.GetBlocksRangeCalled != nil {
+		return stub.GetBlocksRangeCalled(shardID, from, to, options, handler)
+	}
+
+	return nil
+}
@@ -0,0 +1,17 @@
+package mock
+
+import "github.com/multiversx/mx-chain-proxy-go/data"
+
+// EventsProcessorStub -
+type EventsProcessorStub struct {
+	GetEventsCalled func(query data.EventsQuery) (*data.EventsPage, error)
+}
+
+// GetEvents -
+func (stub *EventsProcessorStub) GetEvents(query data.EventsQuery) (*data.EventsPage, error) {
+	if stub.GetEventsCalled != nil {
+		return stub.GetEventsCalled(query)
+	}
+
+	return &data.EventsPage{}, nil
+}
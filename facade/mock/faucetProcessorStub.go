@@ -11,7 +11,14 @@ type FaucetProcessorStub struct {
 	IsEnabledCalled                  func() bool
 	GenerateTxForSendUserFundsCalled func(senderSk crypto.PrivateKey, senderPk string, senderNonce uint64,
 		receiver string, value *big.Int, networkConfig *data.NetworkConfig) (*data.Transaction, error)
-	SenderDetailsFromPemCalled func(receiver string) (crypto.PrivateKey, string, error)
+	ReserveFundsCalled                func(request *data.FundsRequest, clientIP string) (*data.FaucetDisbursement, error)
+	ConfirmFundsCalled                func(disbursement *data.FaucetDisbursement) error
+	CancelFundsCalled                 func(disbursement *data.FaucetDisbursement)
+	GetDisbursementsCalled            func(filter data.FaucetDisbursementsFilter) ([]*data.FaucetDisbursement, error)
+	AcquireSenderCalled               func(receiver string) (crypto.PrivateKey, string, uint64, error)
+	ReleaseSenderCalled               func(sender string, txSent bool)
+	GenerateTxForSendUserTokensCalled func(senderSk crypto.PrivateKey, senderPk string, senderNonce uint64, receiver string, tokens []*data.FaucetToken, networkConfig *data.NetworkConfig) (*data.Transaction, error)
+	GetTokensCalled                   func() []*data.FaucetToken
 }
 
 func (fps *FaucetProcessorStub) IsEnabled() bool {
@@ -22,10 +29,6 @@ func (fps *FaucetProcessorStub) IsEnabled() bool {
 	return true
 }
 
-func (fps *FaucetProcessorStub) SenderDetailsFromPem(receiver string) (crypto.PrivateKey, string, error) {
-	return fps.SenderDetailsFromPemCalled(receiver)
-}
-
 func (fps *FaucetProcessorStub) GenerateTxForSendUserFunds(
 	senderSk crypto.PrivateKey,
 	senderPk string,
@@ -36,3 +39,92 @@ func (fps *FaucetProcessorStub) GenerateTxForSendUserFunds(
 ) (*data.Transaction, error) {
 	return fps.GenerateTxForSendUserFundsCalled(senderSk, senderPk, senderNonce, receiver, value, networkConfig)
 }
+
+// ReserveFunds -
+func (fps *FaucetProcessorStub) ReserveFunds(request *data.FundsRequest, clientIP string) (*data.FaucetDisbursement, error) {
+	if fps.ReserveFundsCalled != nil {
+		return fps.ReserveFundsCalled(request, clientIP)
+	}
+
+	valueAsString := "1"
+	if request.Value != nil {
+		valueAsString = request.Value.String()
+	}
+
+	return &data.FaucetDisbursement{
+		Receiver: request.Receiver,
+		ClientIP: clientIP,
+		Value:    valueAsString,
+	}, nil
+}
+
+// ConfirmFunds -
+func (fps *FaucetProcessorStub) ConfirmFunds(disbursement *data.FaucetDisbursement) error {
+	if fps.ConfirmFundsCalled != nil {
+		return fps.ConfirmFundsCalled(disbursement)
+	}
+
+	return nil
+}
+
+// CancelFunds -
+func (fps *FaucetProcessorStub) CancelFunds(disbursement *data.FaucetDisbursement) {
+	if fps.CancelFundsCalled != nil {
+		fps.CancelFundsCalled(disbursement)
+	}
+}
+
+// GetDisbursements -
+func (fps *FaucetProcessorStub) GetDisbursements(filter data.FaucetDisbursementsFilter) ([]*data.FaucetDisbursement, error) {
+	if fps.GetDisbursementsCalled != nil {
+		return fps.GetDisbursementsCalled(filter)
+	}
+
+	return make([]*data.FaucetDisbursement, 0), nil
+}
+
+// Close -
+func (fps *FaucetProcessorStub) Close() error {
+	return nil
+}
+
+// AcquireSender -
+func (fps *FaucetProcessorStub) AcquireSender(receiver string) (crypto.PrivateKey, string, uint64, error) {
+	if fps.AcquireSenderCalled != nil {
+		return fps.AcquireSenderCalled(receiver)
+	}
+
+	return nil, "", 0, nil
+}
+
+// ReleaseSender -
+func (fps *FaucetProcessorStub) ReleaseSender(sender string, txSent bool) {
+	if fps.ReleaseSenderCalled != nil {
+		fps.ReleaseSenderCalled(sender, txSent)
+	}
+}
+
+// GenerateTxForSendUserTokens -
+func (fps *FaucetProcessorStub) GenerateTxForSendUserTokens(
+	senderSk crypto.PrivateKey,
+	senderPk string,
+	senderNonce uint64,
+	receiver string,
+	tokens []*data.FaucetToken,
+	networkConfig *data.NetworkConfig,
+) (*data.Transaction, error) {
+	if fps.GenerateTxForSendUserTokensCalled != nil {
+		return fps.GenerateTxForSendUserTokensCalled(senderSk, senderPk, senderNonce, receiver, tokens, networkConfig)
+	}
+
+	return &data.Transaction{}, nil
+}
+
+// GetTokens -
+func (fps *FaucetProcessorStub) GetTokens() []*data.FaucetToken {
+	if fps.GetTokensCalled != nil {
+		return fps.GetTokensCalled()
+	}
+
+	return make([]*data.FaucetToken, 0)
+}
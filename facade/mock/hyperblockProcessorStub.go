@@ -0,0 +1,50 @@
+package mock
+
+import (
+	"github.com/multiversx/mx-chain-proxy-go/common"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+// HyperblockProcessorStub -
+type HyperblockProcessorStub struct {
+	GetHyperBlockByHashCalled         func(hash string, options common.HyperblockQueryOptions) (*data.HyperblockApiResponse, error)
+	GetHyperBlockByNonceCalled        func(nonce uint64, options common.HyperblockQueryOptions) (*data.HyperblockApiResponse, error)
+	GetHyperblockReorgsCalled         func() []*data.HyperblockReorg
+	SubscribeToHyperblockReorgsCalled func() (<-chan *data.HyperblockReorg, func())
+}
+
+// GetHyperBlockByHash -
+func (stub *HyperblockProcessorStub) GetHyperBlockByHash(hash string, options common.HyperblockQueryOptions) (*data.HyperblockApiResponse, error) {
+	if stub.GetHyperBlockByHashCalled != nil {
+		return stub.GetHyperBlockByHashCalled(hash, options)
+	}
+
+	return nil, nil
+}
+
+// GetHyperBlockByNonce -
+func (stub *HyperblockProcessorStub) GetHyperBlockByNonce(nonce uint64, options common.HyperblockQueryOptions) (*data.HyperblockApiResponse, error) {
+	if stub.GetHyperBlockByNonceCalled != nil {
+		return stub.GetHyperBlockByNonceCalled(nonce, options)
+	}
+
+	return nil, nil
+}
+
+// GetHyperblockReorgs -
+func (stub *HyperblockProcessorStub) GetHyperblockReorgs() []*data.HyperblockReorg {
+	if stub.GetHyperblockReorgsCalled != nil {
+		return stub.GetHyperblockReorgsCalled()
+	}
+
+	return nil
+}
+
+// SubscribeToHyperblockReorgs -
+func (stub *HyperblockProcessorStub) SubscribeToHyperblockReorgs() (<-chan *data.HyperblockReorg, func()) {
+	if stub.SubscribeToHyperblockReorgsCalled != nil {
+		return stub.SubscribeToHyperblockReorgsCalled()
+	}
+
+	return make(chan *data.HyperblockReorg), func() {}
+}
@@ -7,10 +7,20 @@ import (
 
 // SCQueryServiceStub -
 type SCQueryServiceStub struct {
-	ExecuteQueryCalled func(*data.SCQuery) (*vm.VMOutputApi, error)
+	ExecuteQueryCalled   func(*data.SCQuery) (*vm.VMOutputApi, data.BlockInfo, error)
+	ExecuteQueriesCalled func(queries []*data.SCQuery) ([]*data.SCQueryResult, error)
 }
 
 // ExecuteQuery -
-func (serviceStub *SCQueryServiceStub) ExecuteQuery(query *data.SCQuery) (*vm.VMOutputApi, error) {
+func (serviceStub *SCQueryServiceStub) ExecuteQuery(query *data.SCQuery) (*vm.VMOutputApi, data.BlockInfo, error) {
 	return serviceStub.ExecuteQueryCalled(query)
 }
+
+// ExecuteQueries -
+func (serviceStub *SCQueryServiceStub) ExecuteQueries(queries []*data.SCQuery) ([]*data.SCQueryResult, error) {
+	if serviceStub.ExecuteQueriesCalled != nil {
+		return serviceStub.ExecuteQueriesCalled(queries)
+	}
+
+	return make([]*data.SCQueryResult, 0), nil
+}
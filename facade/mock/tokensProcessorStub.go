@@ -0,0 +1,37 @@
+package mock
+
+import "github.com/multiversx/mx-chain-proxy-go/data"
+
+// TokensProcessorStub -
+type TokensProcessorStub struct {
+	GetTokenPropertiesCalled func(identifier string) (*data.TokenProperties, error)
+	GetTokenHoldersCalled    func(identifier string, filter data.TokenHoldersFilter) (*data.TokenHoldersPage, error)
+	GetTokenTransfersCalled  func(identifier string, filter data.TokenTransfersFilter) (*data.TokenTransfersPage, error)
+}
+
+// GetTokenProperties -
+func (stub *TokensProcessorStub) GetTokenProperties(identifier string) (*data.TokenProperties, error) {
+	if stub.GetTokenPropertiesCalled != nil {
+		return stub.GetTokenPropertiesCalled(identifier)
+	}
+
+	return &data.TokenProperties{}, nil
+}
+
+// GetTokenHolders -
+func (stub *TokensProcessorStub) GetTokenHolders(identifier string, filter data.TokenHoldersFilter) (*data.TokenHoldersPage, error) {
+	if stub.GetTokenHoldersCalled != nil {
+		return stub.GetTokenHoldersCalled(identifier, filter)
+	}
+
+	return &data.TokenHoldersPage{}, nil
+}
+
+// GetTokenTransfers -
+func (stub *TokensProcessorStub) GetTokenTransfers(identifier string, filter data.TokenTransfersFilter) (*data.TokenTransfersPage, error) {
+	if stub.GetTokenTransfersCalled != nil {
+		return stub.GetTokenTransfersCalled(identifier, filter)
+	}
+
+	return &data.TokenTransfersPage{}, nil
+}
@@ -17,6 +17,7 @@ type TransactionProcessorStub struct {
 	SimulateTransactionCalled                   func(tx *data.Transaction, checkSignature bool) (*data.GenericAPIResponse, error)
 	SendUserFundsCalled                         func(receiver string, value *big.Int) error
 	TransactionCostRequestCalled                func(tx *data.Transaction) (*data.TxCostResponseData, error)
+	GetTransactionFeeEstimateCalled             func(tx *data.Transaction) (*data.TransactionFeeEstimate, error)
 	GetTransactionStatusCalled                  func(txHash string, sender string) (string, error)
 	GetProcessedTransactionStatusCalled         func(txHash string) (string, error)
 	GetTransactionCalled                        func(txHash string, withEvents bool) (*transaction.ApiTransactionResult, error)
@@ -119,6 +120,15 @@ func (tps *TransactionProcessorStub) TransactionCostRequest(tx *data.Transaction
 	return nil, errNotImplemented
 }
 
+// GetTransactionFeeEstimate -
+func (tps *TransactionProcessorStub) GetTransactionFeeEstimate(tx *data.Transaction) (*data.TransactionFeeEstimate, error) {
+	if tps.GetTransactionFeeEstimateCalled != nil {
+		return tps.GetTransactionFeeEstimateCalled(tx)
+	}
+
+	return nil, errNotImplemented
+}
+
 // GetTransactionsPool -
 func (tps *TransactionProcessorStub) GetTransactionsPool(fields string) (*data.TransactionsPool, error) {
 	if tps.GetTransactionsPoolCalled != nil {
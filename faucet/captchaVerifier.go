@@ -0,0 +1,87 @@
+package faucet
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const captchaVerifyTimeout = 10 * time.Second
+
+type captchaVerifyResponse struct {
+	Success    bool     `json:"success"`
+	ErrorCodes []string `json:"error-codes"`
+}
+
+type captchaVerifier struct {
+	verifyURL  string
+	secret     string
+	httpClient *http.Client
+}
+
+// NewCaptchaVerifier returns a challenge verifier that validates the captcha tokens against the provider's verification
+// endpoint. The siteverify protocol is used, which is implemented by reCAPTCHA, hCaptcha and Turnstile
+func NewCaptchaVerifier(verifyURL string, secret string) (*captchaVerifier, error) {
+	if len(verifyURL) == 0 {
+		return nil, ErrEmptyCaptchaVerifyURL
+	}
+	if len(secret) == 0 {
+		return nil, ErrEmptyCaptchaSecret
+	}
+
+	return &captchaVerifier{
+		verifyURL: verifyURL,
+		secret:    secret,
+		httpClient: &http.Client{
+			Timeout: captchaVerifyTimeout,
+		},
+	}, nil
+}
+
+// Verify checks the captcha token against the provider's verification endpoint
+func (verifier *captchaVerifier) Verify(_ string, token string, clientIP string) error {
+	if len(token) == 0 {
+		return ErrMissingChallengeToken
+	}
+
+	form := url.Values{}
+	form.Set("secret", verifier.secret)
+	form.Set("response", token)
+	if len(clientIP) > 0 {
+		form.Set("remoteip", clientIP)
+	}
+
+	resp, err := verifier.httpClient.Post(verifier.verifyURL, "application/x-www-form-urlencoded", strings.NewReader(form.Encode()))
+	if err != nil {
+		return fmt.Errorf("captcha verification: %w", err)
+	}
+	defer func() {
+		errNotCritical := resp.Body.Close()
+		if errNotCritical != nil {
+			log.Warn("captcha verifier: close body", "error", errNotCritical.Error())
+		}
+	}()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("captcha verification: unexpected status code %d", resp.StatusCode)
+	}
+
+	response := &captchaVerifyResponse{}
+	err = json.NewDecoder(resp.Body).Decode(response)
+	if err != nil {
+		return fmt.Errorf("captcha verification: %w", err)
+	}
+	if !response.Success {
+		return fmt.Errorf("%w: %s", ErrInvalidChallengeToken, strings.Join(response.ErrorCodes, ", "))
+	}
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (verifier *captchaVerifier) IsInterfaceNil() bool {
+	return verifier == nil
+}
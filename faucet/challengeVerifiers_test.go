@@ -0,0 +1,118 @@
+package faucet_test
+
+import (
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/multiversx/mx-chain-proxy-go/faucet"
+	"github.com/stretchr/testify/require"
+)
+
+// findPoWToken returns a token that satisfies a difficulty of 8 bits exactly for the challenges accepted by the provided function
+func findPoWToken(receiver string, isValidForChallenge func(challenge int64) bool, challenges ...int64) string {
+	for i := 0; ; i++ {
+		token := fmt.Sprintf("%d", i)
+		isWanted := true
+		for _, challenge := range challenges {
+			hash := sha256.Sum256([]byte(fmt.Sprintf("%s:%d:%s", receiver, challenge, token)))
+			isValid := hash[0] == 0
+			if isValid != isValidForChallenge(challenge) {
+				isWanted = false
+				break
+			}
+		}
+		if isWanted {
+			return token
+		}
+	}
+}
+
+func TestProofOfWorkVerifier(t *testing.T) {
+	t.Parallel()
+
+	window := time.Hour
+	verifier, err := faucet.NewProofOfWorkVerifier(0, window)
+	require.Nil(t, verifier)
+	require.Equal(t, faucet.ErrInvalidPoWDifficulty, err)
+
+	verifier, err = faucet.NewProofOfWorkVerifier(65, window)
+	require.Nil(t, verifier)
+	require.Equal(t, faucet.ErrInvalidPoWDifficulty, err)
+
+	verifier, err = faucet.NewProofOfWorkVerifier(8, time.Millisecond)
+	require.Nil(t, verifier)
+	require.Equal(t, faucet.ErrInvalidPoWWindow, err)
+
+	verifier, err = faucet.NewProofOfWorkVerifier(8, window)
+	require.Nil(t, err)
+	require.False(t, verifier.IsInterfaceNil())
+
+	receiver := "erd1receiver"
+	require.Equal(t, faucet.ErrMissingChallengeToken, verifier.Verify(receiver, "", ""))
+
+	challenge := time.Now().Unix() / int64(window/time.Second)
+	isCurrent := func(c int64) bool { return c == challenge }
+	isStale := func(c int64) bool { return c == challenge-2 }
+	isNone := func(c int64) bool { return false }
+	candidateChallenges := []int64{challenge - 2, challenge - 1, challenge, challenge + 1}
+
+	validToken := findPoWToken(receiver, isCurrent, candidateChallenges...)
+	staleToken := findPoWToken(receiver, isStale, candidateChallenges...)
+	invalidToken := findPoWToken(receiver, isNone, candidateChallenges...)
+
+	require.Nil(t, verifier.Verify(receiver, validToken, ""))
+
+	err = verifier.Verify(receiver, validToken, "")
+	require.Equal(t, faucet.ErrChallengeTokenAlreadyUsed, err)
+	require.True(t, errors.Is(err, data.ErrFaucetRequestRejected))
+
+	err = verifier.Verify(receiver, staleToken, "")
+	require.Equal(t, faucet.ErrInvalidChallengeToken, err)
+
+	err = verifier.Verify(receiver, invalidToken, "")
+	require.Equal(t, faucet.ErrInvalidChallengeToken, err)
+	require.True(t, errors.Is(err, data.ErrFaucetRequestRejected))
+}
+
+func TestCaptchaVerifier(t *testing.T) {
+	t.Parallel()
+
+	verifier, err := faucet.NewCaptchaVerifier("", "secret")
+	require.Nil(t, verifier)
+	require.Equal(t, faucet.ErrEmptyCaptchaVerifyURL, err)
+
+	verifier, err = faucet.NewCaptchaVerifier("http://localhost", "")
+	require.Nil(t, verifier)
+	require.Equal(t, faucet.ErrEmptyCaptchaSecret, err)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Nil(t, r.ParseForm())
+		require.Equal(t, "secret", r.PostForm.Get("secret"))
+		require.Equal(t, "10.0.0.1", r.PostForm.Get("remoteip"))
+
+		if r.PostForm.Get("response") == "valid" {
+			_, _ = w.Write([]byte(`{"success": true}`))
+			return
+		}
+
+		_, _ = w.Write([]byte(`{"success": false, "error-codes": ["invalid-input-response"]}`))
+	}))
+	defer server.Close()
+
+	verifier, err = faucet.NewCaptchaVerifier(server.URL, "secret")
+	require.Nil(t, err)
+	require.False(t, verifier.IsInterfaceNil())
+
+	require.Equal(t, faucet.ErrMissingChallengeToken, verifier.Verify("erd1receiver", "", "10.0.0.1"))
+	require.Nil(t, verifier.Verify("erd1receiver", "valid", "10.0.0.1"))
+
+	err = verifier.Verify("erd1receiver", "invalid", "10.0.0.1")
+	require.True(t, errors.Is(err, faucet.ErrInvalidChallengeToken))
+	require.Contains(t, err.Error(), "invalid-input-response")
+}
@@ -1,6 +1,11 @@
 package faucet
 
-import "errors"
+import (
+	"errors"
+	"fmt"
+
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
 
 // ErrNilShardCoordinator signals that the provided shard coordinator is nil
 var ErrNilShardCoordinator = errors.New("nil shard coordinator")
@@ -10,3 +15,57 @@ var ErrFaucetPemFileDoesNotExist = errors.New("faucet pem file does not exist")
 
 // ErrNilPubKeyConverter signals that the provided pub key converter is nil
 var ErrNilPubKeyConverter = errors.New("nil pub key converter")
+
+// ErrNilChallengeVerifier signals that the provided challenge verifier is nil
+var ErrNilChallengeVerifier = errors.New("nil challenge verifier")
+
+// ErrNilDisbursementsLedger signals that the provided disbursements ledger is nil
+var ErrNilDisbursementsLedger = errors.New("nil disbursements ledger")
+
+// ErrEmptyLedgerPath signals that an empty disbursements ledger path has been provided
+var ErrEmptyLedgerPath = errors.New("empty disbursements ledger path")
+
+// ErrInvalidCooldown signals that an invalid cooldown has been provided
+var ErrInvalidCooldown = errors.New("invalid cooldown")
+
+// ErrInvalidMaxValue signals that an invalid maximum value has been provided
+var ErrInvalidMaxValue = errors.New("invalid maximum value")
+
+// ErrInvalidPoWDifficulty signals that an invalid proof of work difficulty has been provided
+var ErrInvalidPoWDifficulty = errors.New("invalid proof of work difficulty")
+
+// ErrInvalidPoWWindow signals that an invalid proof of work challenge window has been provided
+var ErrInvalidPoWWindow = errors.New("invalid proof of work challenge window")
+
+// ErrEmptyCaptchaVerifyURL signals that an empty captcha verification URL has been provided
+var ErrEmptyCaptchaVerifyURL = errors.New("empty captcha verification URL")
+
+// ErrEmptyCaptchaSecret signals that an empty captcha secret has been provided
+var ErrEmptyCaptchaSecret = errors.New("empty captcha secret")
+
+// ErrInvalidValue signals that an invalid value has been requested
+var ErrInvalidValue = fmt.Errorf("%w: invalid value", data.ErrFaucetRequestRejected)
+
+// ErrValueAboveMaximum signals that the requested value is above the maximum value allowed per request
+var ErrValueAboveMaximum = fmt.Errorf("%w: value above the maximum allowed per request", data.ErrFaucetRequestRejected)
+
+// ErrMissingChallengeToken signals that the challenge token is missing
+var ErrMissingChallengeToken = fmt.Errorf("%w: missing challenge token", data.ErrFaucetRequestRejected)
+
+// ErrInvalidChallengeToken signals that the challenge token is not valid
+var ErrInvalidChallengeToken = fmt.Errorf("%w: invalid challenge token", data.ErrFaucetRequestRejected)
+
+// ErrChallengeTokenAlreadyUsed signals that the challenge token was already used
+var ErrChallengeTokenAlreadyUsed = fmt.Errorf("%w: challenge token already used", data.ErrFaucetRequestRejected)
+
+// ErrReceiverInCooldown signals that the receiver was funded too recently
+var ErrReceiverInCooldown = fmt.Errorf("%w: receiver funded too recently", data.ErrFaucetRateLimited)
+
+// ErrClientIPInCooldown signals that the client IP requested funds too recently
+var ErrClientIPInCooldown = fmt.Errorf("%w: too many requests from the same IP address", data.ErrFaucetRateLimited)
+
+// ErrDailyLimitExceeded signals that the requested value would exceed the daily limit
+var ErrDailyLimitExceeded = fmt.Errorf("%w: daily limit exceeded", data.ErrFaucetRateLimited)
+
+// ErrUnknownChallengeType signals that an unknown faucet challenge type was configured
+var ErrUnknownChallengeType = errors.New("unknown faucet challenge type")
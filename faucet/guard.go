@@ -0,0 +1,300 @@
+package faucet
+
+import (
+	"fmt"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	logger "github.com/multiversx/mx-chain-logger-go"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+const dailyLimitWindow = 24 * time.Hour
+
+var log = logger.GetOrCreate("faucet")
+
+// ArgsGuard holds the arguments needed to create a new faucet guard
+type ArgsGuard struct {
+	Ledger             DisbursementsLedger
+	ChallengeVerifier  ChallengeVerifier
+	ReceiverCooldown   time.Duration
+	ClientIPCooldown   time.Duration
+	MaxValuePerRequest *big.Int
+	MaxValuePerDay     *big.Int
+}
+
+type recentDisbursement struct {
+	disbursement *data.FaucetDisbursement
+	value        *big.Int
+	timestamp    time.Time
+}
+
+// guard enforces the faucet's rules (challenge token, cooldowns, maximum values) and keeps the ledger of disbursements.
+// The disbursements of the last 24 hours are kept in memory and are reloaded from the ledger after a restart
+type guard struct {
+	ledger             DisbursementsLedger
+	challengeVerifier  ChallengeVerifier
+	receiverCooldown   time.Duration
+	clientIPCooldown   time.Duration
+	maxValuePerRequest *big.Int
+	maxValuePerDay     *big.Int
+	getTimeHandler     func() time.Time
+
+	mutRecent sync.Mutex
+	recent    []*recentDisbursement
+}
+
+// NewGuard creates a new faucet guard. A nil or zero maximum value means that the corresponding limit is not applied,
+// while a zero cooldown disables the corresponding cooldown
+func NewGuard(args ArgsGuard) (*guard, error) {
+	if check.IfNil(args.Ledger) {
+		return nil, ErrNilDisbursementsLedger
+	}
+	if check.IfNil(args.ChallengeVerifier) {
+		return nil, ErrNilChallengeVerifier
+	}
+	if args.ReceiverCooldown < 0 || args.ReceiverCooldown > dailyLimitWindow {
+		return nil, fmt.Errorf("%w for receivers", ErrInvalidCooldown)
+	}
+	if args.ClientIPCooldown < 0 || args.ClientIPCooldown > dailyLimitWindow {
+		return nil, fmt.Errorf("%w for client IPs", ErrInvalidCooldown)
+	}
+	if args.MaxValuePerRequest != nil && args.MaxValuePerRequest.Sign() < 0 {
+		return nil, fmt.Errorf("%w per request", ErrInvalidMaxValue)
+	}
+	if args.MaxValuePerDay != nil && args.MaxValuePerDay.Sign() < 0 {
+		return nil, fmt.Errorf("%w per day", ErrInvalidMaxValue)
+	}
+
+	g := &guard{
+		ledger:             args.Ledger,
+		challengeVerifier:  args.ChallengeVerifier,
+		receiverCooldown:   args.ReceiverCooldown,
+		clientIPCooldown:   args.ClientIPCooldown,
+		maxValuePerRequest: args.MaxValuePerRequest,
+		maxValuePerDay:     args.MaxValuePerDay,
+		getTimeHandler:     time.Now,
+		recent:             make([]*recentDisbursement, 0),
+	}
+
+	err := g.loadRecentDisbursements()
+	if err != nil {
+		return nil, err
+	}
+
+	return g, nil
+}
+
+func (g *guard) loadRecentDisbursements() error {
+	from := g.getTimeHandler().Add(-dailyLimitWindow).Unix()
+	disbursements, err := g.ledger.GetDisbursements(data.FaucetDisbursementsFilter{
+		From:  from,
+		Limit: maxDisbursementsLimit,
+	})
+	if err != nil {
+		return err
+	}
+
+	// the ledger returns the most recent disbursements first
+	for i := len(disbursements) - 1; i >= 0; i-- {
+		value, ok := big.NewInt(0).SetString(disbursements[i].Value, 10)
+		if !ok {
+			log.Warn("faucet guard: invalid value in ledger", "receiver", disbursements[i].Receiver, "value", disbursements[i].Value)
+			continue
+		}
+
+		g.recent = append(g.recent, &recentDisbursement{
+			disbursement: disbursements[i],
+			value:        value,
+			timestamp:    time.Unix(disbursements[i].Timestamp, 0),
+		})
+	}
+
+	log.Debug("faucet guard: loaded the recent disbursements", "num disbursements", len(g.recent))
+
+	return nil
+}
+
+// Reserve checks the request against the faucet's rules and, if it complies, reserves the value and the tokens for the
+// receiver until the disbursement is either confirmed or canceled. A zero value is accepted for the requests that do
+// not transfer EGLD, which are only subject to the challenge, to the cooldowns and to the tokens' daily limits
+func (g *guard) Reserve(
+	receiver string,
+	clientIP string,
+	value *big.Int,
+	tokens []*data.FaucetToken,
+	challengeToken string,
+) (*data.FaucetDisbursement, error) {
+	if value == nil || value.Sign() < 0 {
+		return nil, ErrInvalidValue
+	}
+	if isLimitSet(g.maxValuePerRequest) && value.Cmp(g.maxValuePerRequest) > 0 {
+		return nil, fmt.Errorf("%w (%s)", ErrValueAboveMaximum, g.maxValuePerRequest.String())
+	}
+
+	err := g.challengeVerifier.Verify(receiver, challengeToken, clientIP)
+	if err != nil {
+		return nil, err
+	}
+
+	g.mutRecent.Lock()
+	defer g.mutRecent.Unlock()
+
+	now := g.getTimeHandler()
+	g.removeExpiredUnprotected(now)
+
+	receiverTotal := big.NewInt(0).Set(value)
+	clientIPTotal := big.NewInt(0).Set(value)
+	receiverTokensTotals := newLimitedTokensTotals(tokens)
+	clientIPTokensTotals := newLimitedTokensTotals(tokens)
+	for _, recent := range g.recent {
+		isSameReceiver := recent.disbursement.Receiver == receiver
+		isSameClientIP := len(clientIP) > 0 && recent.disbursement.ClientIP == clientIP
+		elapsed := now.Sub(recent.timestamp)
+
+		if isSameReceiver {
+			if elapsed < g.receiverCooldown {
+				return nil, fmt.Errorf("%w, retry in %s", ErrReceiverInCooldown, (g.receiverCooldown - elapsed).Round(time.Second))
+			}
+			receiverTotal.Add(receiverTotal, recent.value)
+			addTokensAmounts(receiverTokensTotals, recent.disbursement.Tokens)
+		}
+		if isSameClientIP {
+			if elapsed < g.clientIPCooldown {
+				return nil, fmt.Errorf("%w, retry in %s", ErrClientIPInCooldown, (g.clientIPCooldown - elapsed).Round(time.Second))
+			}
+			clientIPTotal.Add(clientIPTotal, recent.value)
+			addTokensAmounts(clientIPTokensTotals, recent.disbursement.Tokens)
+		}
+	}
+
+	if isLimitSet(g.maxValuePerDay) {
+		if receiverTotal.Cmp(g.maxValuePerDay) > 0 || clientIPTotal.Cmp(g.maxValuePerDay) > 0 {
+			return nil, fmt.Errorf("%w (%s)", ErrDailyLimitExceeded, g.maxValuePerDay.String())
+		}
+	}
+
+	err = checkTokensDailyLimits(tokens, receiverTokensTotals, clientIPTokensTotals)
+	if err != nil {
+		return nil, err
+	}
+
+	disbursement := &data.FaucetDisbursement{
+		Timestamp: now.Unix(),
+		Receiver:  receiver,
+		ClientIP:  clientIP,
+		Value:     value.String(),
+		Tokens:    tokens,
+	}
+	g.recent = append(g.recent, &recentDisbursement{
+		disbursement: disbursement,
+		value:        big.NewInt(0).Set(value),
+		timestamp:    now,
+	})
+
+	return disbursement, nil
+}
+
+// Confirm saves the reserved disbursement in the ledger
+func (g *guard) Confirm(disbursement *data.FaucetDisbursement) error {
+	return g.ledger.Put(disbursement)
+}
+
+// Cancel releases the value reserved for the disbursement
+func (g *guard) Cancel(disbursement *data.FaucetDisbursement) {
+	g.mutRecent.Lock()
+	defer g.mutRecent.Unlock()
+
+	for i, recent := range g.recent {
+		if recent.disbursement == disbursement {
+			g.recent = append(g.recent[:i], g.recent[i+1:]...)
+			return
+		}
+	}
+}
+
+// GetDisbursements returns the disbursements from the ledger matching the provided filter
+func (g *guard) GetDisbursements(filter data.FaucetDisbursementsFilter) ([]*data.FaucetDisbursement, error) {
+	return g.ledger.GetDisbursements(filter)
+}
+
+func (g *guard) removeExpiredUnprotected(now time.Time) {
+	numExpired := 0
+	for _, recent := range g.recent {
+		if now.Sub(recent.timestamp) < dailyLimitWindow {
+			break
+		}
+		numExpired++
+	}
+
+	g.recent = g.recent[numExpired:]
+}
+
+// newLimitedTokensTotals returns the totals of the requested tokens having a daily limit, starting from the requested
+// amounts
+func newLimitedTokensTotals(tokens []*data.FaucetToken) map[string]*big.Int {
+	totals := make(map[string]*big.Int)
+	for _, token := range tokens {
+		if len(token.MaxAmountPerDay) == 0 {
+			continue
+		}
+
+		totals[token.Identifier] = big.NewInt(0)
+	}
+	addTokensAmounts(totals, tokens)
+
+	return totals
+}
+
+// addTokensAmounts adds the amounts of the disbursed tokens to the totals of the limited tokens
+func addTokensAmounts(totals map[string]*big.Int, tokens []*data.FaucetToken) {
+	for _, token := range tokens {
+		total, isLimited := totals[token.Identifier]
+		if !isLimited {
+			continue
+		}
+
+		amount, ok := big.NewInt(0).SetString(token.Amount, 10)
+		if !ok {
+			log.Warn("faucet guard: invalid token amount", "token", token.Identifier, "amount", token.Amount)
+			continue
+		}
+
+		total.Add(total, amount)
+	}
+}
+
+func checkTokensDailyLimits(tokens []*data.FaucetToken, receiverTotals map[string]*big.Int, clientIPTotals map[string]*big.Int) error {
+	for _, token := range tokens {
+		if len(token.MaxAmountPerDay) == 0 {
+			continue
+		}
+
+		maxAmountPerDay, ok := big.NewInt(0).SetString(token.MaxAmountPerDay, 10)
+		if !ok {
+			return fmt.Errorf("%w: %s for %s", ErrInvalidMaxValue, token.MaxAmountPerDay, token.Identifier)
+		}
+
+		if receiverTotals[token.Identifier].Cmp(maxAmountPerDay) > 0 || clientIPTotals[token.Identifier].Cmp(maxAmountPerDay) > 0 {
+			return fmt.Errorf("%w for %s (%s)", ErrDailyLimitExceeded, token.Identifier, token.MaxAmountPerDay)
+		}
+	}
+
+	return nil
+}
+
+func isLimitSet(limit *big.Int) bool {
+	return limit != nil && limit.Sign() > 0
+}
+
+// Close closes the ledger
+func (g *guard) Close() error {
+	return g.ledger.Close()
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (g *guard) IsInterfaceNil() bool {
+	return g == nil
+}
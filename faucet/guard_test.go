@@ -0,0 +1,240 @@
+package faucet
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/stretchr/testify/require"
+)
+
+func createTestGuard(t *testing.T, ledgerPath string) *guard {
+	ledger, err := NewLevelDBLedger(ledgerPath)
+	require.Nil(t, err)
+
+	g, err := NewGuard(ArgsGuard{
+		Ledger:             ledger,
+		ChallengeVerifier:  NewDisabledChallengeVerifier(),
+		ReceiverCooldown:   time.Hour,
+		ClientIPCooldown:   time.Minute,
+		MaxValuePerRequest: big.NewInt(100),
+		MaxValuePerDay:     big.NewInt(250),
+	})
+	require.Nil(t, err)
+
+	return g
+}
+
+func TestNewGuard(t *testing.T) {
+	t.Parallel()
+
+	ledger, err := NewLevelDBLedger(t.TempDir())
+	require.Nil(t, err)
+	defer func() {
+		_ = ledger.Close()
+	}()
+
+	validArgs := func() ArgsGuard {
+		return ArgsGuard{
+			Ledger:            ledger,
+			ChallengeVerifier: NewDisabledChallengeVerifier(),
+		}
+	}
+
+	args := validArgs()
+	args.Ledger = nil
+	g, err := NewGuard(args)
+	require.Nil(t, g)
+	require.Equal(t, ErrNilDisbursementsLedger, err)
+
+	args = validArgs()
+	args.ChallengeVerifier = nil
+	g, err = NewGuard(args)
+	require.Nil(t, g)
+	require.Equal(t, ErrNilChallengeVerifier, err)
+
+	args = validArgs()
+	args.ReceiverCooldown = 25 * time.Hour
+	g, err = NewGuard(args)
+	require.Nil(t, g)
+	require.True(t, errors.Is(err, ErrInvalidCooldown))
+
+	args = validArgs()
+	args.ClientIPCooldown = -time.Second
+	g, err = NewGuard(args)
+	require.Nil(t, g)
+	require.True(t, errors.Is(err, ErrInvalidCooldown))
+
+	args = validArgs()
+	args.MaxValuePerDay = big.NewInt(-1)
+	g, err = NewGuard(args)
+	require.Nil(t, g)
+	require.True(t, errors.Is(err, ErrInvalidMaxValue))
+
+	g, err = NewGuard(validArgs())
+	require.Nil(t, err)
+	require.False(t, g.IsInterfaceNil())
+}
+
+func TestGuard_Reserve(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid values should be rejected", func(t *testing.T) {
+		t.Parallel()
+
+		g := createTestGuard(t, t.TempDir())
+		defer func() {
+			_ = g.Close()
+		}()
+
+		_, err := g.Reserve("alice", "10.0.0.1", big.NewInt(-1), nil, "")
+		require.Equal(t, ErrInvalidValue, err)
+		require.True(t, errors.Is(err, data.ErrFaucetRequestRejected))
+
+		_, err = g.Reserve("alice", "10.0.0.1", big.NewInt(101), nil, "")
+		require.True(t, errors.Is(err, ErrValueAboveMaximum))
+	})
+
+	t.Run("challenge verifier error should be returned", func(t *testing.T) {
+		t.Parallel()
+
+		g := createTestGuard(t, t.TempDir())
+		defer func() {
+			_ = g.Close()
+		}()
+		g.challengeVerifier, _ = NewProofOfWorkVerifier(64, time.Hour)
+
+		_, err := g.Reserve("alice", "10.0.0.1", big.NewInt(10), nil, "")
+		require.Equal(t, ErrMissingChallengeToken, err)
+	})
+
+	t.Run("cooldowns and daily limit should apply", func(t *testing.T) {
+		t.Parallel()
+
+		g := createTestGuard(t, t.TempDir())
+		defer func() {
+			_ = g.Close()
+		}()
+		now := time.Unix(1700000000, 0)
+		g.getTimeHandler = func() time.Time {
+			return now
+		}
+
+		_, err := g.Reserve("alice", "10.0.0.1", big.NewInt(100), nil, "")
+		require.Nil(t, err)
+
+		_, err = g.Reserve("alice", "10.0.0.2", big.NewInt(100), nil, "")
+		require.True(t, errors.Is(err, ErrReceiverInCooldown))
+		require.True(t, errors.Is(err, data.ErrFaucetRateLimited))
+
+		_, err = g.Reserve("bob", "10.0.0.1", big.NewInt(100), nil, "")
+		require.True(t, errors.Is(err, ErrClientIPInCooldown))
+
+		now = now.Add(2 * time.Hour)
+		_, err = g.Reserve("alice", "10.0.0.1", big.NewInt(100), nil, "")
+		require.Nil(t, err)
+
+		now = now.Add(2 * time.Hour)
+		_, err = g.Reserve("alice", "10.0.0.1", big.NewInt(100), nil, "")
+		require.True(t, errors.Is(err, ErrDailyLimitExceeded))
+
+		_, err = g.Reserve("alice", "10.0.0.1", big.NewInt(50), nil, "")
+		require.Nil(t, err)
+
+		now = now.Add(21 * time.Hour)
+		_, err = g.Reserve("alice", "10.0.0.1", big.NewInt(100), nil, "")
+		require.Nil(t, err)
+	})
+
+	t.Run("canceled reservations should be released", func(t *testing.T) {
+		t.Parallel()
+
+		g := createTestGuard(t, t.TempDir())
+		defer func() {
+			_ = g.Close()
+		}()
+
+		disbursement, err := g.Reserve("alice", "10.0.0.1", big.NewInt(100), nil, "")
+		require.Nil(t, err)
+
+		g.Cancel(disbursement)
+
+		_, err = g.Reserve("alice", "10.0.0.1", big.NewInt(100), nil, "")
+		require.Nil(t, err)
+	})
+}
+
+func TestGuard_ReserveTokens(t *testing.T) {
+	t.Parallel()
+
+	g := createTestGuard(t, t.TempDir())
+	defer func() {
+		_ = g.Close()
+	}()
+	g.receiverCooldown = 0
+	g.clientIPCooldown = 0
+	now := time.Unix(1700000000, 0)
+	g.getTimeHandler = func() time.Time {
+		return now
+	}
+
+	limitedToken := &data.FaucetToken{Identifier: "TST-abcdef", Amount: "40", MaxAmountPerDay: "100"}
+	unlimitedToken := &data.FaucetToken{Identifier: "SFT-abcdef-01", Amount: "10"}
+
+	disbursement, err := g.Reserve("alice", "10.0.0.1", big.NewInt(0), []*data.FaucetToken{limitedToken, unlimitedToken}, "")
+	require.Nil(t, err)
+	require.Equal(t, "0", disbursement.Value)
+	require.Equal(t, []*data.FaucetToken{limitedToken, unlimitedToken}, disbursement.Tokens)
+
+	_, err = g.Reserve("alice", "10.0.0.2", big.NewInt(0), []*data.FaucetToken{limitedToken}, "")
+	require.Nil(t, err)
+
+	_, err = g.Reserve("alice", "10.0.0.3", big.NewInt(0), []*data.FaucetToken{limitedToken}, "")
+	require.True(t, errors.Is(err, ErrDailyLimitExceeded))
+	require.True(t, errors.Is(err, data.ErrFaucetRateLimited))
+
+	_, err = g.Reserve("bob", "10.0.0.1", big.NewInt(0), []*data.FaucetToken{limitedToken}, "")
+	require.Nil(t, err)
+	_, err = g.Reserve("carol", "10.0.0.1", big.NewInt(0), []*data.FaucetToken{limitedToken}, "")
+	require.True(t, errors.Is(err, ErrDailyLimitExceeded))
+
+	_, err = g.Reserve("alice", "10.0.0.3", big.NewInt(0), []*data.FaucetToken{unlimitedToken}, "")
+	require.Nil(t, err)
+
+	g.Cancel(disbursement)
+	_, err = g.Reserve("alice", "10.0.0.3", big.NewInt(0), []*data.FaucetToken{limitedToken}, "")
+	require.Nil(t, err)
+
+	now = now.Add(dailyLimitWindow)
+	_, err = g.Reserve("carol", "10.0.0.1", big.NewInt(0), []*data.FaucetToken{limitedToken}, "")
+	require.Nil(t, err)
+}
+
+func TestGuard_ConfirmedDisbursementsShouldSurviveRestarts(t *testing.T) {
+	t.Parallel()
+
+	ledgerPath := t.TempDir()
+	g := createTestGuard(t, ledgerPath)
+
+	disbursement, err := g.Reserve("alice", "10.0.0.1", big.NewInt(100), nil, "")
+	require.Nil(t, err)
+	disbursement.Sender = "faucet"
+	disbursement.TxHash = "hash"
+	err = g.Confirm(disbursement)
+	require.Nil(t, err)
+	require.Nil(t, g.Close())
+
+	g = createTestGuard(t, ledgerPath)
+	defer func() {
+		_ = g.Close()
+	}()
+
+	_, err = g.Reserve("alice", "10.0.0.2", big.NewInt(100), nil, "")
+	require.True(t, errors.Is(err, ErrReceiverInCooldown))
+
+	disbursements, err := g.GetDisbursements(data.FaucetDisbursementsFilter{Receiver: "alice"})
+	require.Nil(t, err)
+	require.Equal(t, []*data.FaucetDisbursement{disbursement}, disbursements)
+}
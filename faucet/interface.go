@@ -0,0 +1,17 @@
+package faucet
+
+import "github.com/multiversx/mx-chain-proxy-go/data"
+
+// ChallengeVerifier defines what a component able to verify the proof of work or captcha tokens should do
+type ChallengeVerifier interface {
+	Verify(receiver string, token string, clientIP string) error
+	IsInterfaceNil() bool
+}
+
+// DisbursementsLedger defines what a component able to persist the faucet disbursements should do
+type DisbursementsLedger interface {
+	Put(disbursement *data.FaucetDisbursement) error
+	GetDisbursements(filter data.FaucetDisbursementsFilter) ([]*data.FaucetDisbursement, error)
+	Close() error
+	IsInterfaceNil() bool
+}
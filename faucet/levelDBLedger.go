@@ -0,0 +1,122 @@
+package faucet
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"math"
+	"time"
+
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/syndtr/goleveldb/leveldb"
+	"github.com/syndtr/goleveldb/leveldb/opt"
+	"github.com/syndtr/goleveldb/leveldb/util"
+)
+
+const (
+	defaultDisbursementsLimit = 100
+	maxDisbursementsLimit     = 1000
+	timestampKeyLength        = 8
+)
+
+// levelDBLedger is a DisbursementsLedger backed by an embedded LevelDB database. The disbursements are stored under
+// keys prefixed by their big endian timestamp, so they can be iterated in chronological order
+type levelDBLedger struct {
+	db *leveldb.DB
+}
+
+// NewLevelDBLedger opens (or creates) the LevelDB database found at the provided path
+func NewLevelDBLedger(path string) (*levelDBLedger, error) {
+	if len(path) == 0 {
+		return nil, ErrEmptyLedgerPath
+	}
+
+	db, err := leveldb.OpenFile(path, &opt.Options{})
+	if err != nil {
+		return nil, err
+	}
+
+	return &levelDBLedger{
+		db: db,
+	}, nil
+}
+
+// Put saves the disbursement in the ledger
+func (ledger *levelDBLedger) Put(disbursement *data.FaucetDisbursement) error {
+	value, err := json.Marshal(disbursement)
+	if err != nil {
+		return err
+	}
+
+	key := make([]byte, timestampKeyLength, timestampKeyLength+len(disbursement.Receiver)+8)
+	binary.BigEndian.PutUint64(key, uint64(disbursement.Timestamp))
+	key = append(key, disbursement.Receiver...)
+	// disambiguate the disbursements made to the same receiver in the same second
+	suffix := make([]byte, 8)
+	binary.BigEndian.PutUint64(suffix, uint64(time.Now().UnixNano()))
+	key = append(key, suffix...)
+
+	return ledger.db.Put(key, value, &opt.WriteOptions{Sync: true})
+}
+
+// GetDisbursements returns the disbursements matching the filter, the most recent ones first
+func (ledger *levelDBLedger) GetDisbursements(filter data.FaucetDisbursementsFilter) ([]*data.FaucetDisbursement, error) {
+	limit := filter.Limit
+	if limit <= 0 {
+		limit = defaultDisbursementsLimit
+	}
+	if limit > maxDisbursementsLimit {
+		limit = maxDisbursementsLimit
+	}
+
+	keysRange := &util.Range{
+		Start: timestampKey(filter.From),
+		Limit: timestampKey(math.MaxInt64),
+	}
+	if filter.To > 0 {
+		keysRange.Limit = timestampKey(filter.To + 1)
+	}
+
+	iterator := ledger.db.NewIterator(keysRange, nil)
+	defer iterator.Release()
+
+	disbursements := make([]*data.FaucetDisbursement, 0)
+	for ok := iterator.Last(); ok && len(disbursements) < limit; ok = iterator.Prev() {
+		disbursement := &data.FaucetDisbursement{}
+		err := json.Unmarshal(iterator.Value(), disbursement)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(filter.Receiver) > 0 && disbursement.Receiver != filter.Receiver {
+			continue
+		}
+		if len(filter.ClientIP) > 0 && disbursement.ClientIP != filter.ClientIP {
+			continue
+		}
+
+		disbursements = append(disbursements, disbursement)
+	}
+
+	return disbursements, iterator.Error()
+}
+
+func timestampKey(timestamp int64) []byte {
+	if timestamp < 0 {
+		timestamp = 0
+	}
+
+	key := make([]byte, timestampKeyLength)
+	binary.BigEndian.PutUint64(key, uint64(timestamp))
+
+	return key
+}
+
+// Close will close the underlying database
+func (ledger *levelDBLedger) Close() error {
+	return ledger.db.Close()
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ledger *levelDBLedger) IsInterfaceNil() bool {
+	return ledger == nil
+}
@@ -0,0 +1,61 @@
+package faucet_test
+
+import (
+	"testing"
+
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/multiversx/mx-chain-proxy-go/faucet"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewLevelDBLedger_EmptyPathShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ledger, err := faucet.NewLevelDBLedger("")
+	require.Nil(t, ledger)
+	require.Equal(t, faucet.ErrEmptyLedgerPath, err)
+}
+
+func TestLevelDBLedger_GetDisbursements(t *testing.T) {
+	t.Parallel()
+
+	ledger, err := faucet.NewLevelDBLedger(t.TempDir())
+	require.Nil(t, err)
+	defer func() {
+		_ = ledger.Close()
+	}()
+
+	disbursements := []*data.FaucetDisbursement{
+		{Timestamp: 100, Receiver: "alice", ClientIP: "10.0.0.1", Value: "1"},
+		{Timestamp: 200, Receiver: "bob", ClientIP: "10.0.0.1", Value: "2"},
+		{Timestamp: 200, Receiver: "alice", ClientIP: "10.0.0.2", Value: "3"},
+		{Timestamp: 300, Receiver: "carol", ClientIP: "10.0.0.3", Value: "4"},
+	}
+	for _, disbursement := range disbursements {
+		require.Nil(t, ledger.Put(disbursement))
+	}
+
+	results, err := ledger.GetDisbursements(data.FaucetDisbursementsFilter{})
+	require.Nil(t, err)
+	require.Len(t, results, 4)
+	require.Equal(t, disbursements[3], results[0])
+	require.Equal(t, disbursements[0], results[3])
+
+	results, err = ledger.GetDisbursements(data.FaucetDisbursementsFilter{Receiver: "alice"})
+	require.Nil(t, err)
+	require.Equal(t, []*data.FaucetDisbursement{disbursements[2], disbursements[0]}, results)
+
+	results, err = ledger.GetDisbursements(data.FaucetDisbursementsFilter{ClientIP: "10.0.0.1"})
+	require.Nil(t, err)
+	require.Equal(t, []*data.FaucetDisbursement{disbursements[1], disbursements[0]}, results)
+
+	results, err = ledger.GetDisbursements(data.FaucetDisbursementsFilter{From: 150, To: 250})
+	require.Nil(t, err)
+	require.Len(t, results, 2)
+	require.Equal(t, int64(200), results[0].Timestamp)
+	require.Equal(t, int64(200), results[1].Timestamp)
+
+	results, err = ledger.GetDisbursements(data.FaucetDisbursementsFilter{Limit: 1})
+	require.Nil(t, err)
+	require.Equal(t, []*data.FaucetDisbursement{disbursements[3]}, results)
+}
@@ -0,0 +1,97 @@
+package faucet
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"math/bits"
+	"sync"
+	"time"
+)
+
+const maxPoWDifficulty = 64
+
+type proofOfWorkVerifier struct {
+	difficulty     int
+	window         time.Duration
+	getTimeHandler func() time.Time
+
+	mutUsedTokens sync.Mutex
+	usedTokens    map[string]time.Time
+}
+
+// NewProofOfWorkVerifier returns a challenge verifier that requires the client to find a token such that the sha256 hash
+// of "<receiver>:<challenge>:<token>" starts with the provided number of zero bits. The challenge is the index of the
+// current time window (the unix timestamp divided by the window duration in seconds), the previous window's challenge
+// being accepted as well. Each token is accepted only once for the same receiver
+func NewProofOfWorkVerifier(difficulty int, window time.Duration) (*proofOfWorkVerifier, error) {
+	if difficulty <= 0 || difficulty > maxPoWDifficulty {
+		return nil, ErrInvalidPoWDifficulty
+	}
+	if window < time.Second {
+		return nil, ErrInvalidPoWWindow
+	}
+
+	return &proofOfWorkVerifier{
+		difficulty:     difficulty,
+		window:         window,
+		getTimeHandler: time.Now,
+		usedTokens:     make(map[string]time.Time),
+	}, nil
+}
+
+// Verify checks that the token is a valid proof of work for the receiver and the current or the previous challenge,
+// and that it was not already used
+func (verifier *proofOfWorkVerifier) Verify(receiver string, token string, _ string) error {
+	if len(token) == 0 {
+		return ErrMissingChallengeToken
+	}
+
+	now := verifier.getTimeHandler()
+	challenge := now.Unix() / int64(verifier.window/time.Second)
+	if !verifier.isValidProof(receiver, challenge, token) && !verifier.isValidProof(receiver, challenge-1, token) {
+		return ErrInvalidChallengeToken
+	}
+
+	verifier.mutUsedTokens.Lock()
+	defer verifier.mutUsedTokens.Unlock()
+
+	// a token can only be valid for two consecutive windows, so it is not remembered longer
+	for usedToken, expiry := range verifier.usedTokens {
+		if !now.Before(expiry) {
+			delete(verifier.usedTokens, usedToken)
+		}
+	}
+
+	key := receiver + ":" + token
+	_, isUsed := verifier.usedTokens[key]
+	if isUsed {
+		return ErrChallengeTokenAlreadyUsed
+	}
+	verifier.usedTokens[key] = now.Add(2 * verifier.window)
+
+	return nil
+}
+
+func (verifier *proofOfWorkVerifier) isValidProof(receiver string, challenge int64, token string) bool {
+	hash := sha256.Sum256([]byte(fmt.Sprintf("%s:%d:%s", receiver, challenge, token)))
+
+	return countLeadingZeroBits(hash[:]) >= verifier.difficulty
+}
+
+func countLeadingZeroBits(buff []byte) int {
+	numZeroBits := 0
+	for _, b := range buff {
+		if b != 0 {
+			return numZeroBits + bits.LeadingZeros8(b)
+		}
+
+		numZeroBits += 8
+	}
+
+	return numZeroBits
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (verifier *proofOfWorkVerifier) IsInterfaceNil() bool {
+	return verifier == nil
+}
@@ -0,0 +1,63 @@
+package abi
+
+import (
+	"encoding/binary"
+	"fmt"
+)
+
+const lengthPrefixNumBytes = 4
+
+type bytesReader struct {
+	buff     []byte
+	position int
+}
+
+func newBytesReader(buff []byte) *bytesReader {
+	return &bytesReader{
+		buff: buff,
+	}
+}
+
+func (reader *bytesReader) read(numBytes int) ([]byte, error) {
+	if numBytes < 0 || reader.position+numBytes > len(reader.buff) {
+		return nil, fmt.Errorf("%w: needed %d bytes at position %d, have %d",
+			ErrNotEnoughData, numBytes, reader.position, len(reader.buff)-reader.position)
+	}
+
+	result := reader.buff[reader.position : reader.position+numBytes]
+	reader.position += numBytes
+
+	return result, nil
+}
+
+func (reader *bytesReader) readWithLength() ([]byte, error) {
+	lengthBytes, err := reader.read(lengthPrefixNumBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	return reader.read(int(binary.BigEndian.Uint32(lengthBytes)))
+}
+
+func (reader *bytesReader) remaining() int {
+	return len(reader.buff) - reader.position
+}
+
+func (reader *bytesReader) isEmpty() bool {
+	return reader.position >= len(reader.buff)
+}
+
+func (reader *bytesReader) checkEmpty() error {
+	if !reader.isEmpty() {
+		return fmt.Errorf("%w: %d bytes remaining", ErrUnexpectedData, len(reader.buff)-reader.position)
+	}
+
+	return nil
+}
+
+func encodeWithLength(buff []byte) []byte {
+	result := make([]byte, lengthPrefixNumBytes, lengthPrefixNumBytes+len(buff))
+	binary.BigEndian.PutUint32(result, uint32(len(buff)))
+
+	return append(result, buff...)
+}
@@ -0,0 +1,718 @@
+package abi
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+)
+
+const (
+	boolTypeName         = "bool"
+	addressTypeName      = "Address"
+	h256TypeName         = "H256"
+	codeMetadataTypeName = "CodeMetadata"
+
+	h256NumBytes         = 32
+	codeMetadataNumBytes = 2
+
+	// maxNestingDepth is the maximum number of nested values (items, fields, options) encoded or decoded, bounding the
+	// recursion for the deeply nested values and the recursive types
+	maxNestingDepth = 64
+
+	enumNameKey   = "name"
+	enumFieldsKey = "fields"
+)
+
+// stringTypes are encoded as buffers and represented as strings in JSON
+var stringTypes = map[string]struct{}{
+	"utf-8 string":              {},
+	"String":                    {},
+	"&str":                      {},
+	"TokenIdentifier":           {},
+	"EgldOrEsdtTokenIdentifier": {},
+}
+
+// bytesTypes are encoded as buffers and represented as hex strings in JSON
+var bytesTypes = map[string]struct{}{
+	"bytes":         {},
+	"ManagedBuffer": {},
+	"BoxedBytes":    {},
+}
+
+// codec is able to encode JSON values into the smart contracts serialization format and back, based on an ABI. The
+// numbers are accepted either as JSON numbers or as decimal strings, the addresses as bech32 strings and the raw bytes
+// as hex strings. The structs are represented as JSON objects and the enums either as the variant name or, for the
+// variants with fields, as {"name": "...", "fields": {...}}
+type codec struct {
+	pubKeyConverter core.PubkeyConverter
+}
+
+// NewCodec returns a new ABI codec
+func NewCodec(pubKeyConverter core.PubkeyConverter) (*codec, error) {
+	if check.IfNil(pubKeyConverter) {
+		return nil, ErrNilPubKeyConverter
+	}
+
+	return &codec{
+		pubKeyConverter: pubKeyConverter,
+	}, nil
+}
+
+func (c *codec) encodeTopLevel(definition *Definition, expression *typeExpression, value interface{}) ([]byte, error) {
+	if nt, isNumeric := numericTypes[expression.name]; isNumeric {
+		number, err := c.numberFromValue(nt, value)
+		if err != nil {
+			return nil, err
+		}
+
+		return nt.encodeTopLevel(number), nil
+	}
+
+	switch expression.name {
+	case boolTypeName:
+		boolValue, ok := value.(bool)
+		if !ok {
+			return nil, fmt.Errorf("%w: expected a bool, got %v", ErrInvalidValue, value)
+		}
+		if boolValue {
+			return []byte{1}, nil
+		}
+
+		return make([]byte, 0), nil
+	case optionTypeName:
+		if value == nil {
+			return make([]byte, 0), nil
+		}
+
+		return c.encodeNestedToBytes(definition, expression, value)
+	case listTypeName:
+		err := expression.checkNumParams(1)
+		if err != nil {
+			return nil, err
+		}
+
+		items, err := valueToArray(value, -1)
+		if err != nil {
+			return nil, err
+		}
+
+		buff := bytes.NewBuffer(make([]byte, 0))
+		for _, item := range items {
+			err = c.encodeNested(buff, definition, expression.params[0], item, 0)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		return buff.Bytes(), nil
+	}
+
+	if _, isString := stringTypes[expression.name]; isString {
+		str, ok := value.(string)
+		if !ok {
+			return nil, fmt.Errorf("%w: expected a string, got %v", ErrInvalidValue, value)
+		}
+
+		return []byte(str), nil
+	}
+	if _, isBytes := bytesTypes[expression.name]; isBytes {
+		return valueToBytes(value, -1)
+	}
+
+	typeDefinition, isCustom := definition.Types[expression.name]
+	if isCustom && typeDefinition.Type == enumTypeKind {
+		variant, _, err := c.getVariantFromValue(definition, typeDefinition, value)
+		if err != nil {
+			return nil, err
+		}
+		if variant.Discriminant == 0 && len(variant.Fields) == 0 {
+			return make([]byte, 0), nil
+		}
+	}
+
+	// the remaining types have the same encoding at top level as when nested
+	return c.encodeNestedToBytes(definition, expression, value)
+}
+
+func (c *codec) encodeNestedToBytes(definition *Definition, expression *typeExpression, value interface{}) ([]byte, error) {
+	buff := bytes.NewBuffer(make([]byte, 0))
+	err := c.encodeNested(buff, definition, expression, value, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	return buff.Bytes(), nil
+}
+
+func (c *codec) encodeNested(buff *bytes.Buffer, definition *Definition, expression *typeExpression, value interface{}, depth int) error {
+	err := checkNestingDepth(depth)
+	if err != nil {
+		return err
+	}
+
+	if nt, isNumeric := numericTypes[expression.name]; isNumeric {
+		number, err := c.numberFromValue(nt, value)
+		if err != nil {
+			return err
+		}
+
+		buff.Write(nt.encodeNested(number))
+		return nil
+	}
+	if _, isString := stringTypes[expression.name]; isString {
+		str, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("%w: expected a string, got %v", ErrInvalidValue, value)
+		}
+
+		buff.Write(encodeWithLength([]byte(str)))
+		return nil
+	}
+	if _, isBytes := bytesTypes[expression.name]; isBytes {
+		rawBytes, err := valueToBytes(value, -1)
+		if err != nil {
+			return err
+		}
+
+		buff.Write(encodeWithLength(rawBytes))
+		return nil
+	}
+	if length, isArray := expression.arrayLength(); isArray {
+		return c.encodeItemsNested(buff, definition, expression, value, length, depth)
+	}
+	if expression.isMultiValue() {
+		return fmt.Errorf("%w: %s is only allowed as an endpoint input or output", ErrUnsupportedType, expression.String())
+	}
+
+	switch expression.name {
+	case boolTypeName:
+		boolValue, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("%w: expected a bool, got %v", ErrInvalidValue, value)
+		}
+		if boolValue {
+			buff.WriteByte(1)
+		} else {
+			buff.WriteByte(0)
+		}
+
+		return nil
+	case addressTypeName:
+		address, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("%w: expected a bech32 address, got %v", ErrInvalidValue, value)
+		}
+
+		addressBytes, err := c.pubKeyConverter.Decode(address)
+		if err != nil {
+			return fmt.Errorf("%w: %s", ErrInvalidValue, err.Error())
+		}
+
+		buff.Write(addressBytes)
+		return nil
+	case h256TypeName:
+		rawBytes, err := valueToBytes(value, h256NumBytes)
+		if err != nil {
+			return err
+		}
+
+		buff.Write(rawBytes)
+		return nil
+	case codeMetadataTypeName:
+		rawBytes, err := valueToBytes(value, codeMetadataNumBytes)
+		if err != nil {
+			return err
+		}
+
+		buff.Write(rawBytes)
+		return nil
+	case optionTypeName:
+		err := expression.checkNumParams(1)
+		if err != nil {
+			return err
+		}
+		if value == nil {
+			buff.WriteByte(0)
+			return nil
+		}
+
+		buff.WriteByte(1)
+		return c.encodeNested(buff, definition, expression.params[0], value, depth+1)
+	case listTypeName:
+		err := expression.checkNumParams(1)
+		if err != nil {
+			return err
+		}
+
+		items, err := valueToArray(value, -1)
+		if err != nil {
+			return err
+		}
+
+		// for lists, the length prefix holds the number of items, not the number of bytes
+		lengthPrefix := make([]byte, lengthPrefixNumBytes)
+		binary.BigEndian.PutUint32(lengthPrefix, uint32(len(items)))
+		buff.Write(lengthPrefix)
+
+		return c.encodeItemsNested(buff, definition, expression, items, len(items), depth)
+	case tupleTypeName:
+		return c.encodeTupleNested(buff, definition, expression, value, depth)
+	}
+
+	return c.encodeCustomNested(buff, definition, expression, value, depth)
+}
+
+// encodeItemsNested encodes the items of a list or fixed size array, one after the other
+func (c *codec) encodeItemsNested(buff *bytes.Buffer, definition *Definition, expression *typeExpression, value interface{}, length int, depth int) error {
+	err := expression.checkNumParams(1)
+	if err != nil {
+		return err
+	}
+
+	items, err := valueToArray(value, length)
+	if err != nil {
+		return err
+	}
+
+	for _, item := range items {
+		err = c.encodeNested(buff, definition, expression.params[0], item, depth+1)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (c *codec) encodeTupleNested(buff *bytes.Buffer, definition *Definition, expression *typeExpression, value interface{}, depth int) error {
+	items, err := valueToArray(value, len(expression.params))
+	if err != nil {
+		return err
+	}
+
+	for idx, item := range items {
+		err = c.encodeNested(buff, definition, expression.params[idx], item, depth+1)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (c *codec) encodeCustomNested(buff *bytes.Buffer, definition *Definition, expression *typeExpression, value interface{}, depth int) error {
+	typeDefinition, err := getCustomTypeDefinition(definition, expression)
+	if err != nil {
+		return err
+	}
+
+	if typeDefinition.Type == structTypeKind {
+		fieldsValues, ok := value.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("%w: expected an object for %s, got %v", ErrInvalidValue, expression.name, value)
+		}
+
+		return c.encodeFieldsNested(buff, definition, typeDefinition.Fields, fieldsValues, depth)
+	}
+
+	variant, fieldsValues, err := c.getVariantFromValue(definition, typeDefinition, value)
+	if err != nil {
+		return err
+	}
+
+	buff.WriteByte(variant.Discriminant)
+	return c.encodeFieldsNested(buff, definition, variant.Fields, fieldsValues, depth)
+}
+
+func (c *codec) encodeFieldsNested(
+	buff *bytes.Buffer,
+	definition *Definition,
+	fields []*FieldDefinition,
+	fieldsValues map[string]interface{},
+	depth int,
+) error {
+	for _, field := range fields {
+		fieldValue, found := fieldsValues[field.Name]
+		if !found {
+			return fmt.Errorf("%w: missing field %s", ErrInvalidValue, field.Name)
+		}
+
+		fieldType, err := parseTypeExpression(field.Type)
+		if err != nil {
+			return err
+		}
+
+		err = c.encodeNested(buff, definition, fieldType, fieldValue, depth+1)
+		if err != nil {
+			return fmt.Errorf("field %s: %w", field.Name, err)
+		}
+	}
+
+	return nil
+}
+
+func (c *codec) getVariantFromValue(
+	definition *Definition,
+	typeDefinition *TypeDefinition,
+	value interface{},
+) (*VariantDefinition, map[string]interface{}, error) {
+	switch typedValue := value.(type) {
+	case string:
+		variant, err := definition.getVariantByName(typeDefinition, typedValue)
+		return variant, nil, err
+	case map[string]interface{}:
+		name, ok := typedValue[enumNameKey].(string)
+		if !ok {
+			return nil, nil, fmt.Errorf("%w: missing enum variant name", ErrInvalidValue)
+		}
+
+		variant, err := definition.getVariantByName(typeDefinition, name)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		fieldsValues, _ := typedValue[enumFieldsKey].(map[string]interface{})
+		return variant, fieldsValues, nil
+	default:
+		return nil, nil, fmt.Errorf("%w: expected an enum variant, got %v", ErrInvalidValue, value)
+	}
+}
+
+func (c *codec) numberFromValue(nt numericType, value interface{}) (*big.Int, error) {
+	number, err := valueToBigInt(value)
+	if err != nil {
+		return nil, err
+	}
+
+	err = nt.checkRange(number)
+	if err != nil {
+		return nil, err
+	}
+
+	return number, nil
+}
+
+func (c *codec) decodeTopLevel(definition *Definition, expression *typeExpression, buff []byte) (interface{}, error) {
+	if nt, isNumeric := numericTypes[expression.name]; isNumeric {
+		number, err := nt.decodeTopLevel(buff)
+		if err != nil {
+			return nil, err
+		}
+
+		return nt.toJSONValue(number), nil
+	}
+	if _, isString := stringTypes[expression.name]; isString {
+		return string(buff), nil
+	}
+	if _, isBytes := bytesTypes[expression.name]; isBytes {
+		return hex.EncodeToString(buff), nil
+	}
+
+	switch expression.name {
+	case boolTypeName:
+		switch {
+		case len(buff) == 0:
+			return false, nil
+		case len(buff) == 1 && buff[0] == 1:
+			return true, nil
+		default:
+			return nil, fmt.Errorf("%w: %s is not a bool", ErrInvalidValue, hex.EncodeToString(buff))
+		}
+	case optionTypeName:
+		if len(buff) == 0 {
+			return nil, nil
+		}
+	case listTypeName:
+		err := expression.checkNumParams(1)
+		if err != nil {
+			return nil, err
+		}
+
+		reader := newBytesReader(buff)
+		items := make([]interface{}, 0)
+		for !reader.isEmpty() {
+			remaining := reader.remaining()
+			item, errDecode := c.decodeNested(reader, definition, expression.params[0], 0)
+			if errDecode != nil {
+				return nil, errDecode
+			}
+			if reader.remaining() == remaining {
+				return nil, fmt.Errorf("%w: the items of %s are not encoded on any byte", ErrUnsupportedType, expression.String())
+			}
+
+			items = append(items, item)
+		}
+
+		return items, nil
+	}
+
+	typeDefinition, isCustom := definition.Types[expression.name]
+	if isCustom && typeDefinition.Type == enumTypeKind && len(buff) == 0 {
+		variant, err := definition.getVariant(typeDefinition, 0)
+		if err != nil {
+			return nil, err
+		}
+
+		return variantToJSONValue(variant, nil), nil
+	}
+
+	// the remaining types have the same encoding at top level as when nested
+	reader := newBytesReader(buff)
+	value, err := c.decodeNested(reader, definition, expression, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	return value, reader.checkEmpty()
+}
+
+func (c *codec) decodeNested(reader *bytesReader, definition *Definition, expression *typeExpression, depth int) (interface{}, error) {
+	err := checkNestingDepth(depth)
+	if err != nil {
+		return nil, err
+	}
+
+	if nt, isNumeric := numericTypes[expression.name]; isNumeric {
+		number, err := nt.decodeNested(reader)
+		if err != nil {
+			return nil, err
+		}
+
+		return nt.toJSONValue(number), nil
+	}
+	if _, isString := stringTypes[expression.name]; isString {
+		buff, err := reader.readWithLength()
+		if err != nil {
+			return nil, err
+		}
+
+		return string(buff), nil
+	}
+	if _, isBytes := bytesTypes[expression.name]; isBytes {
+		buff, err := reader.readWithLength()
+		if err != nil {
+			return nil, err
+		}
+
+		return hex.EncodeToString(buff), nil
+	}
+	if length, isArray := expression.arrayLength(); isArray {
+		return c.decodeItemsNested(reader, definition, expression, length, depth)
+	}
+	if expression.isMultiValue() {
+		return nil, fmt.Errorf("%w: %s is only allowed as an endpoint input or output", ErrUnsupportedType, expression.String())
+	}
+
+	switch expression.name {
+	case boolTypeName:
+		buff, err := reader.read(1)
+		if err != nil {
+			return nil, err
+		}
+
+		return c.decodeTopLevel(definition, expression, bytes.TrimLeft(buff, "\x00"))
+	case addressTypeName:
+		buff, err := reader.read(c.pubKeyConverter.Len())
+		if err != nil {
+			return nil, err
+		}
+
+		return c.pubKeyConverter.Encode(buff), nil
+	case h256TypeName:
+		buff, err := reader.read(h256NumBytes)
+		if err != nil {
+			return nil, err
+		}
+
+		return hex.EncodeToString(buff), nil
+	case codeMetadataTypeName:
+		buff, err := reader.read(codeMetadataNumBytes)
+		if err != nil {
+			return nil, err
+		}
+
+		return hex.EncodeToString(buff), nil
+	case optionTypeName:
+		err := expression.checkNumParams(1)
+		if err != nil {
+			return nil, err
+		}
+
+		flag, err := reader.read(1)
+		if err != nil {
+			return nil, err
+		}
+
+		switch flag[0] {
+		case 0:
+			return nil, nil
+		case 1:
+			return c.decodeNested(reader, definition, expression.params[0], depth+1)
+		default:
+			return nil, fmt.Errorf("%w: invalid option flag %d", ErrInvalidValue, flag[0])
+		}
+	case listTypeName:
+		lengthBytes, err := reader.read(lengthPrefixNumBytes)
+		if err != nil {
+			return nil, err
+		}
+
+		// the items take at least one byte each (the empty structs aside), so a larger length can only be malformed
+		length := int(binary.BigEndian.Uint32(lengthBytes))
+		if length > reader.remaining() {
+			return nil, fmt.Errorf("%w: list of %d items, having %d bytes remaining", ErrNotEnoughData, length, reader.remaining())
+		}
+
+		return c.decodeItemsNested(reader, definition, expression, length, depth)
+	case tupleTypeName:
+		items := make([]interface{}, 0, len(expression.params))
+		for _, param := range expression.params {
+			item, err := c.decodeNested(reader, definition, param, depth+1)
+			if err != nil {
+				return nil, err
+			}
+
+			items = append(items, item)
+		}
+
+		return items, nil
+	}
+
+	return c.decodeCustomNested(reader, definition, expression, depth)
+}
+
+func (c *codec) decodeItemsNested(reader *bytesReader, definition *Definition, expression *typeExpression, length int, depth int) (interface{}, error) {
+	err := expression.checkNumParams(1)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]interface{}, 0)
+	for i := 0; i < length; i++ {
+		item, errDecode := c.decodeNested(reader, definition, expression.params[0], depth+1)
+		if errDecode != nil {
+			return nil, errDecode
+		}
+
+		items = append(items, item)
+	}
+
+	return items, nil
+}
+
+func (c *codec) decodeCustomNested(reader *bytesReader, definition *Definition, expression *typeExpression, depth int) (interface{}, error) {
+	typeDefinition, err := getCustomTypeDefinition(definition, expression)
+	if err != nil {
+		return nil, err
+	}
+
+	if typeDefinition.Type == structTypeKind {
+		return c.decodeFieldsNested(reader, definition, typeDefinition.Fields, depth)
+	}
+
+	discriminant, err := reader.read(1)
+	if err != nil {
+		return nil, err
+	}
+
+	variant, err := definition.getVariant(typeDefinition, discriminant[0])
+	if err != nil {
+		return nil, err
+	}
+
+	fieldsValues, err := c.decodeFieldsNested(reader, definition, variant.Fields, depth)
+	if err != nil {
+		return nil, err
+	}
+
+	return variantToJSONValue(variant, fieldsValues), nil
+}
+
+func (c *codec) decodeFieldsNested(reader *bytesReader, definition *Definition, fields []*FieldDefinition, depth int) (map[string]interface{}, error) {
+	fieldsValues := make(map[string]interface{}, len(fields))
+	for _, field := range fields {
+		fieldType, err := parseTypeExpression(field.Type)
+		if err != nil {
+			return nil, err
+		}
+
+		fieldValue, err := c.decodeNested(reader, definition, fieldType, depth+1)
+		if err != nil {
+			return nil, fmt.Errorf("field %s: %w", field.Name, err)
+		}
+
+		fieldsValues[field.Name] = fieldValue
+	}
+
+	return fieldsValues, nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (c *codec) IsInterfaceNil() bool {
+	return c == nil
+}
+
+func checkNestingDepth(depth int) error {
+	if depth > maxNestingDepth {
+		return fmt.Errorf("%w: more than %d nested values", ErrMaxNestingDepthExceeded, maxNestingDepth)
+	}
+
+	return nil
+}
+
+func getCustomTypeDefinition(definition *Definition, expression *typeExpression) (*TypeDefinition, error) {
+	typeDefinition, found := definition.Types[expression.name]
+	if !found {
+		return nil, fmt.Errorf("%w: %s", ErrUnsupportedType, expression.name)
+	}
+	if typeDefinition.Type != structTypeKind && typeDefinition.Type != enumTypeKind {
+		return nil, fmt.Errorf("%w: %s of kind %s", ErrUnsupportedType, expression.name, typeDefinition.Type)
+	}
+
+	return typeDefinition, nil
+}
+
+func variantToJSONValue(variant *VariantDefinition, fieldsValues map[string]interface{}) interface{} {
+	if len(variant.Fields) == 0 {
+		return variant.Name
+	}
+
+	return map[string]interface{}{
+		enumNameKey:   variant.Name,
+		enumFieldsKey: fieldsValues,
+	}
+}
+
+func valueToArray(value interface{}, expectedLength int) ([]interface{}, error) {
+	items, ok := value.([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("%w: expected an array, got %v", ErrInvalidValue, value)
+	}
+	if expectedLength >= 0 && len(items) != expectedLength {
+		return nil, fmt.Errorf("%w: expected %d items, got %d", ErrInvalidValue, expectedLength, len(items))
+	}
+
+	return items, nil
+}
+
+func valueToBytes(value interface{}, expectedLength int) ([]byte, error) {
+	hexString, ok := value.(string)
+	if !ok {
+		return nil, fmt.Errorf("%w: expected a hex string, got %v", ErrInvalidValue, value)
+	}
+
+	rawBytes, err := hex.DecodeString(hexString)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s is not a valid hex string", ErrInvalidValue, hexString)
+	}
+	if expectedLength >= 0 && len(rawBytes) != expectedLength {
+		return nil, fmt.Errorf("%w: expected %d bytes, got %d", ErrInvalidValue, expectedLength, len(rawBytes))
+	}
+
+	return rawBytes, nil
+}
@@ -0,0 +1,240 @@
+package abi
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"os"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/core/pubkeyConverter"
+	"github.com/stretchr/testify/require"
+)
+
+var testPubKeyConverter, _ = pubkeyConverter.NewBech32PubkeyConverter(32, log)
+
+const testAddress = "erd1qyu5wthldzr8wx5c9ucg8kjagg0jfs53s8nr3zpz3hypefsdd8ssycr6th"
+
+func loadSampleDefinition(t *testing.T) *Definition {
+	buff, err := os.ReadFile("testdata/sample.abi.json")
+	require.Nil(t, err)
+
+	definition, err := NewDefinitionFromJSON(buff)
+	require.Nil(t, err)
+
+	return definition
+}
+
+func parseJSONValue(t *testing.T, jsonValue string) interface{} {
+	value, err := decodeJSONValue(json.RawMessage(jsonValue))
+	require.Nil(t, err)
+
+	return value
+}
+
+func requireRoundTrip(t *testing.T, definition *Definition, typeExpr string, jsonValue string, expectedTopLevel string, expectedNested string) {
+	c, _ := NewCodec(testPubKeyConverter)
+	expression, err := parseTypeExpression(typeExpr)
+	require.Nil(t, err)
+
+	value := parseJSONValue(t, jsonValue)
+
+	topLevel, err := c.encodeTopLevel(definition, expression, value)
+	require.Nil(t, err)
+	require.Equal(t, expectedTopLevel, hex.EncodeToString(topLevel), typeExpr)
+
+	nested, err := c.encodeNestedToBytes(definition, expression, value)
+	require.Nil(t, err)
+	require.Equal(t, expectedNested, hex.EncodeToString(nested), typeExpr)
+
+	decoded, err := c.decodeTopLevel(definition, expression, topLevel)
+	require.Nil(t, err)
+	decodedAsJSON, _ := json.Marshal(decoded)
+	require.JSONEq(t, jsonValue, string(decodedAsJSON), typeExpr)
+
+	reader := newBytesReader(nested)
+	decoded, err = c.decodeNested(reader, definition, expression, 0)
+	require.Nil(t, err)
+	require.True(t, reader.isEmpty())
+	decodedAsJSON, _ = json.Marshal(decoded)
+	require.JSONEq(t, jsonValue, string(decodedAsJSON), typeExpr)
+}
+
+func TestNewCodec(t *testing.T) {
+	t.Parallel()
+
+	c, err := NewCodec(nil)
+	require.True(t, check.IfNil(c))
+	require.Equal(t, ErrNilPubKeyConverter, err)
+
+	c, err = NewCodec(testPubKeyConverter)
+	require.False(t, check.IfNil(c))
+	require.Nil(t, err)
+}
+
+func TestCodec_Numbers(t *testing.T) {
+	t.Parallel()
+
+	definition := loadSampleDefinition(t)
+
+	requireRoundTrip(t, definition, "u8", "255", "ff", "ff")
+	requireRoundTrip(t, definition, "u32", "0", "", "00000000")
+	requireRoundTrip(t, definition, "u64", `"1000"`, "03e8", "00000000000003e8")
+	requireRoundTrip(t, definition, "i32", "-1", "ff", "ffffffff")
+	requireRoundTrip(t, definition, "i16", "128", "0080", "0080")
+	requireRoundTrip(t, definition, "BigUint", `"1000"`, "03e8", "0000000203e8")
+	requireRoundTrip(t, definition, "BigUint", `"0"`, "", "00000000")
+	requireRoundTrip(t, definition, "BigInt", `"-129"`, "ff7f", "00000002ff7f")
+	requireRoundTrip(t, definition, "BigInt", `"255"`, "00ff", "0000000200ff")
+
+	c, _ := NewCodec(testPubKeyConverter)
+	invalidValues := map[string]string{
+		"u8":      "256",
+		"u16":     "-1",
+		"i8":      "-129",
+		"BigUint": `"-5"`,
+		"u32":     `"1.5"`,
+		"u64":     "true",
+	}
+	for typeExpr, jsonValue := range invalidValues {
+		expression, _ := parseTypeExpression(typeExpr)
+		_, err := c.encodeTopLevel(definition, expression, parseJSONValue(t, jsonValue))
+		require.True(t, errors.Is(err, ErrInvalidValue), typeExpr)
+	}
+
+	expression, _ := parseTypeExpression("u16")
+	_, err := c.decodeTopLevel(definition, expression, []byte{1, 2, 3})
+	require.True(t, errors.Is(err, ErrInvalidValue))
+}
+
+func TestCodec_SimpleTypes(t *testing.T) {
+	t.Parallel()
+
+	definition := loadSampleDefinition(t)
+	addressBytes, _ := testPubKeyConverter.Decode(testAddress)
+	addressHex := hex.EncodeToString(addressBytes)
+
+	requireRoundTrip(t, definition, "bool", "true", "01", "01")
+	requireRoundTrip(t, definition, "bool", "false", "", "00")
+	requireRoundTrip(t, definition, "Address", `"`+testAddress+`"`, addressHex, addressHex)
+	requireRoundTrip(t, definition, "TokenIdentifier", `"WEGLD-abcdef"`, "5745474c442d616263646566", "0000000c5745474c442d616263646566")
+	requireRoundTrip(t, definition, "bytes", `"abcd"`, "abcd", "00000002abcd")
+	requireRoundTrip(t, definition, "array2<u16>", "[1, 2]", "00010002", "00010002")
+	requireRoundTrip(t, definition, "tuple<u8,bool>", "[7, true]", "0701", "0701")
+	requireRoundTrip(t, definition, "Option<u32>", "null", "", "00")
+	requireRoundTrip(t, definition, "Option<u32>", "5", "0100000005", "0100000005")
+	requireRoundTrip(t, definition, "List<u16>", "[1, 2]", "00010002", "0000000200010002")
+}
+
+func TestCodec_CustomTypes(t *testing.T) {
+	t.Parallel()
+
+	definition := loadSampleDefinition(t)
+
+	position := `{"amount": "1000", "rate": -2, "tags": ["aa"], "active": true}`
+	positionNested := "0000000203e8" + "fffffffe" + "00000001" + "00000001aa" + "01"
+	requireRoundTrip(t, definition, "Position", position, positionNested, positionNested)
+	requireRoundTrip(t, definition, "Option<Position>", position, "01"+positionNested, "01"+positionNested)
+
+	requireRoundTrip(t, definition, "Status", `"Inactive"`, "", "00")
+	requireRoundTrip(t, definition, "Status", `"Active"`, "01", "01")
+	requireRoundTrip(t, definition, "Status", `{"name": "Paused", "fields": {"until": "5"}}`, "020000000000000005", "020000000000000005")
+
+	c, _ := NewCodec(testPubKeyConverter)
+	expression, _ := parseTypeExpression("Position")
+	_, err := c.encodeTopLevel(definition, expression, parseJSONValue(t, `{"amount": "1000"}`))
+	require.True(t, errors.Is(err, ErrInvalidValue))
+
+	expression, _ = parseTypeExpression("Status")
+	_, err = c.encodeTopLevel(definition, expression, parseJSONValue(t, `"Unknown"`))
+	require.True(t, errors.Is(err, ErrInvalidValue))
+	_, err = c.decodeTopLevel(definition, expression, []byte{9})
+	require.True(t, errors.Is(err, ErrInvalidValue))
+
+	expression, _ = parseTypeExpression("Unknown")
+	_, err = c.encodeTopLevel(definition, expression, "value")
+	require.True(t, errors.Is(err, ErrUnsupportedType))
+
+	expression, _ = parseTypeExpression("List<variadic<u8>>")
+	_, err = c.encodeTopLevel(definition, expression, parseJSONValue(t, "[[1]]"))
+	require.True(t, errors.Is(err, ErrUnsupportedType))
+}
+
+func TestCodec_DecodeTopLevelWithRemainingDataShouldErr(t *testing.T) {
+	t.Parallel()
+
+	definition := loadSampleDefinition(t)
+	c, _ := NewCodec(testPubKeyConverter)
+
+	expression, _ := parseTypeExpression("tuple<u8,u8>")
+	_, err := c.decodeTopLevel(definition, expression, []byte{1, 2, 3})
+	require.True(t, errors.Is(err, ErrUnexpectedData))
+
+	_, err = c.decodeTopLevel(definition, expression, []byte{1})
+	require.True(t, errors.Is(err, ErrNotEnoughData))
+}
+
+func TestCodec_DecodeMaliciousInputsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	c, _ := NewCodec(testPubKeyConverter)
+
+	t.Run("recursive struct should not overflow the stack", func(t *testing.T) {
+		t.Parallel()
+
+		// built directly, since such a definition is rejected by NewDefinitionFromJSON
+		definition := &Definition{
+			Types: map[string]*TypeDefinition{
+				"A": {Type: structTypeKind, Fields: []*FieldDefinition{{Name: "a", Type: "A"}}},
+			},
+		}
+		expression, _ := parseTypeExpression("A")
+		_, err := c.decodeTopLevel(definition, expression, make([]byte, 0))
+		require.True(t, errors.Is(err, ErrMaxNestingDepthExceeded))
+	})
+	t.Run("deeply nested values should err", func(t *testing.T) {
+		t.Parallel()
+
+		definition, err := NewDefinitionFromJSON([]byte(`{"types":{"E":{"type":"enum","variants":[
+			{"name":"Leaf","discriminant":0},{"name":"Node","discriminant":1,"fields":[{"name":"0","type":"E"}]}]}}}`))
+		require.Nil(t, err)
+
+		expression, _ := parseTypeExpression("E")
+		buff := make([]byte, 1000)
+		for i := 0; i < len(buff)-1; i++ {
+			buff[i] = 1
+		}
+		_, err = c.decodeTopLevel(definition, expression, buff)
+		require.True(t, errors.Is(err, ErrMaxNestingDepthExceeded))
+
+		_, err = c.decodeTopLevel(definition, expression, []byte{1, 1, 0})
+		require.Nil(t, err)
+	})
+	t.Run("list longer than the remaining bytes should err", func(t *testing.T) {
+		t.Parallel()
+
+		definition, err := NewDefinitionFromJSON([]byte(`{"types":{"Empty":{"type":"struct"}}}`))
+		require.Nil(t, err)
+
+		expression, _ := parseTypeExpression("List<Empty>")
+		reader := newBytesReader([]byte{0xff, 0xff, 0xff, 0xff})
+		_, err = c.decodeNested(reader, definition, expression, 0)
+		require.True(t, errors.Is(err, ErrNotEnoughData))
+
+		expression, _ = parseTypeExpression("List<u8>")
+		reader = newBytesReader([]byte{0, 0, 0, 3, 1, 2})
+		_, err = c.decodeNested(reader, definition, expression, 0)
+		require.True(t, errors.Is(err, ErrNotEnoughData))
+	})
+	t.Run("top level list of items not encoded on any byte should err", func(t *testing.T) {
+		t.Parallel()
+
+		definition, err := NewDefinitionFromJSON([]byte(`{"types":{"Empty":{"type":"struct"}}}`))
+		require.Nil(t, err)
+
+		expression, _ := parseTypeExpression("List<Empty>")
+		_, err = c.decodeTopLevel(definition, expression, []byte{1})
+		require.True(t, errors.Is(err, ErrUnsupportedType))
+	})
+}
@@ -0,0 +1,278 @@
+package abi
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+)
+
+const (
+	structTypeKind = "struct"
+	enumTypeKind   = "enum"
+)
+
+// Definition holds the ABI of a smart contract, in the JSON format generated by the smart contracts framework
+type Definition struct {
+	Name        string                     `json:"name"`
+	Constructor *Endpoint                  `json:"constructor,omitempty"`
+	Endpoints   []*Endpoint                `json:"endpoints"`
+	Types       map[string]*TypeDefinition `json:"types"`
+}
+
+// Endpoint holds the definition of a smart contract endpoint
+type Endpoint struct {
+	Name       string       `json:"name"`
+	Mutability string       `json:"mutability,omitempty"`
+	Inputs     []*Parameter `json:"inputs"`
+	Outputs    []*Parameter `json:"outputs"`
+}
+
+// Parameter holds the definition of an endpoint's input or output
+type Parameter struct {
+	Name string `json:"name,omitempty"`
+	Type string `json:"type"`
+}
+
+// TypeDefinition holds the definition of a custom type (struct or enum)
+type TypeDefinition struct {
+	Type     string               `json:"type"`
+	Fields   []*FieldDefinition   `json:"fields,omitempty"`
+	Variants []*VariantDefinition `json:"variants,omitempty"`
+}
+
+// FieldDefinition holds the definition of a struct or enum variant field
+type FieldDefinition struct {
+	Name string `json:"name"`
+	Type string `json:"type"`
+}
+
+// VariantDefinition holds the definition of an enum variant
+type VariantDefinition struct {
+	Name         string             `json:"name"`
+	Discriminant uint8              `json:"discriminant"`
+	Fields       []*FieldDefinition `json:"fields,omitempty"`
+}
+
+// NewDefinitionFromJSON parses and validates an ABI provided in JSON format
+func NewDefinitionFromJSON(buff []byte) (*Definition, error) {
+	definition := &Definition{}
+	err := json.Unmarshal(buff, definition)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidABI, err.Error())
+	}
+
+	err = definition.validate()
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidABI, err.Error())
+	}
+
+	return definition, nil
+}
+
+// GetEndpoint returns the endpoint with the provided name
+func (definition *Definition) GetEndpoint(name string) (*Endpoint, error) {
+	for _, endpoint := range definition.Endpoints {
+		if endpoint.Name == name {
+			return endpoint, nil
+		}
+	}
+
+	return nil, fmt.Errorf("%w: %s", ErrEndpointNotFound, name)
+}
+
+func (definition *Definition) validate() error {
+	for name, typeDefinition := range definition.Types {
+		if typeDefinition == nil {
+			return fmt.Errorf("nil definition for type %s", name)
+		}
+
+		err := definition.validateTypeDefinition(typeDefinition)
+		if err != nil {
+			return fmt.Errorf("type %s: %w", name, err)
+		}
+	}
+
+	err := definition.checkRecursiveTypes()
+	if err != nil {
+		return err
+	}
+
+	endpoints := definition.Endpoints
+	if definition.Constructor != nil {
+		endpoints = append([]*Endpoint{definition.Constructor}, endpoints...)
+	}
+	for _, endpoint := range endpoints {
+		if endpoint == nil {
+			return fmt.Errorf("nil endpoint definition")
+		}
+
+		err := definition.validateParameters(endpoint.Inputs)
+		if err != nil {
+			return fmt.Errorf("endpoint %s: %w", endpoint.Name, err)
+		}
+
+		err = definition.validateParameters(endpoint.Outputs)
+		if err != nil {
+			return fmt.Errorf("endpoint %s: %w", endpoint.Name, err)
+		}
+	}
+
+	return nil
+}
+
+func (definition *Definition) validateTypeDefinition(typeDefinition *TypeDefinition) error {
+	switch typeDefinition.Type {
+	case structTypeKind:
+		return definition.validateFields(typeDefinition.Fields)
+	case enumTypeKind:
+		for _, variant := range typeDefinition.Variants {
+			if variant == nil {
+				return fmt.Errorf("nil variant definition")
+			}
+
+			err := definition.validateFields(variant.Fields)
+			if err != nil {
+				return fmt.Errorf("variant %s: %w", variant.Name, err)
+			}
+		}
+
+		return nil
+	default:
+		// other kinds of types (e.g. explicit enums) are not supported by the codec but can be present in the ABI
+		return nil
+	}
+}
+
+func (definition *Definition) validateFields(fields []*FieldDefinition) error {
+	for _, field := range fields {
+		if field == nil {
+			return fmt.Errorf("nil field definition")
+		}
+
+		err := definition.checkTypeExpression(field.Type)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (definition *Definition) validateParameters(parameters []*Parameter) error {
+	for _, parameter := range parameters {
+		if parameter == nil {
+			return fmt.Errorf("nil parameter definition")
+		}
+
+		err := definition.checkTypeExpression(parameter.Type)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// checkRecursiveTypes rejects the structs containing themselves, directly or through other structs, tuples or arrays.
+// Since no Option, List or enum ends the recursion, their values would be infinite and decoding one would never end
+func (definition *Definition) checkRecursiveTypes() error {
+	names := make([]string, 0, len(definition.Types))
+	for name := range definition.Types {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	checked := make(map[string]struct{})
+	for _, name := range names {
+		err := definition.checkStructRecursion(name, make(map[string]struct{}), checked)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (definition *Definition) checkStructRecursion(name string, path map[string]struct{}, checked map[string]struct{}) error {
+	if _, isChecked := checked[name]; isChecked {
+		return nil
+	}
+	if _, isOnPath := path[name]; isOnPath {
+		return fmt.Errorf("%w: %s", ErrRecursiveType, name)
+	}
+
+	typeDefinition, found := definition.Types[name]
+	if !found || typeDefinition.Type != structTypeKind {
+		return nil
+	}
+
+	path[name] = struct{}{}
+	for _, field := range typeDefinition.Fields {
+		fieldType, err := parseTypeExpression(field.Type)
+		if err != nil {
+			return err
+		}
+
+		for _, inlinedName := range inlinedTypeNames(fieldType) {
+			err = definition.checkStructRecursion(inlinedName, path, checked)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	delete(path, name)
+	checked[name] = struct{}{}
+
+	return nil
+}
+
+// inlinedTypeNames returns the names of the types encoded in place of a value of the provided type, with no byte
+// (option flag, list length or enum discriminant) before them
+func inlinedTypeNames(expression *typeExpression) []string {
+	if expression.name == optionTypeName || expression.name == listTypeName || expression.isMultiValue() {
+		return nil
+	}
+
+	length, isArray := expression.arrayLength()
+	if expression.name != tupleTypeName && !isArray {
+		return []string{expression.name}
+	}
+	if isArray && length == 0 {
+		return nil
+	}
+
+	names := make([]string, 0, len(expression.params))
+	for _, param := range expression.params {
+		names = append(names, inlinedTypeNames(param)...)
+	}
+
+	return names
+}
+
+// checkTypeExpression verifies that the type expression can be parsed. The referred types are checked only when values
+// of that type are encoded or decoded, so an ABI using a type not supported by the codec can still be used for the
+// endpoints not depending on it
+func (definition *Definition) checkTypeExpression(expression string) error {
+	_, err := parseTypeExpression(expression)
+	return err
+}
+
+func (definition *Definition) getVariant(typeDefinition *TypeDefinition, discriminant uint8) (*VariantDefinition, error) {
+	for _, variant := range typeDefinition.Variants {
+		if variant.Discriminant == discriminant {
+			return variant, nil
+		}
+	}
+
+	return nil, fmt.Errorf("%w: unknown enum discriminant %d", ErrInvalidValue, discriminant)
+}
+
+func (definition *Definition) getVariantByName(typeDefinition *TypeDefinition, name string) (*VariantDefinition, error) {
+	for _, variant := range typeDefinition.Variants {
+		if variant.Name == name {
+			return variant, nil
+		}
+	}
+
+	return nil, fmt.Errorf("%w: unknown enum variant %s", ErrInvalidValue, name)
+}
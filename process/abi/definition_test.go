@@ -0,0 +1,44 @@
+package abi
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewDefinitionFromJSON_RecursiveTypes(t *testing.T) {
+	t.Parallel()
+
+	t.Run("recursive structs should err", func(t *testing.T) {
+		t.Parallel()
+
+		recursiveTypes := []string{
+			`{"A":{"type":"struct","fields":[{"name":"a","type":"A"}]}}`,
+			`{"A":{"type":"struct","fields":[{"name":"x","type":"u8"},{"name":"b","type":"B"}]},"B":{"type":"struct","fields":[{"name":"a","type":"A"}]}}`,
+			`{"A":{"type":"struct","fields":[{"name":"a","type":"tuple<u8,A>"}]}}`,
+			`{"A":{"type":"struct","fields":[{"name":"a","type":"array2<A>"}]}}`,
+		}
+		for _, types := range recursiveTypes {
+			definition, err := NewDefinitionFromJSON([]byte(`{"types":` + types + `}`))
+			require.Nil(t, definition, types)
+			require.True(t, errors.Is(err, ErrInvalidABI), types)
+			require.Contains(t, err.Error(), ErrRecursiveType.Error(), types)
+		}
+	})
+	t.Run("recursion through an Option, a List or an enum should work", func(t *testing.T) {
+		t.Parallel()
+
+		recursiveTypes := []string{
+			`{"A":{"type":"struct","fields":[{"name":"a","type":"Option<A>"}]}}`,
+			`{"A":{"type":"struct","fields":[{"name":"a","type":"List<A>"}]}}`,
+			`{"A":{"type":"struct","fields":[{"name":"a","type":"array0<A>"}]}}`,
+			`{"A":{"type":"struct","fields":[{"name":"e","type":"E"}]},"E":{"type":"enum","variants":[{"name":"Leaf","discriminant":0},{"name":"Node","discriminant":1,"fields":[{"name":"0","type":"A"}]}]}}`,
+		}
+		for _, types := range recursiveTypes {
+			definition, err := NewDefinitionFromJSON([]byte(`{"types":` + types + `}`))
+			require.Nil(t, err, types)
+			require.NotNil(t, definition, types)
+		}
+	})
+}
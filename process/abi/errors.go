@@ -0,0 +1,40 @@
+package abi
+
+import "errors"
+
+// ErrNilPubKeyConverter signals that a nil pub key converter has been provided
+var ErrNilPubKeyConverter = errors.New("nil pub key converter provided")
+
+// ErrInvalidABI signals that the provided ABI could not be parsed
+var ErrInvalidABI = errors.New("invalid ABI")
+
+// ErrABINotFound signals that no ABI was loaded under the provided name
+var ErrABINotFound = errors.New("ABI not found")
+
+// ErrEndpointNotFound signals that the ABI does not define the requested endpoint
+var ErrEndpointNotFound = errors.New("endpoint not found in ABI")
+
+// ErrInvalidTypeExpression signals that a type expression could not be parsed
+var ErrInvalidTypeExpression = errors.New("invalid type expression")
+
+// ErrUnsupportedType signals that a type is not supported by the codec
+var ErrUnsupportedType = errors.New("unsupported ABI type")
+
+// ErrInvalidValue signals that a provided value does not match its ABI type
+var ErrInvalidValue = errors.New("invalid value")
+
+// ErrWrongNumberOfArguments signals that the number of provided arguments does not match the endpoint's inputs
+var ErrWrongNumberOfArguments = errors.New("wrong number of arguments")
+
+// ErrNotEnoughData signals that the encoded data ended before the value was fully decoded
+var ErrNotEnoughData = errors.New("not enough data to decode")
+
+// ErrUnexpectedData signals that some encoded data remained after all the values were decoded
+var ErrUnexpectedData = errors.New("unexpected data after the decoded values")
+
+// ErrMaxNestingDepthExceeded signals that a value is nested too deeply to be encoded or decoded
+var ErrMaxNestingDepthExceeded = errors.New("maximum nesting depth exceeded")
+
+// ErrRecursiveType signals that a custom type contains itself without an Option, a List or an enum in between, so its
+// values would be infinite
+var ErrRecursiveType = errors.New("recursive type")
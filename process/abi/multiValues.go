@@ -0,0 +1,284 @@
+package abi
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math/big"
+)
+
+var countedVariadicCountType = numericTypes["u32"]
+
+// EncodeArguments encodes the JSON arguments of an endpoint, returning the raw arguments of the contract call. The
+// arguments are matched with the endpoint inputs by position. The multi-value inputs are represented as arrays
+// (variadic<T>, counted-variadic<T>, multi<...>) or as a value or null (optional<T>) and the trailing optional or
+// variadic inputs can be omitted
+func (c *codec) EncodeArguments(definition *Definition, endpointName string, args []json.RawMessage) ([][]byte, error) {
+	endpoint, err := definition.GetEndpoint(endpointName)
+	if err != nil {
+		return nil, err
+	}
+	if len(args) > len(endpoint.Inputs) {
+		return nil, fmt.Errorf("%w: endpoint %s expects at most %d argument(s), got %d",
+			ErrWrongNumberOfArguments, endpoint.Name, len(endpoint.Inputs), len(args))
+	}
+
+	encodedArgs := make([][]byte, 0, len(args))
+	for idx, input := range endpoint.Inputs {
+		inputType, errParse := parseTypeExpression(input.Type)
+		if errParse != nil {
+			return nil, errParse
+		}
+
+		if idx >= len(args) {
+			if !canBeOmitted(inputType) {
+				return nil, fmt.Errorf("%w: missing argument %s", ErrWrongNumberOfArguments, input.Name)
+			}
+
+			continue
+		}
+
+		value, errParse := decodeJSONValue(args[idx])
+		if errParse != nil {
+			return nil, fmt.Errorf("argument %s: %w", input.Name, errParse)
+		}
+
+		encodedArgs, err = c.encodeMultiValue(encodedArgs, definition, inputType, value)
+		if err != nil {
+			return nil, fmt.Errorf("argument %s: %w", input.Name, err)
+		}
+	}
+
+	return encodedArgs, nil
+}
+
+// DecodeResults decodes the raw return data of an endpoint into JSON values, one for each of the endpoint outputs
+func (c *codec) DecodeResults(definition *Definition, endpointName string, returnData [][]byte) ([]interface{}, error) {
+	endpoint, err := definition.GetEndpoint(endpointName)
+	if err != nil {
+		return nil, err
+	}
+
+	reader := &multiValueReader{
+		values: returnData,
+	}
+	results := make([]interface{}, 0, len(endpoint.Outputs))
+	for idx, output := range endpoint.Outputs {
+		outputType, errParse := parseTypeExpression(output.Type)
+		if errParse != nil {
+			return nil, errParse
+		}
+
+		result, errDecode := c.decodeMultiValue(reader, definition, outputType)
+		if errDecode != nil {
+			return nil, fmt.Errorf("output %d: %w", idx, errDecode)
+		}
+
+		results = append(results, result)
+	}
+
+	if reader.hasNext() {
+		return nil, fmt.Errorf("%w: %d return value(s) remaining", ErrUnexpectedData, len(reader.values)-reader.position)
+	}
+
+	return results, nil
+}
+
+func (c *codec) encodeMultiValue(encodedArgs [][]byte, definition *Definition, expression *typeExpression, value interface{}) ([][]byte, error) {
+	if !expression.isMultiValue() {
+		encoded, err := c.encodeTopLevel(definition, expression, value)
+		if err != nil {
+			return nil, err
+		}
+
+		return append(encodedArgs, encoded), nil
+	}
+
+	if expression.name == multiTypeName {
+		items, err := valueToArray(value, len(expression.params))
+		if err != nil {
+			return nil, err
+		}
+
+		for idx, item := range items {
+			encodedArgs, err = c.encodeMultiValue(encodedArgs, definition, expression.params[idx], item)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		return encodedArgs, nil
+	}
+
+	err := expression.checkNumParams(1)
+	if err != nil {
+		return nil, err
+	}
+	if value == nil {
+		return encodedArgs, nil
+	}
+
+	switch expression.name {
+	case optionalTypeName:
+		return c.encodeMultiValue(encodedArgs, definition, expression.params[0], value)
+	case countedVariadicTypeName:
+		items, errArray := valueToArray(value, -1)
+		if errArray != nil {
+			return nil, errArray
+		}
+
+		encodedArgs = append(encodedArgs, countedVariadicCountType.encodeTopLevel(big.NewInt(int64(len(items)))))
+		return c.encodeMultiValueItems(encodedArgs, definition, expression.params[0], items)
+	default:
+		items, errArray := valueToArray(value, -1)
+		if errArray != nil {
+			return nil, errArray
+		}
+
+		return c.encodeMultiValueItems(encodedArgs, definition, expression.params[0], items)
+	}
+}
+
+func (c *codec) encodeMultiValueItems(encodedArgs [][]byte, definition *Definition, expression *typeExpression, items []interface{}) ([][]byte, error) {
+	var err error
+	for _, item := range items {
+		encodedArgs, err = c.encodeMultiValue(encodedArgs, definition, expression, item)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return encodedArgs, nil
+}
+
+func (c *codec) decodeMultiValue(reader *multiValueReader, definition *Definition, expression *typeExpression) (interface{}, error) {
+	if !expression.isMultiValue() {
+		buff, err := reader.next()
+		if err != nil {
+			return nil, err
+		}
+
+		return c.decodeTopLevel(definition, expression, buff)
+	}
+
+	if expression.name == multiTypeName {
+		items := make([]interface{}, 0, len(expression.params))
+		for _, param := range expression.params {
+			item, err := c.decodeMultiValue(reader, definition, param)
+			if err != nil {
+				return nil, err
+			}
+
+			items = append(items, item)
+		}
+
+		return items, nil
+	}
+
+	err := expression.checkNumParams(1)
+	if err != nil {
+		return nil, err
+	}
+
+	switch expression.name {
+	case optionalTypeName:
+		if !reader.hasNext() {
+			return nil, nil
+		}
+
+		return c.decodeMultiValue(reader, definition, expression.params[0])
+	case countedVariadicTypeName:
+		countBytes, errNext := reader.next()
+		if errNext != nil {
+			return nil, errNext
+		}
+
+		count, errCount := countedVariadicCountType.decodeTopLevel(countBytes)
+		if errCount != nil {
+			return nil, errCount
+		}
+		if count.Uint64() > uint64(reader.remaining()) {
+			return nil, fmt.Errorf("%w: %s of %d items, having %d return values remaining",
+				ErrNotEnoughData, expression.String(), count.Uint64(), reader.remaining())
+		}
+
+		items := make([]interface{}, 0)
+		for i := uint64(0); i < count.Uint64(); i++ {
+			item, errDecode := c.decodeMultiValue(reader, definition, expression.params[0])
+			if errDecode != nil {
+				return nil, errDecode
+			}
+
+			items = append(items, item)
+		}
+
+		return items, nil
+	default:
+		items := make([]interface{}, 0)
+		for reader.hasNext() {
+			remaining := reader.remaining()
+			item, errDecode := c.decodeMultiValue(reader, definition, expression.params[0])
+			if errDecode != nil {
+				return nil, errDecode
+			}
+			if reader.remaining() == remaining {
+				return nil, fmt.Errorf("%w: the items of %s do not take any return value", ErrUnsupportedType, expression.String())
+			}
+
+			items = append(items, item)
+		}
+
+		return items, nil
+	}
+}
+
+func canBeOmitted(expression *typeExpression) bool {
+	switch expression.name {
+	case optionalTypeName, variadicTypeName:
+		return true
+	default:
+		return false
+	}
+}
+
+func decodeJSONValue(raw json.RawMessage) (interface{}, error) {
+	if len(bytes.TrimSpace(raw)) == 0 {
+		return nil, nil
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(raw))
+	// the numbers are kept as strings so the large ones won't lose precision
+	decoder.UseNumber()
+
+	var value interface{}
+	err := decoder.Decode(&value)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidValue, err.Error())
+	}
+
+	return value, nil
+}
+
+type multiValueReader struct {
+	values   [][]byte
+	position int
+}
+
+func (reader *multiValueReader) hasNext() bool {
+	return reader.position < len(reader.values)
+}
+
+func (reader *multiValueReader) remaining() int {
+	return len(reader.values) - reader.position
+}
+
+func (reader *multiValueReader) next() ([]byte, error) {
+	if !reader.hasNext() {
+		return nil, fmt.Errorf("%w: expected more return values", ErrNotEnoughData)
+	}
+
+	value := reader.values[reader.position]
+	reader.position++
+
+	return value, nil
+}
@@ -0,0 +1,187 @@
+package abi
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func toRawMessages(values ...string) []json.RawMessage {
+	rawMessages := make([]json.RawMessage, 0, len(values))
+	for _, value := range values {
+		rawMessages = append(rawMessages, json.RawMessage(value))
+	}
+
+	return rawMessages
+}
+
+func toHexStrings(values [][]byte) []string {
+	hexStrings := make([]string, 0, len(values))
+	for _, value := range values {
+		hexStrings = append(hexStrings, hex.EncodeToString(value))
+	}
+
+	return hexStrings
+}
+
+func TestCodec_EncodeArguments(t *testing.T) {
+	t.Parallel()
+
+	definition := loadSampleDefinition(t)
+	c, _ := NewCodec(testPubKeyConverter)
+	addressBytes, _ := testPubKeyConverter.Decode(testAddress)
+	addressHex := hex.EncodeToString(addressBytes)
+
+	t.Run("unknown endpoint should err", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := c.EncodeArguments(definition, "unknown", nil)
+		require.True(t, errors.Is(err, ErrEndpointNotFound))
+	})
+
+	t.Run("too many arguments should err", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := c.EncodeArguments(definition, "add", toRawMessages(`"1"`, `"2"`))
+		require.True(t, errors.Is(err, ErrWrongNumberOfArguments))
+	})
+
+	t.Run("missing mandatory argument should err", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := c.EncodeArguments(definition, "getPosition", toRawMessages(`"`+testAddress+`"`))
+		require.True(t, errors.Is(err, ErrWrongNumberOfArguments))
+	})
+
+	t.Run("invalid argument should err", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := c.EncodeArguments(definition, "add", toRawMessages(`"abc"`))
+		require.True(t, errors.Is(err, ErrInvalidValue))
+
+		_, err = c.EncodeArguments(definition, "add", toRawMessages(`{`))
+		require.True(t, errors.Is(err, ErrInvalidValue))
+	})
+
+	t.Run("large numbers should not lose precision", func(t *testing.T) {
+		t.Parallel()
+
+		args, err := c.EncodeArguments(definition, "add", toRawMessages("1000000000000000000000001"))
+		require.Nil(t, err)
+		require.Equal(t, []string{"d3c21bcecceda1000001"}, toHexStrings(args))
+	})
+
+	t.Run("omitted optional argument should work", func(t *testing.T) {
+		t.Parallel()
+
+		args, err := c.EncodeArguments(definition, "getPosition", toRawMessages(`"`+testAddress+`"`, `"TKN-abcdef"`))
+		require.Nil(t, err)
+		require.Equal(t, []string{addressHex, "544b4e2d616263646566"}, toHexStrings(args))
+
+		args, err = c.EncodeArguments(definition, "getPosition", toRawMessages(`"`+testAddress+`"`, `"TKN-abcdef"`, "null"))
+		require.Nil(t, err)
+		require.Equal(t, 2, len(args))
+	})
+
+	t.Run("provided optional argument should work", func(t *testing.T) {
+		t.Parallel()
+
+		args, err := c.EncodeArguments(definition, "getPosition", toRawMessages(`"`+testAddress+`"`, `"TKN-abcdef"`, "3"))
+		require.Nil(t, err)
+		require.Equal(t, []string{addressHex, "544b4e2d616263646566", "03"}, toHexStrings(args))
+	})
+
+	t.Run("variadic argument should work", func(t *testing.T) {
+		t.Parallel()
+
+		args, err := c.EncodeArguments(definition, "getBalances", toRawMessages(`["`+testAddress+`", "`+testAddress+`"]`))
+		require.Nil(t, err)
+		require.Equal(t, []string{addressHex, addressHex}, toHexStrings(args))
+
+		args, err = c.EncodeArguments(definition, "getBalances", nil)
+		require.Nil(t, err)
+		require.Empty(t, args)
+	})
+}
+
+func TestCodec_DecodeResults(t *testing.T) {
+	t.Parallel()
+
+	definition := loadSampleDefinition(t)
+	c, _ := NewCodec(testPubKeyConverter)
+	addressBytes, _ := testPubKeyConverter.Decode(testAddress)
+
+	t.Run("single result should work", func(t *testing.T) {
+		t.Parallel()
+
+		results, err := c.DecodeResults(definition, "getSum", [][]byte{{0x03, 0xe8}})
+		require.Nil(t, err)
+		require.Equal(t, []interface{}{"1000"}, results)
+	})
+
+	t.Run("missing result should err", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := c.DecodeResults(definition, "getSum", nil)
+		require.True(t, errors.Is(err, ErrNotEnoughData))
+	})
+
+	t.Run("extra results should err", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := c.DecodeResults(definition, "getSum", [][]byte{{1}, {2}})
+		require.True(t, errors.Is(err, ErrUnexpectedData))
+	})
+
+	t.Run("variadic multi results should work", func(t *testing.T) {
+		t.Parallel()
+
+		results, err := c.DecodeResults(definition, "getBalances", [][]byte{addressBytes, {0x0a}, addressBytes, {}})
+		require.Nil(t, err)
+
+		resultsAsJSON, _ := json.Marshal(results)
+		expectedJSON := `[[["` + testAddress + `", "10"], ["` + testAddress + `", "0"]]]`
+		require.JSONEq(t, expectedJSON, string(resultsAsJSON))
+	})
+
+	t.Run("incomplete multi results should err", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := c.DecodeResults(definition, "getBalances", [][]byte{addressBytes, {0x0a}, addressBytes})
+		require.True(t, errors.Is(err, ErrNotEnoughData))
+	})
+
+	t.Run("enum result should work", func(t *testing.T) {
+		t.Parallel()
+
+		results, err := c.DecodeResults(definition, "getStatus", [][]byte{{}})
+		require.Nil(t, err)
+		require.Equal(t, []interface{}{"Inactive"}, results)
+	})
+}
+
+func TestCodec_DecodeMaliciousResultsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	definition, err := NewDefinitionFromJSON([]byte(`{"endpoints":[
+		{"name":"counted","outputs":[{"type":"counted-variadic<optional<u8>>"}]},
+		{"name":"variadic","outputs":[{"type":"variadic<multi>"}]}]}`))
+	require.Nil(t, err)
+	c, _ := NewCodec(testPubKeyConverter)
+
+	t.Run("count larger than the remaining results should err", func(t *testing.T) {
+		t.Parallel()
+
+		_, errDecode := c.DecodeResults(definition, "counted", [][]byte{{0xff, 0xff, 0xff, 0xff}})
+		require.True(t, errors.Is(errDecode, ErrNotEnoughData))
+	})
+	t.Run("variadic items not taking any result should err", func(t *testing.T) {
+		t.Parallel()
+
+		_, errDecode := c.DecodeResults(definition, "variadic", [][]byte{{1}})
+		require.True(t, errors.Is(errDecode, ErrUnsupportedType))
+	})
+}
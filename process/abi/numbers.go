@@ -0,0 +1,187 @@
+package abi
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strings"
+)
+
+type numericType struct {
+	// numBytes is 0 for the arbitrary precision numbers
+	numBytes int
+	signed   bool
+}
+
+var numericTypes = map[string]numericType{
+	"u8":      {numBytes: 1},
+	"u16":     {numBytes: 2},
+	"u32":     {numBytes: 4},
+	"u64":     {numBytes: 8},
+	"usize":   {numBytes: 4},
+	"BigUint": {},
+	"i8":      {numBytes: 1, signed: true},
+	"i16":     {numBytes: 2, signed: true},
+	"i32":     {numBytes: 4, signed: true},
+	"i64":     {numBytes: 8, signed: true},
+	"isize":   {numBytes: 4, signed: true},
+	"BigInt":  {signed: true},
+}
+
+// maxNumBytesAsJSONNumber is the maximum size of the numbers returned as JSON numbers. The larger ones are returned
+// as decimal strings, so they won't lose precision in the clients parsing the JSON numbers as floats
+const maxNumBytesAsJSONNumber = 4
+
+func valueToBigInt(value interface{}) (*big.Int, error) {
+	var str string
+	switch typedValue := value.(type) {
+	case json.Number:
+		str = typedValue.String()
+	case string:
+		str = strings.TrimSpace(typedValue)
+	default:
+		return nil, fmt.Errorf("%w: expected a number, got %v", ErrInvalidValue, value)
+	}
+
+	number, ok := big.NewInt(0).SetString(str, 10)
+	if !ok {
+		return nil, fmt.Errorf("%w: %s is not an integer", ErrInvalidValue, str)
+	}
+
+	return number, nil
+}
+
+func (nt numericType) checkRange(number *big.Int) error {
+	if !nt.signed && number.Sign() < 0 {
+		return fmt.Errorf("%w: negative value %s for an unsigned type", ErrInvalidValue, number.String())
+	}
+	if nt.numBytes == 0 {
+		return nil
+	}
+
+	numBits := uint(nt.numBytes * 8)
+	if !nt.signed {
+		if number.BitLen() > int(numBits) {
+			return fmt.Errorf("%w: value %s overflows %d bits", ErrInvalidValue, number.String(), numBits)
+		}
+
+		return nil
+	}
+
+	limit := big.NewInt(0).Lsh(big.NewInt(1), numBits-1)
+	minValue := big.NewInt(0).Neg(limit)
+	maxValue := big.NewInt(0).Sub(limit, big.NewInt(1))
+	if number.Cmp(minValue) < 0 || number.Cmp(maxValue) > 0 {
+		return fmt.Errorf("%w: value %s overflows %d bits", ErrInvalidValue, number.String(), numBits)
+	}
+
+	return nil
+}
+
+// encodeTopLevel returns the minimal big endian representation (two's complement for the signed types). Zero is
+// encoded as an empty slice
+func (nt numericType) encodeTopLevel(number *big.Int) []byte {
+	if !nt.signed {
+		return number.Bytes()
+	}
+
+	return signedToMinimalBytes(number)
+}
+
+func (nt numericType) encodeNested(number *big.Int) []byte {
+	if nt.numBytes == 0 {
+		return encodeWithLength(nt.encodeTopLevel(number))
+	}
+
+	return toFixedSizeBytes(number, nt.numBytes)
+}
+
+func (nt numericType) decodeTopLevel(buff []byte) (*big.Int, error) {
+	if nt.numBytes > 0 && len(buff) > nt.numBytes {
+		return nil, fmt.Errorf("%w: %d bytes for a %d bytes number", ErrInvalidValue, len(buff), nt.numBytes)
+	}
+
+	if !nt.signed {
+		return big.NewInt(0).SetBytes(buff), nil
+	}
+
+	return signedFromBytes(buff), nil
+}
+
+func (nt numericType) decodeNested(reader *bytesReader) (*big.Int, error) {
+	if nt.numBytes > 0 {
+		buff, err := reader.read(nt.numBytes)
+		if err != nil {
+			return nil, err
+		}
+
+		return nt.decodeTopLevel(buff)
+	}
+
+	buff, err := reader.readWithLength()
+	if err != nil {
+		return nil, err
+	}
+
+	return nt.decodeTopLevel(buff)
+}
+
+func (nt numericType) toJSONValue(number *big.Int) interface{} {
+	if nt.numBytes == 0 || nt.numBytes > maxNumBytesAsJSONNumber {
+		return number.String()
+	}
+	if nt.signed {
+		return number.Int64()
+	}
+
+	return number.Uint64()
+}
+
+func signedToMinimalBytes(number *big.Int) []byte {
+	if number.Sign() == 0 {
+		return make([]byte, 0)
+	}
+
+	if number.Sign() > 0 {
+		buff := number.Bytes()
+		if buff[0]&0x80 != 0 {
+			// an extra byte is needed, otherwise the number would be decoded as negative
+			buff = append([]byte{0}, buff...)
+		}
+
+		return buff
+	}
+
+	// for a negative number, the minimal length L is the smallest one for which number >= -2^(8L-1)
+	numBytes := 1
+	for {
+		minValue := big.NewInt(0).Neg(big.NewInt(0).Lsh(big.NewInt(1), uint(8*numBytes-1)))
+		if number.Cmp(minValue) >= 0 {
+			break
+		}
+		numBytes++
+	}
+
+	return toFixedSizeBytes(number, numBytes)
+}
+
+// toFixedSizeBytes returns the big endian, two's complement representation of the number on the given number of bytes.
+// The number is expected to fit
+func toFixedSizeBytes(number *big.Int, numBytes int) []byte {
+	value := big.NewInt(0).Set(number)
+	if value.Sign() < 0 {
+		value.Add(value, big.NewInt(0).Lsh(big.NewInt(1), uint(8*numBytes)))
+	}
+
+	buff := make([]byte, numBytes)
+	return value.FillBytes(buff)
+}
+
+func signedFromBytes(buff []byte) *big.Int {
+	number := big.NewInt(0).SetBytes(buff)
+	if len(buff) > 0 && buff[0]&0x80 != 0 {
+		number.Sub(number, big.NewInt(0).Lsh(big.NewInt(1), uint(8*len(buff))))
+	}
+
+	return number
+}
@@ -0,0 +1,75 @@
+package abi
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	logger "github.com/multiversx/mx-chain-logger-go"
+)
+
+const (
+	abiFileSuffix  = ".abi.json"
+	jsonFileSuffix = ".json"
+)
+
+var log = logger.GetOrCreate("process/abi")
+
+type registry struct {
+	definitions map[string]*Definition
+}
+
+// NewRegistry loads all the ABI files (*.abi.json or *.json) from the provided directory. Each ABI is registered under
+// its file name, without the extension. An empty directory path results in an empty registry
+func NewRegistry(directory string) (*registry, error) {
+	r := &registry{
+		definitions: make(map[string]*Definition),
+	}
+	if len(directory) == 0 {
+		return r, nil
+	}
+
+	entries, err := os.ReadDir(directory)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, entry := range entries {
+		fileName := entry.Name()
+		if entry.IsDir() || !strings.HasSuffix(fileName, jsonFileSuffix) {
+			continue
+		}
+
+		buff, errRead := os.ReadFile(filepath.Join(directory, fileName))
+		if errRead != nil {
+			return nil, errRead
+		}
+
+		definition, errParse := NewDefinitionFromJSON(buff)
+		if errParse != nil {
+			return nil, fmt.Errorf("%w, file %s", errParse, fileName)
+		}
+
+		name := strings.TrimSuffix(strings.TrimSuffix(fileName, abiFileSuffix), jsonFileSuffix)
+		r.definitions[name] = definition
+		log.Debug("loaded smart contract ABI", "name", name, "num endpoints", len(definition.Endpoints))
+	}
+
+	return r, nil
+}
+
+// GetDefinition returns the ABI registered under the provided name
+func (r *registry) GetDefinition(name string) (*Definition, error) {
+	definition, found := r.definitions[name]
+	if !found {
+		return nil, fmt.Errorf("%w: %s", ErrABINotFound, name)
+	}
+
+	return definition, nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (r *registry) IsInterfaceNil() bool {
+	return r == nil
+}
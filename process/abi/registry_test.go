@@ -0,0 +1,61 @@
+package abi
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewRegistry(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty directory path should return an empty registry", func(t *testing.T) {
+		t.Parallel()
+
+		r, err := NewRegistry("")
+		require.Nil(t, err)
+		require.False(t, check.IfNil(r))
+
+		_, err = r.GetDefinition("sample")
+		require.True(t, errors.Is(err, ErrABINotFound))
+	})
+
+	t.Run("missing directory should err", func(t *testing.T) {
+		t.Parallel()
+
+		r, err := NewRegistry(filepath.Join(t.TempDir(), "missing"))
+		require.NotNil(t, err)
+		require.True(t, check.IfNil(r))
+	})
+
+	t.Run("invalid ABI file should err", func(t *testing.T) {
+		t.Parallel()
+
+		directory := t.TempDir()
+		err := os.WriteFile(filepath.Join(directory, "invalid.abi.json"), []byte(`{"endpoints": [{"name": "f", "inputs": [{"type": "List<"}]}]}`), 0644)
+		require.Nil(t, err)
+
+		r, err := NewRegistry(directory)
+		require.True(t, errors.Is(err, ErrInvalidABI))
+		require.True(t, check.IfNil(r))
+	})
+
+	t.Run("should load the ABI files", func(t *testing.T) {
+		t.Parallel()
+
+		r, err := NewRegistry("testdata")
+		require.Nil(t, err)
+
+		definition, err := r.GetDefinition("sample")
+		require.Nil(t, err)
+		require.Equal(t, "Sample", definition.Name)
+
+		endpoint, err := definition.GetEndpoint("getPosition")
+		require.Nil(t, err)
+		require.Equal(t, 3, len(endpoint.Inputs))
+	})
+}
@@ -0,0 +1,137 @@
+package abi
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+const (
+	optionTypeName          = "Option"
+	listTypeName            = "List"
+	tupleTypeName           = "tuple"
+	arrayTypePrefix         = "array"
+	optionalTypeName        = "optional"
+	variadicTypeName        = "variadic"
+	countedVariadicTypeName = "counted-variadic"
+	multiTypeName           = "multi"
+)
+
+// typeExpression is the parsed form of an ABI type, such as "List<Option<BigUint>>"
+type typeExpression struct {
+	name   string
+	params []*typeExpression
+}
+
+type typeExpressionParser struct {
+	input    string
+	position int
+}
+
+func parseTypeExpression(expression string) (*typeExpression, error) {
+	parser := &typeExpressionParser{
+		input: expression,
+	}
+
+	parsed, err := parser.parse()
+	if err != nil {
+		return nil, fmt.Errorf("%w %s: %s", ErrInvalidTypeExpression, expression, err.Error())
+	}
+	if parser.position != len(parser.input) {
+		return nil, fmt.Errorf("%w %s: unexpected character at position %d", ErrInvalidTypeExpression, expression, parser.position)
+	}
+
+	return parsed, nil
+}
+
+func (parser *typeExpressionParser) parse() (*typeExpression, error) {
+	start := parser.position
+	for parser.position < len(parser.input) && !strings.ContainsRune("<,>", rune(parser.input[parser.position])) {
+		parser.position++
+	}
+
+	name := strings.TrimSpace(parser.input[start:parser.position])
+	if len(name) == 0 {
+		return nil, fmt.Errorf("empty type name at position %d", start)
+	}
+
+	parsed := &typeExpression{
+		name: name,
+	}
+	if parser.position == len(parser.input) || parser.input[parser.position] != '<' {
+		return parsed, nil
+	}
+
+	// skip the '<' character
+	parser.position++
+	for {
+		param, err := parser.parse()
+		if err != nil {
+			return nil, err
+		}
+		parsed.params = append(parsed.params, param)
+
+		if parser.position == len(parser.input) {
+			return nil, fmt.Errorf("unclosed type parameters list")
+		}
+
+		separator := parser.input[parser.position]
+		parser.position++
+		if separator == '>' {
+			break
+		}
+	}
+
+	// allow spaces after a closed type parameters list
+	for parser.position < len(parser.input) && parser.input[parser.position] == ' ' {
+		parser.position++
+	}
+
+	return parsed, nil
+}
+
+func (expression *typeExpression) isMultiValue() bool {
+	switch expression.name {
+	case optionalTypeName, variadicTypeName, countedVariadicTypeName, multiTypeName:
+		return true
+	default:
+		return false
+	}
+}
+
+// arrayLength returns the length of a fixed size array type (e.g. "array32<u8>") or false if the type is not an array
+func (expression *typeExpression) arrayLength() (int, bool) {
+	if !strings.HasPrefix(expression.name, arrayTypePrefix) {
+		return 0, false
+	}
+
+	length, err := strconv.Atoi(strings.TrimPrefix(expression.name, arrayTypePrefix))
+	if err != nil || length < 0 {
+		return 0, false
+	}
+
+	return length, true
+}
+
+func (expression *typeExpression) checkNumParams(numParams int) error {
+	if len(expression.params) != numParams {
+		return fmt.Errorf("%w %s: expected %d type parameter(s), got %d",
+			ErrInvalidTypeExpression, expression.name, numParams, len(expression.params))
+	}
+
+	return nil
+}
+
+// String returns the type expression in the ABI format
+func (expression *typeExpression) String() string {
+	if len(expression.params) == 0 {
+		return expression.name
+	}
+
+	params := make([]string, 0, len(expression.params))
+	for _, param := range expression.params {
+		params = append(params, param.String())
+	}
+
+	return fmt.Sprintf("%s<%s>", expression.name, strings.Join(params, ","))
+}
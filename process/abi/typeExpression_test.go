@@ -0,0 +1,60 @@
+package abi
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseTypeExpression(t *testing.T) {
+	t.Parallel()
+
+	t.Run("simple type should work", func(t *testing.T) {
+		t.Parallel()
+
+		parsed, err := parseTypeExpression("BigUint")
+		require.Nil(t, err)
+		require.Equal(t, "BigUint", parsed.name)
+		require.Empty(t, parsed.params)
+	})
+
+	t.Run("type with spaces in its name should work", func(t *testing.T) {
+		t.Parallel()
+
+		parsed, err := parseTypeExpression("Option<utf-8 string>")
+		require.Nil(t, err)
+		require.Equal(t, "Option", parsed.name)
+		require.Equal(t, "utf-8 string", parsed.params[0].name)
+	})
+
+	t.Run("nested types should work", func(t *testing.T) {
+		t.Parallel()
+
+		parsed, err := parseTypeExpression("variadic<multi<Address, List<Option<u32>>>>")
+		require.Nil(t, err)
+		require.Equal(t, "variadic<multi<Address,List<Option<u32>>>>", parsed.String())
+		require.True(t, parsed.isMultiValue())
+		require.Equal(t, 2, len(parsed.params[0].params))
+	})
+
+	t.Run("array type should work", func(t *testing.T) {
+		t.Parallel()
+
+		parsed, err := parseTypeExpression("array32<u8>")
+		require.Nil(t, err)
+		length, isArray := parsed.arrayLength()
+		require.True(t, isArray)
+		require.Equal(t, 32, length)
+	})
+
+	t.Run("invalid expressions should err", func(t *testing.T) {
+		t.Parallel()
+
+		invalidExpressions := []string{"", "List<", "List<>", "List<u8", "tuple<u8,>", "List<u8>>", "<u8>"}
+		for _, expression := range invalidExpressions {
+			_, err := parseTypeExpression(expression)
+			require.True(t, errors.Is(err, ErrInvalidTypeExpression), expression)
+		}
+	})
+}
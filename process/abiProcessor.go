@@ -0,0 +1,154 @@
+package process
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-proxy-go/common"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/multiversx/mx-chain-proxy-go/process/abi"
+)
+
+const (
+	vmOutputReturnCodeOk = "ok"
+	callDataSeparator    = "@"
+
+	// maxInlineABINumBytes is the maximum size of an ABI provided in a request. The larger ABIs can still be loaded
+	// from the configured directory
+	maxInlineABINumBytes = 256 * 1024
+)
+
+// ABIProcessor is able to execute smart contract queries and to encode the arguments or decode the results of the
+// smart contract endpoints, based on the contracts ABIs
+type ABIProcessor struct {
+	scQueryService SCQueryService
+	abiRegistry    ABIRegistry
+	codec          ABICodec
+}
+
+// NewABIProcessor creates a new instance of ABIProcessor
+func NewABIProcessor(scQueryService SCQueryService, abiRegistry ABIRegistry, codec ABICodec) (*ABIProcessor, error) {
+	if check.IfNil(scQueryService) {
+		return nil, ErrNilSCQueryService
+	}
+	if check.IfNil(abiRegistry) {
+		return nil, ErrNilABIRegistry
+	}
+	if check.IfNil(codec) {
+		return nil, ErrNilABICodec
+	}
+
+	return &ABIProcessor{
+		scQueryService: scQueryService,
+		abiRegistry:    abiRegistry,
+		codec:          codec,
+	}, nil
+}
+
+// ExecuteQuery encodes the JSON arguments, executes the smart contract query and decodes its results. The results are
+// decoded only if the execution was successful
+func (ap *ABIProcessor) ExecuteQuery(request *data.ABIQueryRequest, options common.VmQueryOptions) (*data.ABIQueryResponse, data.BlockInfo, error) {
+	definition, err := ap.getDefinition(request.ABIName, request.ABI)
+	if err != nil {
+		return nil, data.BlockInfo{}, err
+	}
+
+	arguments, err := ap.codec.EncodeArguments(definition, request.Endpoint, request.Args)
+	if err != nil {
+		return nil, data.BlockInfo{}, err
+	}
+
+	query := &data.SCQuery{
+		ScAddress:     request.ScAddress,
+		FuncName:      request.Endpoint,
+		CallerAddr:    request.Caller,
+		CallValue:     request.Value,
+		Arguments:     arguments,
+		BlockNonce:    options.BlockNonce,
+		BlockHash:     options.BlockHash,
+		BlockRootHash: options.BlockRootHash,
+		HintEpoch:     options.HintEpoch,
+	}
+	vmOutput, blockInfo, err := ap.scQueryService.ExecuteQuery(query)
+	if err != nil {
+		return nil, data.BlockInfo{}, err
+	}
+
+	response := &data.ABIQueryResponse{
+		ReturnCode:    vmOutput.ReturnCode,
+		ReturnMessage: vmOutput.ReturnMessage,
+	}
+	if vmOutput.ReturnCode != vmOutputReturnCodeOk {
+		return response, blockInfo, nil
+	}
+
+	response.Results, err = ap.codec.DecodeResults(definition, request.Endpoint, vmOutput.ReturnData)
+	if err != nil {
+		return nil, data.BlockInfo{}, err
+	}
+
+	return response, blockInfo, nil
+}
+
+// EncodeArguments returns the hex encoded arguments of a smart contract endpoint
+func (ap *ABIProcessor) EncodeArguments(request *data.ABIArgumentsRequest) ([]string, error) {
+	definition, err := ap.getDefinition(request.ABIName, request.ABI)
+	if err != nil {
+		return nil, err
+	}
+
+	arguments, err := ap.codec.EncodeArguments(definition, request.Endpoint, request.Args)
+	if err != nil {
+		return nil, err
+	}
+
+	hexArguments := make([]string, 0, len(arguments))
+	for _, argument := range arguments {
+		hexArguments = append(hexArguments, hex.EncodeToString(argument))
+	}
+
+	return hexArguments, nil
+}
+
+// BuildCallData returns the data field of a transaction calling the smart contract endpoint with the provided arguments
+func (ap *ABIProcessor) BuildCallData(request *data.ABIArgumentsRequest) (string, error) {
+	hexArguments, err := ap.EncodeArguments(request)
+	if err != nil {
+		return "", err
+	}
+
+	callDataParts := append([]string{request.Endpoint}, hexArguments...)
+	return strings.Join(callDataParts, callDataSeparator), nil
+}
+
+// DecodeResults decodes the raw return data of a smart contract endpoint
+func (ap *ABIProcessor) DecodeResults(request *data.ABIResultsRequest) ([]interface{}, error) {
+	definition, err := ap.getDefinition(request.ABIName, request.ABI)
+	if err != nil {
+		return nil, err
+	}
+
+	return ap.codec.DecodeResults(definition, request.Endpoint, request.ReturnData)
+}
+
+// getDefinition returns the inline ABI, if provided, or the ABI loaded under the provided name
+func (ap *ABIProcessor) getDefinition(abiName string, inlineABI []byte) (*abi.Definition, error) {
+	if len(inlineABI) > maxInlineABINumBytes {
+		return nil, fmt.Errorf("%w: %d bytes, at most %d allowed", ErrInlineABITooLarge, len(inlineABI), maxInlineABINumBytes)
+	}
+	if len(inlineABI) > 0 && string(inlineABI) != "null" {
+		return abi.NewDefinitionFromJSON(inlineABI)
+	}
+	if len(abiName) > 0 {
+		return ap.abiRegistry.GetDefinition(abiName)
+	}
+
+	return nil, ErrMissingABI
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ap *ABIProcessor) IsInterfaceNil() bool {
+	return ap == nil
+}
@@ -0,0 +1,224 @@
+package process_test
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/pubkeyConverter"
+	"github.com/multiversx/mx-chain-core-go/data/vm"
+	"github.com/multiversx/mx-chain-proxy-go/common"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/multiversx/mx-chain-proxy-go/process"
+	"github.com/multiversx/mx-chain-proxy-go/process/abi"
+	"github.com/multiversx/mx-chain-proxy-go/process/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const sampleABIDirectory = "abi/testdata"
+
+func createABIProcessor(t *testing.T, scQueryService process.SCQueryService) *process.ABIProcessor {
+	converter, _ := pubkeyConverter.NewBech32PubkeyConverter(32, &mock.LoggerStub{})
+	registry, err := abi.NewRegistry(sampleABIDirectory)
+	require.Nil(t, err)
+	codec, err := abi.NewCodec(converter)
+	require.Nil(t, err)
+
+	ap, err := process.NewABIProcessor(scQueryService, registry, codec)
+	require.Nil(t, err)
+
+	return ap
+}
+
+func TestNewABIProcessor(t *testing.T) {
+	t.Parallel()
+
+	registry, _ := abi.NewRegistry("")
+	codec, _ := abi.NewCodec(&mock.PubKeyConverterMock{})
+
+	t.Run("nil sc query service should err", func(t *testing.T) {
+		t.Parallel()
+
+		ap, err := process.NewABIProcessor(nil, registry, codec)
+		require.Nil(t, ap)
+		require.Equal(t, process.ErrNilSCQueryService, err)
+	})
+
+	t.Run("nil registry should err", func(t *testing.T) {
+		t.Parallel()
+
+		ap, err := process.NewABIProcessor(&mock.SCQueryServiceStub{}, nil, codec)
+		require.Nil(t, ap)
+		require.Equal(t, process.ErrNilABIRegistry, err)
+	})
+
+	t.Run("nil codec should err", func(t *testing.T) {
+		t.Parallel()
+
+		ap, err := process.NewABIProcessor(&mock.SCQueryServiceStub{}, registry, nil)
+		require.Nil(t, ap)
+		require.Equal(t, process.ErrNilABICodec, err)
+	})
+
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		ap, err := process.NewABIProcessor(&mock.SCQueryServiceStub{}, registry, codec)
+		require.NotNil(t, ap)
+		require.Nil(t, err)
+		require.False(t, ap.IsInterfaceNil())
+	})
+}
+
+func TestABIProcessor_ExecuteQuery(t *testing.T) {
+	t.Parallel()
+
+	t.Run("missing ABI should err", func(t *testing.T) {
+		t.Parallel()
+
+		ap := createABIProcessor(t, &mock.SCQueryServiceStub{})
+		response, _, err := ap.ExecuteQuery(&data.ABIQueryRequest{Endpoint: "getSum"}, common.VmQueryOptions{})
+		require.Nil(t, response)
+		require.Equal(t, process.ErrMissingABI, err)
+	})
+
+	t.Run("unknown ABI name should err", func(t *testing.T) {
+		t.Parallel()
+
+		ap := createABIProcessor(t, &mock.SCQueryServiceStub{})
+		response, _, err := ap.ExecuteQuery(&data.ABIQueryRequest{ABIName: "unknown", Endpoint: "getSum"}, common.VmQueryOptions{})
+		require.Nil(t, response)
+		require.True(t, errors.Is(err, abi.ErrABINotFound))
+	})
+
+	t.Run("too large inline ABI should err", func(t *testing.T) {
+		t.Parallel()
+
+		inlineABI := []byte(`{"name":"` + strings.Repeat("a", 256*1024) + `"}`)
+		ap := createABIProcessor(t, &mock.SCQueryServiceStub{})
+		response, _, err := ap.ExecuteQuery(&data.ABIQueryRequest{ABI: inlineABI, Endpoint: "getSum"}, common.VmQueryOptions{})
+		require.Nil(t, response)
+		require.True(t, errors.Is(err, process.ErrInlineABITooLarge))
+	})
+
+	t.Run("query error should err", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		ap := createABIProcessor(t, &mock.SCQueryServiceStub{
+			ExecuteQueryCalled: func(query *data.SCQuery) (*vm.VMOutputApi, data.BlockInfo, error) {
+				return nil, data.BlockInfo{}, expectedErr
+			},
+		})
+		response, _, err := ap.ExecuteQuery(&data.ABIQueryRequest{ABIName: "sample", Endpoint: "getSum"}, common.VmQueryOptions{})
+		require.Nil(t, response)
+		require.Equal(t, expectedErr, err)
+	})
+
+	t.Run("failed execution should not decode the results", func(t *testing.T) {
+		t.Parallel()
+
+		ap := createABIProcessor(t, &mock.SCQueryServiceStub{
+			ExecuteQueryCalled: func(query *data.SCQuery) (*vm.VMOutputApi, data.BlockInfo, error) {
+				return &vm.VMOutputApi{
+					ReturnCode:    "user error",
+					ReturnMessage: "storage decode error",
+					ReturnData:    [][]byte{{1, 2, 3}},
+				}, data.BlockInfo{}, nil
+			},
+		})
+		response, _, err := ap.ExecuteQuery(&data.ABIQueryRequest{ABIName: "sample", Endpoint: "getSum"}, common.VmQueryOptions{})
+		require.Nil(t, err)
+		require.Equal(t, "user error", response.ReturnCode)
+		require.Equal(t, "storage decode error", response.ReturnMessage)
+		require.Nil(t, response.Results)
+	})
+
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		blockInfo := data.BlockInfo{Nonce: 37, Hash: "hash"}
+		ap := createABIProcessor(t, &mock.SCQueryServiceStub{
+			ExecuteQueryCalled: func(query *data.SCQuery) (*vm.VMOutputApi, data.BlockInfo, error) {
+				require.Equal(t, "erd1address", query.ScAddress)
+				require.Equal(t, "getStatus", query.FuncName)
+				require.Equal(t, core.OptionalUint64{Value: 37, HasValue: true}, query.BlockNonce)
+				require.Empty(t, query.Arguments)
+
+				return &vm.VMOutputApi{
+					ReturnCode: "ok",
+					ReturnData: [][]byte{{1}},
+				}, blockInfo, nil
+			},
+		})
+		request := &data.ABIQueryRequest{
+			ABIName:   "sample",
+			ScAddress: "erd1address",
+			Endpoint:  "getStatus",
+		}
+		options := common.VmQueryOptions{
+			BlockNonce: core.OptionalUint64{Value: 37, HasValue: true},
+		}
+		response, actualBlockInfo, err := ap.ExecuteQuery(request, options)
+		require.Nil(t, err)
+		require.Equal(t, blockInfo, actualBlockInfo)
+		require.Equal(t, []interface{}{"Active"}, response.Results)
+	})
+}
+
+func TestABIProcessor_BuildCallData(t *testing.T) {
+	t.Parallel()
+
+	ap := createABIProcessor(t, &mock.SCQueryServiceStub{})
+
+	t.Run("invalid arguments should err", func(t *testing.T) {
+		t.Parallel()
+
+		request := &data.ABIArgumentsRequest{
+			ABIName:  "sample",
+			Endpoint: "add",
+			Args:     []json.RawMessage{json.RawMessage(`"-1"`)},
+		}
+		callData, err := ap.BuildCallData(request)
+		require.Empty(t, callData)
+		require.True(t, errors.Is(err, abi.ErrInvalidValue))
+	})
+
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		request := &data.ABIArgumentsRequest{
+			ABIName:  "sample",
+			Endpoint: "add",
+			Args:     []json.RawMessage{json.RawMessage(`"1000"`)},
+		}
+		args, err := ap.EncodeArguments(request)
+		require.Nil(t, err)
+		require.Equal(t, []string{"03e8"}, args)
+
+		callData, err := ap.BuildCallData(request)
+		require.Nil(t, err)
+		require.Equal(t, "add@03e8", callData)
+	})
+}
+
+func TestABIProcessor_DecodeResultsWithInlineABI(t *testing.T) {
+	t.Parallel()
+
+	inlineABI, err := os.ReadFile(sampleABIDirectory + "/sample.abi.json")
+	require.Nil(t, err)
+
+	ap := createABIProcessor(t, &mock.SCQueryServiceStub{})
+	request := &data.ABIResultsRequest{
+		ABIName:    "unknown",
+		ABI:        inlineABI,
+		Endpoint:   "getSum",
+		ReturnData: [][]byte{{3, 232}},
+	}
+	results, err := ap.DecodeResults(request)
+	require.Nil(t, err)
+	require.Equal(t, []interface{}{"1000"}, results)
+}
@@ -358,13 +358,13 @@ func (ap *AccountProcessor) GetGuardianData(address string, options common.Accou
 	return nil, ErrSendingRequest
 }
 
-// GetTransactions resolves the request and returns a slice of transaction for the specific address
-func (ap *AccountProcessor) GetTransactions(address string) ([]data.DatabaseTransaction, error) {
+// GetTransactions resolves the request and returns a page of transactions of the specific address, matching the filter
+func (ap *AccountProcessor) GetTransactions(address string, filter data.TransactionsHistoryFilter) (*data.TransactionsHistoryPage, error) {
 	if _, err := ap.pubKeyConverter.Decode(address); err != nil {
 		return nil, fmt.Errorf("%w, %v", ErrInvalidAddress, err)
 	}
 
-	return ap.connector.GetTransactionsByAddress(address)
+	return ap.connector.GetTransactionsByAddress(address, filter)
 }
 
 // GetCodeHash returns the code hash for a given address
@@ -297,16 +297,50 @@ func TestAccountProcessor_GetTransactions(t *testing.T) {
 		&mock.ElasticSearchConnectorMock{},
 	)
 
-	_, err := ap.GetTransactions("invalidAddress")
+	_, err := ap.GetTransactions("invalidAddress", data.TransactionsHistoryFilter{})
 	assert.True(t, errors.Is(err, process.ErrInvalidAddress))
 
-	_, err = ap.GetTransactions("")
+	_, err = ap.GetTransactions("", data.TransactionsHistoryFilter{})
 	assert.True(t, errors.Is(err, process.ErrInvalidAddress))
 
-	_, err = ap.GetTransactions("erd1ycega644rvjtgtyd8hfzt6hl5ymaa8ml2nhhs5cv045cz5vxm00q022myr")
+	_, err = ap.GetTransactions("erd1ycega644rvjtgtyd8hfzt6hl5ymaa8ml2nhhs5cv045cz5vxm00q022myr", data.TransactionsHistoryFilter{})
 	assert.Nil(t, err)
 }
 
+func TestAccountProcessor_GetTransactionsShouldForwardTheFilter(t *testing.T) {
+	t.Parallel()
+
+	address := "erd1ycega644rvjtgtyd8hfzt6hl5ymaa8ml2nhhs5cv045cz5vxm00q022myr"
+	providedFilter := data.TransactionsHistoryFilter{
+		Direction: data.TransactionsDirectionOut,
+		Order:     data.SortOrderAscending,
+		Limit:     5,
+		Cursor:    "cursor",
+	}
+	expectedPage := &data.TransactionsHistoryPage{
+		Transactions: []data.DatabaseTransaction{{Fee: "10"}},
+		NextCursor:   "next",
+	}
+
+	log := logger.GetOrCreate("test")
+	converter, _ := pubkeyConverter.NewBech32PubkeyConverter(32, log)
+	ap, _ := process.NewAccountProcessor(
+		&mock.ProcessorStub{},
+		converter,
+		&mock.ExternalStorageConnectorStub{
+			GetTransactionsByAddressCalled: func(addr string, filter data.TransactionsHistoryFilter) (*data.TransactionsHistoryPage, error) {
+				assert.Equal(t, address, addr)
+				assert.Equal(t, providedFilter, filter)
+				return expectedPage, nil
+			},
+		},
+	)
+
+	page, err := ap.GetTransactions(address, providedFilter)
+	assert.Nil(t, err)
+	assert.Equal(t, expectedPage, page)
+}
+
 func TestAccountProcessor_GetESDTsWithRoleGetObserversFails(t *testing.T) {
 	t.Parallel()
 
@@ -0,0 +1,132 @@
+package process
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-proxy-go/common"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+var atlasBlockQueryOptions = common.BlockQueryOptions{
+	WithTransactions: true,
+}
+
+// buildAtlasBlock builds the atlas block from the observers data. As for the hyperblocks, only the transactions executed
+// in their destination shard are included, a metablock also holding the transactions of its notarized shard blocks
+func (bp *BlockProcessor) buildAtlasBlock(shardID uint32, nonce uint64) (data.AtlasBlock, error) {
+	blockResponse, err := bp.GetBlockByNonce(shardID, nonce, atlasBlockQueryOptions)
+	if err != nil {
+		return data.AtlasBlock{}, err
+	}
+
+	block := blockResponse.Data.Block
+	builder := &hyperblockBuilder{}
+	builder.addMetaBlock(&block)
+
+	if shardID == core.MetachainShardId {
+		missingShards, errAdd := bp.addShardBlocks(block, builder, common.HyperblockQueryOptions{}, atlasBlockQueryOptions)
+		if errAdd != nil {
+			return data.AtlasBlock{}, errAdd
+		}
+		if len(missingShards) > 0 {
+			return data.AtlasBlock{}, fmt.Errorf("%w: missing blocks of shards %v", ErrIncompleteAtlasBlock, missingShards)
+		}
+	}
+
+	hyperblock := builder.build(false)
+	feeComputer := &atlasFeeComputer{proc: bp.proc}
+	transactions := make([]data.DatabaseTransaction, 0, len(hyperblock.Transactions))
+	for _, tx := range hyperblock.Transactions {
+		if tx == nil {
+			continue
+		}
+
+		timestamp := tx.Timestamp
+		if timestamp == 0 {
+			timestamp = int64(hyperblock.Timestamp)
+		}
+
+		databaseTx := convertApiTransaction(tx, timestamp)
+		if len(databaseTx.Fee) == 0 {
+			databaseTx.Fee, err = feeComputer.computeFee(tx)
+			if err != nil {
+				return data.AtlasBlock{}, err
+			}
+			databaseTx.Transaction.Fee = databaseTx.Fee
+		}
+
+		transactions = append(transactions, databaseTx)
+	}
+
+	return data.AtlasBlock{
+		Nonce:        hyperblock.Nonce,
+		Hash:         hyperblock.Hash,
+		Transactions: transactions,
+	}, nil
+}
+
+// atlasFeeComputer computes the fees of the transactions whose fee is not provided by the observers. The network config
+// is only fetched if needed, once per block
+type atlasFeeComputer struct {
+	proc             Processor
+	networkConfig    *data.NetworkConfig
+	gasPriceModifier float64
+}
+
+func (computer *atlasFeeComputer) computeFee(tx *transaction.ApiTransactionResult) (string, error) {
+	if computer.networkConfig == nil {
+		networkConfig, err := getNetworkConfig(computer.proc)
+		if err != nil {
+			return "", err
+		}
+
+		gasPriceModifier, err := parseGasPriceModifier(networkConfig.Config.GasPriceModifier.String())
+		if err != nil {
+			return "", err
+		}
+
+		computer.networkConfig = networkConfig
+		computer.gasPriceModifier = gasPriceModifier
+	}
+
+	// without the gas used, the gas limit is considered, the same way the initially paid fee is computed
+	gasUnits := tx.GasUsed
+	if gasUnits == 0 {
+		gasUnits = tx.GasLimit
+	}
+
+	moveBalanceGasUnits := computeMoveBalanceGasUnits(&data.Transaction{
+		Data:         tx.Data,
+		GuardianAddr: tx.GuardianAddr,
+	}, &data.TxCostGasConfigs{
+		MinGasLimit:            computer.networkConfig.Config.MinGasLimit,
+		GasPerDataByte:         computer.networkConfig.Config.GasPerDataByte,
+		ExtraGasLimitGuardedTx: computer.networkConfig.Config.ExtraGasLimitGuardedTx,
+	})
+	fee := computeTransactionFee(gasUnits, moveBalanceGasUnits, tx.GasPrice, computer.gasPriceModifier)
+
+	return fee.String(), nil
+}
+
+func getNetworkConfig(proc Processor) (*data.NetworkConfig, error) {
+	observers, err := proc.GetAllObservers()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, observer := range observers {
+		response := &data.NetworkConfigApiResponse{}
+		respCode, err := proc.CallGetRestEndPoint(observer.Address, NetworkConfigPath, response)
+		if err != nil || respCode != http.StatusOK {
+			log.Trace("cannot get the network config", "observer", observer.Address, "error", err)
+			continue
+		}
+
+		return &response.Data, nil
+	}
+
+	return nil, ErrSendingRequest
+}
@@ -11,6 +11,7 @@ import (
 	"net/http"
 	"strconv"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/multiversx/mx-chain-core-go/core"
@@ -38,15 +39,25 @@ type BaseProcessor struct {
 	observersProvider              observer.NodesProviderHandler
 	fullHistoryNodesProvider       observer.NodesProviderHandler
 	pubKeyConverter                core.PubkeyConverter
+	shardsFanOut                   ShardsFanOutHandler
 	shardIDs                       []uint32
 	nodeStatusFetcher              func(url string) (*proxyData.NodeStatusAPIResponse, int, error)
 	chanTriggerNodesState          chan struct{}
 	delayForCheckingNodesSyncState time.Duration
 	cancelFunc                     func()
+	getRequestsCoalescer           *requestsCoalescer
+	numUpstreamGetCalls            uint64
+	numDeduplicatedGetCalls        uint64
 
 	httpClient *http.Client
 }
 
+type getRequestResponse struct {
+	statusCode int
+	body       []byte
+	err        error
+}
+
 // NewBaseProcessor creates a new instance of BaseProcessor struct
 func NewBaseProcessor(
 	requestTimeoutSec int,
@@ -54,6 +65,7 @@ func NewBaseProcessor(
 	observersProvider observer.NodesProviderHandler,
 	fullHistoryNodesProvider observer.NodesProviderHandler,
 	pubKeyConverter core.PubkeyConverter,
+	shardsFanOut ShardsFanOutHandler,
 ) (*BaseProcessor, error) {
 	if check.IfNil(shardCoord) {
 		return nil, ErrNilShardCoordinator
@@ -70,6 +82,9 @@ func NewBaseProcessor(
 	if check.IfNil(pubKeyConverter) {
 		return nil, ErrNilPubKeyConverter
 	}
+	if check.IfNil(shardsFanOut) {
+		return nil, ErrNilShardsFanOut
+	}
 
 	httpClient := http.DefaultClient
 	mutHttpClient.Lock()
@@ -82,9 +97,11 @@ func NewBaseProcessor(
 		fullHistoryNodesProvider:       fullHistoryNodesProvider,
 		httpClient:                     httpClient,
 		pubKeyConverter:                pubKeyConverter,
+		shardsFanOut:                   shardsFanOut,
 		shardIDs:                       computeShardIDs(shardCoord),
 		delayForCheckingNodesSyncState: stepDelayForCheckingNodesSyncState,
 		chanTriggerNodesState:          make(chan struct{}),
+		getRequestsCoalescer:           newRequestsCoalescer(),
 	}
 	bp.nodeStatusFetcher = bp.getNodeStatusResponseFromAPI
 
@@ -109,6 +126,22 @@ func (bp *BaseProcessor) GetShardIDs() []uint32 {
 	return bp.shardIDs
 }
 
+// QueryShards will query the provided shards concurrently, applying the configured partial results policy
+func (bp *BaseProcessor) QueryShards(shardIDs []uint32, queryHandler proxyData.ShardQueryHandler) (*proxyData.ShardsQueryResponse, error) {
+	return bp.shardsFanOut.QueryShards(shardIDs, queryHandler)
+}
+
+// QueryShardsStrict will query the provided shards concurrently and will fail if at least one shard did not respond
+func (bp *BaseProcessor) QueryShardsStrict(shardIDs []uint32, queryHandler proxyData.ShardQueryHandler) (*proxyData.ShardsQueryResponse, error) {
+	return bp.shardsFanOut.QueryShardsStrict(shardIDs, queryHandler)
+}
+
+// QueryShardsBestEffort will query the provided shards concurrently and will return the available responses alongside
+// the missing shards, regardless of the configured policy
+func (bp *BaseProcessor) QueryShardsBestEffort(shardIDs []uint32, queryHandler proxyData.ShardQueryHandler) *proxyData.ShardsQueryResponse {
+	return bp.shardsFanOut.QueryShardsBestEffort(shardIDs, queryHandler)
+}
+
 // ReloadObservers will call the nodes reloading from the observers provider
 func (bp *BaseProcessor) ReloadObservers() proxyData.NodesReloadResponse {
 	return bp.observersProvider.ReloadNodes(proxyData.Observer)
@@ -184,18 +217,52 @@ func (bp *BaseProcessor) ComputeShardId(addressBuff []byte) (uint32, error) {
 	return bp.shardCoordinator.ComputeId(addressBuff), nil
 }
 
-// CallGetRestEndPoint calls an external end point (sends a request on a node)
+// CallGetRestEndPoint calls an external end point (sends a request on a node). Concurrent calls requesting the same
+// path from the same node are coalesced, so they share a single call to the node and its response
 func (bp *BaseProcessor) CallGetRestEndPoint(
 	address string,
 	path string,
 	value interface{},
 ) (int, error) {
+	key := createGetRequestKey(address, path)
+	result, isShared, err := bp.getRequestsCoalescer.do(key, func() (interface{}, error) {
+		atomic.AddUint64(&bp.numUpstreamGetCalls, 1)
+		return bp.doGetRequest(address, path), nil
+	})
+	if isShared {
+		atomic.AddUint64(&bp.numDeduplicatedGetCalls, 1)
+	}
+	if err != nil {
+		return http.StatusInternalServerError, err
+	}
 
-	req, err := http.NewRequest("GET", address+path, nil)
+	response, ok := result.(*getRequestResponse)
+	if !ok {
+		return http.StatusInternalServerError, ErrInvalidCoalescedResult
+	}
+	if response.err != nil {
+		return response.statusCode, response.err
+	}
+
+	err = json.Unmarshal(response.body, value)
 	if err != nil {
 		return http.StatusInternalServerError, err
 	}
 
+	if response.statusCode == http.StatusOK { // everything ok, return status ok and the expected response
+		return response.statusCode, nil
+	}
+
+	// status response not ok, return the error
+	return response.statusCode, errors.New(string(response.body))
+}
+
+func (bp *BaseProcessor) doGetRequest(address string, path string) *getRequestResponse {
+	req, err := http.NewRequest("GET", address+path, nil)
+	if err != nil {
+		return &getRequestResponse{statusCode: http.StatusInternalServerError, err: err}
+	}
+
 	userAgent := "Multiversx Proxy / 1.0.0 <Requesting data from nodes>"
 	req.Header.Set("Accept", "application/json")
 	req.Header.Set("User-Agent", userAgent)
@@ -204,11 +271,11 @@ func (bp *BaseProcessor) CallGetRestEndPoint(
 	if err != nil {
 		if isTimeoutError(err) {
 			bp.triggerNodesSyncCheck(address)
-			return http.StatusRequestTimeout, err
+			return &getRequestResponse{statusCode: http.StatusRequestTimeout, err: err}
 		}
 
 		bp.triggerNodesSyncCheck(address)
-		return http.StatusNotFound, err
+		return &getRequestResponse{statusCode: http.StatusNotFound, err: err}
 	}
 
 	defer func() {
@@ -220,21 +287,28 @@ func (bp *BaseProcessor) CallGetRestEndPoint(
 
 	responseBodyBytes, err := ioutil.ReadAll(resp.Body)
 	if err != nil {
-		return http.StatusInternalServerError, err
+		return &getRequestResponse{statusCode: http.StatusInternalServerError, err: err}
 	}
 
-	err = json.Unmarshal(responseBodyBytes, value)
-	if err != nil {
-		return http.StatusInternalServerError, err
+	return &getRequestResponse{
+		statusCode: resp.StatusCode,
+		body:       responseBodyBytes,
 	}
+}
 
-	responseStatusCode := resp.StatusCode
-	if responseStatusCode == http.StatusOK { // everything ok, return status ok and the expected response
-		return responseStatusCode, nil
-	}
+// createGetRequestKey returns the key under which the GET requests are coalesced. Only the requests towards the same
+// node share the key, so a caller asking a given node always gets that node's response
+func createGetRequestKey(address string, path string) string {
+	return address + path
+}
 
-	// status response not ok, return the error
-	return responseStatusCode, errors.New(string(responseBodyBytes))
+// GetRequestsCoalescingMetrics returns the number of GET requests sent to the nodes and the number of GET requests that
+// were served by sharing the response of an identical in-flight request
+func (bp *BaseProcessor) GetRequestsCoalescingMetrics() proxyData.RequestsCoalescingMetrics {
+	return proxyData.RequestsCoalescingMetrics{
+		NumUpstreamCalls:     atomic.LoadUint64(&bp.numUpstreamGetCalls),
+		NumDeduplicatedCalls: atomic.LoadUint64(&bp.numDeduplicatedGetCalls),
+	}
 }
 
 // CallPostRestEndPoint calls an external end point (sends a request on a node)
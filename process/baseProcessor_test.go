@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"sync"
 	"sync/atomic"
 	"testing"
 	"time"
@@ -53,6 +54,7 @@ func TestNewBaseProcessor_WithInvalidRequestTimeoutShouldErr(t *testing.T) {
 		&mock.ObserversProviderStub{},
 		&mock.ObserversProviderStub{},
 		&mock.PubKeyConverterMock{},
+		&mock.ShardsFanOutStub{},
 	)
 
 	assert.Nil(t, bp)
@@ -68,6 +70,7 @@ func TestNewBaseProcessor_WithNilShardCoordinatorShouldErr(t *testing.T) {
 		&mock.ObserversProviderStub{},
 		&mock.ObserversProviderStub{},
 		&mock.PubKeyConverterMock{},
+		&mock.ShardsFanOutStub{},
 	)
 
 	assert.Nil(t, bp)
@@ -83,6 +86,7 @@ func TestNewBaseProcessor_WithNilObserversProviderShouldErr(t *testing.T) {
 		&mock.ObserversProviderStub{},
 		nil,
 		&mock.PubKeyConverterMock{},
+		&mock.ShardsFanOutStub{},
 	)
 
 	assert.Nil(t, bp)
@@ -98,12 +102,29 @@ func TestNewBaseProcessor_WithNilFullHistoryNodesProviderShouldErr(t *testing.T)
 		nil,
 		&mock.ObserversProviderStub{},
 		&mock.PubKeyConverterMock{},
+		&mock.ShardsFanOutStub{},
 	)
 
 	assert.Nil(t, bp)
 	assert.True(t, errors.Is(err, process.ErrNilNodesProvider))
 }
 
+func TestNewBaseProcessor_WithNilShardsFanOutShouldErr(t *testing.T) {
+	t.Parallel()
+
+	bp, err := process.NewBaseProcessor(
+		5,
+		&mock.ShardCoordinatorMock{},
+		&mock.ObserversProviderStub{},
+		&mock.ObserversProviderStub{},
+		&mock.PubKeyConverterMock{},
+		nil,
+	)
+
+	assert.Nil(t, bp)
+	assert.Equal(t, process.ErrNilShardsFanOut, err)
+}
+
 func TestNewBaseProcessor_WithOkValuesShouldWork(t *testing.T) {
 	t.Parallel()
 
@@ -113,6 +134,7 @@ func TestNewBaseProcessor_WithOkValuesShouldWork(t *testing.T) {
 		&mock.ObserversProviderStub{},
 		&mock.ObserversProviderStub{},
 		&mock.PubKeyConverterMock{},
+		&mock.ShardsFanOutStub{},
 	)
 
 	assert.NotNil(t, bp)
@@ -135,6 +157,7 @@ func TestBaseProcessor_GetObserversEmptyListShouldWork(t *testing.T) {
 		},
 		&mock.ObserversProviderStub{},
 		&mock.PubKeyConverterMock{},
+		&mock.ShardsFanOutStub{},
 	)
 	observers, err := bp.GetObservers(0)
 
@@ -169,6 +192,7 @@ func TestBaseProcessor_ComputeShardId(t *testing.T) {
 		},
 		&mock.ObserversProviderStub{},
 		&mock.PubKeyConverterMock{},
+		&mock.ShardsFanOutStub{},
 	)
 
 	//there are 2 shards, compute ID should correctly process
@@ -203,6 +227,7 @@ func TestBaseProcessor_CallGetRestEndPoint(t *testing.T) {
 		&mock.ObserversProviderStub{},
 		&mock.ObserversProviderStub{},
 		&mock.PubKeyConverterMock{},
+		&mock.ShardsFanOutStub{},
 	)
 	_, err := bp.CallGetRestEndPoint(server.URL, "/some/path", tsRecovered)
 
@@ -231,6 +256,7 @@ func TestBaseProcessor_CallGetRestEndPointShouldTimeout(t *testing.T) {
 		&mock.ObserversProviderStub{},
 		&mock.ObserversProviderStub{},
 		&mock.PubKeyConverterMock{},
+		&mock.ShardsFanOutStub{},
 	)
 	_, err := bp.CallGetRestEndPoint(testServer.URL, "/some/path", tsRecovered)
 
@@ -238,6 +264,114 @@ func TestBaseProcessor_CallGetRestEndPointShouldTimeout(t *testing.T) {
 	assert.NotNil(t, err)
 }
 
+func TestBaseProcessor_CallGetRestEndPointShouldCoalesceConcurrentCalls(t *testing.T) {
+	t.Parallel()
+
+	ts := &testStruct{
+		Nonce: 10000,
+		Name:  "a test struct to be sent and received",
+	}
+	response, _ := json.Marshal(ts)
+
+	numServerCalls := uint32(0)
+	testServer := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		atomic.AddUint32(&numServerCalls, 1)
+		time.Sleep(200 * time.Millisecond)
+		_, _ = rw.Write(response)
+	}))
+	defer testServer.Close()
+
+	bp, _ := process.NewBaseProcessor(
+		5,
+		&mock.ShardCoordinatorMock{},
+		&mock.ObserversProviderStub{
+			GetAllNodesWithSyncStateCalled: func() []*data.NodeData {
+				return []*data.NodeData{{Address: testServer.URL, ShardId: 0}}
+			},
+		},
+		&mock.ObserversProviderStub{},
+		&mock.PubKeyConverterMock{},
+		&mock.ShardsFanOutStub{},
+	)
+
+	numCalls := 10
+	wg := sync.WaitGroup{}
+	wg.Add(numCalls)
+	for i := 0; i < numCalls; i++ {
+		go func() {
+			defer wg.Done()
+
+			tsRecovered := &testStruct{}
+			_, err := bp.CallGetRestEndPoint(testServer.URL, "/some/path", tsRecovered)
+			assert.Nil(t, err)
+			assert.Equal(t, ts, tsRecovered)
+		}()
+	}
+	wg.Wait()
+
+	require.Equal(t, uint32(1), atomic.LoadUint32(&numServerCalls))
+	metrics := bp.GetRequestsCoalescingMetrics()
+	require.Equal(t, uint64(1), metrics.NumUpstreamCalls)
+	require.Equal(t, uint64(numCalls-1), metrics.NumDeduplicatedCalls)
+
+	// sequential calls are not coalesced
+	_, err := bp.CallGetRestEndPoint(testServer.URL, "/some/path", &testStruct{})
+	require.Nil(t, err)
+	require.Equal(t, uint32(2), atomic.LoadUint32(&numServerCalls))
+	require.Equal(t, uint64(2), bp.GetRequestsCoalescingMetrics().NumUpstreamCalls)
+}
+
+func TestBaseProcessor_CallGetRestEndPointShouldNotCoalesceCallsToDifferentNodes(t *testing.T) {
+	t.Parallel()
+
+	numServerCalls := uint32(0)
+	createServer := func(name string) *httptest.Server {
+		response, _ := json.Marshal(&testStruct{Name: name})
+		return httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+			atomic.AddUint32(&numServerCalls, 1)
+			time.Sleep(200 * time.Millisecond)
+			_, _ = rw.Write(response)
+		}))
+	}
+	firstServer := createServer("first")
+	defer firstServer.Close()
+	secondServer := createServer("second")
+	defer secondServer.Close()
+
+	bp, _ := process.NewBaseProcessor(
+		5,
+		&mock.ShardCoordinatorMock{},
+		&mock.ObserversProviderStub{
+			GetAllNodesWithSyncStateCalled: func() []*data.NodeData {
+				return []*data.NodeData{
+					{Address: firstServer.URL, ShardId: 0},
+					{Address: secondServer.URL, ShardId: 0},
+				}
+			},
+		},
+		&mock.ObserversProviderStub{},
+		&mock.PubKeyConverterMock{},
+		&mock.ShardsFanOutStub{},
+	)
+
+	wg := sync.WaitGroup{}
+	wg.Add(2)
+	callNode := func(address string, expectedName string) {
+		defer wg.Done()
+
+		tsRecovered := &testStruct{}
+		_, err := bp.CallGetRestEndPoint(address, "/some/path", tsRecovered)
+		assert.Nil(t, err)
+		assert.Equal(t, expectedName, tsRecovered.Name)
+	}
+	go callNode(firstServer.URL, "first")
+	go callNode(secondServer.URL, "second")
+	wg.Wait()
+
+	require.Equal(t, uint32(2), atomic.LoadUint32(&numServerCalls))
+	require.Equal(t, uint64(0), bp.GetRequestsCoalescingMetrics().NumDeduplicatedCalls)
+}
+
 func TestBaseProcessor_CallPostRestEndPoint(t *testing.T) {
 	ts := &testStruct{
 		Nonce: 10000,
@@ -255,6 +389,7 @@ func TestBaseProcessor_CallPostRestEndPoint(t *testing.T) {
 		&mock.ObserversProviderStub{},
 		&mock.ObserversProviderStub{},
 		&mock.PubKeyConverterMock{},
+		&mock.ShardsFanOutStub{},
 	)
 	rc, err := bp.CallPostRestEndPoint(server.URL, "/some/path", ts, tsRecv)
 
@@ -285,6 +420,7 @@ func TestBaseProcessor_CallPostRestEndPointShouldTimeout(t *testing.T) {
 		&mock.ObserversProviderStub{},
 		&mock.ObserversProviderStub{},
 		&mock.PubKeyConverterMock{},
+		&mock.ShardsFanOutStub{},
 	)
 	rc, err := bp.CallPostRestEndPoint(testServer.URL, "/some/path", ts, tsRecv)
 
@@ -325,6 +461,7 @@ func TestBaseProcessor_GetAllObserversWithOkValuesShouldPass(t *testing.T) {
 		},
 		&mock.ObserversProviderStub{},
 		&mock.PubKeyConverterMock{},
+		&mock.ShardsFanOutStub{},
 	)
 
 	assert.Nil(t, err)
@@ -375,6 +512,7 @@ func TestBaseProcessor_GetObserversOnePerShardShouldWork(t *testing.T) {
 		},
 		&mock.ObserversProviderStub{},
 		&mock.PubKeyConverterMock{},
+		&mock.ShardsFanOutStub{},
 	)
 
 	observers, err := bp.GetObserversOnePerShard()
@@ -423,6 +561,7 @@ func TestBaseProcessor_GetObserversOnePerShardOneShardHasNoObserverShouldWork(t
 		},
 		&mock.ObserversProviderStub{},
 		&mock.PubKeyConverterMock{},
+		&mock.ShardsFanOutStub{},
 	)
 
 	observers, err := bp.GetObserversOnePerShard()
@@ -471,6 +610,7 @@ func TestBaseProcessor_GetObserversOnePerShardMetachainHasNoObserverShouldWork(t
 		},
 		&mock.ObserversProviderStub{},
 		&mock.PubKeyConverterMock{},
+		&mock.ShardsFanOutStub{},
 	)
 
 	observers, err := bp.GetObserversOnePerShard()
@@ -523,6 +663,7 @@ func TestBaseProcessor_GetFullHistoryNodesOnePerShardShouldWork(t *testing.T) {
 			},
 		},
 		&mock.PubKeyConverterMock{},
+		&mock.ShardsFanOutStub{},
 	)
 
 	observers, err := bp.GetFullHistoryNodesOnePerShard()
@@ -543,6 +684,7 @@ func TestBaseProcessor_GetShardIDs(t *testing.T) {
 		&mock.ObserversProviderStub{},
 		&mock.ObserversProviderStub{},
 		&mock.PubKeyConverterMock{},
+		&mock.ShardsFanOutStub{},
 	)
 
 	expected := []uint32{0, 1, 2, core.MetachainShardId}
@@ -570,6 +712,7 @@ func TestBaseProcessor_HandleNodesSyncStateShouldSetNodeOutOfSyncIfVMQueriesNotR
 		},
 		&mock.ObserversProviderStub{},
 		&mock.PubKeyConverterMock{},
+		&mock.ShardsFanOutStub{},
 	)
 
 	bp.SetNodeStatusFetcher(func(url string) (*data.NodeStatusAPIResponse, int, error) {
@@ -630,6 +773,7 @@ func TestBaseProcessor_HandleNodesSyncStateShouldTreatObserverThatWasDown(t *tes
 		},
 		&mock.ObserversProviderStub{},
 		&mock.PubKeyConverterMock{},
+		&mock.ShardsFanOutStub{},
 	)
 
 	bp.SetNodeStatusFetcher(func(url string) (*data.NodeStatusAPIResponse, int, error) {
@@ -691,6 +835,7 @@ func TestBaseProcessor_HandleNodesSyncStateShouldBeTriggeredEarlierIfANodeIsOffl
 		},
 		&mock.ObserversProviderStub{},
 		&mock.PubKeyConverterMock{},
+		&mock.ShardsFanOutStub{},
 	)
 
 	bp.SetNodeStatusFetcher(func(url string) (*data.NodeStatusAPIResponse, int, error) {
@@ -739,6 +884,7 @@ func TestBaseProcessor_HandleNodesSyncStateShouldConsiderNodeAsOnlineIfProbableN
 		},
 		&mock.ObserversProviderStub{},
 		&mock.PubKeyConverterMock{},
+		&mock.ShardsFanOutStub{},
 	)
 
 	bp.SetNodeStatusFetcher(func(url string) (*data.NodeStatusAPIResponse, int, error) {
@@ -798,6 +944,7 @@ func TestBaseProcessor_HandleNodesSyncState(t *testing.T) {
 			},
 		},
 		&mock.PubKeyConverterMock{},
+		&mock.ShardsFanOutStub{},
 	)
 
 	bp.SetNodeStatusFetcher(func(url string) (*data.NodeStatusAPIResponse, int, error) {
@@ -1,6 +1,7 @@
 package process
 
 import (
+	"errors"
 	"fmt"
 
 	"github.com/multiversx/mx-chain-core-go/core"
@@ -37,28 +38,41 @@ const (
 
 // BlockProcessor handles blocks retrieving
 type BlockProcessor struct {
-	proc     Processor
-	dbReader ExternalStorageConnector
+	proc             Processor
+	dbReader         ExternalStorageConnector
+	shardBlocksCache ShardBlocksCache
 }
 
 // NewBlockProcessor will create a new block processor
-func NewBlockProcessor(dbReader ExternalStorageConnector, proc Processor) (*BlockProcessor, error) {
+func NewBlockProcessor(dbReader ExternalStorageConnector, proc Processor, shardBlocksCache ShardBlocksCache) (*BlockProcessor, error) {
 	if check.IfNil(dbReader) {
 		return nil, ErrNilDatabaseConnector
 	}
 	if check.IfNil(proc) {
 		return nil, ErrNilCoreProcessor
 	}
+	if check.IfNil(shardBlocksCache) {
+		return nil, ErrNilShardBlocksCache
+	}
 
 	return &BlockProcessor{
-		dbReader: dbReader,
-		proc:     proc,
+		dbReader:         dbReader,
+		proc:             proc,
+		shardBlocksCache: shardBlocksCache,
 	}, nil
 }
 
-// GetAtlasBlockByShardIDAndNonce return the block byte shardID and nonce
+// GetAtlasBlockByShardIDAndNonce return the block byte shardID and nonce. When the history backend is disabled or does
+// not hold the block, such as the shard blocks, as the ingested hyperblocks are only stored as metachain blocks, the
+// block is built from the observers data
 func (bp *BlockProcessor) GetAtlasBlockByShardIDAndNonce(shardID uint32, nonce uint64) (data.AtlasBlock, error) {
-	return bp.dbReader.GetAtlasBlockByShardIDAndNonce(shardID, nonce)
+	atlasBlock, err := bp.dbReader.GetAtlasBlockByShardIDAndNonce(shardID, nonce)
+	isMissingFromHistory := errors.Is(err, data.ErrDatabaseConnectionIsDisabled) || errors.Is(err, data.ErrAtlasBlockNotFound)
+	if !isMissingFromHistory {
+		return atlasBlock, err
+	}
+
+	return bp.buildAtlasBlock(shardID, nonce)
 }
 
 // GetBlockByHash will return the block based on its hash
@@ -139,13 +153,16 @@ func (bp *BlockProcessor) GetHyperBlockByHash(hash string, options common.Hyperb
 	metaBlock := metaBlockResponse.Data.Block
 	builder.addMetaBlock(&metaBlock)
 
-	err = bp.addShardBlocks(metaBlock, builder, options, blockQueryOptions)
+	missingShards, err := bp.addShardBlocks(metaBlock, builder, options, blockQueryOptions)
 	if err != nil {
 		return nil, err
 	}
 
 	hyperblock := builder.build(options.NotarizedAtSource)
-	return data.NewHyperblockApiResponse(hyperblock), nil
+	response := data.NewHyperblockApiResponse(hyperblock)
+	response.Data.MissingShards = missingShards
+
+	return response, nil
 }
 
 func (bp *BlockProcessor) addShardBlocks(
@@ -153,25 +170,84 @@ func (bp *BlockProcessor) addShardBlocks(
 	builder *hyperblockBuilder,
 	options common.HyperblockQueryOptions,
 	blockQueryOptions common.BlockQueryOptions,
-) error {
+) ([]uint32, error) {
+	shardIDs := make([]uint32, 0, len(metaBlock.NotarizedBlocks))
 	for _, notarizedBlock := range metaBlock.NotarizedBlocks {
-		shardBlockResponse, err := bp.GetBlockByHash(notarizedBlock.Shard, notarizedBlock.Hash, blockQueryOptions)
-		if err != nil {
-			return err
-		}
+		shardIDs = append(shardIDs, notarizedBlock.Shard)
+	}
 
-		alteredAccounts, err := bp.getAlteredAccountsIfNeeded(options, notarizedBlock)
-		if err != nil {
-			return err
+	shardsResponse, err := bp.proc.QueryShards(shardIDs, func(index int, _ uint32) (interface{}, error) {
+		return bp.getShardBlockWithAlteredAccounts(metaBlock.NotarizedBlocks[index], options, blockQueryOptions)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// the responses are in the same order as the notarized blocks, so the hyperblock contents are deterministic
+	for _, response := range shardsResponse.Responses {
+		shardBlock, ok := response.(*shardBlockWithAlteredAccounts)
+		if !ok {
+			continue
 		}
 
-		builder.addShardBlock(&shardBlockWithAlteredAccounts{
-			shardBlock:      &shardBlockResponse.Data.Block,
-			alteredAccounts: alteredAccounts,
-		})
+		builder.addShardBlock(shardBlock)
 	}
 
-	return nil
+	return shardsResponse.MissingShards, nil
+}
+
+// getShardBlockWithAlteredAccounts fetches the notarized shard block and, if requested, its altered accounts in parallel.
+// The result is shared through the shard blocks cache with the neighbouring hyperblocks notarizing the same block, so it
+// must not be altered
+func (bp *BlockProcessor) getShardBlockWithAlteredAccounts(
+	notarizedBlock *api.NotarizedBlock,
+	options common.HyperblockQueryOptions,
+	blockQueryOptions common.BlockQueryOptions,
+) (*shardBlockWithAlteredAccounts, error) {
+	key := fmt.Sprintf("%d_%s_%t_%t_%t_%s", notarizedBlock.Shard, notarizedBlock.Hash, blockQueryOptions.WithTransactions,
+		blockQueryOptions.WithLogs, options.WithAlteredAccounts, options.AlteredAccountsOptions.TokensFilter)
+
+	value, err := bp.shardBlocksCache.GetOrFetch(key, func() (interface{}, error) {
+		return bp.fetchShardBlockWithAlteredAccounts(notarizedBlock, options, blockQueryOptions)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	shardBlock, ok := value.(*shardBlockWithAlteredAccounts)
+	if !ok {
+		return nil, ErrInvalidShardBlock
+	}
+
+	return shardBlock, nil
+}
+
+func (bp *BlockProcessor) fetchShardBlockWithAlteredAccounts(
+	notarizedBlock *api.NotarizedBlock,
+	options common.HyperblockQueryOptions,
+	blockQueryOptions common.BlockQueryOptions,
+) (*shardBlockWithAlteredAccounts, error) {
+	var alteredAccounts []*outport.AlteredAccount
+	var errAlteredAccounts error
+	alteredAccountsDone := make(chan struct{})
+	go func() {
+		alteredAccounts, errAlteredAccounts = bp.getAlteredAccountsIfNeeded(options, notarizedBlock)
+		close(alteredAccountsDone)
+	}()
+
+	shardBlockResponse, err := bp.GetBlockByHash(notarizedBlock.Shard, notarizedBlock.Hash, blockQueryOptions)
+	<-alteredAccountsDone
+	if err != nil {
+		return nil, err
+	}
+	if errAlteredAccounts != nil {
+		return nil, errAlteredAccounts
+	}
+
+	return &shardBlockWithAlteredAccounts{
+		shardBlock:      &shardBlockResponse.Data.Block,
+		alteredAccounts: alteredAccounts,
+	}, nil
 }
 
 func (bp *BlockProcessor) getAlteredAccountsIfNeeded(options common.HyperblockQueryOptions, notarizedBlock *api.NotarizedBlock) ([]*outport.AlteredAccount, error) {
@@ -205,13 +281,16 @@ func (bp *BlockProcessor) GetHyperBlockByNonce(nonce uint64, options common.Hype
 	metaBlock := metaBlockResponse.Data.Block
 	builder.addMetaBlock(&metaBlock)
 
-	err = bp.addShardBlocks(metaBlock, builder, options, blockQueryOptions)
+	missingShards, err := bp.addShardBlocks(metaBlock, builder, options, blockQueryOptions)
 	if err != nil {
 		return nil, err
 	}
 
 	hyperblock := builder.build(options.NotarizedAtSource)
-	return data.NewHyperblockApiResponse(hyperblock), nil
+	response := data.NewHyperblockApiResponse(hyperblock)
+	response.Data.MissingShards = missingShards
+
+	return response, nil
 }
 
 // GetInternalBlockByHash will return the internal block based on its hash
@@ -457,3 +536,8 @@ func (bp *BlockProcessor) GetAlteredAccountsByHash(shardID uint32, hash string,
 
 	return nil, ErrSendingRequest
 }
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (bp *BlockProcessor) IsInterfaceNil() bool {
+	return bp == nil
+}
@@ -3,8 +3,11 @@ package process_test
 import (
 	"errors"
 	"fmt"
+	"net/http"
 	"strings"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/data/api"
@@ -21,7 +24,7 @@ import (
 func TestNewBlockProcessor_NilExternalStorageConnectorShouldErr(t *testing.T) {
 	t.Parallel()
 
-	bp, err := process.NewBlockProcessor(nil, &mock.ProcessorStub{})
+	bp, err := process.NewBlockProcessor(nil, &mock.ProcessorStub{}, &mock.ShardBlocksCacheStub{})
 	require.Nil(t, bp)
 	require.Equal(t, process.ErrNilDatabaseConnector, err)
 }
@@ -29,15 +32,23 @@ func TestNewBlockProcessor_NilExternalStorageConnectorShouldErr(t *testing.T) {
 func TestNewBlockProcessor_NilProcessorShouldErr(t *testing.T) {
 	t.Parallel()
 
-	bp, err := process.NewBlockProcessor(&mock.ExternalStorageConnectorStub{}, nil)
+	bp, err := process.NewBlockProcessor(&mock.ExternalStorageConnectorStub{}, nil, &mock.ShardBlocksCacheStub{})
 	require.Nil(t, bp)
 	require.Equal(t, process.ErrNilCoreProcessor, err)
 }
 
+func TestNewBlockProcessor_NilShardBlocksCacheShouldErr(t *testing.T) {
+	t.Parallel()
+
+	bp, err := process.NewBlockProcessor(&mock.ExternalStorageConnectorStub{}, &mock.ProcessorStub{}, nil)
+	require.Nil(t, bp)
+	require.Equal(t, process.ErrNilShardBlocksCache, err)
+}
+
 func TestNewBlockProcessor_ShouldWork(t *testing.T) {
 	t.Parallel()
 
-	bp, err := process.NewBlockProcessor(&mock.ExternalStorageConnectorStub{}, &mock.ProcessorStub{})
+	bp, err := process.NewBlockProcessor(&mock.ExternalStorageConnectorStub{}, &mock.ProcessorStub{}, &mock.ShardBlocksCacheStub{})
 	require.NotNil(t, bp)
 	require.NoError(t, err)
 }
@@ -45,7 +56,7 @@ func TestNewBlockProcessor_ShouldWork(t *testing.T) {
 func TestBlockProcessor_GetAtlasBlockByShardIDAndNonce(t *testing.T) {
 	t.Parallel()
 
-	bp, _ := process.NewBlockProcessor(&mock.ExternalStorageConnectorStub{}, &mock.ProcessorStub{})
+	bp, _ := process.NewBlockProcessor(&mock.ExternalStorageConnectorStub{}, &mock.ProcessorStub{}, &mock.ShardBlocksCacheStub{})
 	require.NotNil(t, bp)
 
 	res, err := bp.GetAtlasBlockByShardIDAndNonce(0, 1)
@@ -53,6 +64,149 @@ func TestBlockProcessor_GetAtlasBlockByShardIDAndNonce(t *testing.T) {
 	require.NotNil(t, res)
 }
 
+func TestBlockProcessor_GetAtlasBlockByShardIDAndNonceFromObservers(t *testing.T) {
+	t.Parallel()
+
+	disabledConnector := &mock.ExternalStorageConnectorStub{
+		GetAtlasBlockByShardIDAndNonceCalled: func(shardID uint32, nonce uint64) (data.AtlasBlock, error) {
+			return data.AtlasBlock{}, data.ErrDatabaseConnectionIsDisabled
+		},
+	}
+	createProcessor := func(shardBlockCallErr error) *mock.ProcessorStub {
+		return &mock.ProcessorStub{
+			GetObserversCalled: func(shardId uint32) ([]*data.NodeData, error) {
+				return []*data.NodeData{{ShardId: shardId, Address: "observerAddress"}}, nil
+			},
+			GetAllObserversCalled: func() ([]*data.NodeData, error) {
+				return []*data.NodeData{{ShardId: 0, Address: "observerAddress"}}, nil
+			},
+			CallGetRestEndPointCalled: func(address string, path string, value interface{}) (int, error) {
+				switch path {
+				case "/block/by-nonce/7?withTxs=true":
+					ret := value.(*data.BlockApiResponse)
+					ret.Data.Block = api.Block{
+						Nonce:     7,
+						Hash:      "metaHash",
+						Shard:     core.MetachainShardId,
+						Timestamp: 1000,
+						NotarizedBlocks: []*api.NotarizedBlock{
+							{Shard: 1, Hash: "hash1"},
+							{Shard: 2, Hash: "hash2"},
+						},
+					}
+				case "/block/by-hash/hash2?withTxs=true":
+					ret := value.(*data.BlockApiResponse)
+					ret.Data.Block = api.Block{Hash: "hash2", Shard: 2}
+				case "/block/by-hash/hash1?withTxs=true":
+					if shardBlockCallErr != nil {
+						return http.StatusInternalServerError, shardBlockCallErr
+					}
+
+					ret := value.(*data.BlockApiResponse)
+					ret.Data.Block = api.Block{
+						Hash:  "hash1",
+						Shard: 1,
+						MiniBlocks: []*api.MiniBlock{
+							{
+								DestinationShard: 1,
+								Type:             "TxBlock",
+								Transactions: []*transaction.ApiTransactionResult{
+									{Hash: "tx1", Fee: "100", Timestamp: 999},
+									{Hash: "tx2", GasLimit: 60000, GasPrice: 1000000000, Data: []byte("ab")},
+								},
+							},
+							{
+								DestinationShard: 0,
+								Type:             "TxBlock",
+								Transactions:     []*transaction.ApiTransactionResult{{Hash: "tx3"}},
+							},
+						},
+					}
+				case process.NetworkConfigPath:
+					ret := value.(*data.NetworkConfigApiResponse)
+					ret.Data.Config.MinGasLimit = 50000
+					ret.Data.Config.GasPerDataByte = 1500
+					ret.Data.Config.GasPriceModifier = "0.01"
+				default:
+					require.Fail(t, "unexpected path "+path)
+				}
+
+				return http.StatusOK, nil
+			},
+		}
+	}
+
+	t.Run("enabled history backend should be used", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		bp, _ := process.NewBlockProcessor(&mock.ExternalStorageConnectorStub{
+			GetAtlasBlockByShardIDAndNonceCalled: func(shardID uint32, nonce uint64) (data.AtlasBlock, error) {
+				return data.AtlasBlock{}, expectedErr
+			},
+		}, &mock.ProcessorStub{
+			GetObserversCalled: func(shardId uint32) ([]*data.NodeData, error) {
+				require.Fail(t, "should have not been called")
+				return nil, nil
+			},
+		}, &mock.ShardBlocksCacheStub{})
+
+		_, err := bp.GetAtlasBlockByShardIDAndNonce(core.MetachainShardId, 7)
+		require.Equal(t, expectedErr, err)
+	})
+	t.Run("disabled history backend should build the block from observers", func(t *testing.T) {
+		t.Parallel()
+
+		bp, _ := process.NewBlockProcessor(disabledConnector, createProcessor(nil), &mock.ShardBlocksCacheStub{})
+
+		atlasBlock, err := bp.GetAtlasBlockByShardIDAndNonce(core.MetachainShardId, 7)
+		require.Nil(t, err)
+		require.Equal(t, uint64(7), atlasBlock.Nonce)
+		require.Equal(t, "metaHash", atlasBlock.Hash)
+		require.Len(t, atlasBlock.Transactions, 2)
+
+		require.Equal(t, "tx1", atlasBlock.Transactions[0].Hash)
+		require.Equal(t, "100", atlasBlock.Transactions[0].Fee)
+		require.Equal(t, time.Duration(999), atlasBlock.Transactions[0].Timestamp)
+
+		// 53000 move balance gas units at full price, plus 7000 processing gas units at 1% of the price
+		require.Equal(t, "tx2", atlasBlock.Transactions[1].Hash)
+		require.Equal(t, "53070000000000", atlasBlock.Transactions[1].Fee)
+		require.Equal(t, "53070000000000", atlasBlock.Transactions[1].Transaction.Fee)
+		require.Equal(t, time.Duration(1000), atlasBlock.Transactions[1].Timestamp)
+	})
+	t.Run("block missing from the history backend should be built from observers", func(t *testing.T) {
+		t.Parallel()
+
+		connector := &mock.ExternalStorageConnectorStub{
+			GetAtlasBlockByShardIDAndNonceCalled: func(shardID uint32, nonce uint64) (data.AtlasBlock, error) {
+				return data.AtlasBlock{}, data.ErrAtlasBlockNotFound
+			},
+		}
+		bp, _ := process.NewBlockProcessor(connector, createProcessor(nil), &mock.ShardBlocksCacheStub{})
+
+		atlasBlock, err := bp.GetAtlasBlockByShardIDAndNonce(core.MetachainShardId, 7)
+		require.Nil(t, err)
+		require.Equal(t, "metaHash", atlasBlock.Hash)
+		require.Len(t, atlasBlock.Transactions, 2)
+	})
+	t.Run("missing shard block should err", func(t *testing.T) {
+		t.Parallel()
+
+		shardsFanOut, _ := process.NewShardsFanOut(process.ArgsShardsFanOut{
+			MaxParallelRequests:  2,
+			PerShardTimeout:      time.Second,
+			PartialResultsPolicy: process.ReturnPartialResultsPolicy,
+		})
+		proc := createProcessor(errors.New("expected error"))
+		proc.QueryShardsCalled = shardsFanOut.QueryShards
+		bp, _ := process.NewBlockProcessor(disabledConnector, proc, &mock.ShardBlocksCacheStub{})
+
+		_, err := bp.GetAtlasBlockByShardIDAndNonce(core.MetachainShardId, 7)
+		require.True(t, errors.Is(err, process.ErrIncompleteAtlasBlock))
+	})
+}
+
 func TestBlockProcessor_GetBlockByHashShouldGetFullHistoryNodes(t *testing.T) {
 	t.Parallel()
 
@@ -70,7 +224,7 @@ func TestBlockProcessor_GetBlockByHashShouldGetFullHistoryNodes(t *testing.T) {
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(&mock.ExternalStorageConnectorStub{}, proc)
+	bp, _ := process.NewBlockProcessor(&mock.ExternalStorageConnectorStub{}, proc, &mock.ShardBlocksCacheStub{})
 	require.NotNil(t, bp)
 
 	_, _ = bp.GetBlockByHash(0, "hash", common.BlockQueryOptions{})
@@ -96,7 +250,7 @@ func TestBlockProcessor_GetBlockByHashShouldGetObservers(t *testing.T) {
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(&mock.ExternalStorageConnectorStub{}, proc)
+	bp, _ := process.NewBlockProcessor(&mock.ExternalStorageConnectorStub{}, proc, &mock.ShardBlocksCacheStub{})
 	require.NotNil(t, bp)
 
 	_, _ = bp.GetBlockByHash(0, "hash", common.BlockQueryOptions{})
@@ -118,7 +272,7 @@ func TestBlockProcessor_GetBlockByHashNoFullNodesOrObserversShouldErr(t *testing
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(&mock.ExternalStorageConnectorStub{}, proc)
+	bp, _ := process.NewBlockProcessor(&mock.ExternalStorageConnectorStub{}, proc, &mock.ShardBlocksCacheStub{})
 	require.NotNil(t, bp)
 
 	res, err := bp.GetBlockByHash(0, "hash", common.BlockQueryOptions{})
@@ -139,7 +293,7 @@ func TestBlockProcessor_GetBlockByHashCallGetFailsShouldErr(t *testing.T) {
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(&mock.ExternalStorageConnectorStub{}, proc)
+	bp, _ := process.NewBlockProcessor(&mock.ExternalStorageConnectorStub{}, proc, &mock.ShardBlocksCacheStub{})
 	require.NotNil(t, bp)
 
 	res, err := bp.GetBlockByHash(0, "hash", common.BlockQueryOptions{})
@@ -162,7 +316,7 @@ func TestBlockProcessor_GetBlockByHashShouldWork(t *testing.T) {
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(&mock.ExternalStorageConnectorStub{}, proc)
+	bp, _ := process.NewBlockProcessor(&mock.ExternalStorageConnectorStub{}, proc, &mock.ShardBlocksCacheStub{})
 	require.NotNil(t, bp)
 
 	res, err := bp.GetBlockByHash(0, "hash", common.BlockQueryOptions{})
@@ -190,7 +344,7 @@ func TestBlockProcessor_GetBlockByHashShouldWorkAndIncludeAlsoTxs(t *testing.T)
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(&mock.ExternalStorageConnectorStub{}, proc)
+	bp, _ := process.NewBlockProcessor(&mock.ExternalStorageConnectorStub{}, proc, &mock.ShardBlocksCacheStub{})
 	require.NotNil(t, bp)
 
 	res, err := bp.GetBlockByHash(0, "hash", common.BlockQueryOptions{WithTransactions: true})
@@ -219,7 +373,7 @@ func TestBlockProcessor_GetBlockByNonceShouldGetFullHistoryNodes(t *testing.T) {
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(&mock.ExternalStorageConnectorStub{}, proc)
+	bp, _ := process.NewBlockProcessor(&mock.ExternalStorageConnectorStub{}, proc, &mock.ShardBlocksCacheStub{})
 	require.NotNil(t, bp)
 
 	_, _ = bp.GetBlockByNonce(0, 0, common.BlockQueryOptions{})
@@ -245,7 +399,7 @@ func TestBlockProcessor_GetBlockByNonceShouldGetObservers(t *testing.T) {
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(&mock.ExternalStorageConnectorStub{}, proc)
+	bp, _ := process.NewBlockProcessor(&mock.ExternalStorageConnectorStub{}, proc, &mock.ShardBlocksCacheStub{})
 	require.NotNil(t, bp)
 
 	_, _ = bp.GetBlockByNonce(0, 1, common.BlockQueryOptions{})
@@ -267,7 +421,7 @@ func TestBlockProcessor_GetBlockByNonceNoFullNodesOrObserversShouldErr(t *testin
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(&mock.ExternalStorageConnectorStub{}, proc)
+	bp, _ := process.NewBlockProcessor(&mock.ExternalStorageConnectorStub{}, proc, &mock.ShardBlocksCacheStub{})
 	require.NotNil(t, bp)
 
 	res, err := bp.GetBlockByNonce(0, 1, common.BlockQueryOptions{})
@@ -288,7 +442,7 @@ func TestBlockProcessor_GetBlockByNonceCallGetFailsShouldErr(t *testing.T) {
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(&mock.ExternalStorageConnectorStub{}, proc)
+	bp, _ := process.NewBlockProcessor(&mock.ExternalStorageConnectorStub{}, proc, &mock.ShardBlocksCacheStub{})
 	require.NotNil(t, bp)
 
 	res, err := bp.GetBlockByNonce(0, 0, common.BlockQueryOptions{})
@@ -311,7 +465,7 @@ func TestBlockProcessor_GetBlockByNonceShouldWork(t *testing.T) {
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(&mock.ExternalStorageConnectorStub{}, proc)
+	bp, _ := process.NewBlockProcessor(&mock.ExternalStorageConnectorStub{}, proc, &mock.ShardBlocksCacheStub{})
 	require.NotNil(t, bp)
 
 	res, err := bp.GetBlockByNonce(0, nonce, common.BlockQueryOptions{})
@@ -339,7 +493,7 @@ func TestBlockProcessor_GetBlockByNonceShouldWorkAndIncludeAlsoTxs(t *testing.T)
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(&mock.ExternalStorageConnectorStub{}, proc)
+	bp, _ := process.NewBlockProcessor(&mock.ExternalStorageConnectorStub{}, proc, &mock.ShardBlocksCacheStub{})
 	require.NotNil(t, bp)
 
 	res, err := bp.GetBlockByNonce(0, 3, common.BlockQueryOptions{WithTransactions: true})
@@ -378,7 +532,7 @@ func TestBlockProcessor_GetHyperBlock(t *testing.T) {
 		},
 	}
 
-	processor, err := process.NewBlockProcessor(&mock.ExternalStorageConnectorStub{}, proc)
+	processor, err := process.NewBlockProcessor(&mock.ExternalStorageConnectorStub{}, proc, &mock.ShardBlocksCacheStub{})
 	require.Nil(t, err)
 	require.NotNil(t, processor)
 
@@ -399,6 +553,53 @@ func TestBlockProcessor_GetHyperBlock(t *testing.T) {
 	require.Equal(t, "abcd", response.Data.Hyperblock.Hash)
 }
 
+func TestBlockProcessor_GetHyperBlockShouldReuseCachedShardBlocks(t *testing.T) {
+	t.Parallel()
+
+	numShardBlockRequests := uint32(0)
+	proc := &mock.ProcessorStub{
+		GetFullHistoryNodesCalled: func(shardId uint32) ([]*data.NodeData, error) {
+			return []*data.NodeData{{ShardId: shardId, Address: fmt.Sprintf("http://observer-%d", shardId)}}, nil
+		},
+		CallGetRestEndPointCalled: func(address string, path string, value interface{}) (int, error) {
+			response := value.(*data.BlockApiResponse)
+			if !strings.Contains(address, "4294967295") {
+				atomic.AddUint32(&numShardBlockRequests, 1)
+				response.Data = data.BlockApiResponsePayload{Block: api.Block{Hash: "shardHash"}}
+				return http.StatusOK, nil
+			}
+
+			// both neighbouring hyperblocks notarize the same shard block
+			response.Data = data.BlockApiResponsePayload{Block: api.Block{Nonce: 42, Hash: "metaHash"}}
+			response.Data.Block.NotarizedBlocks = []*api.NotarizedBlock{{Shard: 0, Nonce: 39, Hash: "shardHash"}}
+
+			return http.StatusOK, nil
+		},
+	}
+
+	cache, _ := process.NewShardBlocksCache(process.ArgsShardBlocksCache{
+		TTL:        time.Minute,
+		MaxEntries: 10,
+	})
+	bp, _ := process.NewBlockProcessor(&mock.ExternalStorageConnectorStub{}, proc, cache)
+
+	response, err := bp.GetHyperBlockByNonce(42, common.HyperblockQueryOptions{})
+	require.Nil(t, err)
+	require.Len(t, response.Data.Hyperblock.ShardBlocks, 1)
+	require.Equal(t, "shardHash", response.Data.Hyperblock.ShardBlocks[0].Hash)
+
+	response, err = bp.GetHyperBlockByNonce(43, common.HyperblockQueryOptions{})
+	require.Nil(t, err)
+	require.Len(t, response.Data.Hyperblock.ShardBlocks, 1)
+	require.Equal(t, "shardHash", response.Data.Hyperblock.ShardBlocks[0].Hash)
+	require.Equal(t, uint32(1), atomic.LoadUint32(&numShardBlockRequests))
+
+	// different options should not use the same cache entry
+	_, err = bp.GetHyperBlockByNonce(43, common.HyperblockQueryOptions{WithLogs: true})
+	require.Nil(t, err)
+	require.Equal(t, uint32(2), atomic.LoadUint32(&numShardBlockRequests))
+}
+
 // GetInternalBlockByNonce
 
 func TestBlockProcessor_GetInternalBlockByNonceInvalidOutputFormat_ShouldFail(t *testing.T) {
@@ -410,7 +611,7 @@ func TestBlockProcessor_GetInternalBlockByNonceInvalidOutputFormat_ShouldFail(t
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(&mock.ExternalStorageConnectorStub{}, proc)
+	bp, _ := process.NewBlockProcessor(&mock.ExternalStorageConnectorStub{}, proc, &mock.ShardBlocksCacheStub{})
 	require.NotNil(t, bp)
 
 	blk, err := bp.GetInternalBlockByNonce(0, 0, 2)
@@ -435,7 +636,7 @@ func TestBlockProcessor_GetInternalBlockByNonceShouldGetFullHistoryNodes(t *test
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(&mock.ExternalStorageConnectorStub{}, proc)
+	bp, _ := process.NewBlockProcessor(&mock.ExternalStorageConnectorStub{}, proc, &mock.ShardBlocksCacheStub{})
 	require.NotNil(t, bp)
 
 	_, _ = bp.GetInternalBlockByNonce(0, 0, common.Internal)
@@ -461,7 +662,7 @@ func TestBlockProcessor_GetInternalBlockByNonceShouldGetObservers(t *testing.T)
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(&mock.ExternalStorageConnectorStub{}, proc)
+	bp, _ := process.NewBlockProcessor(&mock.ExternalStorageConnectorStub{}, proc, &mock.ShardBlocksCacheStub{})
 	require.NotNil(t, bp)
 
 	_, _ = bp.GetInternalBlockByNonce(0, 1, common.Internal)
@@ -483,7 +684,7 @@ func TestBlockProcessor_GetInternalBlockByNonceNoFullNodesOrObserversShouldErr(t
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(&mock.ExternalStorageConnectorStub{}, proc)
+	bp, _ := process.NewBlockProcessor(&mock.ExternalStorageConnectorStub{}, proc, &mock.ShardBlocksCacheStub{})
 	require.NotNil(t, bp)
 
 	res, err := bp.GetInternalBlockByNonce(0, 1, common.Internal)
@@ -504,7 +705,7 @@ func TestBlockProcessor_GetInternalBlockByNonceCallGetFailsShouldErr(t *testing.
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(&mock.ExternalStorageConnectorStub{}, proc)
+	bp, _ := process.NewBlockProcessor(&mock.ExternalStorageConnectorStub{}, proc, &mock.ShardBlocksCacheStub{})
 	require.NotNil(t, bp)
 
 	res, err := bp.GetInternalBlockByNonce(0, 0, common.Internal)
@@ -533,7 +734,7 @@ func TestBlockProcessor_GetInternalBlockByNonceShouldWork(t *testing.T) {
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(&mock.ExternalStorageConnectorStub{}, proc)
+	bp, _ := process.NewBlockProcessor(&mock.ExternalStorageConnectorStub{}, proc, &mock.ShardBlocksCacheStub{})
 	require.NotNil(t, bp)
 
 	res, err := bp.GetInternalBlockByNonce(0, nonce, common.Internal)
@@ -558,7 +759,7 @@ func TestBlockProcessor_GetInternalBlockByHashInvalidOutputFormat_ShouldFail(t *
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(&mock.ExternalStorageConnectorStub{}, proc)
+	bp, _ := process.NewBlockProcessor(&mock.ExternalStorageConnectorStub{}, proc, &mock.ShardBlocksCacheStub{})
 	require.NotNil(t, bp)
 
 	blk, err := bp.GetInternalBlockByHash(0, "aaaa", 2)
@@ -583,7 +784,7 @@ func TestBlockProcessor_GetInternalBlockByHashShouldGetFullHistoryNodes(t *testi
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(&mock.ExternalStorageConnectorStub{}, proc)
+	bp, _ := process.NewBlockProcessor(&mock.ExternalStorageConnectorStub{}, proc, &mock.ShardBlocksCacheStub{})
 	require.NotNil(t, bp)
 
 	_, _ = bp.GetInternalBlockByHash(0, "aaaa", common.Internal)
@@ -609,7 +810,7 @@ func TestBlockProcessor_GetInternalBlockByHashShouldGetObservers(t *testing.T) {
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(&mock.ExternalStorageConnectorStub{}, proc)
+	bp, _ := process.NewBlockProcessor(&mock.ExternalStorageConnectorStub{}, proc, &mock.ShardBlocksCacheStub{})
 	require.NotNil(t, bp)
 
 	_, _ = bp.GetInternalBlockByHash(0, "aaaa", common.Internal)
@@ -631,7 +832,7 @@ func TestBlockProcessor_GetInternalBlockByHashNoFullNodesOrObserversShouldErr(t
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(&mock.ExternalStorageConnectorStub{}, proc)
+	bp, _ := process.NewBlockProcessor(&mock.ExternalStorageConnectorStub{}, proc, &mock.ShardBlocksCacheStub{})
 	require.NotNil(t, bp)
 
 	res, err := bp.GetInternalBlockByHash(0, "aaaa", common.Internal)
@@ -652,7 +853,7 @@ func TestBlockProcessor_GetInternalBlockByHashCallGetFailsShouldErr(t *testing.T
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(&mock.ExternalStorageConnectorStub{}, proc)
+	bp, _ := process.NewBlockProcessor(&mock.ExternalStorageConnectorStub{}, proc, &mock.ShardBlocksCacheStub{})
 	require.NotNil(t, bp)
 
 	res, err := bp.GetInternalBlockByHash(0, "aaaa", common.Internal)
@@ -680,7 +881,7 @@ func TestBlockProcessor_GetInternalBlockByHashShouldWork(t *testing.T) {
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(&mock.ExternalStorageConnectorStub{}, proc)
+	bp, _ := process.NewBlockProcessor(&mock.ExternalStorageConnectorStub{}, proc, &mock.ShardBlocksCacheStub{})
 	require.NotNil(t, bp)
 
 	res, err := bp.GetInternalBlockByHash(0, "aaaa", common.Internal)
@@ -705,7 +906,7 @@ func TestBlockProcessor_GetInternalMiniBlockByHashInvalidOutputFormat_ShouldFail
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(&mock.ExternalStorageConnectorStub{}, proc)
+	bp, _ := process.NewBlockProcessor(&mock.ExternalStorageConnectorStub{}, proc, &mock.ShardBlocksCacheStub{})
 	require.NotNil(t, bp)
 
 	blk, err := bp.GetInternalMiniBlockByHash(0, "aaaa", 1, 2)
@@ -730,7 +931,7 @@ func TestBlockProcessor_GetInternalMiniBlockByHashShouldGetFullHistoryNodes(t *t
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(&mock.ExternalStorageConnectorStub{}, proc)
+	bp, _ := process.NewBlockProcessor(&mock.ExternalStorageConnectorStub{}, proc, &mock.ShardBlocksCacheStub{})
 	require.NotNil(t, bp)
 
 	_, _ = bp.GetInternalMiniBlockByHash(0, "aaaa", 1, common.Internal)
@@ -756,7 +957,7 @@ func TestBlockProcessor_GetInternalMiniBlockByHashShouldGetObservers(t *testing.
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(&mock.ExternalStorageConnectorStub{}, proc)
+	bp, _ := process.NewBlockProcessor(&mock.ExternalStorageConnectorStub{}, proc, &mock.ShardBlocksCacheStub{})
 	require.NotNil(t, bp)
 
 	_, _ = bp.GetInternalMiniBlockByHash(0, "aaaa", 1, common.Internal)
@@ -778,7 +979,7 @@ func TestBlockProcessor_GetInternalMiniBlockByHashNoFullNodesOrObserversShouldEr
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(&mock.ExternalStorageConnectorStub{}, proc)
+	bp, _ := process.NewBlockProcessor(&mock.ExternalStorageConnectorStub{}, proc, &mock.ShardBlocksCacheStub{})
 	require.NotNil(t, bp)
 
 	res, err := bp.GetInternalMiniBlockByHash(0, "aaaa", 1, common.Internal)
@@ -799,7 +1000,7 @@ func TestBlockProcessor_GetInternalMiniBlockByHashCallGetFailsShouldErr(t *testi
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(&mock.ExternalStorageConnectorStub{}, proc)
+	bp, _ := process.NewBlockProcessor(&mock.ExternalStorageConnectorStub{}, proc, &mock.ShardBlocksCacheStub{})
 	require.NotNil(t, bp)
 
 	res, err := bp.GetInternalMiniBlockByHash(0, "aaaa", 1, common.Internal)
@@ -827,7 +1028,7 @@ func TestBlockProcessor_GetInternalMiniBlockByHashShouldWork(t *testing.T) {
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(&mock.ExternalStorageConnectorStub{}, proc)
+	bp, _ := process.NewBlockProcessor(&mock.ExternalStorageConnectorStub{}, proc, &mock.ShardBlocksCacheStub{})
 	require.NotNil(t, bp)
 
 	res, err := bp.GetInternalMiniBlockByHash(0, "aaaa", 1, common.Internal)
@@ -852,7 +1053,7 @@ func TestBlockProcessor_GetInternalStartOfEpochMetaBlockInvalidOutputFormat_Shou
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(&mock.ExternalStorageConnectorStub{}, proc)
+	bp, _ := process.NewBlockProcessor(&mock.ExternalStorageConnectorStub{}, proc, &mock.ShardBlocksCacheStub{})
 	require.NotNil(t, bp)
 
 	blk, err := bp.GetInternalStartOfEpochMetaBlock(0, 2)
@@ -877,7 +1078,7 @@ func TestBlockProcessor_GetInternalStartOfEpochMetaBlockShouldGetFullHistoryNode
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(&mock.ExternalStorageConnectorStub{}, proc)
+	bp, _ := process.NewBlockProcessor(&mock.ExternalStorageConnectorStub{}, proc, &mock.ShardBlocksCacheStub{})
 	require.NotNil(t, bp)
 
 	_, _ = bp.GetInternalStartOfEpochMetaBlock(0, common.Internal)
@@ -903,7 +1104,7 @@ func TestBlockProcessor_GetInternalStartOfEpochMetaBlockShouldGetObservers(t *te
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(&mock.ExternalStorageConnectorStub{}, proc)
+	bp, _ := process.NewBlockProcessor(&mock.ExternalStorageConnectorStub{}, proc, &mock.ShardBlocksCacheStub{})
 	require.NotNil(t, bp)
 
 	_, _ = bp.GetInternalStartOfEpochMetaBlock(0, common.Internal)
@@ -925,7 +1126,7 @@ func TestBlockProcessor_GetInternalStartOfEpochMetaBlockNoFullNodesOrObserversSh
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(&mock.ExternalStorageConnectorStub{}, proc)
+	bp, _ := process.NewBlockProcessor(&mock.ExternalStorageConnectorStub{}, proc, &mock.ShardBlocksCacheStub{})
 	require.NotNil(t, bp)
 
 	res, err := bp.GetInternalStartOfEpochMetaBlock(0, common.Internal)
@@ -947,7 +1148,7 @@ func TestBlockProcessor_GetInternalStartOfEpochMetaBlockCallGetFailsShouldErr(t
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(&mock.ExternalStorageConnectorStub{}, proc)
+	bp, _ := process.NewBlockProcessor(&mock.ExternalStorageConnectorStub{}, proc, &mock.ShardBlocksCacheStub{})
 	require.NotNil(t, bp)
 
 	res, err := bp.GetInternalStartOfEpochMetaBlock(0, common.Internal)
@@ -975,7 +1176,7 @@ func TestBlockProcessor_GetInternalStartOfEpochMetaBlockShouldWork(t *testing.T)
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(&mock.ExternalStorageConnectorStub{}, proc)
+	bp, _ := process.NewBlockProcessor(&mock.ExternalStorageConnectorStub{}, proc, &mock.ShardBlocksCacheStub{})
 	require.NotNil(t, bp)
 
 	res, err := bp.GetInternalStartOfEpochMetaBlock(1, common.Internal)
@@ -1005,7 +1206,7 @@ func TestBlockProcessor_GetAlteredAccountsByNonce(t *testing.T) {
 			},
 		}
 
-		bp, _ := process.NewBlockProcessor(&mock.ExternalStorageConnectorStub{}, proc)
+		bp, _ := process.NewBlockProcessor(&mock.ExternalStorageConnectorStub{}, proc, &mock.ShardBlocksCacheStub{})
 		res, err := bp.GetAlteredAccountsByNonce(requestedShardID, 4, common.GetAlteredAccountsForBlockOptions{})
 		require.Equal(t, expectedErr, err)
 		require.Nil(t, res)
@@ -1034,7 +1235,7 @@ func TestBlockProcessor_GetAlteredAccountsByNonce(t *testing.T) {
 			},
 		}
 
-		bp, _ := process.NewBlockProcessor(&mock.ExternalStorageConnectorStub{}, proc)
+		bp, _ := process.NewBlockProcessor(&mock.ExternalStorageConnectorStub{}, proc, &mock.ShardBlocksCacheStub{})
 		res, err := bp.GetAlteredAccountsByNonce(requestedShardID, 4, common.GetAlteredAccountsForBlockOptions{})
 		require.Equal(t, 2, callGetEndpointCt)
 		require.Equal(t, process.ErrSendingRequest, err)
@@ -1063,7 +1264,7 @@ func TestBlockProcessor_GetAlteredAccountsByNonce(t *testing.T) {
 			},
 		}
 
-		bp, _ := process.NewBlockProcessor(&mock.ExternalStorageConnectorStub{}, proc)
+		bp, _ := process.NewBlockProcessor(&mock.ExternalStorageConnectorStub{}, proc, &mock.ShardBlocksCacheStub{})
 		res, err := bp.GetAlteredAccountsByNonce(requestedShardID, 4, common.GetAlteredAccountsForBlockOptions{})
 		require.Nil(t, err)
 		require.Equal(t, &data.AlteredAccountsApiResponse{
@@ -1092,7 +1293,7 @@ func TestBlockProcessor_GetAlteredAccountsByHash(t *testing.T) {
 			},
 		}
 
-		bp, _ := process.NewBlockProcessor(&mock.ExternalStorageConnectorStub{}, proc)
+		bp, _ := process.NewBlockProcessor(&mock.ExternalStorageConnectorStub{}, proc, &mock.ShardBlocksCacheStub{})
 		res, err := bp.GetAlteredAccountsByHash(requestedShardID, "hash", common.GetAlteredAccountsForBlockOptions{})
 		require.Equal(t, expectedErr, err)
 		require.Nil(t, res)
@@ -1121,7 +1322,7 @@ func TestBlockProcessor_GetAlteredAccountsByHash(t *testing.T) {
 			},
 		}
 
-		bp, _ := process.NewBlockProcessor(&mock.ExternalStorageConnectorStub{}, proc)
+		bp, _ := process.NewBlockProcessor(&mock.ExternalStorageConnectorStub{}, proc, &mock.ShardBlocksCacheStub{})
 		res, err := bp.GetAlteredAccountsByHash(requestedShardID, "hash", common.GetAlteredAccountsForBlockOptions{})
 		require.Equal(t, 2, callGetEndpointCt)
 		require.Equal(t, process.ErrSendingRequest, err)
@@ -1150,7 +1351,7 @@ func TestBlockProcessor_GetAlteredAccountsByHash(t *testing.T) {
 			},
 		}
 
-		bp, _ := process.NewBlockProcessor(&mock.ExternalStorageConnectorStub{}, proc)
+		bp, _ := process.NewBlockProcessor(&mock.ExternalStorageConnectorStub{}, proc, &mock.ShardBlocksCacheStub{})
 		res, err := bp.GetAlteredAccountsByHash(requestedShardID, "hash", common.GetAlteredAccountsForBlockOptions{})
 		require.Nil(t, err)
 		require.Equal(t, &data.AlteredAccountsApiResponse{
@@ -1170,31 +1371,20 @@ func TestBlockProcessor_GetHyperBlockByNonceWithAlteredAccounts(t *testing.T) {
 	alteredAcc1 := &outport.AlteredAccount{Address: "erd1q"}
 	alteredAcc2 := &outport.AlteredAccount{Address: "erd1w"}
 
-	callGetEndpointCt := 0
-	getObserversCt := 0
+	callGetEndpointCt := uint32(0)
+	getObserversCt := uint32(0)
 	proc := &mock.ProcessorStub{
 		GetObserversCalled: func(shardId uint32) ([]*data.NodeData, error) {
-			switch getObserversCt {
-			case 0:
-				require.Equal(t, core.MetachainShardId, shardId)
-			case 1, 2:
-				require.Equal(t, uint32(1), shardId)
-			case 3, 4:
-				require.Equal(t, uint32(2), shardId)
-			}
-
-			getObserversCt++
+			atomic.AddUint32(&getObserversCt, 1)
 			return []*data.NodeData{{ShardId: shardId, Address: observerAddr}}, nil
 		},
 
+		// the shard blocks and their altered accounts are fetched in parallel, so the calls are told apart by path
 		CallGetRestEndPointCalled: func(address string, path string, value interface{}) (int, error) {
 			require.Equal(t, observerAddr, address)
 
-			switch callGetEndpointCt {
-			case 0:
-				require.Equal(t, &data.BlockApiResponse{}, value)
-				require.Equal(t, "/block/by-nonce/4?withTxs=true", path)
-
+			switch path {
+			case "/block/by-nonce/4?withTxs=true":
 				ret := value.(*data.BlockApiResponse)
 				ret.Code = data.ReturnCodeSuccess
 				ret.Data.Block = api.Block{
@@ -1210,42 +1400,32 @@ func TestBlockProcessor_GetHyperBlockByNonceWithAlteredAccounts(t *testing.T) {
 						},
 					},
 				}
-			case 1:
-				require.Equal(t, &data.BlockApiResponse{}, value)
-				require.Equal(t, "/block/by-hash/hash1?withTxs=true", path)
-
+			case "/block/by-hash/hash1?withTxs=true":
 				ret := value.(*data.BlockApiResponse)
 				ret.Code = data.ReturnCodeSuccess
 				ret.Data.Block = api.Block{Hash: "hash1", Shard: 1}
-			case 2:
-				require.Equal(t, &data.AlteredAccountsApiResponse{}, value)
-				require.Equal(t, "/block/altered-accounts/by-hash/hash1", path)
-
+			case "/block/altered-accounts/by-hash/hash1":
 				ret := value.(*data.AlteredAccountsApiResponse)
 				ret.Code = data.ReturnCodeSuccess
 				ret.Data.Accounts = []*outport.AlteredAccount{alteredAcc1}
-			case 3:
-				require.Equal(t, &data.BlockApiResponse{}, value)
-				require.Equal(t, "/block/by-hash/hash2?withTxs=true", path)
-
+			case "/block/by-hash/hash2?withTxs=true":
 				ret := value.(*data.BlockApiResponse)
 				ret.Code = data.ReturnCodeSuccess
 				ret.Data.Block = api.Block{Hash: "hash2", Shard: 2}
-			case 4:
-				require.Equal(t, &data.AlteredAccountsApiResponse{}, value)
-				require.Equal(t, "/block/altered-accounts/by-hash/hash2", path)
-
+			case "/block/altered-accounts/by-hash/hash2":
 				ret := value.(*data.AlteredAccountsApiResponse)
 				ret.Code = data.ReturnCodeSuccess
 				ret.Data.Accounts = []*outport.AlteredAccount{alteredAcc2}
+			default:
+				require.Fail(t, "unexpected path "+path)
 			}
 
-			callGetEndpointCt++
+			atomic.AddUint32(&callGetEndpointCt, 1)
 			return 0, nil
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(&mock.ExternalStorageConnectorStub{}, proc)
+	bp, _ := process.NewBlockProcessor(&mock.ExternalStorageConnectorStub{}, proc, &mock.ShardBlocksCacheStub{})
 
 	res, err := bp.GetHyperBlockByNonce(4, common.HyperblockQueryOptions{WithAlteredAccounts: true})
 	require.Nil(t, err)
@@ -1276,8 +1456,8 @@ func TestBlockProcessor_GetHyperBlockByNonceWithAlteredAccounts(t *testing.T) {
 		},
 	}, res)
 	require.NotNil(t, res)
-	require.Equal(t, 5, callGetEndpointCt)
-	require.Equal(t, 5, getObserversCt)
+	require.Equal(t, uint32(5), atomic.LoadUint32(&callGetEndpointCt))
+	require.Equal(t, uint32(5), atomic.LoadUint32(&getObserversCt))
 }
 
 func TestBlockProcessor_GetHyperBlockByHashWithAlteredAccounts(t *testing.T) {
@@ -1287,31 +1467,20 @@ func TestBlockProcessor_GetHyperBlockByHashWithAlteredAccounts(t *testing.T) {
 	alteredAcc1 := &outport.AlteredAccount{Address: "erd1q"}
 	alteredAcc2 := &outport.AlteredAccount{Address: "erd1w"}
 
-	callGetEndpointCt := 0
-	getObserversCt := 0
+	callGetEndpointCt := uint32(0)
+	getObserversCt := uint32(0)
 	proc := &mock.ProcessorStub{
 		GetObserversCalled: func(shardId uint32) ([]*data.NodeData, error) {
-			switch getObserversCt {
-			case 0:
-				require.Equal(t, core.MetachainShardId, shardId)
-			case 1, 2:
-				require.Equal(t, uint32(1), shardId)
-			case 3, 4:
-				require.Equal(t, uint32(2), shardId)
-			}
-
-			getObserversCt++
+			atomic.AddUint32(&getObserversCt, 1)
 			return []*data.NodeData{{ShardId: shardId, Address: observerAddr}}, nil
 		},
 
+		// the shard blocks and their altered accounts are fetched in parallel, so the calls are told apart by path
 		CallGetRestEndPointCalled: func(address string, path string, value interface{}) (int, error) {
 			require.Equal(t, observerAddr, address)
 
-			switch callGetEndpointCt {
-			case 0:
-				require.Equal(t, &data.BlockApiResponse{}, value)
-				require.Equal(t, "/block/by-hash/abcdef?withTxs=true", path)
-
+			switch path {
+			case "/block/by-hash/abcdef?withTxs=true":
 				ret := value.(*data.BlockApiResponse)
 				ret.Code = data.ReturnCodeSuccess
 				ret.Data.Block = api.Block{
@@ -1327,42 +1496,32 @@ func TestBlockProcessor_GetHyperBlockByHashWithAlteredAccounts(t *testing.T) {
 						},
 					},
 				}
-			case 1:
-				require.Equal(t, &data.BlockApiResponse{}, value)
-				require.Equal(t, "/block/by-hash/hash1?withTxs=true", path)
-
+			case "/block/by-hash/hash1?withTxs=true":
 				ret := value.(*data.BlockApiResponse)
 				ret.Code = data.ReturnCodeSuccess
 				ret.Data.Block = api.Block{Hash: "hash1", Shard: 1}
-			case 2:
-				require.Equal(t, &data.AlteredAccountsApiResponse{}, value)
-				require.Equal(t, "/block/altered-accounts/by-hash/hash1", path)
-
+			case "/block/altered-accounts/by-hash/hash1":
 				ret := value.(*data.AlteredAccountsApiResponse)
 				ret.Code = data.ReturnCodeSuccess
 				ret.Data.Accounts = []*outport.AlteredAccount{alteredAcc1}
-			case 3:
-				require.Equal(t, &data.BlockApiResponse{}, value)
-				require.Equal(t, "/block/by-hash/hash2?withTxs=true", path)
-
+			case "/block/by-hash/hash2?withTxs=true":
 				ret := value.(*data.BlockApiResponse)
 				ret.Code = data.ReturnCodeSuccess
 				ret.Data.Block = api.Block{Hash: "hash2", Shard: 2}
-			case 4:
-				require.Equal(t, &data.AlteredAccountsApiResponse{}, value)
-				require.Equal(t, "/block/altered-accounts/by-hash/hash2", path)
-
+			case "/block/altered-accounts/by-hash/hash2":
 				ret := value.(*data.AlteredAccountsApiResponse)
 				ret.Code = data.ReturnCodeSuccess
 				ret.Data.Accounts = []*outport.AlteredAccount{alteredAcc2}
+			default:
+				require.Fail(t, "unexpected path "+path)
 			}
 
-			callGetEndpointCt++
+			atomic.AddUint32(&callGetEndpointCt, 1)
 			return 0, nil
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(&mock.ExternalStorageConnectorStub{}, proc)
+	bp, _ := process.NewBlockProcessor(&mock.ExternalStorageConnectorStub{}, proc, &mock.ShardBlocksCacheStub{})
 
 	res, err := bp.GetHyperBlockByHash("abcdef", common.HyperblockQueryOptions{WithAlteredAccounts: true})
 	require.Nil(t, err)
@@ -1393,8 +1552,8 @@ func TestBlockProcessor_GetHyperBlockByHashWithAlteredAccounts(t *testing.T) {
 		},
 	}, res)
 	require.NotNil(t, res)
-	require.Equal(t, 5, callGetEndpointCt)
-	require.Equal(t, 5, getObserversCt)
+	require.Equal(t, uint32(5), atomic.LoadUint32(&callGetEndpointCt))
+	require.Equal(t, uint32(5), atomic.LoadUint32(&getObserversCt))
 }
 
 func TestBlockProcessor_GetInternalStartOfEpochValidatorsInfo(t *testing.T) {
@@ -1419,7 +1578,7 @@ func TestBlockProcessor_GetInternalStartOfEpochValidatorsInfo(t *testing.T) {
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(&mock.ExternalStorageConnectorStub{}, proc)
+	bp, _ := process.NewBlockProcessor(&mock.ExternalStorageConnectorStub{}, proc, &mock.ShardBlocksCacheStub{})
 	require.NotNil(t, bp)
 
 	res, err := bp.GetInternalStartOfEpochValidatorsInfo(1)
@@ -1427,3 +1586,46 @@ func TestBlockProcessor_GetInternalStartOfEpochValidatorsInfo(t *testing.T) {
 	require.NotNil(t, res)
 	require.Equal(t, expectedData, res.Data)
 }
+
+func TestBlockProcessor_GetHyperBlockByHashWithMissingShards(t *testing.T) {
+	t.Parallel()
+
+	shardsFanOut, _ := process.NewShardsFanOut(process.ArgsShardsFanOut{
+		MaxParallelRequests:  2,
+		PerShardTimeout:      time.Second,
+		PartialResultsPolicy: process.ReturnPartialResultsPolicy,
+	})
+	proc := &mock.ProcessorStub{
+		GetObserversCalled: func(shardId uint32) ([]*data.NodeData, error) {
+			return []*data.NodeData{{ShardId: shardId, Address: "observerAddress"}}, nil
+		},
+		CallGetRestEndPointCalled: func(address string, path string, value interface{}) (int, error) {
+			ret := value.(*data.BlockApiResponse)
+			switch path {
+			case "/block/by-hash/metaHash?withTxs=true":
+				ret.Data.Block = api.Block{
+					Hash: "metaHash",
+					NotarizedBlocks: []*api.NotarizedBlock{
+						{Shard: 0, Hash: "hash0"},
+						{Shard: 1, Hash: "hash1"},
+					},
+				}
+			case "/block/by-hash/hash0?withTxs=true":
+				ret.Data.Block = api.Block{Hash: "hash0", Shard: 0}
+			default:
+				return http.StatusInternalServerError, errors.New("expected error")
+			}
+
+			ret.Code = data.ReturnCodeSuccess
+			return http.StatusOK, nil
+		},
+		QueryShardsCalled: shardsFanOut.QueryShards,
+	}
+
+	bp, _ := process.NewBlockProcessor(&mock.ExternalStorageConnectorStub{}, proc, &mock.ShardBlocksCacheStub{})
+	res, err := bp.GetHyperBlockByHash("metaHash", common.HyperblockQueryOptions{})
+	require.Nil(t, err)
+	require.Len(t, res.Data.Hyperblock.ShardBlocks, 1)
+	require.Equal(t, "hash0", res.Data.Hyperblock.ShardBlocks[0].Hash)
+	require.Equal(t, []uint32{1}, res.Data.MissingShards)
+}
@@ -2,6 +2,7 @@ package process
 
 import (
 	"fmt"
+	"sync"
 
 	"github.com/multiversx/mx-chain-core-go/core/check"
 	"github.com/multiversx/mx-chain-core-go/data/api"
@@ -13,6 +14,11 @@ const (
 	blockByRoundPath = "/block/by-round"
 )
 
+type shardBlockByRound struct {
+	block        *api.Block
+	disagreement *data.ShardBlocksDisagreement
+}
+
 // BlocksProcessor handles blocks retrieving from all shards
 type BlocksProcessor struct {
 	proc Processor
@@ -32,37 +38,149 @@ func NewBlocksProcessor(proc Processor) (*BlocksProcessor, error) {
 // GetBlocksByRound return all blocks(from all shards) by a specific round. For each shard, a block is requested
 // (from only one observer) and added in a slice of blocks => should have max blocks = no of shards.
 // If there are more observers in a shard which can be queried for a block by round, we get the block from
-// the first one which responds (no sanity checks are performed)
-func (bp *BlocksProcessor) GetBlocksByRound(round uint64, options common.BlockQueryOptions) (*data.BlocksApiResponse, error) {
+// the first one which responds (no sanity checks are performed), unless verifying is requested. In that case, the
+// blocks of all the observers of the shard are compared and their disagreements are reported. The shards are queried
+// concurrently and the ones for which no block could be fetched are reported as missing
+func (bp *BlocksProcessor) GetBlocksByRound(round uint64, options common.BlocksQueryOptions) (*data.BlocksApiResponse, error) {
 	shardIDs := bp.proc.GetShardIDs()
+	path := common.BuildUrlWithBlockQueryOptions(fmt.Sprintf("%s/%d", blockByRoundPath, round), options.BlockQueryOptions)
+
+	shardsResponse := bp.proc.QueryShardsBestEffort(shardIDs, func(_ int, shardID uint32) (interface{}, error) {
+		return bp.getShardBlockByRound(shardID, round, path, options.Verify)
+	})
+
 	ret := &data.BlocksApiResponse{
 		Data: data.BlocksApiResponsePayload{
-			Blocks: make([]*api.Block, 0, len(shardIDs)),
+			Blocks:        make([]*api.Block, 0, len(shardIDs)),
+			MissingShards: make([]uint32, 0),
 		},
 	}
+	if len(shardsResponse.MissingShards) > 0 {
+		ret.Data.MissingShards = shardsResponse.MissingShards
+	}
+
+	// the responses are in the same order as the shards, so the blocks and the disagreements are as well
+	for _, response := range shardsResponse.Responses {
+		shardBlock, ok := response.(*shardBlockByRound)
+		if !ok {
+			continue
+		}
+
+		ret.Data.Blocks = append(ret.Data.Blocks, shardBlock.block)
+		if shardBlock.disagreement != nil {
+			ret.Data.Disagreements = append(ret.Data.Disagreements, shardBlock.disagreement)
+		}
+	}
 
-	path := common.BuildUrlWithBlockQueryOptions(fmt.Sprintf("%s/%d", blockByRoundPath, round), options)
+	return ret, nil
+}
+
+func (bp *BlocksProcessor) getShardBlockByRound(shardID uint32, round uint64, path string, verify bool) (*shardBlockByRound, error) {
+	observers, err := bp.proc.GetObservers(shardID)
+	if err != nil {
+		log.Warn("cannot get the observers", "shard id", shardID, "round", round, "error", err.Error())
+		return nil, err
+	}
+
+	shardBlock := &shardBlockByRound{}
+	if verify {
+		shardBlock.block, shardBlock.disagreement = bp.getVerifiedBlock(shardID, observers, path)
+	} else {
+		shardBlock.block = bp.getBlockFromFirstObserver(observers, path)
+	}
+
+	if shardBlock.block == nil {
+		log.Warn("no block could be fetched", "shard id", shardID, "round", round)
+		return nil, fmt.Errorf("%w, shard %d", ErrNoBlockFetched, shardID)
+	}
+
+	log.Info("block requested successfully", "shard id", shardID, "round", round)
+
+	return shardBlock, nil
+}
 
-	for _, shardID := range shardIDs {
-		observers, err := bp.proc.GetObservers(shardID)
+func (bp *BlocksProcessor) getBlockFromFirstObserver(observers []*data.NodeData, path string) *api.Block {
+	for _, observer := range observers {
+		block, err := bp.getBlockFromObserver(observer, path)
 		if err != nil {
-			return nil, err
+			log.Error("block request failed", "shard id", observer.ShardId, "observer", observer.Address, "error", err.Error())
+			continue
 		}
 
-		for _, observer := range observers {
-			block, err := bp.getBlockFromObserver(observer, path)
+		return block
+	}
+
+	return nil
+}
+
+// getVerifiedBlock requests the block from all the observers concurrently and returns the one returned by most of
+// them, the first observers in the list winning the ties, along with the disagreement between the observers, if any
+func (bp *BlocksProcessor) getVerifiedBlock(shardID uint32, observers []*data.NodeData, path string) (*api.Block, *data.ShardBlocksDisagreement) {
+	blocks := make([]*api.Block, len(observers))
+	wg := &sync.WaitGroup{}
+	wg.Add(len(observers))
+	for idx, observer := range observers {
+		go func(index int, node *data.NodeData) {
+			defer wg.Done()
+
+			block, err := bp.getBlockFromObserver(node, path)
 			if err != nil {
-				log.Error("block request failed", "shard id", observer.ShardId, "observer", observer.Address, "error", err.Error())
-				continue
+				log.Error("block request failed", "shard id", node.ShardId, "observer", node.Address, "error", err.Error())
+				return
+			}
+
+			blocks[index] = block
+		}(idx, observer)
+	}
+	wg.Wait()
+
+	versions := make([]*data.ObservedBlockVersion, 0)
+	blocksByHash := make(map[string]*api.Block)
+	var selectedVersion *data.ObservedBlockVersion
+	for idx, block := range blocks {
+		if block == nil {
+			continue
+		}
+
+		version := getBlockVersion(versions, block.Hash)
+		if version == nil {
+			version = &data.ObservedBlockVersion{
+				Hash:  block.Hash,
+				Nonce: block.Nonce,
 			}
+			versions = append(versions, version)
+			blocksByHash[block.Hash] = block
+		}
+		version.Observers = append(version.Observers, observers[idx].Address)
 
-			log.Info("block requested successfully", "shard id", observer.ShardId, "observer", observer.Address, "round", round)
-			ret.Data.Blocks = append(ret.Data.Blocks, block)
-			break
+		if selectedVersion == nil || len(version.Observers) > len(selectedVersion.Observers) {
+			selectedVersion = version
 		}
 	}
 
-	return ret, nil
+	if selectedVersion == nil {
+		return nil, nil
+	}
+	if len(versions) == 1 {
+		return blocksByHash[selectedVersion.Hash], nil
+	}
+
+	log.Warn("observers returned different blocks", "shard id", shardID, "path", path, "num versions", len(versions))
+
+	return blocksByHash[selectedVersion.Hash], &data.ShardBlocksDisagreement{
+		ShardID:  shardID,
+		Versions: versions,
+	}
+}
+
+func getBlockVersion(versions []*data.ObservedBlockVersion, hash string) *data.ObservedBlockVersion {
+	for _, version := range versions {
+		if version.Hash == hash {
+			return version
+		}
+	}
+
+	return nil
 }
 
 func (bp *BlocksProcessor) getBlockFromObserver(observer *data.NodeData, path string) (*api.Block, error) {
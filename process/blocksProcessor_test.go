@@ -1,9 +1,14 @@
 package process_test
 
 import (
+	"encoding/json"
 	"errors"
+	"net/http"
+	"net/http/httptest"
 	"testing"
+	"time"
 
+	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/data/api"
 	"github.com/multiversx/mx-chain-proxy-go/common"
 	"github.com/multiversx/mx-chain-proxy-go/data"
@@ -21,7 +26,7 @@ func TestNewBlocksProcessor_NilProcessor_ExpectError(t *testing.T) {
 	require.Equal(t, err, process.ErrNilCoreProcessor)
 }
 
-func TestBlocksProcessor_GetBlocksByRound_InvalidObservers_ExpectError(t *testing.T) {
+func TestBlocksProcessor_GetBlocksByRound_InvalidObservers_ExpectMissingShards(t *testing.T) {
 	t.Parallel()
 
 	err := errors.New("err observers")
@@ -36,10 +41,36 @@ func TestBlocksProcessor_GetBlocksByRound_InvalidObservers_ExpectError(t *testin
 
 	bp, _ := process.NewBlocksProcessor(proc)
 
-	ret, actualErr := bp.GetBlocksByRound(0, common.BlockQueryOptions{})
+	ret, actualErr := bp.GetBlocksByRound(0, common.BlocksQueryOptions{})
 
-	require.Equal(t, err, actualErr)
-	require.Equal(t, (*data.BlocksApiResponse)(nil), ret)
+	require.Nil(t, actualErr)
+	require.Empty(t, ret.Data.Blocks)
+	require.Equal(t, []uint32{0, 1, 2}, ret.Data.MissingShards)
+}
+
+func TestBlocksProcessor_GetBlocksByRound_ShouldQueryTheShardsThroughTheFanOut(t *testing.T) {
+	t.Parallel()
+
+	queriedShards := make([]uint32, 0)
+	proc := &mock.ProcessorStub{
+		GetShardIDsCalled: func() []uint32 {
+			return []uint32{0, 1}
+		},
+		QueryShardsBestEffortCalled: func(shardIDs []uint32, queryHandler data.ShardQueryHandler) *data.ShardsQueryResponse {
+			queriedShards = append(queriedShards, shardIDs...)
+			return &data.ShardsQueryResponse{
+				Responses:     make([]interface{}, len(shardIDs)),
+				MissingShards: shardIDs,
+			}
+		},
+	}
+
+	bp, _ := process.NewBlocksProcessor(proc)
+
+	ret, err := bp.GetBlocksByRound(0, common.BlocksQueryOptions{})
+	require.Nil(t, err)
+	require.Equal(t, []uint32{0, 1}, queriedShards)
+	require.Equal(t, []uint32{0, 1}, ret.Data.MissingShards)
 }
 
 func TestBlocksProcessor_GetBlocksByRound_InvalidCallGetRestEndPoint_ExpectZeroFetchedBlocks(t *testing.T) {
@@ -77,10 +108,11 @@ func TestBlocksProcessor_GetBlocksByRound_InvalidCallGetRestEndPoint_ExpectZeroF
 
 	bp, _ := process.NewBlocksProcessor(proc)
 
-	ret, actualErr := bp.GetBlocksByRound(0, common.BlockQueryOptions{})
+	ret, actualErr := bp.GetBlocksByRound(0, common.BlocksQueryOptions{})
 	expectedRet := &data.BlocksApiResponse{
 		Data: data.BlocksApiResponsePayload{
-			Blocks: make([]*api.Block, 0, 2),
+			Blocks:        make([]*api.Block, 0, 2),
+			MissingShards: []uint32{0, 1},
 		},
 	}
 	require.Equal(t, nil, actualErr)
@@ -145,13 +177,140 @@ func TestBlocksProcessor_GetBlocksByRound_TwoBlocks_ThreeObservers_OneObserverGe
 	}
 
 	bp, _ := process.NewBlocksProcessor(proc)
-	ret, err := bp.GetBlocksByRound(0, common.BlockQueryOptions{WithTransactions: true})
+	ret, err := bp.GetBlocksByRound(0, common.BlocksQueryOptions{BlockQueryOptions: common.BlockQueryOptions{WithTransactions: true}})
 
 	expectedApiResp := &data.BlocksApiResponse{
 		Data: data.BlocksApiResponsePayload{
-			Blocks: []*api.Block{&block1, &block2},
+			Blocks:        []*api.Block{&block1, &block2},
+			MissingShards: make([]uint32, 0),
 		},
 	}
 	require.Nil(t, err)
 	require.Equal(t, expectedApiResp, ret)
 }
+
+func TestBlocksProcessor_GetBlocksByRound_Verify(t *testing.T) {
+	t.Parallel()
+
+	observers := map[uint32][]*data.NodeData{
+		0: {{ShardId: 0, Address: "addr0"}, {ShardId: 0, Address: "addr1"}, {ShardId: 0, Address: "addr2"}},
+		1: {{ShardId: 1, Address: "addr3"}, {ShardId: 1, Address: "addr4"}},
+		2: {{ShardId: 2, Address: "addr5"}},
+	}
+	blocks := map[string]api.Block{
+		"addr0": {Nonce: 10, Hash: "fork"},
+		"addr1": {Nonce: 10, Hash: "canonical"},
+		"addr2": {Nonce: 10, Hash: "canonical"},
+		"addr3": {Nonce: 20, Hash: "shard1"},
+		"addr4": {Nonce: 20, Hash: "shard1"},
+	}
+	proc := &mock.ProcessorStub{
+		GetShardIDsCalled: func() []uint32 {
+			return []uint32{0, 1, 2}
+		},
+		GetObserversCalled: func(shardId uint32) ([]*data.NodeData, error) {
+			return observers[shardId], nil
+		},
+		CallGetRestEndPointCalled: func(address string, path string, value interface{}) (int, error) {
+			block, found := blocks[address]
+			if !found {
+				return http.StatusInternalServerError, errors.New("observer error")
+			}
+
+			value.(*data.BlockApiResponse).Data.Block = block
+			return http.StatusOK, nil
+		},
+	}
+	bp, _ := process.NewBlocksProcessor(proc)
+
+	t.Run("without verifying, the first block of each shard should be returned", func(t *testing.T) {
+		t.Parallel()
+
+		ret, err := bp.GetBlocksByRound(0, common.BlocksQueryOptions{})
+		require.Nil(t, err)
+		require.Len(t, ret.Data.Blocks, 2)
+		require.Equal(t, "fork", ret.Data.Blocks[0].Hash)
+		require.Equal(t, "shard1", ret.Data.Blocks[1].Hash)
+		require.Equal(t, []uint32{2}, ret.Data.MissingShards)
+		require.Empty(t, ret.Data.Disagreements)
+	})
+	t.Run("verifying should select the blocks returned by most observers and report the disagreements", func(t *testing.T) {
+		t.Parallel()
+
+		ret, err := bp.GetBlocksByRound(0, common.BlocksQueryOptions{Verify: true})
+		require.Nil(t, err)
+		require.Len(t, ret.Data.Blocks, 2)
+		require.Equal(t, "canonical", ret.Data.Blocks[0].Hash)
+		require.Equal(t, "shard1", ret.Data.Blocks[1].Hash)
+		require.Equal(t, []uint32{2}, ret.Data.MissingShards)
+
+		expectedDisagreements := []*data.ShardBlocksDisagreement{
+			{
+				ShardID: 0,
+				Versions: []*data.ObservedBlockVersion{
+					{Hash: "fork", Nonce: 10, Observers: []string{"addr0"}},
+					{Hash: "canonical", Nonce: 10, Observers: []string{"addr1", "addr2"}},
+				},
+			},
+		}
+		require.Equal(t, expectedDisagreements, ret.Data.Disagreements)
+	})
+}
+
+func TestBlocksProcessor_GetBlocksByRound_VerifyWithBaseProcessorShouldQueryEachObserver(t *testing.T) {
+	t.Parallel()
+
+	createObserverServer := func(hash string) *httptest.Server {
+		response, _ := json.Marshal(data.BlockApiResponse{
+			Data: data.BlockApiResponsePayload{Block: api.Block{Nonce: 10, Hash: hash}},
+		})
+		return httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+			// keep the requests in flight long enough for them to overlap
+			time.Sleep(100 * time.Millisecond)
+			_, _ = rw.Write(response)
+		}))
+	}
+	canonicalObserver := createObserverServer("canonical")
+	defer canonicalObserver.Close()
+	forkedObserver := createObserverServer("fork")
+	defer forkedObserver.Close()
+
+	observers := []*data.NodeData{
+		{ShardId: 0, Address: canonicalObserver.URL},
+		{ShardId: 0, Address: forkedObserver.URL},
+	}
+	baseProc, _ := process.NewBaseProcessor(
+		5,
+		&mock.ShardCoordinatorMock{NumShards: 1},
+		&mock.ObserversProviderStub{
+			GetNodesByShardIdCalled: func(shardId uint32) ([]*data.NodeData, error) {
+				if shardId != 0 {
+					return make([]*data.NodeData, 0), nil
+				}
+				return observers, nil
+			},
+			GetAllNodesWithSyncStateCalled: func() []*data.NodeData {
+				return observers
+			},
+		},
+		&mock.ObserversProviderStub{},
+		&mock.PubKeyConverterMock{},
+		&mock.ShardsFanOutStub{},
+	)
+	bp, _ := process.NewBlocksProcessor(baseProc)
+
+	ret, err := bp.GetBlocksByRound(0, common.BlocksQueryOptions{Verify: true})
+	require.Nil(t, err)
+	require.Len(t, ret.Data.Blocks, 1)
+	require.Equal(t, "canonical", ret.Data.Blocks[0].Hash)
+	require.Equal(t, []uint32{core.MetachainShardId}, ret.Data.MissingShards)
+	require.Equal(t, []*data.ShardBlocksDisagreement{
+		{
+			ShardID: 0,
+			Versions: []*data.ObservedBlockVersion{
+				{Hash: "canonical", Nonce: 10, Observers: []string{canonicalObserver.URL}},
+				{Hash: "fork", Nonce: 10, Observers: []string{forkedObserver.URL}},
+			},
+		},
+	}, ret.Data.Disagreements)
+}
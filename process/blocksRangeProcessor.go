@@ -0,0 +1,160 @@
+package process
+
+import (
+	"fmt"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-proxy-go/common"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+// ArgsBlocksRangeProcessor holds the arguments needed to create a blocks range processor
+type ArgsBlocksRangeProcessor struct {
+	HyperblockProvider  HyperblockProvider
+	BlockProvider       BlockByNonceProvider
+	MaxRangeSize        int
+	MaxParallelRequests int
+}
+
+type nonceFetchResult struct {
+	item interface{}
+	err  error
+}
+
+type nonceFetchHandler func(nonce uint64) (interface{}, error)
+
+// BlocksRangeProcessor fetches ranges of blocks or hyperblocks, using a bounded number of concurrent requests, and
+// hands them over in nonce order
+type BlocksRangeProcessor struct {
+	hyperblockProvider  HyperblockProvider
+	blockProvider       BlockByNonceProvider
+	maxRangeSize        uint64
+	maxParallelRequests int
+}
+
+// NewBlocksRangeProcessor creates a new blocks range processor
+func NewBlocksRangeProcessor(args ArgsBlocksRangeProcessor) (*BlocksRangeProcessor, error) {
+	if check.IfNil(args.HyperblockProvider) {
+		return nil, ErrNilHyperblockProvider
+	}
+	if check.IfNil(args.BlockProvider) {
+		return nil, ErrNilBlockByNonceProvider
+	}
+	if args.MaxRangeSize <= 0 {
+		return nil, ErrInvalidMaxRangeSize
+	}
+	if args.MaxParallelRequests <= 0 {
+		return nil, ErrInvalidMaxParallelRequests
+	}
+
+	return &BlocksRangeProcessor{
+		hyperblockProvider:  args.HyperblockProvider,
+		blockProvider:       args.BlockProvider,
+		maxRangeSize:        uint64(args.MaxRangeSize),
+		maxParallelRequests: args.MaxParallelRequests,
+	}, nil
+}
+
+// GetHyperBlocksRange calls the handler with the hyperblocks between the provided nonces, both included, in order
+func (brp *BlocksRangeProcessor) GetHyperBlocksRange(
+	from uint64,
+	to uint64,
+	options common.HyperblockQueryOptions,
+	handler data.BlocksRangeItemHandler,
+) error {
+	return brp.fetchRange(from, to, handler, func(nonce uint64) (interface{}, error) {
+		response, err := brp.hyperblockProvider.GetHyperBlockByNonce(nonce, options)
+		if err != nil {
+			return nil, err
+		}
+
+		return &response.Data, nil
+	})
+}
+
+// GetBlocksRange calls the handler with the blocks of the given shard between the provided nonces, both included, in order
+func (brp *BlocksRangeProcessor) GetBlocksRange(
+	shardID uint32,
+	from uint64,
+	to uint64,
+	options common.BlockQueryOptions,
+	handler data.BlocksRangeItemHandler,
+) error {
+	return brp.fetchRange(from, to, handler, func(nonce uint64) (interface{}, error) {
+		response, err := brp.blockProvider.GetBlockByNonce(shardID, nonce, options)
+		if err != nil {
+			return nil, err
+		}
+
+		return &response.Data, nil
+	})
+}
+
+func (brp *BlocksRangeProcessor) checkRange(from uint64, to uint64) error {
+	if from > to {
+		return fmt.Errorf("%w: the range start is greater than its end", data.ErrInvalidBlocksRange)
+	}
+	if to-from >= brp.maxRangeSize {
+		return fmt.Errorf("%w: at most %d nonces can be requested at once", data.ErrInvalidBlocksRange, brp.maxRangeSize)
+	}
+
+	return nil
+}
+
+// fetchRange starts the fetching of the next nonces as soon as there are free slots, while the results are handed over
+// in nonce order. A slot is freed only after its result was handed over, so a slow nonce holds back the ones after it
+// and the number of results waiting in memory stays bounded
+func (brp *BlocksRangeProcessor) fetchRange(from uint64, to uint64, handler data.BlocksRangeItemHandler, fetch nonceFetchHandler) error {
+	err := brp.checkRange(from, to)
+	if err != nil {
+		return err
+	}
+
+	slots := make(chan struct{}, brp.maxParallelRequests)
+	pending := make(chan chan nonceFetchResult, brp.maxParallelRequests)
+	done := make(chan struct{})
+	defer close(done)
+
+	go func() {
+		defer close(pending)
+
+		numNonces := to - from + 1
+		for i := uint64(0); i < numNonces; i++ {
+			select {
+			case slots <- struct{}{}:
+			case <-done:
+				return
+			}
+
+			resultChan := make(chan nonceFetchResult, 1)
+			go func(nonce uint64) {
+				item, errFetch := fetch(nonce)
+				resultChan <- nonceFetchResult{item: item, err: errFetch}
+			}(from + i)
+
+			pending <- resultChan
+		}
+	}()
+
+	nonce := from
+	for resultChan := range pending {
+		result := <-resultChan
+		<-slots
+		if result.err != nil {
+			return fmt.Errorf("%w for nonce %d", result.err, nonce)
+		}
+
+		err = handler(result.item)
+		if err != nil {
+			return err
+		}
+		nonce++
+	}
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (brp *BlocksRangeProcessor) IsInterfaceNil() bool {
+	return brp == nil
+}
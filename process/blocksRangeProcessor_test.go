@@ -0,0 +1,193 @@
+package process_test
+
+import (
+	"errors"
+	"math/rand"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/data/api"
+	"github.com/multiversx/mx-chain-proxy-go/common"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/multiversx/mx-chain-proxy-go/process"
+	"github.com/multiversx/mx-chain-proxy-go/process/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockArgsBlocksRangeProcessor() process.ArgsBlocksRangeProcessor {
+	return process.ArgsBlocksRangeProcessor{
+		HyperblockProvider:  &mock.HyperblockProviderStub{},
+		BlockProvider:       &mock.BlockByNonceProviderStub{},
+		MaxRangeSize:        10,
+		MaxParallelRequests: 3,
+	}
+}
+
+func TestNewBlocksRangeProcessor(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil hyperblock provider should err", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsBlocksRangeProcessor()
+		args.HyperblockProvider = nil
+		brp, err := process.NewBlocksRangeProcessor(args)
+		require.Equal(t, process.ErrNilHyperblockProvider, err)
+		require.True(t, brp.IsInterfaceNil())
+	})
+	t.Run("nil block provider should err", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsBlocksRangeProcessor()
+		args.BlockProvider = nil
+		brp, err := process.NewBlocksRangeProcessor(args)
+		require.Equal(t, process.ErrNilBlockByNonceProvider, err)
+		require.Nil(t, brp)
+	})
+	t.Run("invalid max range size should err", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsBlocksRangeProcessor()
+		args.MaxRangeSize = 0
+		brp, err := process.NewBlocksRangeProcessor(args)
+		require.Equal(t, process.ErrInvalidMaxRangeSize, err)
+		require.Nil(t, brp)
+	})
+	t.Run("invalid max parallel requests should err", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsBlocksRangeProcessor()
+		args.MaxParallelRequests = 0
+		brp, err := process.NewBlocksRangeProcessor(args)
+		require.Equal(t, process.ErrInvalidMaxParallelRequests, err)
+		require.Nil(t, brp)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		brp, err := process.NewBlocksRangeProcessor(createMockArgsBlocksRangeProcessor())
+		require.Nil(t, err)
+		require.False(t, brp.IsInterfaceNil())
+	})
+}
+
+func TestBlocksRangeProcessor_GetHyperBlocksRange(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid ranges should err", func(t *testing.T) {
+		t.Parallel()
+
+		brp, _ := process.NewBlocksRangeProcessor(createMockArgsBlocksRangeProcessor())
+		handler := func(item interface{}) error {
+			require.Fail(t, "should have not been called")
+			return nil
+		}
+
+		err := brp.GetHyperBlocksRange(5, 4, common.HyperblockQueryOptions{}, handler)
+		require.True(t, errors.Is(err, data.ErrInvalidBlocksRange))
+
+		err = brp.GetHyperBlocksRange(5, 15, common.HyperblockQueryOptions{}, handler)
+		require.True(t, errors.Is(err, data.ErrInvalidBlocksRange))
+	})
+	t.Run("should preserve the order and bound the concurrent requests", func(t *testing.T) {
+		t.Parallel()
+
+		providedOptions := common.HyperblockQueryOptions{WithLogs: true, NotarizedAtSource: true}
+		numInFlight := int32(0)
+		maxInFlight := int32(0)
+		args := createMockArgsBlocksRangeProcessor()
+		args.HyperblockProvider = &mock.HyperblockProviderStub{
+			GetHyperBlockByNonceCalled: func(nonce uint64, options common.HyperblockQueryOptions) (*data.HyperblockApiResponse, error) {
+				require.Equal(t, providedOptions, options)
+
+				current := atomic.AddInt32(&numInFlight, 1)
+				defer atomic.AddInt32(&numInFlight, -1)
+				for {
+					max := atomic.LoadInt32(&maxInFlight)
+					if current <= max || atomic.CompareAndSwapInt32(&maxInFlight, max, current) {
+						break
+					}
+				}
+
+				time.Sleep(time.Duration(rand.Intn(5)) * time.Millisecond)
+				return data.NewHyperblockApiResponse(api.Hyperblock{Nonce: nonce}), nil
+			},
+		}
+		brp, _ := process.NewBlocksRangeProcessor(args)
+
+		nonces := make([]uint64, 0)
+		err := brp.GetHyperBlocksRange(100, 109, providedOptions, func(item interface{}) error {
+			nonces = append(nonces, item.(*data.HyperblockApiResponsePayload).Hyperblock.Nonce)
+			return nil
+		})
+		require.Nil(t, err)
+		require.Equal(t, []uint64{100, 101, 102, 103, 104, 105, 106, 107, 108, 109}, nonces)
+		require.LessOrEqual(t, atomic.LoadInt32(&maxInFlight), int32(args.MaxParallelRequests))
+	})
+	t.Run("should stop on the first error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		args := createMockArgsBlocksRangeProcessor()
+		args.HyperblockProvider = &mock.HyperblockProviderStub{
+			GetHyperBlockByNonceCalled: func(nonce uint64, options common.HyperblockQueryOptions) (*data.HyperblockApiResponse, error) {
+				if nonce == 3 {
+					return nil, expectedErr
+				}
+
+				return data.NewHyperblockApiResponse(api.Hyperblock{Nonce: nonce}), nil
+			},
+		}
+		brp, _ := process.NewBlocksRangeProcessor(args)
+
+		numHandled := 0
+		err := brp.GetHyperBlocksRange(0, 9, common.HyperblockQueryOptions{}, func(item interface{}) error {
+			numHandled++
+			return nil
+		})
+		require.True(t, errors.Is(err, expectedErr))
+		require.Equal(t, 3, numHandled)
+	})
+	t.Run("handler error should stop the range", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("client gone")
+		args := createMockArgsBlocksRangeProcessor()
+		args.HyperblockProvider = &mock.HyperblockProviderStub{
+			GetHyperBlockByNonceCalled: func(nonce uint64, options common.HyperblockQueryOptions) (*data.HyperblockApiResponse, error) {
+				return data.NewHyperblockApiResponse(api.Hyperblock{Nonce: nonce}), nil
+			},
+		}
+		brp, _ := process.NewBlocksRangeProcessor(args)
+
+		err := brp.GetHyperBlocksRange(0, 9, common.HyperblockQueryOptions{}, func(item interface{}) error {
+			return expectedErr
+		})
+		require.Equal(t, expectedErr, err)
+	})
+}
+
+func TestBlocksRangeProcessor_GetBlocksRange(t *testing.T) {
+	t.Parallel()
+
+	providedOptions := common.BlockQueryOptions{WithTransactions: true}
+	args := createMockArgsBlocksRangeProcessor()
+	args.BlockProvider = &mock.BlockByNonceProviderStub{
+		GetBlockByNonceCalled: func(shardID uint32, nonce uint64, options common.BlockQueryOptions) (*data.BlockApiResponse, error) {
+			require.Equal(t, uint32(2), shardID)
+			require.Equal(t, providedOptions, options)
+
+			return &data.BlockApiResponse{Data: data.BlockApiResponsePayload{Block: api.Block{Nonce: nonce}}}, nil
+		},
+	}
+	brp, _ := process.NewBlocksRangeProcessor(args)
+
+	nonces := make([]uint64, 0)
+	err := brp.GetBlocksRange(2, 7, 9, providedOptions, func(item interface{}) error {
+		nonces = append(nonces, item.(*data.BlockApiResponsePayload).Block.Nonce)
+		return nil
+	})
+	require.Nil(t, err)
+	require.Equal(t, []uint64{7, 8, 9}, nonces)
+}
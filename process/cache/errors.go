@@ -19,3 +19,15 @@ var ErrNilGenericApiResponseInCache = errors.New("nil generic api response in ca
 
 // ErrNilGenericApiResponseToStoreInCache signals that the provided generic api response is nil
 var ErrNilGenericApiResponseToStoreInCache = errors.New("nil generic api response to store in cache")
+
+// ErrNilSnapshotStorer signals that a nil snapshot storer has been provided
+var ErrNilSnapshotStorer = errors.New("nil snapshot storer")
+
+// ErrEmptySnapshotsPath signals that an empty path for the snapshots database has been provided
+var ErrEmptySnapshotsPath = errors.New("empty snapshots path")
+
+// ErrEmptySnapshotKey signals that an empty snapshot key has been provided
+var ErrEmptySnapshotKey = errors.New("empty snapshot key")
+
+// ErrSnapshotNotFound signals that no snapshot was found for the provided key
+var ErrSnapshotNotFound = errors.New("snapshot not found")
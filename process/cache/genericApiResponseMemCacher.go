@@ -2,6 +2,7 @@ package cache
 
 import (
 	"sync"
+	"time"
 
 	"github.com/multiversx/mx-chain-proxy-go/data"
 )
@@ -9,6 +10,7 @@ import (
 // genericApiResponseMemoryCacher will handle caching the ValidatorsStatss response
 type genericApiResponseMemoryCacher struct {
 	storedResponse        *data.GenericAPIResponse
+	lastStoreTime         time.Time
 	mutGenericApiResponse sync.RWMutex
 }
 
@@ -36,9 +38,18 @@ func (garmc *genericApiResponseMemoryCacher) Load() (*data.GenericAPIResponse, e
 func (garmc *genericApiResponseMemoryCacher) Store(genericApiResponse *data.GenericAPIResponse) {
 	garmc.mutGenericApiResponse.Lock()
 	garmc.storedResponse = genericApiResponse
+	garmc.lastStoreTime = time.Now()
 	garmc.mutGenericApiResponse.Unlock()
 }
 
+// GetCacheAge returns the time elapsed since the stored generic api response was updated
+func (garmc *genericApiResponseMemoryCacher) GetCacheAge() time.Duration {
+	garmc.mutGenericApiResponse.RLock()
+	defer garmc.mutGenericApiResponse.RUnlock()
+
+	return getCacheAge(garmc.lastStoreTime)
+}
+
 // IsInterfaceNil will return true if there is no value under the interface
 func (garmc *genericApiResponseMemoryCacher) IsInterfaceNil() bool {
 	return garmc == nil
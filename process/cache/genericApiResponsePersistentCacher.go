@@ -0,0 +1,93 @@
+package cache
+
+import (
+	"sync"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+// genericApiResponsePersistentCacher will handle caching a generic api response, persisting it on disk so the last
+// known response survives a restart and can be served until it is refreshed
+type genericApiResponsePersistentCacher struct {
+	storer                SnapshotStorer
+	key                   []byte
+	storedResponse        *data.GenericAPIResponse
+	lastStoreTime         time.Time
+	mutGenericApiResponse sync.RWMutex
+}
+
+// NewGenericApiResponsePersistentCacher will return a new instance of genericApiResponsePersistentCacher, loaded
+// with the response persisted under the provided key (if any)
+func NewGenericApiResponsePersistentCacher(storer SnapshotStorer, key string) (*genericApiResponsePersistentCacher, error) {
+	if check.IfNil(storer) {
+		return nil, ErrNilSnapshotStorer
+	}
+	if len(key) == 0 {
+		return nil, ErrEmptySnapshotKey
+	}
+
+	garpc := &genericApiResponsePersistentCacher{
+		storer: storer,
+		key:    []byte(key),
+	}
+
+	storedResponse := &data.GenericAPIResponse{}
+	lastStoreTime, err := loadSnapshot(storer, garpc.key, storedResponse)
+	if err != nil {
+		log.Debug("generic api response persistent cacher: no snapshot loaded", "key", key, "error", err.Error())
+		return garpc, nil
+	}
+
+	garpc.storedResponse = storedResponse
+	garpc.lastStoreTime = lastStoreTime
+	log.Info("generic api response persistent cacher: loaded snapshot", "key", key, "age", getCacheAge(lastStoreTime))
+
+	return garpc, nil
+}
+
+// Load will return the generic api response stored in cache (if found)
+func (garpc *genericApiResponsePersistentCacher) Load() (*data.GenericAPIResponse, error) {
+	garpc.mutGenericApiResponse.RLock()
+	defer garpc.mutGenericApiResponse.RUnlock()
+
+	if garpc.storedResponse == nil {
+		return nil, ErrNilGenericApiResponseInCache
+	}
+
+	return garpc.storedResponse, nil
+}
+
+// Store will update the generic api response in cache and will persist it. A nil response clears both the cache
+// and the persisted snapshot
+func (garpc *genericApiResponsePersistentCacher) Store(genericApiResponse *data.GenericAPIResponse) {
+	storeTime := time.Now()
+	garpc.mutGenericApiResponse.Lock()
+	garpc.storedResponse = genericApiResponse
+	garpc.lastStoreTime = storeTime
+	garpc.mutGenericApiResponse.Unlock()
+
+	var err error
+	if genericApiResponse == nil {
+		err = garpc.storer.Remove(garpc.key)
+	} else {
+		err = saveSnapshot(garpc.storer, garpc.key, genericApiResponse, storeTime)
+	}
+	if err != nil {
+		log.Warn("generic api response persistent cacher: cannot persist snapshot", "key", string(garpc.key), "error", err.Error())
+	}
+}
+
+// GetCacheAge returns the time elapsed since the stored generic api response was updated
+func (garpc *genericApiResponsePersistentCacher) GetCacheAge() time.Duration {
+	garpc.mutGenericApiResponse.RLock()
+	defer garpc.mutGenericApiResponse.RUnlock()
+
+	return getCacheAge(garpc.lastStoreTime)
+}
+
+// IsInterfaceNil will return true if there is no value under the interface
+func (garpc *genericApiResponsePersistentCacher) IsInterfaceNil() bool {
+	return garpc == nil
+}
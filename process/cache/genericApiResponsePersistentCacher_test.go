@@ -0,0 +1,76 @@
+package cache_test
+
+import (
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/multiversx/mx-chain-proxy-go/process/cache"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewGenericApiResponsePersistentCacher(t *testing.T) {
+	t.Parallel()
+
+	garpc, err := cache.NewGenericApiResponsePersistentCacher(nil, "key")
+	assert.True(t, check.IfNil(garpc))
+	assert.Equal(t, cache.ErrNilSnapshotStorer, err)
+
+	storer := createSnapshotStorer(t, t.TempDir())
+	defer func() {
+		_ = storer.Close()
+	}()
+
+	garpc, err = cache.NewGenericApiResponsePersistentCacher(storer, "")
+	assert.True(t, check.IfNil(garpc))
+	assert.Equal(t, cache.ErrEmptySnapshotKey, err)
+
+	garpc, err = cache.NewGenericApiResponsePersistentCacher(storer, "key")
+	assert.False(t, check.IfNil(garpc))
+	assert.NoError(t, err)
+
+	response, err := garpc.Load()
+	assert.Nil(t, response)
+	assert.Equal(t, cache.ErrNilGenericApiResponseInCache, err)
+}
+
+func TestGenericApiResponsePersistentCacher_ShouldSurviveRestarts(t *testing.T) {
+	t.Parallel()
+
+	path := t.TempDir()
+	response := &data.GenericAPIResponse{
+		Data: map[string]interface{}{
+			"metrics": map[string]interface{}{
+				"erd_total_supply": "1000",
+			},
+		},
+		Code: data.ReturnCodeSuccess,
+	}
+
+	storer := createSnapshotStorer(t, path)
+	garpc, _ := cache.NewGenericApiResponsePersistentCacher(storer, "economics")
+	garpc.Store(response)
+	require.NoError(t, storer.Close())
+
+	storer = createSnapshotStorer(t, path)
+	garpc, _ = cache.NewGenericApiResponsePersistentCacher(storer, "economics")
+	loadedResponse, err := garpc.Load()
+	require.NoError(t, err)
+	assert.Equal(t, response, loadedResponse)
+	assert.True(t, garpc.GetCacheAge() > 0)
+
+	// storing a nil response should also remove the snapshot
+	garpc.Store(nil)
+	require.NoError(t, storer.Close())
+
+	storer = createSnapshotStorer(t, path)
+	defer func() {
+		_ = storer.Close()
+	}()
+
+	garpc, _ = cache.NewGenericApiResponsePersistentCacher(storer, "economics")
+	loadedResponse, err = garpc.Load()
+	assert.Nil(t, loadedResponse)
+	assert.Equal(t, cache.ErrNilGenericApiResponseInCache, err)
+}
@@ -2,14 +2,17 @@ package cache
 
 import (
 	"sync"
+	"time"
 
 	"github.com/multiversx/mx-chain-proxy-go/data"
 )
 
 // HeartbeatMemoryCacher will handle caching the heartbeats response
 type HeartbeatMemoryCacher struct {
-	storedHeartbeats []data.PubKeyHeartbeat
-	mutHeartbeats    sync.RWMutex
+	storedHeartbeats    []data.PubKeyHeartbeat
+	storedMissingShards []uint32
+	lastStoreTime       time.Time
+	mutHeartbeats       sync.RWMutex
 }
 
 // NewHeartbeatMemoryCacher will return a new instance of HeartbeatMemoryCacher
@@ -29,7 +32,10 @@ func (hmc *HeartbeatMemoryCacher) LoadHeartbeats() (*data.HeartbeatResponse, err
 		return nil, ErrNilHeartbeatsInCache
 	}
 
-	return &data.HeartbeatResponse{Heartbeats: hmc.storedHeartbeats}, nil
+	return &data.HeartbeatResponse{
+		Heartbeats:    hmc.storedHeartbeats,
+		MissingShards: hmc.storedMissingShards,
+	}, nil
 }
 
 // StoreHeartbeats will update the stored heartbeats response in cache
@@ -40,11 +46,21 @@ func (hmc *HeartbeatMemoryCacher) StoreHeartbeats(hbts *data.HeartbeatResponse)
 
 	hmc.mutHeartbeats.Lock()
 	hmc.storedHeartbeats = hbts.Heartbeats
+	hmc.storedMissingShards = hbts.MissingShards
+	hmc.lastStoreTime = time.Now()
 	hmc.mutHeartbeats.Unlock()
 
 	return nil
 }
 
+// GetCacheAge returns the time elapsed since the stored heartbeats were updated
+func (hmc *HeartbeatMemoryCacher) GetCacheAge() time.Duration {
+	hmc.mutHeartbeats.RLock()
+	defer hmc.mutHeartbeats.RUnlock()
+
+	return getCacheAge(hmc.lastStoreTime)
+}
+
 // IsInterfaceNil will return true if there is no value under the interface
 func (hmc *HeartbeatMemoryCacher) IsInterfaceNil() bool {
 	return hmc == nil
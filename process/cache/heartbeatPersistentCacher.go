@@ -0,0 +1,91 @@
+package cache
+
+import (
+	"sync"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+var heartbeatsSnapshotKey = []byte("heartbeats")
+
+// heartbeatPersistentCacher will handle caching the heartbeats response, persisting it on disk so the last known
+// heartbeats survive a restart and can be served until they are refreshed
+type heartbeatPersistentCacher struct {
+	storer           SnapshotStorer
+	storedHeartbeats *data.HeartbeatResponse
+	lastStoreTime    time.Time
+	mutHeartbeats    sync.RWMutex
+}
+
+// NewHeartbeatPersistentCacher will return a new instance of heartbeatPersistentCacher, loaded with the persisted
+// heartbeats snapshot (if any)
+func NewHeartbeatPersistentCacher(storer SnapshotStorer) (*heartbeatPersistentCacher, error) {
+	if check.IfNil(storer) {
+		return nil, ErrNilSnapshotStorer
+	}
+
+	hpc := &heartbeatPersistentCacher{
+		storer: storer,
+	}
+
+	storedHeartbeats := &data.HeartbeatResponse{}
+	lastStoreTime, err := loadSnapshot(storer, heartbeatsSnapshotKey, storedHeartbeats)
+	if err != nil {
+		log.Debug("heartbeat persistent cacher: no snapshot loaded", "error", err.Error())
+		return hpc, nil
+	}
+
+	hpc.storedHeartbeats = storedHeartbeats
+	hpc.lastStoreTime = lastStoreTime
+	log.Info("heartbeat persistent cacher: loaded snapshot", "age", getCacheAge(lastStoreTime))
+
+	return hpc, nil
+}
+
+// LoadHeartbeats will return the heartbeats response stored in cache (if found)
+func (hpc *heartbeatPersistentCacher) LoadHeartbeats() (*data.HeartbeatResponse, error) {
+	hpc.mutHeartbeats.RLock()
+	defer hpc.mutHeartbeats.RUnlock()
+
+	if hpc.storedHeartbeats == nil {
+		return nil, ErrNilHeartbeatsInCache
+	}
+
+	return &data.HeartbeatResponse{
+		Heartbeats:    hpc.storedHeartbeats.Heartbeats,
+		MissingShards: hpc.storedHeartbeats.MissingShards,
+	}, nil
+}
+
+// StoreHeartbeats will update the stored heartbeats response in cache and will persist it
+func (hpc *heartbeatPersistentCacher) StoreHeartbeats(hbts *data.HeartbeatResponse) error {
+	if hbts == nil {
+		return ErrNilHeartbeatsToStoreInCache
+	}
+
+	storeTime := time.Now()
+	hpc.mutHeartbeats.Lock()
+	hpc.storedHeartbeats = &data.HeartbeatResponse{
+		Heartbeats:    hbts.Heartbeats,
+		MissingShards: hbts.MissingShards,
+	}
+	hpc.lastStoreTime = storeTime
+	hpc.mutHeartbeats.Unlock()
+
+	return saveSnapshot(hpc.storer, heartbeatsSnapshotKey, hbts, storeTime)
+}
+
+// GetCacheAge returns the time elapsed since the stored heartbeats were updated
+func (hpc *heartbeatPersistentCacher) GetCacheAge() time.Duration {
+	hpc.mutHeartbeats.RLock()
+	defer hpc.mutHeartbeats.RUnlock()
+
+	return getCacheAge(hpc.lastStoreTime)
+}
+
+// IsInterfaceNil will return true if there is no value under the interface
+func (hpc *heartbeatPersistentCacher) IsInterfaceNil() bool {
+	return hpc == nil
+}
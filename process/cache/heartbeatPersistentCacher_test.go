@@ -0,0 +1,88 @@
+package cache_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/multiversx/mx-chain-proxy-go/process/cache"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewHeartbeatPersistentCacher(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil storer should err", func(t *testing.T) {
+		t.Parallel()
+
+		hpc, err := cache.NewHeartbeatPersistentCacher(nil)
+		assert.True(t, check.IfNil(hpc))
+		assert.Equal(t, cache.ErrNilSnapshotStorer, err)
+	})
+
+	t.Run("empty storer should work", func(t *testing.T) {
+		t.Parallel()
+
+		storer := createSnapshotStorer(t, t.TempDir())
+		defer func() {
+			_ = storer.Close()
+		}()
+
+		hpc, err := cache.NewHeartbeatPersistentCacher(storer)
+		assert.False(t, check.IfNil(hpc))
+		assert.NoError(t, err)
+
+		hbts, err := hpc.LoadHeartbeats()
+		assert.Nil(t, hbts)
+		assert.Equal(t, cache.ErrNilHeartbeatsInCache, err)
+		assert.Equal(t, time.Duration(0), hpc.GetCacheAge())
+	})
+}
+
+func TestHeartbeatPersistentCacher_StoreHeartbeatsNilHbtsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	storer := createSnapshotStorer(t, t.TempDir())
+	defer func() {
+		_ = storer.Close()
+	}()
+
+	hpc, _ := cache.NewHeartbeatPersistentCacher(storer)
+	err := hpc.StoreHeartbeats(nil)
+	assert.Equal(t, cache.ErrNilHeartbeatsToStoreInCache, err)
+}
+
+func TestHeartbeatPersistentCacher_ShouldSurviveRestarts(t *testing.T) {
+	t.Parallel()
+
+	path := t.TempDir()
+	hbtsResp := &data.HeartbeatResponse{
+		Heartbeats: []data.PubKeyHeartbeat{
+			{
+				NodeDisplayName: "node1",
+				PublicKey:       "pk1",
+			},
+		},
+		MissingShards: []uint32{1},
+	}
+
+	storer := createSnapshotStorer(t, path)
+	hpc, _ := cache.NewHeartbeatPersistentCacher(storer)
+	require.NoError(t, hpc.StoreHeartbeats(hbtsResp))
+	require.NoError(t, storer.Close())
+
+	storer = createSnapshotStorer(t, path)
+	defer func() {
+		_ = storer.Close()
+	}()
+
+	hpc, _ = cache.NewHeartbeatPersistentCacher(storer)
+	hbts, err := hpc.LoadHeartbeats()
+	require.NoError(t, err)
+	assert.Equal(t, hbtsResp.Heartbeats[0].PublicKey, hbts.Heartbeats[0].PublicKey)
+	assert.Equal(t, hbtsResp.Heartbeats[0].NodeDisplayName, hbts.Heartbeats[0].NodeDisplayName)
+	assert.Equal(t, hbtsResp.MissingShards, hbts.MissingShards)
+	assert.True(t, hpc.GetCacheAge() > 0)
+}
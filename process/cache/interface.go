@@ -0,0 +1,10 @@
+package cache
+
+// SnapshotStorer defines what a key-value store able to persist the cached data snapshots should do
+type SnapshotStorer interface {
+	Put(key []byte, value []byte) error
+	Get(key []byte) ([]byte, error)
+	Remove(key []byte) error
+	Close() error
+	IsInterfaceNil() bool
+}
@@ -0,0 +1,57 @@
+package cache
+
+import (
+	"github.com/syndtr/goleveldb/leveldb"
+	"github.com/syndtr/goleveldb/leveldb/opt"
+)
+
+// levelDBSnapshotStorer is a SnapshotStorer backed by an embedded LevelDB database
+type levelDBSnapshotStorer struct {
+	db *leveldb.DB
+}
+
+// NewLevelDBSnapshotStorer opens (or creates) the LevelDB database found at the provided path
+func NewLevelDBSnapshotStorer(path string) (*levelDBSnapshotStorer, error) {
+	if len(path) == 0 {
+		return nil, ErrEmptySnapshotsPath
+	}
+
+	db, err := leveldb.OpenFile(path, &opt.Options{})
+	if err != nil {
+		return nil, err
+	}
+
+	return &levelDBSnapshotStorer{
+		db: db,
+	}, nil
+}
+
+// Put will save the value under the provided key
+func (storer *levelDBSnapshotStorer) Put(key []byte, value []byte) error {
+	return storer.db.Put(key, value, &opt.WriteOptions{Sync: true})
+}
+
+// Get returns the value stored under the provided key or ErrSnapshotNotFound if the key is missing
+func (storer *levelDBSnapshotStorer) Get(key []byte) ([]byte, error) {
+	value, err := storer.db.Get(key, nil)
+	if err == leveldb.ErrNotFound {
+		return nil, ErrSnapshotNotFound
+	}
+
+	return value, err
+}
+
+// Remove will delete the value stored under the provided key
+func (storer *levelDBSnapshotStorer) Remove(key []byte) error {
+	return storer.db.Delete(key, &opt.WriteOptions{Sync: true})
+}
+
+// Close will close the underlying database
+func (storer *levelDBSnapshotStorer) Close() error {
+	return storer.db.Close()
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (storer *levelDBSnapshotStorer) IsInterfaceNil() bool {
+	return storer == nil
+}
@@ -0,0 +1,63 @@
+package cache_test
+
+import (
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-proxy-go/process/cache"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createSnapshotStorer(t *testing.T, path string) cache.SnapshotStorer {
+	storer, err := cache.NewLevelDBSnapshotStorer(path)
+	require.NoError(t, err)
+
+	return storer
+}
+
+func TestNewLevelDBSnapshotStorer(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty path should err", func(t *testing.T) {
+		t.Parallel()
+
+		storer, err := cache.NewLevelDBSnapshotStorer("")
+		assert.True(t, check.IfNil(storer))
+		assert.Equal(t, cache.ErrEmptySnapshotsPath, err)
+	})
+
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		storer, err := cache.NewLevelDBSnapshotStorer(t.TempDir())
+		assert.False(t, check.IfNil(storer))
+		assert.NoError(t, err)
+		assert.NoError(t, storer.Close())
+	})
+}
+
+func TestLevelDBSnapshotStorer_PutGetRemove(t *testing.T) {
+	t.Parallel()
+
+	path := t.TempDir()
+	storer := createSnapshotStorer(t, path)
+
+	value, err := storer.Get([]byte("key"))
+	assert.Nil(t, value)
+	assert.Equal(t, cache.ErrSnapshotNotFound, err)
+
+	require.NoError(t, storer.Put([]byte("key"), []byte("value")))
+	require.NoError(t, storer.Close())
+
+	// the value should survive reopening the database
+	storer = createSnapshotStorer(t, path)
+	value, err = storer.Get([]byte("key"))
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("value"), value)
+
+	require.NoError(t, storer.Remove([]byte("key")))
+	_, err = storer.Get([]byte("key"))
+	assert.Equal(t, cache.ErrSnapshotNotFound, err)
+	assert.NoError(t, storer.Close())
+}
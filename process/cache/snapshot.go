@@ -0,0 +1,62 @@
+package cache
+
+import (
+	"encoding/json"
+	"time"
+
+	logger "github.com/multiversx/mx-chain-logger-go"
+)
+
+var log = logger.GetOrCreate("process/cache")
+
+// snapshot is the format the cached data is persisted in, alongside the moment it was stored
+type snapshot struct {
+	Timestamp int64           `json:"timestamp"`
+	Data      json.RawMessage `json:"data"`
+}
+
+func saveSnapshot(storer SnapshotStorer, key []byte, value interface{}, storeTime time.Time) error {
+	dataBytes, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+
+	snapshotBytes, err := json.Marshal(&snapshot{
+		Timestamp: storeTime.UnixNano(),
+		Data:      dataBytes,
+	})
+	if err != nil {
+		return err
+	}
+
+	return storer.Put(key, snapshotBytes)
+}
+
+// loadSnapshot will decode the persisted data into the provided value and will return the moment it was stored
+func loadSnapshot(storer SnapshotStorer, key []byte, value interface{}) (time.Time, error) {
+	snapshotBytes, err := storer.Get(key)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	storedSnapshot := &snapshot{}
+	err = json.Unmarshal(snapshotBytes, storedSnapshot)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	err = json.Unmarshal(storedSnapshot.Data, value)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	return time.Unix(0, storedSnapshot.Timestamp), nil
+}
+
+func getCacheAge(lastStoreTime time.Time) time.Duration {
+	if lastStoreTime.IsZero() {
+		return 0
+	}
+
+	return time.Since(lastStoreTime)
+}
@@ -2,6 +2,7 @@ package cache
 
 import (
 	"sync"
+	"time"
 
 	"github.com/multiversx/mx-chain-proxy-go/data"
 )
@@ -9,6 +10,7 @@ import (
 // validatorsStatsMemoryCacher will handle caching the ValidatorsStatss response
 type validatorsStatsMemoryCacher struct {
 	storedValidatorsStats map[string]*data.ValidatorApiResponse
+	lastStoreTime         time.Time
 	mutValidatorsStatss   sync.RWMutex
 }
 
@@ -40,11 +42,20 @@ func (vsmc *validatorsStatsMemoryCacher) StoreValStats(valStats map[string]*data
 
 	vsmc.mutValidatorsStatss.Lock()
 	vsmc.storedValidatorsStats = valStats
+	vsmc.lastStoreTime = time.Now()
 	vsmc.mutValidatorsStatss.Unlock()
 
 	return nil
 }
 
+// GetCacheAge returns the time elapsed since the stored validator statistics were updated
+func (vsmc *validatorsStatsMemoryCacher) GetCacheAge() time.Duration {
+	vsmc.mutValidatorsStatss.RLock()
+	defer vsmc.mutValidatorsStatss.RUnlock()
+
+	return getCacheAge(vsmc.lastStoreTime)
+}
+
 // IsInterfaceNil will return true if there is no value under the interface
 func (vsmc *validatorsStatsMemoryCacher) IsInterfaceNil() bool {
 	return vsmc == nil
@@ -0,0 +1,85 @@
+package cache
+
+import (
+	"sync"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+var validatorStatsSnapshotKey = []byte("validatorStatistics")
+
+// validatorStatsPersistentCacher will handle caching the validator statistics, persisting them on disk so the last
+// known statistics survive a restart and can be served until they are refreshed
+type validatorStatsPersistentCacher struct {
+	storer                SnapshotStorer
+	storedValidatorsStats map[string]*data.ValidatorApiResponse
+	lastStoreTime         time.Time
+	mutValidatorsStats    sync.RWMutex
+}
+
+// NewValidatorStatsPersistentCacher will return a new instance of validatorStatsPersistentCacher, loaded with the
+// persisted validator statistics snapshot (if any)
+func NewValidatorStatsPersistentCacher(storer SnapshotStorer) (*validatorStatsPersistentCacher, error) {
+	if check.IfNil(storer) {
+		return nil, ErrNilSnapshotStorer
+	}
+
+	vspc := &validatorStatsPersistentCacher{
+		storer: storer,
+	}
+
+	storedValidatorsStats := make(map[string]*data.ValidatorApiResponse)
+	lastStoreTime, err := loadSnapshot(storer, validatorStatsSnapshotKey, &storedValidatorsStats)
+	if err != nil {
+		log.Debug("validator statistics persistent cacher: no snapshot loaded", "error", err.Error())
+		return vspc, nil
+	}
+
+	vspc.storedValidatorsStats = storedValidatorsStats
+	vspc.lastStoreTime = lastStoreTime
+	log.Info("validator statistics persistent cacher: loaded snapshot", "age", getCacheAge(lastStoreTime))
+
+	return vspc, nil
+}
+
+// LoadValStats will return the validator statistics stored in cache (if found)
+func (vspc *validatorStatsPersistentCacher) LoadValStats() (map[string]*data.ValidatorApiResponse, error) {
+	vspc.mutValidatorsStats.RLock()
+	defer vspc.mutValidatorsStats.RUnlock()
+
+	if vspc.storedValidatorsStats == nil {
+		return nil, ErrNilValidatorStatsInCache
+	}
+
+	return vspc.storedValidatorsStats, nil
+}
+
+// StoreValStats will update the stored validator statistics in cache and will persist them
+func (vspc *validatorStatsPersistentCacher) StoreValStats(valStats map[string]*data.ValidatorApiResponse) error {
+	if valStats == nil {
+		return ErrNilValidatorStatsToStoreInCache
+	}
+
+	storeTime := time.Now()
+	vspc.mutValidatorsStats.Lock()
+	vspc.storedValidatorsStats = valStats
+	vspc.lastStoreTime = storeTime
+	vspc.mutValidatorsStats.Unlock()
+
+	return saveSnapshot(vspc.storer, validatorStatsSnapshotKey, valStats, storeTime)
+}
+
+// GetCacheAge returns the time elapsed since the stored validator statistics were updated
+func (vspc *validatorStatsPersistentCacher) GetCacheAge() time.Duration {
+	vspc.mutValidatorsStats.RLock()
+	defer vspc.mutValidatorsStats.RUnlock()
+
+	return getCacheAge(vspc.lastStoreTime)
+}
+
+// IsInterfaceNil will return true if there is no value under the interface
+func (vspc *validatorStatsPersistentCacher) IsInterfaceNil() bool {
+	return vspc == nil
+}
@@ -0,0 +1,63 @@
+package cache_test
+
+import (
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/multiversx/mx-chain-proxy-go/process/cache"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewValidatorStatsPersistentCacher(t *testing.T) {
+	t.Parallel()
+
+	vspc, err := cache.NewValidatorStatsPersistentCacher(nil)
+	assert.True(t, check.IfNil(vspc))
+	assert.Equal(t, cache.ErrNilSnapshotStorer, err)
+
+	storer := createSnapshotStorer(t, t.TempDir())
+	defer func() {
+		_ = storer.Close()
+	}()
+
+	vspc, err = cache.NewValidatorStatsPersistentCacher(storer)
+	assert.False(t, check.IfNil(vspc))
+	assert.NoError(t, err)
+
+	valStats, err := vspc.LoadValStats()
+	assert.Nil(t, valStats)
+	assert.Equal(t, cache.ErrNilValidatorStatsInCache, err)
+
+	err = vspc.StoreValStats(nil)
+	assert.Equal(t, cache.ErrNilValidatorStatsToStoreInCache, err)
+}
+
+func TestValidatorStatsPersistentCacher_ShouldSurviveRestarts(t *testing.T) {
+	t.Parallel()
+
+	path := t.TempDir()
+	valStats := map[string]*data.ValidatorApiResponse{
+		"pk1": {
+			TempRating:      50.5,
+			ValidatorStatus: "eligible",
+		},
+	}
+
+	storer := createSnapshotStorer(t, path)
+	vspc, _ := cache.NewValidatorStatsPersistentCacher(storer)
+	require.NoError(t, vspc.StoreValStats(valStats))
+	require.NoError(t, storer.Close())
+
+	storer = createSnapshotStorer(t, path)
+	defer func() {
+		_ = storer.Close()
+	}()
+
+	vspc, _ = cache.NewValidatorStatsPersistentCacher(storer)
+	loadedValStats, err := vspc.LoadValStats()
+	require.NoError(t, err)
+	assert.Equal(t, valStats, loadedValStats)
+	assert.True(t, vspc.GetCacheAge() > 0)
+}
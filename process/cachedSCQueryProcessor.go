@@ -0,0 +1,282 @@
+package process
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data/vm"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+const queryKeySeparator = "|"
+
+// ArgsCachedSCQueryProcessor holds the arguments needed to create a new cached smart contracts query processor
+type ArgsCachedSCQueryProcessor struct {
+	SCQueryService       SCQueryService
+	Processor            Processor
+	PubKeyConverter      core.PubkeyConverter
+	MaxEntriesPerShard   int
+	NonceRefreshInterval time.Duration
+}
+
+type cachedQueryResult struct {
+	vmOutput  *vm.VMOutputApi
+	blockInfo data.BlockInfo
+}
+
+type shardQueriesCache struct {
+	blockNonce    uint64
+	lastRefreshed time.Time
+	results       map[string]*cachedQueryResult
+}
+
+// CachedSCQueryProcessor wraps a smart contracts query service and caches the successful results of the queries
+// executed on the latest state. The cache entries are bound to the block nonce of the contract's shard, so all the
+// entries of a shard are evicted as soon as the shard advances. Concurrent identical queries are coalesced into a
+// single observer call
+type CachedSCQueryProcessor struct {
+	scQueryService       SCQueryService
+	proc                 Processor
+	pubKeyConverter      core.PubkeyConverter
+	maxEntriesPerShard   int
+	nonceRefreshInterval time.Duration
+	coalescer            *requestsCoalescer
+
+	mutShards sync.Mutex
+	shards    map[uint32]*shardQueriesCache
+}
+
+// NewCachedSCQueryProcessor creates a new instance of CachedSCQueryProcessor
+func NewCachedSCQueryProcessor(args ArgsCachedSCQueryProcessor) (*CachedSCQueryProcessor, error) {
+	if check.IfNil(args.SCQueryService) {
+		return nil, ErrNilSCQueryService
+	}
+	if check.IfNil(args.Processor) {
+		return nil, ErrNilCoreProcessor
+	}
+	if check.IfNil(args.PubKeyConverter) {
+		return nil, ErrNilPubKeyConverter
+	}
+	if args.MaxEntriesPerShard <= 0 {
+		return nil, ErrInvalidMaxCacheEntries
+	}
+	if args.NonceRefreshInterval <= 0 {
+		return nil, ErrInvalidNonceRefreshInterval
+	}
+
+	return &CachedSCQueryProcessor{
+		scQueryService:       args.SCQueryService,
+		proc:                 args.Processor,
+		pubKeyConverter:      args.PubKeyConverter,
+		maxEntriesPerShard:   args.MaxEntriesPerShard,
+		nonceRefreshInterval: args.NonceRefreshInterval,
+		coalescer:            newRequestsCoalescer(),
+		shards:               make(map[uint32]*shardQueriesCache),
+	}, nil
+}
+
+// ExecuteQuery returns the cached result of the query, if the query was already executed at the current block nonce
+// of the contract's shard. Otherwise, the query is executed and its result is cached. The queries targeting a past
+// block are not cached
+func (cqp *CachedSCQueryProcessor) ExecuteQuery(query *data.SCQuery) (*vm.VMOutputApi, data.BlockInfo, error) {
+	if query.IsHistorical() {
+		return cqp.scQueryService.ExecuteQuery(query)
+	}
+
+	shardID, err := cqp.computeShardID(query)
+	if err != nil {
+		return cqp.scQueryService.ExecuteQuery(query)
+	}
+
+	blockNonce, err := cqp.getShardBlockNonce(shardID)
+	if err != nil {
+		log.Debug("SC query cache: cannot get the block nonce of the shard, the cache will not be used",
+			"shard", shardID, "error", err.Error())
+		return cqp.scQueryService.ExecuteQuery(query)
+	}
+
+	key := createQueryKey(query, blockNonce)
+	result, found := cqp.getResult(shardID, blockNonce, key)
+	if found {
+		return result.vmOutput, result.blockInfo, nil
+	}
+
+	response, _, err := cqp.coalescer.do(key, func() (interface{}, error) {
+		vmOutput, blockInfo, errQuery := cqp.scQueryService.ExecuteQuery(query)
+		if errQuery != nil {
+			return nil, errQuery
+		}
+
+		return &cachedQueryResult{
+			vmOutput:  vmOutput,
+			blockInfo: blockInfo,
+		}, nil
+	})
+	if err != nil {
+		return nil, data.BlockInfo{}, err
+	}
+
+	result, ok := response.(*cachedQueryResult)
+	if !ok {
+		return nil, data.BlockInfo{}, ErrInvalidCoalescedResult
+	}
+	cqp.putResult(shardID, blockNonce, key, result)
+
+	return result.vmOutput, result.blockInfo, nil
+}
+
+// ExecuteQueries executes the batch of queries without using the cache, as the queries are pinned to a block nonce
+func (cqp *CachedSCQueryProcessor) ExecuteQueries(queries []*data.SCQuery) ([]*data.SCQueryResult, error) {
+	return cqp.scQueryService.ExecuteQueries(queries)
+}
+
+func (cqp *CachedSCQueryProcessor) computeShardID(query *data.SCQuery) (uint32, error) {
+	addressBytes, err := cqp.pubKeyConverter.Decode(query.ScAddress)
+	if err != nil {
+		return 0, err
+	}
+
+	return cqp.proc.ComputeShardId(addressBytes)
+}
+
+// getShardBlockNonce returns the block nonce of the shard, as known by the cache. The nonce is refreshed from the
+// shard's observers at most once per refresh interval
+func (cqp *CachedSCQueryProcessor) getShardBlockNonce(shardID uint32) (uint64, error) {
+	cqp.mutShards.Lock()
+	shardCache, found := cqp.shards[shardID]
+	if found && time.Since(shardCache.lastRefreshed) < cqp.nonceRefreshInterval {
+		blockNonce := shardCache.blockNonce
+		cqp.mutShards.Unlock()
+
+		return blockNonce, nil
+	}
+	cqp.mutShards.Unlock()
+
+	key := fmt.Sprintf("shard nonce %d", shardID)
+	response, _, err := cqp.coalescer.do(key, func() (interface{}, error) {
+		return cqp.fetchShardBlockNonce(shardID)
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	blockNonce, ok := response.(uint64)
+	if !ok {
+		return 0, ErrInvalidCoalescedResult
+	}
+	cqp.updateShardBlockNonce(shardID, blockNonce)
+
+	return blockNonce, nil
+}
+
+func (cqp *CachedSCQueryProcessor) fetchShardBlockNonce(shardID uint32) (uint64, error) {
+	observers, err := cqp.proc.GetObservers(shardID)
+	if err != nil {
+		return 0, err
+	}
+
+	for _, observer := range observers {
+		var nodeStatusResponse *data.GenericAPIResponse
+		_, err = cqp.proc.CallGetRestEndPoint(observer.Address, NodeStatusPath, &nodeStatusResponse)
+		if err != nil || nodeStatusResponse == nil {
+			continue
+		}
+
+		metric, ok := getMetric(nodeStatusResponse.Data, MetricNonce)
+		if !ok {
+			return 0, ErrCannotParseNodeStatusMetrics
+		}
+
+		return getUint(metric), nil
+	}
+
+	return 0, ErrSendingRequest
+}
+
+// updateShardBlockNonce records the latest block nonce of the shard, evicting the cached results if the shard advanced
+func (cqp *CachedSCQueryProcessor) updateShardBlockNonce(shardID uint32, blockNonce uint64) {
+	cqp.mutShards.Lock()
+	defer cqp.mutShards.Unlock()
+
+	shardCache, found := cqp.shards[shardID]
+	if !found || blockNonce > shardCache.blockNonce {
+		cqp.shards[shardID] = &shardQueriesCache{
+			blockNonce:    blockNonce,
+			lastRefreshed: time.Now(),
+			results:       make(map[string]*cachedQueryResult),
+		}
+		return
+	}
+
+	shardCache.lastRefreshed = time.Now()
+}
+
+func (cqp *CachedSCQueryProcessor) getResult(shardID uint32, blockNonce uint64, key string) (*cachedQueryResult, bool) {
+	cqp.mutShards.Lock()
+	defer cqp.mutShards.Unlock()
+
+	shardCache, found := cqp.shards[shardID]
+	if !found || shardCache.blockNonce != blockNonce {
+		return nil, false
+	}
+
+	result, found := shardCache.results[key]
+	return result, found
+}
+
+// putResult caches the result, if it was successfully computed against the current block nonce of the shard. A result
+// computed against a newer block means that the shard advanced, so the existing entries are evicted
+func (cqp *CachedSCQueryProcessor) putResult(shardID uint32, blockNonce uint64, key string, result *cachedQueryResult) {
+	if result.vmOutput == nil || result.vmOutput.ReturnCode != vmOutputReturnCodeOk {
+		return
+	}
+
+	resultBlockNonce := result.blockInfo.Nonce
+	if resultBlockNonce > blockNonce {
+		cqp.updateShardBlockNonce(shardID, resultBlockNonce)
+		return
+	}
+	if resultBlockNonce != 0 && resultBlockNonce < blockNonce {
+		// the observer is lagging behind
+		return
+	}
+
+	cqp.mutShards.Lock()
+	defer cqp.mutShards.Unlock()
+
+	shardCache, found := cqp.shards[shardID]
+	if !found || shardCache.blockNonce != blockNonce {
+		return
+	}
+	if len(shardCache.results) >= cqp.maxEntriesPerShard {
+		return
+	}
+
+	shardCache.results[key] = result
+}
+
+func createQueryKey(query *data.SCQuery, blockNonce uint64) string {
+	keyParts := []string{
+		fmt.Sprintf("%d", blockNonce),
+		query.ScAddress,
+		query.FuncName,
+		query.CallerAddr,
+		query.CallValue,
+		fmt.Sprintf("%v %v", query.SameScState, query.ShouldBeSynced),
+	}
+	for _, argument := range query.Arguments {
+		keyParts = append(keyParts, hex.EncodeToString(argument))
+	}
+
+	return strings.Join(keyParts, queryKeySeparator)
+}
+
+// IsInterfaceNil returns true if the value under the interface is nil
+func (cqp *CachedSCQueryProcessor) IsInterfaceNil() bool {
+	return cqp == nil
+}
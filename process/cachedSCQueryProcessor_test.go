@@ -0,0 +1,350 @@
+package process
+
+import (
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/data/vm"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/multiversx/mx-chain-proxy-go/process/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func createArgsCachedSCQueryProcessor() ArgsCachedSCQueryProcessor {
+	return ArgsCachedSCQueryProcessor{
+		SCQueryService: &mock.SCQueryServiceStub{},
+		Processor: &mock.ProcessorStub{
+			GetObserversCalled: func(shardId uint32) ([]*data.NodeData, error) {
+				return []*data.NodeData{{Address: "observer", ShardId: shardId}}, nil
+			},
+			ComputeShardIdCalled: func(addressBuff []byte) (uint32, error) {
+				return 0, nil
+			},
+		},
+		PubKeyConverter:      testPubKeyConverter,
+		MaxEntriesPerShard:   10,
+		NonceRefreshInterval: time.Hour,
+	}
+}
+
+func setNodeStatusNonce(value interface{}, nonce uint64) {
+	response := value.(**data.GenericAPIResponse)
+	*response = &data.GenericAPIResponse{
+		Data: map[string]interface{}{
+			"metrics": map[string]interface{}{
+				MetricNonce: float64(nonce),
+			},
+		},
+	}
+}
+
+func TestNewCachedSCQueryProcessor(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil sc query service should err", func(t *testing.T) {
+		t.Parallel()
+
+		args := createArgsCachedSCQueryProcessor()
+		args.SCQueryService = nil
+		cqp, err := NewCachedSCQueryProcessor(args)
+		require.Nil(t, cqp)
+		require.Equal(t, ErrNilSCQueryService, err)
+	})
+
+	t.Run("nil processor should err", func(t *testing.T) {
+		t.Parallel()
+
+		args := createArgsCachedSCQueryProcessor()
+		args.Processor = nil
+		cqp, err := NewCachedSCQueryProcessor(args)
+		require.Nil(t, cqp)
+		require.Equal(t, ErrNilCoreProcessor, err)
+	})
+
+	t.Run("nil pub key converter should err", func(t *testing.T) {
+		t.Parallel()
+
+		args := createArgsCachedSCQueryProcessor()
+		args.PubKeyConverter = nil
+		cqp, err := NewCachedSCQueryProcessor(args)
+		require.Nil(t, cqp)
+		require.Equal(t, ErrNilPubKeyConverter, err)
+	})
+
+	t.Run("invalid max entries should err", func(t *testing.T) {
+		t.Parallel()
+
+		args := createArgsCachedSCQueryProcessor()
+		args.MaxEntriesPerShard = 0
+		cqp, err := NewCachedSCQueryProcessor(args)
+		require.Nil(t, cqp)
+		require.Equal(t, ErrInvalidMaxCacheEntries, err)
+	})
+
+	t.Run("invalid nonce refresh interval should err", func(t *testing.T) {
+		t.Parallel()
+
+		args := createArgsCachedSCQueryProcessor()
+		args.NonceRefreshInterval = 0
+		cqp, err := NewCachedSCQueryProcessor(args)
+		require.Nil(t, cqp)
+		require.Equal(t, ErrInvalidNonceRefreshInterval, err)
+	})
+
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		cqp, err := NewCachedSCQueryProcessor(createArgsCachedSCQueryProcessor())
+		require.NotNil(t, cqp)
+		require.Nil(t, err)
+		require.False(t, cqp.IsInterfaceNil())
+	})
+}
+
+func TestCachedSCQueryProcessor_ExecuteQuery(t *testing.T) {
+	t.Parallel()
+
+	t.Run("identical queries at the same block nonce should be served from cache", func(t *testing.T) {
+		t.Parallel()
+
+		numQueries := uint32(0)
+		args := createArgsCachedSCQueryProcessor()
+		args.SCQueryService = &mock.SCQueryServiceStub{
+			ExecuteQueryCalled: func(query *data.SCQuery) (*vm.VMOutputApi, data.BlockInfo, error) {
+				atomic.AddUint32(&numQueries, 1)
+				return &vm.VMOutputApi{ReturnCode: "ok", ReturnData: [][]byte{query.Arguments[0]}}, data.BlockInfo{Nonce: 10}, nil
+			},
+		}
+		args.Processor.(*mock.ProcessorStub).CallGetRestEndPointCalled = func(address string, path string, value interface{}) (int, error) {
+			setNodeStatusNonce(value, 10)
+			return 200, nil
+		}
+		cqp, _ := NewCachedSCQueryProcessor(args)
+
+		query := &data.SCQuery{ScAddress: dummyScAddress, FuncName: "getPrice", Arguments: [][]byte{{1}}}
+		for i := 0; i < 3; i++ {
+			vmOutput, blockInfo, err := cqp.ExecuteQuery(query)
+			require.Nil(t, err)
+			require.Equal(t, [][]byte{{1}}, vmOutput.ReturnData)
+			require.Equal(t, uint64(10), blockInfo.Nonce)
+		}
+		require.Equal(t, uint32(1), atomic.LoadUint32(&numQueries))
+
+		otherQuery := &data.SCQuery{ScAddress: dummyScAddress, FuncName: "getPrice", Arguments: [][]byte{{2}}}
+		vmOutput, _, err := cqp.ExecuteQuery(otherQuery)
+		require.Nil(t, err)
+		require.Equal(t, [][]byte{{2}}, vmOutput.ReturnData)
+		require.Equal(t, uint32(2), atomic.LoadUint32(&numQueries))
+	})
+
+	t.Run("shard advancing should invalidate the cache", func(t *testing.T) {
+		t.Parallel()
+
+		numQueries := uint32(0)
+		shardNonce := uint64(10)
+		args := createArgsCachedSCQueryProcessor()
+		args.NonceRefreshInterval = time.Nanosecond
+		args.SCQueryService = &mock.SCQueryServiceStub{
+			ExecuteQueryCalled: func(query *data.SCQuery) (*vm.VMOutputApi, data.BlockInfo, error) {
+				atomic.AddUint32(&numQueries, 1)
+				return &vm.VMOutputApi{ReturnCode: "ok"}, data.BlockInfo{Nonce: atomic.LoadUint64(&shardNonce)}, nil
+			},
+		}
+		args.Processor.(*mock.ProcessorStub).CallGetRestEndPointCalled = func(address string, path string, value interface{}) (int, error) {
+			setNodeStatusNonce(value, atomic.LoadUint64(&shardNonce))
+			return 200, nil
+		}
+		cqp, _ := NewCachedSCQueryProcessor(args)
+
+		query := &data.SCQuery{ScAddress: dummyScAddress, FuncName: "getPrice"}
+		_, _, _ = cqp.ExecuteQuery(query)
+		_, _, _ = cqp.ExecuteQuery(query)
+		require.Equal(t, uint32(1), atomic.LoadUint32(&numQueries))
+
+		atomic.StoreUint64(&shardNonce, 11)
+		_, blockInfo, _ := cqp.ExecuteQuery(query)
+		require.Equal(t, uint64(11), blockInfo.Nonce)
+		require.Equal(t, uint32(2), atomic.LoadUint32(&numQueries))
+	})
+
+	t.Run("failed queries should not be cached", func(t *testing.T) {
+		t.Parallel()
+
+		numQueries := 0
+		expectedErr := errors.New("expected error")
+		args := createArgsCachedSCQueryProcessor()
+		args.SCQueryService = &mock.SCQueryServiceStub{
+			ExecuteQueryCalled: func(query *data.SCQuery) (*vm.VMOutputApi, data.BlockInfo, error) {
+				numQueries++
+				if numQueries == 1 {
+					return nil, data.BlockInfo{}, expectedErr
+				}
+
+				return &vm.VMOutputApi{ReturnCode: "user error"}, data.BlockInfo{Nonce: 10}, nil
+			},
+		}
+		args.Processor.(*mock.ProcessorStub).CallGetRestEndPointCalled = func(address string, path string, value interface{}) (int, error) {
+			setNodeStatusNonce(value, 10)
+			return 200, nil
+		}
+		cqp, _ := NewCachedSCQueryProcessor(args)
+
+		query := &data.SCQuery{ScAddress: dummyScAddress, FuncName: "getPrice"}
+		_, _, err := cqp.ExecuteQuery(query)
+		require.Equal(t, expectedErr, err)
+
+		vmOutput, _, err := cqp.ExecuteQuery(query)
+		require.Nil(t, err)
+		require.Equal(t, "user error", vmOutput.ReturnCode)
+
+		_, _, _ = cqp.ExecuteQuery(query)
+		require.Equal(t, 3, numQueries)
+	})
+
+	t.Run("panicking query should err and should not be cached", func(t *testing.T) {
+		t.Parallel()
+
+		numQueries := 0
+		args := createArgsCachedSCQueryProcessor()
+		args.SCQueryService = &mock.SCQueryServiceStub{
+			ExecuteQueryCalled: func(query *data.SCQuery) (*vm.VMOutputApi, data.BlockInfo, error) {
+				numQueries++
+				if numQueries == 1 {
+					panic("query panic")
+				}
+
+				return &vm.VMOutputApi{ReturnCode: "ok"}, data.BlockInfo{Nonce: 10}, nil
+			},
+		}
+		args.Processor.(*mock.ProcessorStub).CallGetRestEndPointCalled = func(address string, path string, value interface{}) (int, error) {
+			setNodeStatusNonce(value, 10)
+			return 200, nil
+		}
+		cqp, _ := NewCachedSCQueryProcessor(args)
+
+		query := &data.SCQuery{ScAddress: dummyScAddress, FuncName: "getPrice"}
+		vmOutput, _, err := cqp.ExecuteQuery(query)
+		require.True(t, errors.Is(err, ErrCoalescedCallPanicked))
+		require.Nil(t, vmOutput)
+
+		vmOutput, _, err = cqp.ExecuteQuery(query)
+		require.Nil(t, err)
+		require.Equal(t, "ok", vmOutput.ReturnCode)
+		require.Equal(t, 2, numQueries)
+	})
+	t.Run("historical queries should not use the cache", func(t *testing.T) {
+		t.Parallel()
+
+		numQueries := 0
+		args := createArgsCachedSCQueryProcessor()
+		args.SCQueryService = &mock.SCQueryServiceStub{
+			ExecuteQueryCalled: func(query *data.SCQuery) (*vm.VMOutputApi, data.BlockInfo, error) {
+				numQueries++
+				return &vm.VMOutputApi{ReturnCode: "ok"}, data.BlockInfo{Nonce: 5}, nil
+			},
+		}
+		args.Processor.(*mock.ProcessorStub).CallGetRestEndPointCalled = func(address string, path string, value interface{}) (int, error) {
+			require.Fail(t, "should not fetch the shard nonce")
+			return 0, nil
+		}
+		cqp, _ := NewCachedSCQueryProcessor(args)
+
+		query := &data.SCQuery{
+			ScAddress:  dummyScAddress,
+			FuncName:   "getPrice",
+			BlockNonce: core.OptionalUint64{Value: 5, HasValue: true},
+		}
+		_, _, _ = cqp.ExecuteQuery(query)
+		_, _, _ = cqp.ExecuteQuery(query)
+		require.Equal(t, 2, numQueries)
+	})
+
+	t.Run("unavailable shard nonce should bypass the cache", func(t *testing.T) {
+		t.Parallel()
+
+		numQueries := 0
+		args := createArgsCachedSCQueryProcessor()
+		args.SCQueryService = &mock.SCQueryServiceStub{
+			ExecuteQueryCalled: func(query *data.SCQuery) (*vm.VMOutputApi, data.BlockInfo, error) {
+				numQueries++
+				return &vm.VMOutputApi{ReturnCode: "ok"}, data.BlockInfo{Nonce: 5}, nil
+			},
+		}
+		args.Processor.(*mock.ProcessorStub).CallGetRestEndPointCalled = func(address string, path string, value interface{}) (int, error) {
+			return 0, errors.New("observer down")
+		}
+		cqp, _ := NewCachedSCQueryProcessor(args)
+
+		query := &data.SCQuery{ScAddress: dummyScAddress, FuncName: "getPrice"}
+		_, _, err := cqp.ExecuteQuery(query)
+		require.Nil(t, err)
+		_, _, _ = cqp.ExecuteQuery(query)
+		require.Equal(t, 2, numQueries)
+	})
+
+	t.Run("concurrent identical queries should be coalesced", func(t *testing.T) {
+		t.Parallel()
+
+		numQueries := uint32(0)
+		release := make(chan struct{})
+		args := createArgsCachedSCQueryProcessor()
+		args.SCQueryService = &mock.SCQueryServiceStub{
+			ExecuteQueryCalled: func(query *data.SCQuery) (*vm.VMOutputApi, data.BlockInfo, error) {
+				atomic.AddUint32(&numQueries, 1)
+				<-release
+				return &vm.VMOutputApi{ReturnCode: "ok"}, data.BlockInfo{Nonce: 10}, nil
+			},
+		}
+		args.Processor.(*mock.ProcessorStub).CallGetRestEndPointCalled = func(address string, path string, value interface{}) (int, error) {
+			setNodeStatusNonce(value, 10)
+			return 200, nil
+		}
+		cqp, _ := NewCachedSCQueryProcessor(args)
+		// warm up the shard nonce
+		_, _ = cqp.getShardBlockNonce(0)
+
+		numCalls := 10
+		wg := sync.WaitGroup{}
+		wg.Add(numCalls)
+		for i := 0; i < numCalls; i++ {
+			go func() {
+				defer wg.Done()
+
+				vmOutput, _, err := cqp.ExecuteQuery(&data.SCQuery{ScAddress: dummyScAddress, FuncName: "getPrice"})
+				require.Nil(t, err)
+				require.Equal(t, "ok", vmOutput.ReturnCode)
+			}()
+		}
+
+		time.Sleep(time.Millisecond * 100)
+		close(release)
+		wg.Wait()
+
+		require.Equal(t, uint32(1), atomic.LoadUint32(&numQueries))
+	})
+}
+
+func TestCachedSCQueryProcessor_ExecuteQueriesShouldNotUseTheCache(t *testing.T) {
+	t.Parallel()
+
+	numCalls := 0
+	args := createArgsCachedSCQueryProcessor()
+	args.SCQueryService = &mock.SCQueryServiceStub{
+		ExecuteQueriesCalled: func(queries []*data.SCQuery) ([]*data.SCQueryResult, error) {
+			numCalls++
+			return make([]*data.SCQueryResult, len(queries)), nil
+		},
+	}
+	cqp, _ := NewCachedSCQueryProcessor(args)
+
+	queries := []*data.SCQuery{{ScAddress: dummyScAddress}}
+	_, _ = cqp.ExecuteQueries(queries)
+	results, err := cqp.ExecuteQueries(queries)
+	require.Nil(t, err)
+	require.Equal(t, 1, len(results))
+	require.Equal(t, 2, numCalls)
+}
@@ -1,17 +1,59 @@
 package database
 
 import (
+	"bytes"
+	"encoding/base64"
 	"encoding/json"
 	"fmt"
+	"strconv"
+	"strings"
 
 	dataIndexer "github.com/multiversx/mx-chain-es-indexer-go/data"
 	"github.com/multiversx/mx-chain-proxy-go/data"
 )
 
+// numHistorySortValues is the number of values the transactions are sorted by: the timestamp, the nonce and the hash,
+// the latter one making the order strict
+const numHistorySortValues = 3
+
+const (
+	// numEventsSortValues is the number of values the events are sorted by: the block nonce (the timestamp, for
+	// Elasticsearch), the transaction hash and the index of the event
+	numEventsSortValues = 3
+	numTopEvents        = 20
+	maxNumEventsPerPage = 100
+	// numSearchSortValues is the number of values the items of the documents holding several items (such as the events
+	// of the logs) are sorted by: the value the documents are sorted by, the document id and the index of the item
+	// within the document
+	numSearchSortValues       = 3
+	numTopTokenResults        = 20
+	maxNumTokenResultsPerPage = 100
+	// numTokenHoldersSearchSortValues is the number of values the token holders are sorted by, in Elasticsearch: the
+	// balance, the address and the token nonce
+	numTokenHoldersSearchSortValues = 3
+)
+
+const (
+	// pointInTimeKeepAlive is the time an Elasticsearch point in time is kept alive after each search using it, which
+	// is the time a client has to request the next page of the search
+	pointInTimeKeepAlive = "5m"
+	// numPointInTimeHistoryCursorValues is the number of values held by the cursors of the transactions searched in
+	// Elasticsearch: the point in time id, followed by the timestamp, the nonce and the shard document the transactions
+	// are sorted by, the latter one making the order strict
+	numPointInTimeHistoryCursorValues = 4
+	// numSearchHitSortValues is the number of values the Elasticsearch documents holding several items are sorted by:
+	// the timestamp and the shard document
+	numSearchHitSortValues = 2
+	// numPointInTimeSearchCursorValues is the number of values held by the cursors of the items searched in
+	// Elasticsearch: the point in time id, followed by the sort values of the document, the document id and the index
+	// of the item within the document
+	numPointInTimeSearchCursorValues = 5
+)
+
 func convertObjectToBlock(obj object) (*dataIndexer.Block, string, error) {
 	h1 := obj["hits"].(object)["hits"].([]interface{})
 	if len(h1) == 0 {
-		return nil, "", errCannotFindBlockInDb
+		return nil, "", data.ErrAtlasBlockNotFound
 	}
 	h2 := h1[0].(object)["_source"]
 
@@ -36,20 +78,438 @@ func convertObjectToTransactions(obj object) ([]data.DatabaseTransaction, error)
 
 	txs := make([]data.DatabaseTransaction, 0)
 	for _, h1 := range hits["hits"].([]interface{}) {
-		h2 := h1.(object)["_source"]
-
-		var tx data.DatabaseTransaction
-		marshalizedTx, _ := json.Marshal(h2)
-		err := json.Unmarshal(marshalizedTx, &tx)
+		tx, err := convertHitToTransaction(h1)
 		if err != nil {
 			continue
 		}
 
-		h3 := h1.(object)["_id"]
-		txHash := fmt.Sprint(h3)
-		tx.Hash = txHash
-		tx.Fee = tx.CalculateFee()
-		txs = append(txs, tx)
+		txs = append(txs, *tx)
 	}
 	return txs, nil
 }
+
+// convertObjectToTransactionsPage returns at most limit transactions, along with the cursor of the next page if the
+// search, done in the provided point in time, returned more transactions than the limit
+func convertObjectToTransactionsPage(obj object, limit int, pointInTimeID string) (*data.TransactionsHistoryPage, error) {
+	hits, ok := obj["hits"].(object)
+	if !ok {
+		return nil, errCannotGetTxsFromBody
+	}
+	hitsList, ok := hits["hits"].([]interface{})
+	if !ok {
+		return nil, errCannotGetTxsFromBody
+	}
+
+	page := &data.TransactionsHistoryPage{
+		Transactions: make([]data.DatabaseTransaction, 0, limit),
+	}
+	hasNextPage := len(hitsList) > limit
+	if hasNextPage {
+		hitsList = hitsList[:limit]
+	}
+
+	for _, h1 := range hitsList {
+		tx, err := convertHitToTransaction(h1)
+		if err != nil {
+			continue
+		}
+
+		page.Transactions = append(page.Transactions, *tx)
+	}
+
+	if hasNextPage && len(hitsList) > 0 {
+		sortValues, ok := hitsList[len(hitsList)-1].(object)["sort"].([]interface{})
+		if !ok || len(sortValues) != numPointInTimeHistoryCursorValues-1 {
+			return nil, errCannotGetTxsFromBody
+		}
+
+		cursor, err := encodeHistoryCursor(append([]interface{}{pointInTimeID}, sortValues...))
+		if err != nil {
+			return nil, err
+		}
+		page.NextCursor = cursor
+	}
+
+	return page, nil
+}
+
+func convertHitToTransaction(hit interface{}) (*data.DatabaseTransaction, error) {
+	h2 := hit.(object)["_source"]
+
+	var tx data.DatabaseTransaction
+	marshalizedTx, _ := json.Marshal(h2)
+	err := json.Unmarshal(marshalizedTx, &tx)
+	if err != nil {
+		return nil, err
+	}
+
+	h3 := hit.(object)["_id"]
+	txHash := fmt.Sprint(h3)
+	tx.Hash = txHash
+	tx.Fee = tx.CalculateFee()
+
+	return &tx, nil
+}
+
+// extractMatchingEvents returns the events of the logs of a transaction which match the filter, along with their index
+func extractMatchingEvents(txHash string, source []byte, filter data.EventsFilter) ([]indexedHitItem, error) {
+	var logs dataIndexer.Logs
+	err := json.Unmarshal(source, &logs)
+	if err != nil {
+		return nil, err
+	}
+
+	events := make([]indexedHitItem, 0, len(logs.Events))
+	for index, event := range logs.Events {
+		if !isEventMatchingFilter(event, filter) {
+			continue
+		}
+
+		events = append(events, indexedHitItem{
+			index: index,
+			item: data.DatabaseEvent{
+				TxHash:     txHash,
+				Index:      index,
+				Address:    event.Address,
+				Identifier: event.Identifier,
+				Topics:     event.Topics,
+				Data:       event.Data,
+				Timestamp:  int64(logs.Timestamp),
+			},
+		})
+	}
+
+	return events, nil
+}
+
+func isEventMatchingFilter(event *dataIndexer.Event, filter data.EventsFilter) bool {
+	if event == nil {
+		return false
+	}
+	if len(filter.Address) > 0 && event.Address != filter.Address {
+		return false
+	}
+	if len(filter.Identifier) > 0 && event.Identifier != filter.Identifier {
+		return false
+	}
+
+	for position, topic := range filter.Topics {
+		if topic == nil {
+			continue
+		}
+		if position >= len(event.Topics) || !bytes.Equal(event.Topics[position], topic) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// extractMatchingTokenTransfers returns the transfers of an operation whose token matches the filter, along with their
+// index within the tokens of the operation
+func extractMatchingTokenTransfers(txHash string, source []byte, filter data.TokenTransfersFilter) ([]indexedHitItem, error) {
+	var operation dataIndexer.Transaction
+	err := json.Unmarshal(source, &operation)
+	if err != nil {
+		return nil, err
+	}
+
+	transfers := make([]indexedHitItem, 0, len(operation.Tokens))
+	for index, identifier := range operation.Tokens {
+		collection, nonce, ok := splitTokenIdentifier(identifier)
+		isMatchingNonce := !filter.Nonce.HasValue || filter.Nonce.Value == nonce
+		if !ok || collection != filter.Token || !isMatchingNonce {
+			continue
+		}
+
+		// the multi transfers have a single receiver, the one of the operation being the sender itself
+		receiver := operation.Receiver
+		if len(operation.Receivers) > index {
+			receiver = operation.Receivers[index]
+		} else if len(operation.Receivers) > 0 {
+			receiver = operation.Receivers[0]
+		}
+		value := ""
+		if len(operation.ESDTValues) > index {
+			value = operation.ESDTValues[index]
+		}
+
+		transfers = append(transfers, indexedHitItem{
+			index: index,
+			item: data.DatabaseTokenTransfer{
+				TxHash:    txHash,
+				Index:     index,
+				Token:     collection,
+				Nonce:     nonce,
+				Sender:    operation.Sender,
+				Receiver:  receiver,
+				Value:     value,
+				Timestamp: int64(operation.Timestamp),
+			},
+		})
+	}
+
+	return transfers, nil
+}
+
+// splitTokenIdentifier returns the collection and the nonce of a token identifier, as indexed by Elasticsearch: the
+// collection, optionally followed by the hex encoded nonce
+func splitTokenIdentifier(identifier string) (string, uint64, bool) {
+	parts := strings.Split(identifier, "-")
+	switch len(parts) {
+	case 2:
+		return identifier, 0, true
+	case 3:
+		nonce, err := strconv.ParseUint(parts[2], 16, 64)
+		if err != nil {
+			return "", 0, false
+		}
+
+		return parts[0] + "-" + parts[1], nonce, true
+	default:
+		return "", 0, false
+	}
+}
+
+// convertObjectToTokenHoldersPage returns at most limit holders, along with the cursor of the next page if the search
+// returned more holders than the limit
+func convertObjectToTokenHoldersPage(obj object, limit int) (*data.TokenHoldersPage, error) {
+	hits, ok := obj["hits"].(object)
+	if !ok {
+		return nil, errCannotGetHitsFromBody
+	}
+	hitsList, ok := hits["hits"].([]interface{})
+	if !ok {
+		return nil, errCannotGetHitsFromBody
+	}
+
+	page := &data.TokenHoldersPage{
+		Holders: make([]data.TokenHolder, 0, limit),
+	}
+	hasNextPage := len(hitsList) > limit
+	if hasNextPage {
+		hitsList = hitsList[:limit]
+	}
+
+	var lastSortValues []interface{}
+	for _, hit := range hitsList {
+		hitObject, isObject := hit.(object)
+		if !isObject {
+			return nil, errCannotGetHitsFromBody
+		}
+		lastSortValues, _ = hitObject["sort"].([]interface{})
+
+		marshalizedAccount, err := json.Marshal(hitObject["_source"])
+		if err != nil {
+			return nil, err
+		}
+		var account dataIndexer.AccountInfo
+		err = json.Unmarshal(marshalizedAccount, &account)
+		if err != nil {
+			return nil, err
+		}
+
+		page.Holders = append(page.Holders, data.TokenHolder{
+			Address: account.Address,
+			Nonce:   account.TokenNonce,
+			Balance: account.Balance,
+		})
+	}
+
+	if hasNextPage && len(hitsList) > 0 {
+		if len(lastSortValues) != numTokenHoldersSearchSortValues {
+			return nil, errCannotGetHitsFromBody
+		}
+
+		cursor, err := encodeHistoryCursor(lastSortValues)
+		if err != nil {
+			return nil, err
+		}
+		page.NextCursor = cursor
+	}
+
+	return page, nil
+}
+
+func encodeHistoryCursor(sortValues []interface{}) (string, error) {
+	cursorBytes, err := json.Marshal(sortValues)
+	if err != nil {
+		return "", err
+	}
+
+	return base64.RawURLEncoding.EncodeToString(cursorBytes), nil
+}
+
+func decodeHistoryCursor(cursor string, numSortValues int) ([]interface{}, error) {
+	if len(cursor) == 0 {
+		return nil, nil
+	}
+
+	cursorBytes, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", data.ErrInvalidTransactionsHistoryCursor, err.Error())
+	}
+
+	var sortValues []interface{}
+	decoder := json.NewDecoder(bytes.NewReader(cursorBytes))
+	decoder.UseNumber()
+	err = decoder.Decode(&sortValues)
+	if err != nil || len(sortValues) != numSortValues {
+		return nil, data.ErrInvalidTransactionsHistoryCursor
+	}
+
+	return sortValues, nil
+}
+
+// decodeTransactionsHistoryCursor returns the timestamp, the nonce and the hash held by the cursor, rejecting the
+// cursors holding values of other types
+func decodeTransactionsHistoryCursor(cursor string) ([]interface{}, error) {
+	sortValues, err := decodeHistoryCursor(cursor, numHistorySortValues)
+	if err != nil || len(sortValues) == 0 {
+		return nil, err
+	}
+
+	timestamp, isTimestampNumber := sortValues[0].(json.Number)
+	nonce, isNonceNumber := sortValues[1].(json.Number)
+	hash, isHashString := sortValues[2].(string)
+	if !isTimestampNumber || !isNonceNumber || !isHashString {
+		return nil, data.ErrInvalidTransactionsHistoryCursor
+	}
+
+	timestampValue, errTimestamp := timestamp.Int64()
+	nonceValue, errNonce := nonce.Int64()
+	if errTimestamp != nil || errNonce != nil {
+		return nil, data.ErrInvalidTransactionsHistoryCursor
+	}
+
+	return []interface{}{timestampValue, nonceValue, hash}, nil
+}
+
+// decodePointInTimeHistoryCursor returns the point in time id held by the cursor of the transactions searched in
+// Elasticsearch, along with the timestamp, the nonce and the shard document following it, rejecting the cursors
+// holding values of other types
+func decodePointInTimeHistoryCursor(cursor string) (string, []interface{}, error) {
+	cursorValues, err := decodeHistoryCursor(cursor, numPointInTimeHistoryCursorValues)
+	if err != nil || len(cursorValues) == 0 {
+		return "", nil, err
+	}
+
+	pointInTimeID, isPointInTimeIDString := cursorValues[0].(string)
+	if !isPointInTimeIDString || len(pointInTimeID) == 0 {
+		return "", nil, data.ErrInvalidTransactionsHistoryCursor
+	}
+
+	sortValues := make([]interface{}, 0, len(cursorValues)-1)
+	for _, value := range cursorValues[1:] {
+		number, isNumber := value.(json.Number)
+		if !isNumber {
+			return "", nil, data.ErrInvalidTransactionsHistoryCursor
+		}
+
+		numberValue, errNumber := number.Int64()
+		if errNumber != nil {
+			return "", nil, data.ErrInvalidTransactionsHistoryCursor
+		}
+		sortValues = append(sortValues, numberValue)
+	}
+
+	return pointInTimeID, sortValues, nil
+}
+
+func decodeEventsCursor(cursor string) ([]interface{}, error) {
+	return decodeSearchCursor(cursor, data.ErrInvalidEventsCursor)
+}
+
+// decodeSearchCursor returns the sort values held by the cursor, which are a number, a hash and an index, or the
+// provided error if the cursor holds values of other types
+func decodeSearchCursor(cursor string, errInvalidCursor error) ([]interface{}, error) {
+	sortValues, err := decodeHistoryCursor(cursor, numSearchSortValues)
+	if err != nil {
+		return nil, errInvalidCursor
+	}
+	if len(sortValues) == 0 {
+		return nil, nil
+	}
+
+	sortValue, isSortValueNumber := sortValues[0].(json.Number)
+	hash, isHashString := sortValues[1].(string)
+	index, isIndexNumber := sortValues[2].(json.Number)
+	if !isSortValueNumber || !isHashString || !isIndexNumber {
+		return nil, errInvalidCursor
+	}
+
+	sortValueInt, errSortValue := sortValue.Int64()
+	indexValue, errIndex := index.Int64()
+	if errSortValue != nil || errIndex != nil {
+		return nil, errInvalidCursor
+	}
+
+	return []interface{}{sortValueInt, hash, indexValue}, nil
+}
+
+// decodePointInTimeSearchCursor returns the point in time id held by the cursor of the items searched in
+// Elasticsearch, along with the timestamp, the shard document, the document id and the item index following it, or
+// the provided error if the cursor holds values of other types
+func decodePointInTimeSearchCursor(cursor string, errInvalidCursor error) (string, []interface{}, error) {
+	cursorValues, err := decodeHistoryCursor(cursor, numPointInTimeSearchCursorValues)
+	if err != nil {
+		return "", nil, errInvalidCursor
+	}
+	if len(cursorValues) == 0 {
+		return "", nil, nil
+	}
+
+	pointInTimeID, isPointInTimeIDString := cursorValues[0].(string)
+	timestamp, isTimestampNumber := cursorValues[1].(json.Number)
+	shardDoc, isShardDocNumber := cursorValues[2].(json.Number)
+	id, isIDString := cursorValues[3].(string)
+	index, isIndexNumber := cursorValues[4].(json.Number)
+	if !isPointInTimeIDString || len(pointInTimeID) == 0 || !isTimestampNumber || !isShardDocNumber || !isIDString || !isIndexNumber {
+		return "", nil, errInvalidCursor
+	}
+
+	timestampValue, errTimestamp := timestamp.Int64()
+	shardDocValue, errShardDoc := shardDoc.Int64()
+	indexValue, errIndex := index.Int64()
+	if errTimestamp != nil || errShardDoc != nil || errIndex != nil {
+		return "", nil, errInvalidCursor
+	}
+
+	return pointInTimeID, []interface{}{timestampValue, shardDocValue, id, indexValue}, nil
+}
+
+func decodeTokenHoldersSearchCursor(cursor string) ([]interface{}, error) {
+	sortValues, err := decodeHistoryCursor(cursor, numTokenHoldersSearchSortValues)
+	if err != nil {
+		return nil, data.ErrInvalidTokenCursor
+	}
+	if len(sortValues) == 0 {
+		return nil, nil
+	}
+
+	balance, isBalanceNumber := sortValues[0].(json.Number)
+	address, isAddressString := sortValues[1].(string)
+	tokenNonce, isTokenNonceNumber := sortValues[2].(json.Number)
+	if !isBalanceNumber || !isAddressString || !isTokenNonceNumber {
+		return nil, data.ErrInvalidTokenCursor
+	}
+
+	balanceValue, errBalance := balance.Float64()
+	tokenNonceValue, errTokenNonce := tokenNonce.Int64()
+	if errBalance != nil || errTokenNonce != nil {
+		return nil, data.ErrInvalidTokenCursor
+	}
+
+	return []interface{}{balanceValue, address, tokenNonceValue}, nil
+}
+
+func tokenResultsLimit(limit int) int {
+	if limit <= 0 {
+		return numTopTokenResults
+	}
+	if limit > maxNumTokenResultsPerPage {
+		return maxNumTokenResultsPerPage
+	}
+
+	return limit
+}
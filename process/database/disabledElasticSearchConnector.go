@@ -1,12 +1,6 @@
 package database
 
-import (
-	"errors"
-
-	"github.com/multiversx/mx-chain-proxy-go/data"
-)
-
-var errDatabaseConnectionIsDisabled = errors.New("database connection is disabled")
+import "github.com/multiversx/mx-chain-proxy-go/data"
 
 type disabledElasticSearchConnector struct{}
 
@@ -15,13 +9,28 @@ func NewDisabledElasticSearchConnector() *disabledElasticSearchConnector {
 }
 
 // GetTransactionsByAddress will return error because database connection is disabled
-func (desc *disabledElasticSearchConnector) GetTransactionsByAddress(_ string) ([]data.DatabaseTransaction, error) {
-	return nil, errDatabaseConnectionIsDisabled
+func (desc *disabledElasticSearchConnector) GetTransactionsByAddress(_ string, _ data.TransactionsHistoryFilter) (*data.TransactionsHistoryPage, error) {
+	return nil, data.ErrDatabaseConnectionIsDisabled
+}
+
+// GetEvents will return error because database connection is disabled
+func (desc *disabledElasticSearchConnector) GetEvents(_ data.EventsFilter) (*data.EventsPage, error) {
+	return nil, data.ErrDatabaseConnectionIsDisabled
+}
+
+// GetTokenHolders will return error because database connection is disabled
+func (desc *disabledElasticSearchConnector) GetTokenHolders(_ data.TokenHoldersFilter) (*data.TokenHoldersPage, error) {
+	return nil, data.ErrDatabaseConnectionIsDisabled
+}
+
+// GetTokenTransfers will return error because database connection is disabled
+func (desc *disabledElasticSearchConnector) GetTokenTransfers(_ data.TokenTransfersFilter) (*data.TokenTransfersPage, error) {
+	return nil, data.ErrDatabaseConnectionIsDisabled
 }
 
 // GetAtlasBlockByShardIDAndNonce will return error because database connection is disabled
 func (desc *disabledElasticSearchConnector) GetAtlasBlockByShardIDAndNonce(_ uint32, _ uint64) (data.AtlasBlock, error) {
-	return data.AtlasBlock{}, errDatabaseConnectionIsDisabled
+	return data.AtlasBlock{}, data.ErrDatabaseConnectionIsDisabled
 }
 
 // IsInterfaceNil -
@@ -2,14 +2,18 @@ package database
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
+	"net/http"
 
 	"github.com/elastic/go-elasticsearch/v7"
+	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-proxy-go/data"
 )
 
 const (
 	numTopTransactions           = 20
+	maxNumTransactionsPerPage    = 100
 	numTransactionFromAMiniblock = 100
 )
 
@@ -35,14 +39,272 @@ func NewElasticSearchConnector(url, username, password string) (*elasticSearchCo
 	}, nil
 }
 
-// GetTransactionsByAddress gets transactions TO or FROM the specified address
-func (esc *elasticSearchConnector) GetTransactionsByAddress(address string) ([]data.DatabaseTransaction, error) {
-	decodedBody, err := esc.doSearchRequestTx(address, "transactions", numTopTransactions)
+// GetTransactionsByAddress gets a page of transactions TO or FROM the specified address, matching the filter. The
+// pages are fetched with search_after in a point in time, the cursor of the next page holding the point in time id and
+// the sort values of the last transaction
+func (esc *elasticSearchConnector) GetTransactionsByAddress(address string, filter data.TransactionsHistoryFilter) (*data.TransactionsHistoryPage, error) {
+	pointInTimeID, searchAfter, err := decodePointInTimeHistoryCursor(filter.Cursor)
 	if err != nil {
 		return nil, err
 	}
 
-	return convertObjectToTransactions(decodedBody)
+	limit := filter.Limit
+	if limit <= 0 {
+		limit = numTopTransactions
+	}
+	if limit > maxNumTransactionsPerPage {
+		limit = maxNumTransactionsPerPage
+	}
+
+	if len(pointInTimeID) == 0 {
+		pointInTimeID, err = esc.openPointInTime("transactions")
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	query := txsByAddressQuery(address, filter, searchAfter)
+	// one more transaction is requested, in order to know whether there is a next page
+	decodedBody, newPointInTimeID, err := esc.doPointInTimeSearchRequest(query, pointInTimeID, limit+1, data.ErrInvalidTransactionsHistoryCursor)
+	if err != nil {
+		esc.closePointInTime(pointInTimeID)
+		return nil, err
+	}
+
+	page, err := convertObjectToTransactionsPage(decodedBody, limit, newPointInTimeID)
+	if err != nil || len(page.NextCursor) == 0 {
+		esc.closePointInTime(newPointInTimeID)
+	}
+
+	return page, err
+}
+
+// GetEvents gets a page of events matching the filter from the logs index, sorted by timestamp and transaction hash,
+// ascending by default. The hyperblock nonces range is converted into the timestamps range of the corresponding
+// hyperblocks, as the logs are not indexed by block nonce, so the hyperblock nonce of the returned events is not set.
+// The cursor of the next page holds the id of the point in time the search is done in and the sort values of the
+// last event
+func (esc *elasticSearchConnector) GetEvents(filter data.EventsFilter) (*data.EventsPage, error) {
+	pointInTimeID, after, err := decodePointInTimeSearchCursor(filter.Cursor, data.ErrInvalidEventsCursor)
+	if err != nil {
+		return nil, err
+	}
+
+	limit := filter.Limit
+	if limit <= 0 {
+		limit = numTopEvents
+	}
+	if limit > maxNumEventsPerPage {
+		limit = maxNumEventsPerPage
+	}
+
+	fromTimestamp, toTimestamp, err := esc.getHyperblocksTimestamps(filter.FromBlock, filter.ToBlock, data.ErrInvalidEventsFilter)
+	if err != nil {
+		return nil, err
+	}
+
+	createQuery := func(id string, searchAfter []interface{}) object {
+		return logsByEventsFilterQuery(filter, fromTimestamp, toTimestamp, id, searchAfter)
+	}
+	extractEvents := func(id string, source []byte) ([]indexedHitItem, error) {
+		return extractMatchingEvents(id, source, filter)
+	}
+	items, nextCursor, err := esc.searchItemsPage("logs", limit, pointInTimeID, after, data.ErrInvalidEventsCursor, createQuery, extractEvents)
+	if err != nil {
+		return nil, err
+	}
+
+	page := &data.EventsPage{
+		Events:     make([]data.DatabaseEvent, 0, len(items)),
+		NextCursor: nextCursor,
+	}
+	for _, item := range items {
+		page.Events = append(page.Events, item.(data.DatabaseEvent))
+	}
+
+	return page, nil
+}
+
+// GetTokenHolders gets a page of the holders of a token from the accountsesdt index, the highest balance first. The
+// accounts are not indexed by block nonce, so the hyperblock nonce of the returned holders is not set. The cursor of
+// the next page holds the sort values of the last holder
+func (esc *elasticSearchConnector) GetTokenHolders(filter data.TokenHoldersFilter) (*data.TokenHoldersPage, error) {
+	searchAfter, err := decodeTokenHoldersSearchCursor(filter.Cursor)
+	if err != nil {
+		return nil, err
+	}
+
+	limit := tokenResultsLimit(filter.Limit)
+
+	// one more holder is requested, in order to know whether there is a next page
+	query := tokenHoldersQuery(filter, searchAfter)
+	decodedBody, err := esc.doSearchRequest(query, "accountsesdt", limit+1)
+	if err != nil {
+		return nil, err
+	}
+
+	return convertObjectToTokenHoldersPage(decodedBody, limit)
+}
+
+// GetTokenTransfers gets a page of the transfers of a token from the operations index, sorted by timestamp and
+// transaction hash, ascending by default. A transfer is listed both with the transaction and with the smart contract
+// results carrying it, as indexed in the operations index. The hyperblock nonces range is converted into the
+// timestamps range of the corresponding hyperblocks, so the hyperblock nonce of the returned transfers is not set. The
+// cursor of the next page holds the id of the point in time the search is done in and the sort values of the last
+// transfer
+func (esc *elasticSearchConnector) GetTokenTransfers(filter data.TokenTransfersFilter) (*data.TokenTransfersPage, error) {
+	pointInTimeID, after, err := decodePointInTimeSearchCursor(filter.Cursor, data.ErrInvalidTokenCursor)
+	if err != nil {
+		return nil, err
+	}
+
+	limit := tokenResultsLimit(filter.Limit)
+
+	fromTimestamp, toTimestamp, err := esc.getHyperblocksTimestamps(filter.FromBlock, filter.ToBlock, data.ErrInvalidTokenFilter)
+	if err != nil {
+		return nil, err
+	}
+
+	createQuery := func(id string, searchAfter []interface{}) object {
+		return operationsByTokenQuery(filter, fromTimestamp, toTimestamp, id, searchAfter)
+	}
+	extractTransfers := func(id string, source []byte) ([]indexedHitItem, error) {
+		return extractMatchingTokenTransfers(id, source, filter)
+	}
+	items, nextCursor, err := esc.searchItemsPage("operations", limit, pointInTimeID, after, data.ErrInvalidTokenCursor, createQuery, extractTransfers)
+	if err != nil {
+		return nil, err
+	}
+
+	page := &data.TokenTransfersPage{
+		Transfers:  make([]data.TokenTransfer, 0, len(items)),
+		NextCursor: nextCursor,
+	}
+	for _, item := range items {
+		page.Transfers = append(page.Transfers, data.TokenTransfer{DatabaseTokenTransfer: item.(data.DatabaseTokenTransfer)})
+	}
+
+	return page, nil
+}
+
+// searchItemsPage returns at most limit items extracted from the documents returned by the searches created by the
+// provided function, which sort the documents by timestamp and shard document, along with the cursor of the next page.
+// The searches are done in the given point in time, or in a new one if it is not set, which is closed once the last
+// page is returned. The after values are the sort values of the last item of the previous page, if any
+func (esc *elasticSearchConnector) searchItemsPage(
+	index string,
+	limit int,
+	pointInTimeID string,
+	after []interface{},
+	errInvalidCursor error,
+	createQuery func(id string, searchAfter []interface{}) object,
+	extractItems hitItemsExtractor,
+) ([]interface{}, string, error) {
+	var err error
+	if len(pointInTimeID) == 0 {
+		pointInTimeID, err = esc.openPointInTime(index)
+		if err != nil {
+			return nil, "", err
+		}
+	}
+
+	collector := newSearchPageCollector(limit, extractItems)
+	err = esc.collectItemsPage(collector, pointInTimeID, after, errInvalidCursor, createQuery)
+	if err != nil || len(collector.nextCursor) == 0 {
+		esc.closePointInTime(collector.pointInTimeID)
+	}
+	if err != nil {
+		return nil, "", err
+	}
+
+	return collector.items, collector.nextCursor, nil
+}
+
+func (esc *elasticSearchConnector) collectItemsPage(
+	collector *searchPageCollector,
+	pointInTimeID string,
+	after []interface{},
+	errInvalidCursor error,
+	createQuery func(id string, searchAfter []interface{}) object,
+) error {
+	collector.pointInTimeID = pointInTimeID
+	var searchAfter []interface{}
+	if len(after) > 0 {
+		// the items of the last document of the previous page might not have been all returned
+		decodedBody, newPointInTimeID, err := esc.doPointInTimeSearchRequest(createQuery(after[2].(string), nil), collector.pointInTimeID, 1, errInvalidCursor)
+		if err != nil {
+			return err
+		}
+		collector.pointInTimeID = newPointInTimeID
+
+		isPageFull, err := collector.collectItems(decodedBody, after[3].(int64))
+		if err != nil || isPageFull {
+			return err
+		}
+
+		searchAfter = after[:numSearchHitSortValues]
+	}
+
+	for {
+		decodedBody, newPointInTimeID, err := esc.doPointInTimeSearchRequest(createQuery("", searchAfter), collector.pointInTimeID, collector.limit, errInvalidCursor)
+		if err != nil {
+			return err
+		}
+		collector.pointInTimeID = newPointInTimeID
+
+		isPageFull, err := collector.collectItems(decodedBody, -1)
+		if err != nil || isPageFull {
+			return err
+		}
+
+		// the documents matched by the query might hold no item matching the criteria, so the search continues until
+		// the page is filled or there are no more documents
+		if collector.numHits < collector.limit {
+			return nil
+		}
+		searchAfter = collector.lastHitSortValues
+	}
+}
+
+func (esc *elasticSearchConnector) getHyperblocksTimestamps(
+	fromBlock core.OptionalUint64,
+	toBlock core.OptionalUint64,
+	errInvalidFilter error,
+) (int64, int64, error) {
+	fromTimestamp, toTimestamp := int64(0), int64(0)
+	var err error
+	if fromBlock.HasValue {
+		fromTimestamp, err = esc.getHyperblockTimestamp(fromBlock.Value, errInvalidFilter)
+		if err != nil {
+			return 0, 0, err
+		}
+	}
+	if toBlock.HasValue {
+		toTimestamp, err = esc.getHyperblockTimestamp(toBlock.Value, errInvalidFilter)
+		if err != nil {
+			return 0, 0, err
+		}
+	}
+
+	return fromTimestamp, toTimestamp, nil
+}
+
+func (esc *elasticSearchConnector) getHyperblockTimestamp(nonce uint64, errInvalidFilter error) (int64, error) {
+	query := blockByNonceAndShardIDQuery(nonce, core.MetachainShardId)
+	decodedBody, err := esc.doSearchRequest(query, "blocks", 1)
+	if err != nil {
+		return 0, err
+	}
+
+	metaBlock, _, err := convertObjectToBlock(decodedBody)
+	if errors.Is(err, data.ErrAtlasBlockNotFound) {
+		return 0, fmt.Errorf("%w: hyperblock %d is not indexed", errInvalidFilter, nonce)
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	return int64(metaBlock.Timestamp), nil
 }
 
 // GetAtlasBlockByShardIDAndNonce gets from database a block with the specified shardID and nonce
@@ -150,31 +412,96 @@ func (esc *elasticSearchConnector) doSearchRequest(query object, index string, s
 	return decodedBody, nil
 }
 
-func (esc *elasticSearchConnector) doSearchRequestTx(address string, index string, size int) (object, error) {
-	query := fmt.Sprintf("sender:%s OR receiver:%s", address, address)
+// openPointInTime opens a point in time on the index, returning its id. The point in time is kept alive for
+// pointInTimeKeepAlive after each search using it
+func (esc *elasticSearchConnector) openPointInTime(index string) (string, error) {
+	res, err := esc.client.OpenPointInTime(
+		esc.client.OpenPointInTime.WithIndex(index),
+		esc.client.OpenPointInTime.WithKeepAlive(pointInTimeKeepAlive),
+	)
+	if err != nil {
+		return "", fmt.Errorf("cannot open point in time: %w", err)
+	}
+
+	defer func() {
+		_ = res.Body.Close()
+	}()
+	if res.IsError() {
+		return "", fmt.Errorf("cannot open point in time: %v", res)
+	}
+
+	var response struct {
+		ID string `json:"id"`
+	}
+	err = json.NewDecoder(res.Body).Decode(&response)
+	if err != nil {
+		return "", err
+	}
+	if len(response.ID) == 0 {
+		return "", errCannotOpenPointInTime
+	}
+
+	return response.ID, nil
+}
+
+// closePointInTime releases the resources of a point in time. The errors are ignored, as the point in time expires
+// anyway after pointInTimeKeepAlive
+func (esc *elasticSearchConnector) closePointInTime(pointInTimeID string) {
+	buff, err := encodeQuery(object{"id": pointInTimeID})
+	if err != nil {
+		return
+	}
+
+	res, err := esc.client.ClosePointInTime(esc.client.ClosePointInTime.WithBody(&buff))
+	if err != nil {
+		return
+	}
+	_ = res.Body.Close()
+}
+
+// doPointInTimeSearchRequest runs the query in the given point in time, returning the decoded body along with the id of
+// the point in time to be used by the next searches. The provided error is returned, wrapped, if the point in time
+// expired, as its id was read from a cursor
+func (esc *elasticSearchConnector) doPointInTimeSearchRequest(query object, pointInTimeID string, size int, errInvalidCursor error) (object, string, error) {
+	query["pit"] = object{
+		"id":         pointInTimeID,
+		"keep_alive": pointInTimeKeepAlive,
+	}
+	buff, err := encodeQuery(query)
+	if err != nil {
+		return nil, "", err
+	}
+
 	res, err := esc.client.Search(
-		esc.client.Search.WithIndex(index),
 		esc.client.Search.WithSize(size),
-		esc.client.Search.WithQuery(query),
-		esc.client.Search.WithSort("timestamp:desc"),
+		esc.client.Search.WithBody(&buff),
 	)
 	if err != nil {
-		return nil, fmt.Errorf("cannot get data from database: %w", err)
+		return nil, "", fmt.Errorf("cannot get data from database: %w", err)
 	}
 
 	defer func() {
 		_ = res.Body.Close()
 	}()
+	if res.StatusCode == http.StatusNotFound {
+		return nil, "", fmt.Errorf("%w: the point in time of the search expired", errInvalidCursor)
+	}
 	if res.IsError() {
-		return nil, fmt.Errorf("cannot get data from database: %v", res)
+		return nil, "", fmt.Errorf("cannot get data from database: %v", res)
 	}
 
 	var decodedBody map[string]interface{}
 	if err := json.NewDecoder(res.Body).Decode(&decodedBody); err != nil {
-		return nil, err
+		return nil, "", err
 	}
 
-	return decodedBody, nil
+	// the id of the point in time might change after each search
+	newPointInTimeID, ok := decodedBody["pit_id"].(string)
+	if !ok || len(newPointInTimeID) == 0 {
+		newPointInTimeID = pointInTimeID
+	}
+
+	return decodedBody, newPointInTimeID, nil
 }
 
 // IsInterfaceNil returns true if there is no value under the interface
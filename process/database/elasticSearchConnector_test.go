@@ -1,10 +1,19 @@
 package database
 
 import (
+	"encoding/json"
+	"errors"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
 	"testing"
 
 	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-proxy-go/data"
 	"github.com/stretchr/testify/require"
 )
 
@@ -18,7 +27,7 @@ func TestDatabaseReader(t *testing.T) {
 	require.Nil(t, err)
 
 	addr := "erd1ewshdn9yv0wx38xgs5cdhvcq4dz0n7tdlgh8wfj9nxugwmyunnyqpkpzal"
-	txs, err := reader.GetTransactionsByAddress(addr)
+	txs, err := reader.GetTransactionsByAddress(addr, data.TransactionsHistoryFilter{})
 	fmt.Println(txs)
 	require.Nil(t, err)
 }
@@ -36,3 +45,324 @@ func TestDatabaseReader_GetBlockByShardIDAndNonce(t *testing.T) {
 	fmt.Println(block)
 	require.Nil(t, err)
 }
+
+type fakeDocument struct {
+	id        string
+	timestamp int64
+	source    object
+}
+
+// createFakeElasticSearchServer serves the point in time searches of the logs and operations indices from the provided
+// documents, sorted by timestamp and shard document (the position of the document in its index), applying only the ids
+// filter, the sort order and search_after, and the searches of the blocks index from the provided block timestamps. The
+// server checks, at the end of the test, that all the points in time opened were closed
+func createFakeElasticSearchServer(t *testing.T, documents map[string][]fakeDocument, blockTimestamps map[string]int64) *httptest.Server {
+	mutOpenPointsInTime := sync.Mutex{}
+	openPointsInTime := make(map[string]string)
+	numPointsInTime := 0
+	t.Cleanup(func() {
+		mutOpenPointsInTime.Lock()
+		defer mutOpenPointsInTime.Unlock()
+
+		require.Empty(t, openPointsInTime)
+	})
+
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var query object
+		_ = json.NewDecoder(r.Body).Decode(&query)
+		size, _ := strconv.Atoi(r.URL.Query().Get("size"))
+
+		w.Header().Set("Content-Type", "application/json")
+		mutOpenPointsInTime.Lock()
+		defer mutOpenPointsInTime.Unlock()
+
+		hits := make([]interface{}, 0)
+		switch {
+		case strings.HasSuffix(r.URL.Path, "/_pit") && r.Method == http.MethodDelete:
+			delete(openPointsInTime, query["id"].(string))
+			_ = json.NewEncoder(w).Encode(object{"succeeded": true})
+			return
+		case strings.HasSuffix(r.URL.Path, "/_pit"):
+			numPointsInTime++
+			pointInTimeID := fmt.Sprintf("pit%d", numPointsInTime)
+			openPointsInTime[pointInTimeID] = strings.Split(strings.TrimPrefix(r.URL.Path, "/"), "/")[0]
+			_ = json.NewEncoder(w).Encode(object{"id": pointInTimeID})
+			return
+		case strings.HasPrefix(r.URL.Path, "/blocks/"):
+			clauses := query["query"].(object)["bool"].(object)["must"].([]interface{})
+			nonce := clauses[0].(object)["match"].(object)["nonce"].(string)
+			timestamp, found := blockTimestamps[nonce]
+			if found {
+				hits = append(hits, object{"_id": "hash" + nonce, "_source": object{"nonce": 0, "timestamp": timestamp}})
+			}
+		default:
+			pointInTimeID := query["pit"].(object)["id"].(string)
+			index, found := openPointsInTime[pointInTimeID]
+			if !found {
+				w.WriteHeader(http.StatusNotFound)
+				_ = json.NewEncoder(w).Encode(object{"error": "search_context_missing_exception"})
+				return
+			}
+			hits = searchFakeDocuments(query, documents[index], size)
+		}
+
+		_ = json.NewEncoder(w).Encode(object{"hits": object{"hits": hits}})
+	}))
+}
+
+func searchFakeDocuments(query object, indexDocuments []fakeDocument, size int) []interface{} {
+	isDescending := query["sort"].([]interface{})[0].(object)["timestamp"].(object)["order"] == data.SortOrderDescending
+	shardDocs := make([]int, 0, len(indexDocuments))
+	for shardDoc := range indexDocuments {
+		shardDocs = append(shardDocs, shardDoc)
+	}
+	sort.Slice(shardDocs, func(i, j int) bool {
+		first, second := indexDocuments[shardDocs[i]], indexDocuments[shardDocs[j]]
+		if first.timestamp == second.timestamp {
+			return (shardDocs[i] < shardDocs[j]) != isDescending
+		}
+		return (first.timestamp < second.timestamp) != isDescending
+	})
+
+	selectedID := ""
+	filterClauses := query["query"].(object)["bool"].(object)["filter"].([]interface{})
+	for _, clause := range filterClauses {
+		ids, ok := clause.(object)["ids"]
+		if ok {
+			selectedID = ids.(object)["values"].([]interface{})[0].(string)
+		}
+	}
+
+	searchAfter, hasSearchAfter := query["search_after"].([]interface{})
+	hits := make([]interface{}, 0)
+	for _, shardDoc := range shardDocs {
+		document := indexDocuments[shardDoc]
+		if len(selectedID) > 0 && document.id != selectedID {
+			continue
+		}
+		if hasSearchAfter {
+			afterTimestamp := int64(searchAfter[0].(float64))
+			afterShardDoc := int(searchAfter[1].(float64))
+			isAfter := document.timestamp*1000 > afterTimestamp || (document.timestamp*1000 == afterTimestamp && shardDoc > afterShardDoc)
+			if isDescending {
+				isAfter = document.timestamp*1000 < afterTimestamp || (document.timestamp*1000 == afterTimestamp && shardDoc < afterShardDoc)
+			}
+			if !isAfter {
+				continue
+			}
+		}
+		if len(hits) == size {
+			break
+		}
+
+		source := object{"timestamp": document.timestamp}
+		for field, value := range document.source {
+			source[field] = value
+		}
+		hits = append(hits, object{
+			"_id":     document.id,
+			"_source": source,
+			"sort":    []interface{}{document.timestamp * 1000, shardDoc},
+		})
+	}
+
+	return hits
+}
+
+func TestElasticSearchConnector_GetEvents(t *testing.T) {
+	t.Parallel()
+
+	deposit := func(topic string) object {
+		return object{"address": "erd1contract", "identifier": "deposit", "topics": [][]byte{[]byte(topic)}}
+	}
+	withdraw := object{"address": "erd1contract", "identifier": "withdraw", "topics": [][]byte{[]byte("a")}}
+	logsDocuments := []fakeDocument{
+		{id: "a", timestamp: 10, source: object{"events": []object{deposit("a"), withdraw, deposit("b")}}},
+		{id: "b", timestamp: 20, source: object{"events": []object{withdraw}}},
+		{id: "c", timestamp: 20, source: object{"events": []object{deposit("a")}}},
+		{id: "d", timestamp: 30, source: object{"events": []object{deposit("b")}}},
+	}
+	server := createFakeElasticSearchServer(t, map[string][]fakeDocument{"logs": logsDocuments}, map[string]int64{"5": 15})
+	t.Cleanup(server.Close)
+
+	esc, err := NewElasticSearchConnector(server.URL, "", "")
+	require.Nil(t, err)
+
+	getEvents := func(filter data.EventsFilter) []string {
+		eventIDs := make([]string, 0)
+		for {
+			page, errGet := esc.GetEvents(filter)
+			require.Nil(t, errGet)
+			require.LessOrEqual(t, len(page.Events), filter.Limit)
+			for _, event := range page.Events {
+				eventIDs = append(eventIDs, fmt.Sprintf("%s#%d@%d", event.TxHash, event.Index, event.Timestamp))
+			}
+			if len(page.NextCursor) == 0 {
+				return eventIDs
+			}
+
+			filter.Cursor = page.NextCursor
+		}
+	}
+
+	t.Run("should return the matching events, page by page", func(t *testing.T) {
+		t.Parallel()
+
+		eventIDs := getEvents(data.EventsFilter{Identifier: "deposit", Limit: 1})
+		require.Equal(t, []string{"a#0@10", "a#2@10", "c#0@20", "d#0@30"}, eventIDs)
+
+		eventIDs = getEvents(data.EventsFilter{Identifier: "deposit", Order: data.SortOrderDescending, Limit: 3})
+		require.Equal(t, []string{"d#0@30", "c#0@20", "a#0@10", "a#2@10"}, eventIDs)
+	})
+	t.Run("topics should be matched by position", func(t *testing.T) {
+		t.Parallel()
+
+		eventIDs := getEvents(data.EventsFilter{Topics: [][]byte{[]byte("a")}, Limit: 2})
+		require.Equal(t, []string{"a#0@10", "a#1@10", "b#0@20", "c#0@20"}, eventIDs)
+
+		eventIDs = getEvents(data.EventsFilter{Identifier: "deposit", Topics: [][]byte{[]byte("b")}, Limit: 2})
+		require.Equal(t, []string{"a#2@10", "d#0@30"}, eventIDs)
+	})
+	t.Run("the block range should be converted into a timestamps range", func(t *testing.T) {
+		t.Parallel()
+
+		query := logsByEventsFilterQuery(data.EventsFilter{}, 15, 0, "", nil)
+		filterClauses := query["query"].(object)["bool"].(object)["filter"].([]interface{})
+		require.Equal(t, []interface{}{timestampRangeQuery(15, 0)}, filterClauses)
+
+		_, err := esc.GetEvents(data.EventsFilter{FromBlock: core.OptionalUint64{Value: 5, HasValue: true}})
+		require.Nil(t, err)
+
+		_, err = esc.GetEvents(data.EventsFilter{FromBlock: core.OptionalUint64{Value: 6, HasValue: true}})
+		require.True(t, errors.Is(err, data.ErrInvalidEventsFilter))
+	})
+	t.Run("invalid cursor should error", func(t *testing.T) {
+		t.Parallel()
+
+		cursor, _ := encodeHistoryCursor([]interface{}{10, 5, 0})
+		_, err := esc.GetEvents(data.EventsFilter{Cursor: cursor})
+		require.Equal(t, data.ErrInvalidEventsCursor, err)
+	})
+	t.Run("expired point in time should error", func(t *testing.T) {
+		t.Parallel()
+
+		cursor, _ := encodeHistoryCursor([]interface{}{"expired", 10000, 0, "a", 0})
+		_, err := esc.GetEvents(data.EventsFilter{Cursor: cursor})
+		require.True(t, errors.Is(err, data.ErrInvalidEventsCursor))
+	})
+}
+
+func TestElasticSearchConnector_GetTokenTransfers(t *testing.T) {
+	t.Parallel()
+
+	operationsDocuments := []fakeDocument{
+		{id: "a", timestamp: 10, source: object{"sender": "alice", "receiver": "bob", "tokens": []string{"TKN-abcdef"}, "esdtValues": []string{"100"}}},
+		{id: "b", timestamp: 20, source: object{
+			"sender":     "alice",
+			"receiver":   "alice",
+			"receivers":  []string{"carol"},
+			"tokens":     []string{"NFT-abcdef-01", "OTHER-abcdef", "NFT-abcdef-02"},
+			"esdtValues": []string{"1", "5", "1"},
+		}},
+		{id: "c", timestamp: 30, source: object{"sender": "bob", "receiver": "carol", "tokens": []string{"TKN-abcdefg"}, "esdtValues": []string{"7"}}},
+		{id: "d", timestamp: 40, source: object{"sender": "carol", "receiver": "dave", "receivers": []string{"dave", "erin"}, "tokens": []string{"NFT-abcdef-02", "TKN-abcdef"}, "esdtValues": []string{"1", "3"}}},
+	}
+	server := createFakeElasticSearchServer(t, map[string][]fakeDocument{"operations": operationsDocuments}, nil)
+	t.Cleanup(server.Close)
+
+	esc, err := NewElasticSearchConnector(server.URL, "", "")
+	require.Nil(t, err)
+
+	getTransfers := func(filter data.TokenTransfersFilter) []string {
+		transfers := make([]string, 0)
+		for {
+			page, errGet := esc.GetTokenTransfers(filter)
+			require.Nil(t, errGet)
+			require.LessOrEqual(t, len(page.Transfers), filter.Limit)
+			for _, transfer := range page.Transfers {
+				transfers = append(transfers, fmt.Sprintf("%s#%d:%s/%d:%s->%s:%s", transfer.TxHash, transfer.Index,
+					transfer.Token, transfer.Nonce, transfer.Sender, transfer.Receiver, transfer.Value))
+			}
+			if len(page.NextCursor) == 0 {
+				return transfers
+			}
+
+			filter.Cursor = page.NextCursor
+		}
+	}
+
+	t.Run("collection should select the transfers of all its tokens", func(t *testing.T) {
+		t.Parallel()
+
+		transfers := getTransfers(data.TokenTransfersFilter{Token: "NFT-abcdef", Limit: 1})
+		require.Equal(t, []string{
+			"b#0:NFT-abcdef/1:alice->carol:1",
+			"b#2:NFT-abcdef/2:alice->carol:1",
+			"d#0:NFT-abcdef/2:carol->dave:1",
+		}, transfers)
+	})
+	t.Run("nonce should select a single token", func(t *testing.T) {
+		t.Parallel()
+
+		filter := data.TokenTransfersFilter{Token: "NFT-abcdef", Nonce: core.OptionalUint64{Value: 2, HasValue: true}, Order: data.SortOrderDescending, Limit: 5}
+		transfers := getTransfers(filter)
+		require.Equal(t, []string{"d#0:NFT-abcdef/2:carol->dave:1", "b#2:NFT-abcdef/2:alice->carol:1"}, transfers)
+
+		query := operationsByTokenQuery(filter, 0, 0, "", nil)
+		filterClauses := query["query"].(object)["bool"].(object)["filter"].([]interface{})
+		require.Equal(t, matchQuery("tokens", "NFT-abcdef-02"), filterClauses[0])
+	})
+	t.Run("fungible token should not match the tokens sharing its prefix", func(t *testing.T) {
+		t.Parallel()
+
+		transfers := getTransfers(data.TokenTransfersFilter{Token: "TKN-abcdef", Limit: 5})
+		require.Equal(t, []string{"a#0:TKN-abcdef/0:alice->bob:100", "d#1:TKN-abcdef/0:carol->erin:3"}, transfers)
+	})
+	t.Run("invalid cursor should error", func(t *testing.T) {
+		t.Parallel()
+
+		cursor, _ := encodeHistoryCursor([]interface{}{"pit", 10, 0, "a"})
+		_, err := esc.GetTokenTransfers(data.TokenTransfersFilter{Token: "TKN-abcdef", Cursor: cursor})
+		require.Equal(t, data.ErrInvalidTokenCursor, err)
+	})
+}
+
+func TestConvertObjectToTokenHoldersPage(t *testing.T) {
+	t.Parallel()
+
+	createHit := func(address string, balance string, balanceNum float64) interface{} {
+		return object{
+			"_id":     address + "-TKN-abcdef-0",
+			"_source": object{"address": address, "balance": balance, "balanceNum": balanceNum, "token": "TKN-abcdef"},
+			"sort":    []interface{}{balanceNum, address, 0},
+		}
+	}
+
+	query := tokenHoldersQuery(data.TokenHoldersFilter{Token: "NFT-abcdef", Nonce: core.OptionalUint64{Value: 2, HasValue: true}}, []interface{}{1.5, "alice", int64(2)})
+	require.Equal(t, []interface{}{matchQuery("token", "NFT-abcdef"), matchQuery("tokenNonce", "2")}, query["query"].(object)["bool"].(object)["filter"])
+	require.Equal(t, []interface{}{1.5, "alice", int64(2)}, query["search_after"])
+	require.Equal(t, []interface{}{
+		object{"balanceNum": object{"order": data.SortOrderDescending}},
+		object{"address": object{"order": data.SortOrderAscending}},
+		object{"tokenNonce": object{"order": data.SortOrderAscending, "missing": 0}},
+	}, query["sort"])
+
+	obj := object{"hits": object{"hits": []interface{}{createHit("carol", "1000", 1000), createHit("alice", "100", 100), createHit("bob", "20", 20)}}}
+	page, err := convertObjectToTokenHoldersPage(obj, 2)
+	require.Nil(t, err)
+	require.Equal(t, []data.TokenHolder{{Address: "carol", Balance: "1000"}, {Address: "alice", Balance: "100"}}, page.Holders)
+	require.False(t, page.Incomplete)
+
+	sortValues, err := decodeTokenHoldersSearchCursor(page.NextCursor)
+	require.Nil(t, err)
+	require.Equal(t, []interface{}{float64(100), "alice", int64(0)}, sortValues)
+
+	page, err = convertObjectToTokenHoldersPage(obj, 3)
+	require.Nil(t, err)
+	require.Len(t, page.Holders, 3)
+	require.Empty(t, page.NextCursor)
+
+	invalidCursor, _ := encodeHistoryCursor([]interface{}{"100", "alice", 0})
+	_, err = decodeTokenHoldersSearchCursor(invalidCursor)
+	require.Equal(t, data.ErrInvalidTokenCursor, err)
+}
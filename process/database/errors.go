@@ -2,6 +2,8 @@ package database
 
 import "errors"
 
-var errCannotFindBlockInDb = errors.New("cannot find blocks in database")
 var errCannotUnmarshalBlock = errors.New("cannot unmarshal block")
 var errCannotGetTxsFromBody = errors.New("cannot get transactions from decoded body")
+var errEmptyDatabasePath = errors.New("empty database path")
+var errCannotGetHitsFromBody = errors.New("cannot get hits from decoded body")
+var errCannotOpenPointInTime = errors.New("cannot open point in time")
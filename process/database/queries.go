@@ -2,8 +2,13 @@ package database
 
 import (
 	"bytes"
+	"encoding/base64"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
+	"math/big"
+
+	"github.com/multiversx/mx-chain-proxy-go/data"
 )
 
 type object = map[string]interface{}
@@ -57,3 +62,257 @@ func txsByMiniblockHashQuery(hash string) object {
 		},
 	}
 }
+
+// txsByAddressQuery returns the query selecting the transactions of an address matching the filter, sorted by timestamp,
+// nonce and shard document, starting after the given sort values, if any. The shard document makes the order strict but
+// is only defined within a point in time, so the query has to be run in one
+func txsByAddressQuery(address string, filter data.TransactionsHistoryFilter, searchAfter []interface{}) object {
+	filterClauses := []interface{}{addressByDirectionQuery(address, filter.Direction)}
+	if len(filter.Status) > 0 {
+		filterClauses = append(filterClauses, matchQuery("status", filter.Status))
+	}
+	if len(filter.Token) > 0 {
+		filterClauses = append(filterClauses, matchQuery("tokens", filter.Token))
+	}
+	if len(filter.Function) > 0 {
+		filterClauses = append(filterClauses, matchQuery("function", filter.Function))
+	}
+	if filter.From > 0 || filter.To > 0 {
+		filterClauses = append(filterClauses, timestampRangeQuery(filter.From, filter.To))
+	}
+	if filter.FromNonce.HasValue || filter.ToNonce.HasValue {
+		filterClauses = append(filterClauses, nonceRangeQuery(filter.FromNonce.Value, filter.FromNonce.HasValue, filter.ToNonce.Value, filter.ToNonce.HasValue))
+	}
+
+	order := data.SortOrderDescending
+	if filter.Order == data.SortOrderAscending {
+		order = data.SortOrderAscending
+	}
+
+	query := object{
+		"query": object{
+			"bool": object{
+				"filter": filterClauses,
+			},
+		},
+		"sort": []interface{}{
+			object{"timestamp": object{"order": order}},
+			object{"nonce": object{"order": order}},
+			object{"_shard_doc": object{"order": order}},
+		},
+	}
+	if len(searchAfter) > 0 {
+		query["search_after"] = searchAfter
+	}
+
+	return query
+}
+
+// logsByEventsFilterQuery returns the query selecting the logs holding at least one event which might match the filter,
+// sorted by timestamp and shard document, starting after the given sort values, if any, to be run in a point in time.
+// The topics are indexed as analyzed text, so the selected events still have to be checked against the filter. A
+// non-empty hash restricts the selection to the logs of that transaction
+func logsByEventsFilterQuery(filter data.EventsFilter, fromTimestamp int64, toTimestamp int64, txHash string, searchAfter []interface{}) object {
+	eventClauses := make([]interface{}, 0)
+	if len(filter.Address) > 0 {
+		eventClauses = append(eventClauses, matchQuery("events.address", filter.Address))
+	}
+	if len(filter.Identifier) > 0 {
+		eventClauses = append(eventClauses, matchQuery("events.identifier", filter.Identifier))
+	}
+	for _, topic := range filter.Topics {
+		if len(topic) == 0 {
+			continue
+		}
+
+		eventClauses = append(eventClauses, object{
+			"match_phrase": object{
+				"events.topics": base64.StdEncoding.EncodeToString(topic),
+			},
+		})
+	}
+
+	filterClauses := make([]interface{}, 0)
+	if len(eventClauses) > 0 {
+		filterClauses = append(filterClauses, object{
+			"nested": object{
+				"path": "events",
+				"query": object{
+					"bool": object{
+						"filter": eventClauses,
+					},
+				},
+			},
+		})
+	}
+	if fromTimestamp > 0 || toTimestamp > 0 {
+		filterClauses = append(filterClauses, timestampRangeQuery(fromTimestamp, toTimestamp))
+	}
+	if len(txHash) > 0 {
+		filterClauses = append(filterClauses, idsQuery(txHash))
+	}
+
+	order := data.SortOrderAscending
+	if filter.Order == data.SortOrderDescending {
+		order = data.SortOrderDescending
+	}
+
+	query := object{
+		"query": object{
+			"bool": object{
+				"filter": filterClauses,
+			},
+		},
+		"sort": []interface{}{
+			object{"timestamp": object{"order": order}},
+			object{"_shard_doc": object{"order": order}},
+		},
+	}
+	if len(searchAfter) > 0 {
+		query["search_after"] = searchAfter
+	}
+
+	return query
+}
+
+// tokenHoldersQuery returns the query selecting the accounts holding a token, sorted by balance, the highest first, and
+// by address and token nonce, starting after the given sort values, if any
+func tokenHoldersQuery(filter data.TokenHoldersFilter, searchAfter []interface{}) object {
+	filterClauses := []interface{}{matchQuery("token", filter.Token)}
+	if filter.Nonce.HasValue {
+		filterClauses = append(filterClauses, matchQuery("tokenNonce", fmt.Sprintf("%d", filter.Nonce.Value)))
+	}
+
+	query := object{
+		"query": object{
+			"bool": object{
+				"filter": filterClauses,
+			},
+		},
+		"sort": []interface{}{
+			object{"balanceNum": object{"order": data.SortOrderDescending}},
+			object{"address": object{"order": data.SortOrderAscending}},
+			// the fungible tokens are indexed without a nonce
+			object{"tokenNonce": object{"order": data.SortOrderAscending, "missing": 0}},
+		},
+	}
+	if len(searchAfter) > 0 {
+		query["search_after"] = searchAfter
+	}
+
+	return query
+}
+
+// operationsByTokenQuery returns the query selecting the successful operations transferring a token, or any token of
+// the collection when the nonce is not set, sorted by timestamp and shard document, starting after the given sort
+// values, if any, to be run in a point in time. A non-empty hash restricts the selection to that operation
+func operationsByTokenQuery(filter data.TokenTransfersFilter, fromTimestamp int64, toTimestamp int64, txHash string, searchAfter []interface{}) object {
+	tokenClause := object{
+		"prefix": object{
+			"tokens": filter.Token,
+		},
+	}
+	if filter.Nonce.HasValue {
+		nonceBytes := big.NewInt(0).SetUint64(filter.Nonce.Value).Bytes()
+		tokenClause = matchQuery("tokens", filter.Token+"-"+hex.EncodeToString(nonceBytes))
+	}
+
+	filterClauses := []interface{}{tokenClause}
+	if fromTimestamp > 0 || toTimestamp > 0 {
+		filterClauses = append(filterClauses, timestampRangeQuery(fromTimestamp, toTimestamp))
+	}
+	if len(txHash) > 0 {
+		filterClauses = append(filterClauses, idsQuery(txHash))
+	}
+
+	order := data.SortOrderAscending
+	if filter.Order == data.SortOrderDescending {
+		order = data.SortOrderDescending
+	}
+
+	query := object{
+		"query": object{
+			"bool": object{
+				"filter":   filterClauses,
+				"must_not": []interface{}{matchQuery("status", "fail"), matchQuery("status", "invalid")},
+			},
+		},
+		"sort": []interface{}{
+			object{"timestamp": object{"order": order}},
+			object{"_shard_doc": object{"order": order}},
+		},
+	}
+	if len(searchAfter) > 0 {
+		query["search_after"] = searchAfter
+	}
+
+	return query
+}
+
+func addressByDirectionQuery(address string, direction string) object {
+	switch direction {
+	case data.TransactionsDirectionOut:
+		return matchQuery("sender", address)
+	case data.TransactionsDirectionIn:
+		return shouldQuery(matchQuery("receiver", address), matchQuery("receivers", address))
+	default:
+		return shouldQuery(matchQuery("sender", address), matchQuery("receiver", address), matchQuery("receivers", address))
+	}
+}
+
+func timestampRangeQuery(from int64, to int64) object {
+	bounds := object{}
+	if from > 0 {
+		bounds["gte"] = from
+	}
+	if to > 0 {
+		bounds["lte"] = to
+	}
+
+	return object{
+		"range": object{
+			"timestamp": bounds,
+		},
+	}
+}
+
+func nonceRangeQuery(from uint64, hasFrom bool, to uint64, hasTo bool) object {
+	bounds := object{}
+	if hasFrom {
+		bounds["gte"] = from
+	}
+	if hasTo {
+		bounds["lte"] = to
+	}
+
+	return object{
+		"range": object{
+			"nonce": bounds,
+		},
+	}
+}
+
+func matchQuery(field string, value string) object {
+	return object{
+		"match": object{
+			field: value,
+		},
+	}
+}
+
+func idsQuery(ids ...interface{}) object {
+	return object{
+		"ids": object{
+			"values": ids,
+		},
+	}
+}
+
+func shouldQuery(clauses ...interface{}) object {
+	return object{
+		"bool": object{
+			"should":               clauses,
+			"minimum_should_match": 1,
+		},
+	}
+}
@@ -0,0 +1,262 @@
+package database
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTxsByAddressQuery_NoFilterShouldMatchBothDirectionsDescending(t *testing.T) {
+	t.Parallel()
+
+	query := txsByAddressQuery("erd1addr", data.TransactionsHistoryFilter{}, nil)
+
+	filterClauses := query["query"].(object)["bool"].(object)["filter"].([]interface{})
+	require.Len(t, filterClauses, 1)
+	shouldClauses := filterClauses[0].(object)["bool"].(object)["should"].([]interface{})
+	require.Equal(t, []interface{}{
+		matchQuery("sender", "erd1addr"),
+		matchQuery("receiver", "erd1addr"),
+		matchQuery("receivers", "erd1addr"),
+	}, shouldClauses)
+
+	require.Equal(t, []interface{}{
+		object{"timestamp": object{"order": data.SortOrderDescending}},
+		object{"nonce": object{"order": data.SortOrderDescending}},
+		object{"_shard_doc": object{"order": data.SortOrderDescending}},
+	}, query["sort"])
+	_, hasSearchAfter := query["search_after"]
+	require.False(t, hasSearchAfter)
+}
+
+func TestTxsByAddressQuery_DirectionShouldSelectTheAddressField(t *testing.T) {
+	t.Parallel()
+
+	query := txsByAddressQuery("erd1addr", data.TransactionsHistoryFilter{Direction: data.TransactionsDirectionOut}, nil)
+	filterClauses := query["query"].(object)["bool"].(object)["filter"].([]interface{})
+	require.Equal(t, matchQuery("sender", "erd1addr"), filterClauses[0])
+
+	query = txsByAddressQuery("erd1addr", data.TransactionsHistoryFilter{Direction: data.TransactionsDirectionIn}, nil)
+	filterClauses = query["query"].(object)["bool"].(object)["filter"].([]interface{})
+	require.Equal(t, shouldQuery(matchQuery("receiver", "erd1addr"), matchQuery("receivers", "erd1addr")), filterClauses[0])
+}
+
+func TestTxsByAddressQuery_AllFiltersShouldBeApplied(t *testing.T) {
+	t.Parallel()
+
+	filter := data.TransactionsHistoryFilter{
+		Direction: data.TransactionsDirectionOut,
+		Status:    "success",
+		Token:     "TKN-123456",
+		Function:  "claim",
+		From:      100,
+		To:        200,
+		FromNonce: core.OptionalUint64{Value: 0, HasValue: true},
+		ToNonce:   core.OptionalUint64{Value: 7, HasValue: true},
+		Order:     data.SortOrderAscending,
+	}
+	searchAfter := []interface{}{int64(150), int64(3), int64(12)}
+	query := txsByAddressQuery("erd1addr", filter, searchAfter)
+
+	filterClauses := query["query"].(object)["bool"].(object)["filter"].([]interface{})
+	require.Equal(t, []interface{}{
+		matchQuery("sender", "erd1addr"),
+		matchQuery("status", "success"),
+		matchQuery("tokens", "TKN-123456"),
+		matchQuery("function", "claim"),
+		object{"range": object{"timestamp": object{"gte": int64(100), "lte": int64(200)}}},
+		object{"range": object{"nonce": object{"gte": uint64(0), "lte": uint64(7)}}},
+	}, filterClauses)
+	require.Equal(t, []interface{}{
+		object{"timestamp": object{"order": data.SortOrderAscending}},
+		object{"nonce": object{"order": data.SortOrderAscending}},
+		object{"_shard_doc": object{"order": data.SortOrderAscending}},
+	}, query["sort"])
+	require.Equal(t, searchAfter, query["search_after"])
+}
+
+func TestHistoryCursor_EncodeDecode(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty cursor should return no sort values", func(t *testing.T) {
+		t.Parallel()
+
+		sortValues, err := decodeHistoryCursor("", numHistorySortValues)
+		require.Nil(t, err)
+		require.Nil(t, sortValues)
+	})
+	t.Run("invalid base64 should error", func(t *testing.T) {
+		t.Parallel()
+
+		sortValues, err := decodeHistoryCursor("!!!", numHistorySortValues)
+		require.True(t, errors.Is(err, data.ErrInvalidTransactionsHistoryCursor))
+		require.Nil(t, sortValues)
+	})
+	t.Run("wrong number of sort values should error", func(t *testing.T) {
+		t.Parallel()
+
+		cursor, _ := encodeHistoryCursor([]interface{}{1})
+		sortValues, err := decodeHistoryCursor(cursor, numHistorySortValues)
+		require.True(t, errors.Is(err, data.ErrInvalidTransactionsHistoryCursor))
+		require.Nil(t, sortValues)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		cursor, err := encodeHistoryCursor([]interface{}{float64(1690000000), float64(37), "h1"})
+		require.Nil(t, err)
+
+		sortValues, err := decodeHistoryCursor(cursor, numHistorySortValues)
+		require.Nil(t, err)
+		require.Equal(t, []interface{}{json.Number("1690000000"), json.Number("37"), "h1"}, sortValues)
+	})
+}
+
+func TestDecodeTransactionsHistoryCursor(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty cursor should return no sort values", func(t *testing.T) {
+		t.Parallel()
+
+		sortValues, err := decodeTransactionsHistoryCursor("")
+		require.Nil(t, err)
+		require.Nil(t, sortValues)
+	})
+	t.Run("values of wrong types should error", func(t *testing.T) {
+		t.Parallel()
+
+		for _, values := range [][]interface{}{
+			{"a", 37, "h1"},
+			{1690000000, "b", "h1"},
+			{1690000000, 37, 5},
+			{1.5, 37, "h1"},
+		} {
+			cursor, _ := encodeHistoryCursor(values)
+			sortValues, err := decodeTransactionsHistoryCursor(cursor)
+			require.True(t, errors.Is(err, data.ErrInvalidTransactionsHistoryCursor))
+			require.Nil(t, sortValues)
+		}
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		cursor, _ := encodeHistoryCursor([]interface{}{1690000000, 37, "h1"})
+		sortValues, err := decodeTransactionsHistoryCursor(cursor)
+		require.Nil(t, err)
+		require.Equal(t, []interface{}{int64(1690000000), int64(37), "h1"}, sortValues)
+	})
+}
+
+func TestDecodePointInTimeHistoryCursor(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty cursor should return no sort values", func(t *testing.T) {
+		t.Parallel()
+
+		pointInTimeID, sortValues, err := decodePointInTimeHistoryCursor("")
+		require.Nil(t, err)
+		require.Empty(t, pointInTimeID)
+		require.Nil(t, sortValues)
+	})
+	t.Run("values of wrong types should error", func(t *testing.T) {
+		t.Parallel()
+
+		for _, values := range [][]interface{}{
+			{"pit", 1690000000, 37, "h1"},
+			{"", 1690000000, 37, 12},
+			{5, 1690000000, 37, 12},
+			{"pit", "a", 37, 12},
+			{"pit", 1690000000, 1.5, 12},
+			{"pit", 1690000000, 37},
+		} {
+			cursor, _ := encodeHistoryCursor(values)
+			pointInTimeID, sortValues, err := decodePointInTimeHistoryCursor(cursor)
+			require.True(t, errors.Is(err, data.ErrInvalidTransactionsHistoryCursor))
+			require.Empty(t, pointInTimeID)
+			require.Nil(t, sortValues)
+		}
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		cursor, _ := encodeHistoryCursor([]interface{}{"pit", 1690000000, 37, 12})
+		pointInTimeID, sortValues, err := decodePointInTimeHistoryCursor(cursor)
+		require.Nil(t, err)
+		require.Equal(t, "pit", pointInTimeID)
+		require.Equal(t, []interface{}{int64(1690000000), int64(37), int64(12)}, sortValues)
+	})
+}
+
+func TestDecodePointInTimeSearchCursor(t *testing.T) {
+	t.Parallel()
+
+	errInvalidCursor := errors.New("invalid cursor")
+	for _, values := range [][]interface{}{
+		{"pit", 10, 3, "a"},
+		{"", 10, 3, "a", 0},
+		{"pit", 10, "3", "a", 0},
+		{"pit", 10, 3, 5, 0},
+		{"pit", 10, 3, "a", 0.5},
+	} {
+		cursor, _ := encodeHistoryCursor(values)
+		pointInTimeID, after, err := decodePointInTimeSearchCursor(cursor, errInvalidCursor)
+		require.Equal(t, errInvalidCursor, err)
+		require.Empty(t, pointInTimeID)
+		require.Nil(t, after)
+	}
+
+	cursor, _ := encodeHistoryCursor([]interface{}{"pit", 10, 3, "a", 2})
+	pointInTimeID, after, err := decodePointInTimeSearchCursor(cursor, errInvalidCursor)
+	require.Nil(t, err)
+	require.Equal(t, "pit", pointInTimeID)
+	require.Equal(t, []interface{}{int64(10), int64(3), "a", int64(2)}, after)
+}
+
+func TestConvertObjectToTransactionsPage(t *testing.T) {
+	t.Parallel()
+
+	createHit := func(hash string, nonce uint64, timestamp int64, shardDoc int64) interface{} {
+		return object{
+			"_id":     hash,
+			"_source": object{"nonce": nonce, "timestamp": timestamp, "gasUsed": 0, "gasPrice": 0},
+			"sort":    []interface{}{timestamp, nonce, shardDoc},
+		}
+	}
+
+	t.Run("invalid body should error", func(t *testing.T) {
+		t.Parallel()
+
+		page, err := convertObjectToTransactionsPage(object{}, 2, "pit")
+		require.Equal(t, errCannotGetTxsFromBody, err)
+		require.Nil(t, page)
+	})
+	t.Run("last page should not have a cursor", func(t *testing.T) {
+		t.Parallel()
+
+		obj := object{"hits": object{"hits": []interface{}{createHit("h1", 2, 20, 4), createHit("h2", 1, 10, 7)}}}
+		page, err := convertObjectToTransactionsPage(obj, 2, "pit")
+		require.Nil(t, err)
+		require.Len(t, page.Transactions, 2)
+		require.Equal(t, "h1", page.Transactions[0].Hash)
+		require.Equal(t, "h2", page.Transactions[1].Hash)
+		require.Empty(t, page.NextCursor)
+	})
+	t.Run("more hits than the limit should return the cursor of the last kept hit", func(t *testing.T) {
+		t.Parallel()
+
+		obj := object{"hits": object{"hits": []interface{}{createHit("h1", 3, 30, 4), createHit("h2", 2, 20, 7), createHit("h3", 1, 10, 1)}}}
+		page, err := convertObjectToTransactionsPage(obj, 2, "pit")
+		require.Nil(t, err)
+		require.Len(t, page.Transactions, 2)
+		require.Equal(t, "h2", page.Transactions[1].Hash)
+
+		pointInTimeID, sortValues, err := decodePointInTimeHistoryCursor(page.NextCursor)
+		require.Nil(t, err)
+		require.Equal(t, "pit", pointInTimeID)
+		require.Equal(t, []interface{}{int64(20), int64(2), int64(7)}, sortValues)
+	})
+}
@@ -0,0 +1,106 @@
+package database
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// hitItemsExtractor returns the items of a search hit matching the criteria of the search, each one along with its
+// index within the hit
+type hitItemsExtractor func(id string, source []byte) ([]indexedHitItem, error)
+
+type indexedHitItem struct {
+	index int
+	item  interface{}
+}
+
+// searchPageCollector fills a page with the items of the hits returned by consecutive searches, done in the same point
+// in time. As a hit might hold several items, the sort values of an item are the sort values of its hit followed by its
+// id and its index within the hit
+type searchPageCollector struct {
+	limit              int
+	extractItems       hitItemsExtractor
+	pointInTimeID      string
+	items              []interface{}
+	nextCursor         string
+	lastItemSortValues []interface{}
+	lastHitSortValues  []interface{}
+	numHits            int
+}
+
+func newSearchPageCollector(limit int, extractItems hitItemsExtractor) *searchPageCollector {
+	return &searchPageCollector{
+		limit:        limit,
+		extractItems: extractItems,
+		items:        make([]interface{}, 0, limit),
+	}
+}
+
+// collectItems adds to the page the items of the hits found in the decoded body, skipping the items of the first hit
+// whose index is not greater than the provided one. It returns true when the page is full and another item was found,
+// in which case the cursor of the next page is set
+func (collector *searchPageCollector) collectItems(decodedBody object, afterIndex int64) (bool, error) {
+	hits, ok := decodedBody["hits"].(object)
+	if !ok {
+		return false, errCannotGetHitsFromBody
+	}
+	hitsList, ok := hits["hits"].([]interface{})
+	if !ok {
+		return false, errCannotGetHitsFromBody
+	}
+
+	collector.numHits = len(hitsList)
+	for _, hit := range hitsList {
+		id, sortValues, source, err := splitSearchHit(hit)
+		if err != nil {
+			return false, err
+		}
+		collector.lastHitSortValues = sortValues
+
+		hitItems, err := collector.extractItems(id, source)
+		if err != nil {
+			return false, err
+		}
+
+		for _, hitItem := range hitItems {
+			if int64(hitItem.index) <= afterIndex {
+				continue
+			}
+
+			if len(collector.items) == collector.limit {
+				cursor, errEncode := encodeHistoryCursor(append([]interface{}{collector.pointInTimeID}, collector.lastItemSortValues...))
+				if errEncode != nil {
+					return false, errEncode
+				}
+				collector.nextCursor = cursor
+				return true, nil
+			}
+
+			collector.items = append(collector.items, hitItem.item)
+			collector.lastItemSortValues = []interface{}{sortValues[0], sortValues[1], id, hitItem.index}
+		}
+
+		afterIndex = -1
+	}
+
+	return false, nil
+}
+
+// splitSearchHit returns the id, the sort values and the marshaled source of a hit sorted by timestamp and shard document
+func splitSearchHit(hit interface{}) (string, []interface{}, []byte, error) {
+	hitObject, ok := hit.(object)
+	if !ok {
+		return "", nil, nil, errCannotGetHitsFromBody
+	}
+	sortValues, ok := hitObject["sort"].([]interface{})
+	if !ok || len(sortValues) != numSearchHitSortValues {
+		return "", nil, nil, errCannotGetHitsFromBody
+	}
+
+	source, err := json.Marshal(hitObject["_source"])
+	if err != nil {
+		return "", nil, nil, err
+	}
+
+	return fmt.Sprint(hitObject["_id"]), sortValues, source, nil
+}
@@ -0,0 +1,692 @@
+package database
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+	"path/filepath"
+	"strings"
+
+	// registers the sqlite3 driver
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+const (
+	sqliteDriverName = "sqlite3"
+	// hyperblockIngesterCheckpoint is the name of the checkpoint holding the nonce of the last indexed hyperblock
+	hyperblockIngesterCheckpoint = "hyperblock_ingester"
+	// hyperblockIngesterStartCheckpoint is the name of the checkpoint holding the nonce of the first indexed hyperblock
+	hyperblockIngesterStartCheckpoint = "hyperblock_ingester_start"
+	// numTokenHoldersSortValues is the number of values the token holders are sorted by: the balance, the token nonce
+	// and the address
+	numTokenHoldersSortValues = 3
+	// numTokenTransfersSortValues is the number of values the token transfers are sorted by: the block nonce, the
+	// transaction hash, the index of the event and the index of the transfer within the event
+	numTokenTransfersSortValues = 4
+	// sortableBalanceLength is the number of digits the balances are padded to, in order to be sorted as text. It
+	// covers the 256 bits values
+	sortableBalanceLength = 80
+)
+
+type sqliteConnector struct {
+	db *sql.DB
+}
+
+// NewSQLiteConnector opens (or creates) the SQLite history database found at the provided path
+func NewSQLiteConnector(path string) (*sqliteConnector, error) {
+	if len(path) == 0 {
+		return nil, errEmptyDatabasePath
+	}
+
+	err := os.MkdirAll(filepath.Dir(path), os.ModePerm)
+	if err != nil {
+		return nil, fmt.Errorf("cannot create database directory: %w", err)
+	}
+
+	db, err := sql.Open(sqliteDriverName, fmt.Sprintf("file:%s?_journal_mode=WAL&_busy_timeout=5000", path))
+	if err != nil {
+		return nil, fmt.Errorf("cannot open database: %w", err)
+	}
+	// a single connection serializes the writes of the indexing and keeps in-memory databases alive
+	db.SetMaxOpenConns(1)
+
+	for _, statement := range sqliteSchema {
+		_, err = db.Exec(statement)
+		if err != nil {
+			_ = db.Close()
+			return nil, fmt.Errorf("cannot create database schema: %w", err)
+		}
+	}
+
+	return &sqliteConnector{
+		db: db,
+	}, nil
+}
+
+// GetTransactionsByAddress gets a page of transactions TO or FROM the specified address, matching the filter. The
+// cursor of the next page holds the sort values of the last transaction
+func (sc *sqliteConnector) GetTransactionsByAddress(address string, filter data.TransactionsHistoryFilter) (*data.TransactionsHistoryPage, error) {
+	after, err := decodeTransactionsHistoryCursor(filter.Cursor)
+	if err != nil {
+		return nil, err
+	}
+
+	limit := filter.Limit
+	if limit <= 0 {
+		limit = numTopTransactions
+	}
+	if limit > maxNumTransactionsPerPage {
+		limit = maxNumTransactionsPerPage
+	}
+
+	// one more transaction is requested, in order to know whether there is a next page
+	query, args := txsByAddressSQLQuery(address, filter, after, limit+1)
+	rows, err := sc.db.Query(query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("cannot get data from database: %w", err)
+	}
+	defer func() {
+		_ = rows.Close()
+	}()
+
+	page := &data.TransactionsHistoryPage{
+		Transactions: make([]data.DatabaseTransaction, 0, limit),
+	}
+	var lastSortValues []interface{}
+	for rows.Next() {
+		var payload string
+		var timestamp, nonce int64
+		var hash string
+		err = rows.Scan(&payload, &timestamp, &nonce, &hash)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(page.Transactions) == limit {
+			cursor, errEncode := encodeHistoryCursor(lastSortValues)
+			if errEncode != nil {
+				return nil, errEncode
+			}
+			page.NextCursor = cursor
+			break
+		}
+
+		tx, err := convertPayloadToTransaction(payload)
+		if err != nil {
+			return nil, err
+		}
+		page.Transactions = append(page.Transactions, *tx)
+		lastSortValues = []interface{}{timestamp, nonce, hash}
+	}
+
+	return page, rows.Err()
+}
+
+// GetAtlasBlockByShardIDAndNonce gets from database a block with the specified shardID and nonce
+func (sc *sqliteConnector) GetAtlasBlockByShardIDAndNonce(shardID uint32, nonce uint64) (data.AtlasBlock, error) {
+	var hash string
+	err := sc.db.QueryRow("SELECT hash FROM blocks WHERE shard_id = ? AND nonce = ?", shardID, nonce).Scan(&hash)
+	if errors.Is(err, sql.ErrNoRows) {
+		return data.AtlasBlock{}, data.ErrAtlasBlockNotFound
+	}
+	if err != nil {
+		return data.AtlasBlock{}, fmt.Errorf("cannot get data from database: %w", err)
+	}
+
+	rows, err := sc.db.Query("SELECT payload FROM transactions WHERE block_shard_id = ? AND block_nonce = ? ORDER BY rowid", shardID, nonce)
+	if err != nil {
+		return data.AtlasBlock{}, fmt.Errorf("cannot get data from database: %w", err)
+	}
+	defer func() {
+		_ = rows.Close()
+	}()
+
+	txs := make([]data.DatabaseTransaction, 0)
+	for rows.Next() {
+		var payload string
+		err = rows.Scan(&payload)
+		if err != nil {
+			return data.AtlasBlock{}, err
+		}
+
+		tx, err := convertPayloadToTransaction(payload)
+		if err != nil {
+			return data.AtlasBlock{}, err
+		}
+		txs = append(txs, *tx)
+	}
+	if rows.Err() != nil {
+		return data.AtlasBlock{}, rows.Err()
+	}
+
+	return data.AtlasBlock{
+		Nonce:        nonce,
+		Hash:         hash,
+		Transactions: txs,
+	}, nil
+}
+
+// SaveBlock stores the block of the given shard along with its transactions, replacing the previously stored version
+// of the block, if any
+func (sc *sqliteConnector) SaveBlock(shardID uint32, block data.AtlasBlock) error {
+	dbTx, err := sc.db.Begin()
+	if err != nil {
+		return err
+	}
+
+	err = saveBlock(dbTx, shardID, block)
+	if err != nil {
+		_ = dbTx.Rollback()
+		return err
+	}
+
+	return dbTx.Commit()
+}
+
+func saveBlock(dbTx *sql.Tx, shardID uint32, block data.AtlasBlock) error {
+	err := deleteBlock(dbTx, shardID, block.Nonce)
+	if err != nil {
+		return err
+	}
+
+	_, err = dbTx.Exec("INSERT INTO blocks (shard_id, nonce, hash) VALUES (?, ?, ?)", shardID, block.Nonce, block.Hash)
+	if err != nil {
+		return err
+	}
+
+	for i := range block.Transactions {
+		err = saveTransaction(dbTx, shardID, block.Nonce, &block.Transactions[i])
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func deleteBlock(dbTx *sql.Tx, shardID uint32, nonce uint64) error {
+	statements := []string{
+		"DELETE FROM address_transactions WHERE tx_hash IN (SELECT hash FROM transactions WHERE block_shard_id = ? AND block_nonce = ?)",
+		"DELETE FROM transaction_tokens WHERE tx_hash IN (SELECT hash FROM transactions WHERE block_shard_id = ? AND block_nonce = ?)",
+		"DELETE FROM transactions WHERE block_shard_id = ? AND block_nonce = ?",
+		"DELETE FROM blocks WHERE shard_id = ? AND nonce = ?",
+	}
+	for _, statement := range statements {
+		_, err := dbTx.Exec(statement, shardID, nonce)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func saveTransaction(dbTx *sql.Tx, shardID uint32, blockNonce uint64, tx *data.DatabaseTransaction) error {
+	payload, err := json.Marshal(tx)
+	if err != nil {
+		return err
+	}
+
+	_, err = dbTx.Exec(
+		"INSERT OR REPLACE INTO transactions (hash, block_shard_id, block_nonce, status, function, payload) VALUES (?, ?, ?, ?, ?, ?)",
+		tx.Hash, shardID, blockNonce, tx.Status, tx.Function, string(payload),
+	)
+	if err != nil {
+		return err
+	}
+
+	for address, roles := range getTransactionAddresses(tx) {
+		_, err = dbTx.Exec(
+			"INSERT OR REPLACE INTO address_transactions (address, tx_hash, is_sender, is_receiver, timestamp, nonce) VALUES (?, ?, ?, ?, ?, ?)",
+			address, tx.Hash, roles.isSender, roles.isReceiver, int64(tx.Timestamp), tx.Nonce,
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, token := range tx.Tokens {
+		_, err = dbTx.Exec("INSERT OR IGNORE INTO transaction_tokens (tx_hash, token) VALUES (?, ?)", tx.Hash, token)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// IndexHyperblock stores the data of the hyperblock and records it as the last indexed one, atomically. The previously
+// stored data of the hyperblock, if any, is replaced
+func (sc *sqliteConnector) IndexHyperblock(hyperblock *data.IndexedHyperblock) error {
+	dbTx, err := sc.db.Begin()
+	if err != nil {
+		return err
+	}
+
+	err = indexHyperblock(dbTx, hyperblock)
+	if err != nil {
+		_ = dbTx.Rollback()
+		return err
+	}
+
+	return dbTx.Commit()
+}
+
+func indexHyperblock(dbTx *sql.Tx, hyperblock *data.IndexedHyperblock) error {
+	err := saveBlock(dbTx, core.MetachainShardId, data.AtlasBlock{
+		Nonce:        hyperblock.Nonce,
+		Hash:         hyperblock.Hash,
+		Transactions: hyperblock.Transactions,
+	})
+	if err != nil {
+		return err
+	}
+
+	statements := []string{
+		"DELETE FROM events WHERE block_nonce = ?",
+		"DELETE FROM event_topics WHERE block_nonce = ?",
+		"DELETE FROM token_transfers WHERE block_nonce = ?",
+	}
+	for _, statement := range statements {
+		_, err = dbTx.Exec(statement, hyperblock.Nonce)
+		if err != nil {
+			return err
+		}
+	}
+
+	for i := range hyperblock.Events {
+		err = saveEvent(dbTx, &hyperblock.Events[i])
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, transfer := range hyperblock.TokenTransfers {
+		_, err = dbTx.Exec(
+			"INSERT OR REPLACE INTO token_transfers (tx_hash, event_index, transfer_index, block_nonce, token, token_nonce, sender, receiver, value, timestamp) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)",
+			transfer.TxHash, transfer.EventIndex, transfer.Index, transfer.BlockNonce, transfer.Token, transfer.Nonce, transfer.Sender, transfer.Receiver, transfer.Value, transfer.Timestamp,
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, balance := range hyperblock.TokenBalances {
+		err = saveTokenBalance(dbTx, balance)
+		if err != nil {
+			return err
+		}
+	}
+
+	_, err = dbTx.Exec("INSERT OR IGNORE INTO checkpoints (name, nonce) VALUES (?, ?)", hyperblockIngesterStartCheckpoint, hyperblock.Nonce)
+	if err != nil {
+		return err
+	}
+
+	_, err = dbTx.Exec("INSERT OR REPLACE INTO checkpoints (name, nonce) VALUES (?, ?)", hyperblockIngesterCheckpoint, hyperblock.Nonce)
+
+	return err
+}
+
+// saveTokenBalance stores the balance, unless a newer one is already stored. The zero (or empty) balances remove the
+// holder
+func saveTokenBalance(dbTx *sql.Tx, balance data.DatabaseTokenBalance) error {
+	value, ok := big.NewInt(0), true
+	if len(balance.Balance) > 0 {
+		_, ok = value.SetString(balance.Balance, 10)
+	}
+	if !ok || value.Sign() < 0 {
+		return fmt.Errorf("invalid balance %s of token %s held by %s", balance.Balance, balance.Token, balance.Address)
+	}
+
+	if value.Sign() == 0 {
+		_, err := dbTx.Exec(
+			"DELETE FROM token_balances WHERE token = ? AND token_nonce = ? AND address = ? AND block_nonce <= ?",
+			balance.Token, balance.Nonce, balance.Address, balance.BlockNonce,
+		)
+		return err
+	}
+
+	digits := value.String()
+	sortableBalance := strings.Repeat("0", sortableBalanceLength-len(digits)) + digits
+	_, err := dbTx.Exec(
+		"INSERT INTO token_balances (token, token_nonce, address, balance, sortable_balance, block_nonce) VALUES (?, ?, ?, ?, ?, ?) "+
+			"ON CONFLICT (token, token_nonce, address) DO UPDATE SET balance = excluded.balance, "+
+			"sortable_balance = excluded.sortable_balance, block_nonce = excluded.block_nonce "+
+			"WHERE excluded.block_nonce >= token_balances.block_nonce",
+		balance.Token, balance.Nonce, balance.Address, digits, sortableBalance, balance.BlockNonce,
+	)
+
+	return err
+}
+
+func saveEvent(dbTx *sql.Tx, event *data.DatabaseEvent) error {
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+
+	_, err = dbTx.Exec(
+		"INSERT OR REPLACE INTO events (tx_hash, event_index, block_nonce, address, identifier, timestamp, payload) VALUES (?, ?, ?, ?, ?, ?, ?)",
+		event.TxHash, event.Index, event.BlockNonce, event.Address, event.Identifier, event.Timestamp, string(payload),
+	)
+	if err != nil {
+		return err
+	}
+
+	for position, topic := range event.Topics {
+		if topic == nil {
+			// empty topics are matched against empty values, never against NULL
+			topic = make([]byte, 0)
+		}
+
+		_, err = dbTx.Exec(
+			"INSERT OR REPLACE INTO event_topics (tx_hash, event_index, position, block_nonce, topic) VALUES (?, ?, ?, ?, ?)",
+			event.TxHash, event.Index, position, event.BlockNonce, topic,
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// GetEvents gets a page of events matching the filter, sorted by block nonce, ascending by default. The cursor of the
+// next page holds the sort values of the last event
+func (sc *sqliteConnector) GetEvents(filter data.EventsFilter) (*data.EventsPage, error) {
+	after, err := decodeEventsCursor(filter.Cursor)
+	if err != nil {
+		return nil, err
+	}
+
+	limit := filter.Limit
+	if limit <= 0 {
+		limit = numTopEvents
+	}
+	if limit > maxNumEventsPerPage {
+		limit = maxNumEventsPerPage
+	}
+
+	// one more event is requested, in order to know whether there is a next page
+	query, args := eventsSQLQuery(filter, after, limit+1)
+	rows, err := sc.db.Query(query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("cannot get data from database: %w", err)
+	}
+	defer func() {
+		_ = rows.Close()
+	}()
+
+	page := &data.EventsPage{
+		Events: make([]data.DatabaseEvent, 0, limit),
+	}
+	var lastSortValues []interface{}
+	for rows.Next() {
+		var payload string
+		var blockNonce, index int64
+		var txHash string
+		err = rows.Scan(&payload, &blockNonce, &txHash, &index)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(page.Events) == limit {
+			cursor, errEncode := encodeHistoryCursor(lastSortValues)
+			if errEncode != nil {
+				return nil, errEncode
+			}
+			page.NextCursor = cursor
+			break
+		}
+
+		var event data.DatabaseEvent
+		err = json.Unmarshal([]byte(payload), &event)
+		if err != nil {
+			return nil, err
+		}
+		page.Events = append(page.Events, event)
+		lastSortValues = []interface{}{blockNonce, txHash, index}
+	}
+
+	return page, rows.Err()
+}
+
+// GetTokenHolders gets a page of the holders of a token, the highest balance first. The balances are the ones of the
+// indexed hyperblocks, so the page is marked as incomplete unless the hyperblocks were indexed since the genesis. The
+// cursor of the next page holds the sort values of the last holder
+func (sc *sqliteConnector) GetTokenHolders(filter data.TokenHoldersFilter) (*data.TokenHoldersPage, error) {
+	after, err := decodeTokenHoldersCursor(filter.Cursor)
+	if err != nil {
+		return nil, err
+	}
+
+	isIndexedSinceGenesis, err := sc.isIndexedSinceGenesis()
+	if err != nil {
+		return nil, err
+	}
+
+	limit := tokenResultsLimit(filter.Limit)
+
+	// one more holder is requested, in order to know whether there is a next page
+	query, args := tokenHoldersSQLQuery(filter, after, limit+1)
+	rows, err := sc.db.Query(query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("cannot get data from database: %w", err)
+	}
+	defer func() {
+		_ = rows.Close()
+	}()
+
+	page := &data.TokenHoldersPage{
+		Holders:    make([]data.TokenHolder, 0, limit),
+		Incomplete: !isIndexedSinceGenesis,
+	}
+	var lastSortValues []interface{}
+	for rows.Next() {
+		var holder data.TokenHolder
+		var sortableBalance string
+		err = rows.Scan(&holder.Address, &holder.Nonce, &holder.Balance, &holder.BlockNonce, &sortableBalance)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(page.Holders) == limit {
+			page.NextCursor, err = encodeHistoryCursor(lastSortValues)
+			if err != nil {
+				return nil, err
+			}
+			break
+		}
+
+		page.Holders = append(page.Holders, holder)
+		lastSortValues = []interface{}{sortableBalance, holder.Nonce, holder.Address}
+	}
+
+	return page, rows.Err()
+}
+
+// GetTokenTransfers gets a page of the transfers of a token, sorted by block nonce, ascending by default. The cursor of
+// the next page holds the sort values of the last transfer
+func (sc *sqliteConnector) GetTokenTransfers(filter data.TokenTransfersFilter) (*data.TokenTransfersPage, error) {
+	after, err := decodeTokenTransfersCursor(filter.Cursor)
+	if err != nil {
+		return nil, err
+	}
+
+	limit := tokenResultsLimit(filter.Limit)
+
+	// one more transfer is requested, in order to know whether there is a next page
+	query, args := tokenTransfersSQLQuery(filter, after, limit+1)
+	rows, err := sc.db.Query(query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("cannot get data from database: %w", err)
+	}
+	defer func() {
+		_ = rows.Close()
+	}()
+
+	page := &data.TokenTransfersPage{
+		Transfers: make([]data.TokenTransfer, 0, limit),
+	}
+	var lastSortValues []interface{}
+	for rows.Next() {
+		var transfer data.DatabaseTokenTransfer
+		err = rows.Scan(&transfer.TxHash, &transfer.EventIndex, &transfer.Index, &transfer.BlockNonce, &transfer.Token,
+			&transfer.Nonce, &transfer.Sender, &transfer.Receiver, &transfer.Value, &transfer.Timestamp)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(page.Transfers) == limit {
+			page.NextCursor, err = encodeHistoryCursor(lastSortValues)
+			if err != nil {
+				return nil, err
+			}
+			break
+		}
+
+		page.Transfers = append(page.Transfers, data.TokenTransfer{DatabaseTokenTransfer: transfer})
+		lastSortValues = []interface{}{transfer.BlockNonce, transfer.TxHash, transfer.EventIndex, transfer.Index}
+	}
+
+	return page, rows.Err()
+}
+
+// GetLastIndexedHyperblockNonce returns the nonce of the last indexed hyperblock, if any
+func (sc *sqliteConnector) GetLastIndexedHyperblockNonce() (uint64, bool, error) {
+	var nonce uint64
+	err := sc.db.QueryRow("SELECT nonce FROM checkpoints WHERE name = ?", hyperblockIngesterCheckpoint).Scan(&nonce)
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, false, nil
+	}
+	if err != nil {
+		return 0, false, fmt.Errorf("cannot get data from database: %w", err)
+	}
+
+	return nonce, true, nil
+}
+
+// isIndexedSinceGenesis returns true if the first indexed hyperblock is the genesis one. The databases created before
+// the first indexed nonce was recorded are considered as not indexed since the genesis
+func (sc *sqliteConnector) isIndexedSinceGenesis() (bool, error) {
+	var nonce uint64
+	err := sc.db.QueryRow("SELECT nonce FROM checkpoints WHERE name = ?", hyperblockIngesterStartCheckpoint).Scan(&nonce)
+	if errors.Is(err, sql.ErrNoRows) {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("cannot get data from database: %w", err)
+	}
+
+	return nonce == 0, nil
+}
+
+type addressRoles struct {
+	isSender   bool
+	isReceiver bool
+}
+
+func getTransactionAddresses(tx *data.DatabaseTransaction) map[string]*addressRoles {
+	addresses := make(map[string]*addressRoles)
+	getRoles := func(address string) *addressRoles {
+		roles, found := addresses[address]
+		if !found {
+			roles = &addressRoles{}
+			addresses[address] = roles
+		}
+		return roles
+	}
+
+	if len(tx.Sender) > 0 {
+		getRoles(tx.Sender).isSender = true
+	}
+	if len(tx.Receiver) > 0 {
+		getRoles(tx.Receiver).isReceiver = true
+	}
+	for _, receiver := range tx.Receivers {
+		getRoles(receiver).isReceiver = true
+	}
+
+	return addresses
+}
+
+func convertPayloadToTransaction(payload string) (*data.DatabaseTransaction, error) {
+	var tx data.DatabaseTransaction
+	err := json.Unmarshal([]byte(payload), &tx)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(tx.Fee) == 0 {
+		tx.Fee = tx.CalculateFee()
+	}
+
+	return &tx, nil
+}
+
+func decodeTokenHoldersCursor(cursor string) ([]interface{}, error) {
+	sortValues, err := decodeHistoryCursor(cursor, numTokenHoldersSortValues)
+	if err != nil {
+		return nil, data.ErrInvalidTokenCursor
+	}
+	if len(sortValues) == 0 {
+		return nil, nil
+	}
+
+	sortableBalance, isBalanceString := sortValues[0].(string)
+	nonce, isNonceNumber := sortValues[1].(json.Number)
+	address, isAddressString := sortValues[2].(string)
+	if !isBalanceString || !isNonceNumber || !isAddressString || len(sortableBalance) != sortableBalanceLength {
+		return nil, data.ErrInvalidTokenCursor
+	}
+
+	nonceValue, err := nonce.Int64()
+	if err != nil {
+		return nil, data.ErrInvalidTokenCursor
+	}
+
+	return []interface{}{sortableBalance, nonceValue, address}, nil
+}
+
+func decodeTokenTransfersCursor(cursor string) ([]interface{}, error) {
+	sortValues, err := decodeHistoryCursor(cursor, numTokenTransfersSortValues)
+	if err != nil {
+		return nil, data.ErrInvalidTokenCursor
+	}
+	if len(sortValues) == 0 {
+		return nil, nil
+	}
+
+	blockNonce, isBlockNonceNumber := sortValues[0].(json.Number)
+	txHash, isTxHashString := sortValues[1].(string)
+	eventIndex, isEventIndexNumber := sortValues[2].(json.Number)
+	transferIndex, isTransferIndexNumber := sortValues[3].(json.Number)
+	if !isBlockNonceNumber || !isTxHashString || !isEventIndexNumber || !isTransferIndexNumber {
+		return nil, data.ErrInvalidTokenCursor
+	}
+
+	blockNonceValue, errBlockNonce := blockNonce.Int64()
+	eventIndexValue, errEventIndex := eventIndex.Int64()
+	transferIndexValue, errTransferIndex := transferIndex.Int64()
+	if errBlockNonce != nil || errEventIndex != nil || errTransferIndex != nil {
+		return nil, data.ErrInvalidTokenCursor
+	}
+
+	return []interface{}{blockNonceValue, txHash, eventIndexValue, transferIndexValue}, nil
+}
+
+// Close closes the underlying database
+func (sc *sqliteConnector) Close() error {
+	return sc.db.Close()
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (sc *sqliteConnector) IsInterfaceNil() bool {
+	return sc == nil
+}
@@ -0,0 +1,535 @@
+package database
+
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	indexerData "github.com/multiversx/mx-chain-es-indexer-go/data"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/stretchr/testify/require"
+)
+
+func createTestSQLiteConnector(t *testing.T) *sqliteConnector {
+	connector, err := NewSQLiteConnector(filepath.Join(t.TempDir(), "history.sqlite"))
+	require.Nil(t, err)
+	t.Cleanup(func() {
+		_ = connector.Close()
+	})
+
+	return connector
+}
+
+func createTestDatabaseTransaction(hash string, sender string, receiver string, nonce uint64, timestamp int64) data.DatabaseTransaction {
+	return data.DatabaseTransaction{
+		Hash: hash,
+		Transaction: indexerData.Transaction{
+			Nonce:     nonce,
+			Sender:    sender,
+			Receiver:  receiver,
+			Value:     "1",
+			GasPrice:  10,
+			GasUsed:   5,
+			Timestamp: time.Duration(timestamp),
+			Status:    "success",
+		},
+	}
+}
+
+func TestNewSQLiteConnector_EmptyPathShouldErr(t *testing.T) {
+	t.Parallel()
+
+	connector, err := NewSQLiteConnector("")
+	require.Equal(t, errEmptyDatabasePath, err)
+	require.True(t, connector.IsInterfaceNil())
+}
+
+func TestSQLiteConnector_GetAtlasBlockByShardIDAndNonce(t *testing.T) {
+	t.Parallel()
+
+	connector := createTestSQLiteConnector(t)
+
+	_, err := connector.GetAtlasBlockByShardIDAndNonce(core.MetachainShardId, 7)
+	require.Equal(t, data.ErrAtlasBlockNotFound, err)
+
+	err = connector.SaveBlock(core.MetachainShardId, data.AtlasBlock{
+		Nonce: 7,
+		Hash:  "old",
+		Transactions: []data.DatabaseTransaction{
+			createTestDatabaseTransaction("h0", "alice", "bob", 0, 100),
+		},
+	})
+	require.Nil(t, err)
+
+	// saving the block again should replace the previous version
+	err = connector.SaveBlock(core.MetachainShardId, data.AtlasBlock{
+		Nonce: 7,
+		Hash:  "new",
+		Transactions: []data.DatabaseTransaction{
+			createTestDatabaseTransaction("h1", "alice", "bob", 1, 100),
+			createTestDatabaseTransaction("h2", "bob", "carol", 0, 100),
+		},
+	})
+	require.Nil(t, err)
+
+	block, err := connector.GetAtlasBlockByShardIDAndNonce(core.MetachainShardId, 7)
+	require.Nil(t, err)
+	require.Equal(t, uint64(7), block.Nonce)
+	require.Equal(t, "new", block.Hash)
+	require.Len(t, block.Transactions, 2)
+	require.Equal(t, "h1", block.Transactions[0].Hash)
+	require.Equal(t, "h2", block.Transactions[1].Hash)
+	require.Equal(t, "50", block.Transactions[0].Fee)
+
+	page, err := connector.GetTransactionsByAddress("alice", data.TransactionsHistoryFilter{})
+	require.Nil(t, err)
+	require.Len(t, page.Transactions, 1)
+	require.Equal(t, "h1", page.Transactions[0].Hash)
+}
+
+func TestSQLiteConnector_GetTransactionsByAddress(t *testing.T) {
+	t.Parallel()
+
+	connector := createTestSQLiteConnector(t)
+
+	multiTransfer := createTestDatabaseTransaction("h4", "bob", "bob", 3, 400)
+	multiTransfer.Receivers = []string{"alice"}
+	multiTransfer.Tokens = []string{"TKN-123456"}
+	multiTransfer.Function = "MultiESDTNFTTransfer"
+	failed := createTestDatabaseTransaction("h5", "alice", "carol", 2, 500)
+	failed.Status = "fail"
+
+	err := connector.SaveBlock(core.MetachainShardId, data.AtlasBlock{
+		Nonce: 1,
+		Hash:  "block1",
+		Transactions: []data.DatabaseTransaction{
+			createTestDatabaseTransaction("h1", "alice", "bob", 0, 100),
+			createTestDatabaseTransaction("h2", "bob", "alice", 0, 200),
+			createTestDatabaseTransaction("h3", "alice", "alice", 1, 300),
+			multiTransfer,
+			failed,
+		},
+	})
+	require.Nil(t, err)
+
+	getHashes := func(page *data.TransactionsHistoryPage) []string {
+		hashes := make([]string, 0, len(page.Transactions))
+		for _, tx := range page.Transactions {
+			hashes = append(hashes, tx.Hash)
+		}
+		return hashes
+	}
+
+	t.Run("no filter should return all the transactions, the newest first", func(t *testing.T) {
+		page, err := connector.GetTransactionsByAddress("alice", data.TransactionsHistoryFilter{})
+		require.Nil(t, err)
+		require.Equal(t, []string{"h5", "h4", "h3", "h2", "h1"}, getHashes(page))
+		require.Empty(t, page.NextCursor)
+	})
+	t.Run("direction", func(t *testing.T) {
+		page, err := connector.GetTransactionsByAddress("alice", data.TransactionsHistoryFilter{Direction: data.TransactionsDirectionOut})
+		require.Nil(t, err)
+		require.Equal(t, []string{"h5", "h3", "h1"}, getHashes(page))
+
+		page, err = connector.GetTransactionsByAddress("alice", data.TransactionsHistoryFilter{Direction: data.TransactionsDirectionIn})
+		require.Nil(t, err)
+		require.Equal(t, []string{"h4", "h3", "h2"}, getHashes(page))
+	})
+	t.Run("status, token and function", func(t *testing.T) {
+		page, err := connector.GetTransactionsByAddress("alice", data.TransactionsHistoryFilter{Status: "fail"})
+		require.Nil(t, err)
+		require.Equal(t, []string{"h5"}, getHashes(page))
+
+		page, err = connector.GetTransactionsByAddress("alice", data.TransactionsHistoryFilter{Token: "TKN-123456", Function: "MultiESDTNFTTransfer"})
+		require.Nil(t, err)
+		require.Equal(t, []string{"h4"}, getHashes(page))
+	})
+	t.Run("timestamp and nonce ranges", func(t *testing.T) {
+		page, err := connector.GetTransactionsByAddress("alice", data.TransactionsHistoryFilter{From: 200, To: 400})
+		require.Nil(t, err)
+		require.Equal(t, []string{"h4", "h3", "h2"}, getHashes(page))
+
+		page, err = connector.GetTransactionsByAddress("alice", data.TransactionsHistoryFilter{
+			FromNonce: core.OptionalUint64{Value: 1, HasValue: true},
+			ToNonce:   core.OptionalUint64{Value: 2, HasValue: true},
+		})
+		require.Nil(t, err)
+		require.Equal(t, []string{"h5", "h3"}, getHashes(page))
+	})
+	t.Run("pages should be chained by cursors", func(t *testing.T) {
+		filter := data.TransactionsHistoryFilter{Order: data.SortOrderAscending, Limit: 2}
+		hashes := make([]string, 0)
+		numPages := 0
+		for {
+			page, err := connector.GetTransactionsByAddress("alice", filter)
+			require.Nil(t, err)
+			hashes = append(hashes, getHashes(page)...)
+			numPages++
+			if len(page.NextCursor) == 0 {
+				break
+			}
+			filter.Cursor = page.NextCursor
+		}
+
+		require.Equal(t, 3, numPages)
+		require.Equal(t, []string{"h1", "h2", "h3", "h4", "h5"}, hashes)
+	})
+	t.Run("invalid cursor should error", func(t *testing.T) {
+		cursor, _ := encodeHistoryCursor([]interface{}{100, 0})
+		page, err := connector.GetTransactionsByAddress("alice", data.TransactionsHistoryFilter{Cursor: cursor})
+		require.True(t, errors.Is(err, data.ErrInvalidTransactionsHistoryCursor))
+		require.Nil(t, page)
+
+		cursor, _ = encodeHistoryCursor([]interface{}{100, 0, 5})
+		page, err = connector.GetTransactionsByAddress("alice", data.TransactionsHistoryFilter{Cursor: cursor})
+		require.True(t, errors.Is(err, data.ErrInvalidTransactionsHistoryCursor))
+		require.Nil(t, page)
+	})
+}
+
+func TestSQLiteConnector_IndexHyperblock(t *testing.T) {
+	t.Parallel()
+
+	connector := createTestSQLiteConnector(t)
+
+	_, found, err := connector.GetLastIndexedHyperblockNonce()
+	require.Nil(t, err)
+	require.False(t, found)
+
+	tx := createTestDatabaseTransaction("h1", "alice", "bob", 0, 100)
+	tx.Fee = "123"
+	hyperblock := &data.IndexedHyperblock{
+		Nonce:        5,
+		Hash:         "hyperblockHash",
+		Transactions: []data.DatabaseTransaction{tx},
+		Events: []data.DatabaseEvent{
+			{TxHash: "h1", Index: 0, Address: "alice", Identifier: "ESDTTransfer", BlockNonce: 5, Timestamp: 100},
+		},
+		TokenTransfers: []data.DatabaseTokenTransfer{
+			{TxHash: "h1", Token: "TKN-123456", Sender: "alice", Receiver: "bob", Value: "10", BlockNonce: 5, Timestamp: 100},
+		},
+	}
+	err = connector.IndexHyperblock(hyperblock)
+	require.Nil(t, err)
+	// indexing the same hyperblock again should replace the stored data
+	err = connector.IndexHyperblock(hyperblock)
+	require.Nil(t, err)
+
+	nonce, found, err := connector.GetLastIndexedHyperblockNonce()
+	require.Nil(t, err)
+	require.True(t, found)
+	require.Equal(t, uint64(5), nonce)
+
+	block, err := connector.GetAtlasBlockByShardIDAndNonce(core.MetachainShardId, 5)
+	require.Nil(t, err)
+	require.Equal(t, "hyperblockHash", block.Hash)
+	require.Len(t, block.Transactions, 1)
+	require.Equal(t, "123", block.Transactions[0].Fee)
+
+	var numEvents, numTransfers int
+	require.Nil(t, connector.db.QueryRow("SELECT COUNT(*) FROM events").Scan(&numEvents))
+	require.Nil(t, connector.db.QueryRow("SELECT COUNT(*) FROM token_transfers").Scan(&numTransfers))
+	require.Equal(t, 1, numEvents)
+	require.Equal(t, 1, numTransfers)
+}
+
+func TestSQLiteConnector_GetEvents(t *testing.T) {
+	t.Parallel()
+
+	connector := createTestSQLiteConnector(t)
+
+	for nonce := uint64(1); nonce <= 3; nonce++ {
+		err := connector.IndexHyperblock(&data.IndexedHyperblock{
+			Nonce: nonce,
+			Hash:  fmt.Sprintf("hyperblock%d", nonce),
+			Events: []data.DatabaseEvent{
+				{TxHash: fmt.Sprintf("h%d", nonce), Index: 0, Address: "contract", Identifier: "deposit", Topics: [][]byte{[]byte("alice"), {}}, BlockNonce: nonce},
+				{TxHash: fmt.Sprintf("h%d", nonce), Index: 1, Address: "contract", Identifier: "withdraw", Topics: [][]byte{[]byte("bob"), {1}}, BlockNonce: nonce},
+				{TxHash: fmt.Sprintf("h%d", nonce), Index: 2, Address: "other", Identifier: "deposit", Topics: [][]byte{[]byte("bob")}, BlockNonce: nonce},
+			},
+		})
+		require.Nil(t, err)
+	}
+
+	getIDs := func(page *data.EventsPage) []string {
+		ids := make([]string, 0, len(page.Events))
+		for _, event := range page.Events {
+			ids = append(ids, fmt.Sprintf("%s/%d", event.TxHash, event.Index))
+		}
+		return ids
+	}
+
+	t.Run("no filter should return all the events, the oldest first", func(t *testing.T) {
+		page, err := connector.GetEvents(data.EventsFilter{})
+		require.Nil(t, err)
+		require.Len(t, page.Events, 9)
+		require.Equal(t, "h1/0", getIDs(page)[0])
+		require.Empty(t, page.NextCursor)
+	})
+	t.Run("address, identifier and block range", func(t *testing.T) {
+		page, err := connector.GetEvents(data.EventsFilter{
+			Address:    "contract",
+			Identifier: "deposit",
+			FromBlock:  core.OptionalUint64{Value: 2, HasValue: true},
+			Order:      data.SortOrderDescending,
+		})
+		require.Nil(t, err)
+		require.Equal(t, []string{"h3/0", "h2/0"}, getIDs(page))
+
+		page, err = connector.GetEvents(data.EventsFilter{ToBlock: core.OptionalUint64{Value: 1, HasValue: true}})
+		require.Nil(t, err)
+		require.Equal(t, []string{"h1/0", "h1/1", "h1/2"}, getIDs(page))
+	})
+	t.Run("topics should be matched by position", func(t *testing.T) {
+		page, err := connector.GetEvents(data.EventsFilter{Topics: [][]byte{[]byte("bob")}, ToBlock: core.OptionalUint64{Value: 1, HasValue: true}})
+		require.Nil(t, err)
+		require.Equal(t, []string{"h1/1", "h1/2"}, getIDs(page))
+
+		page, err = connector.GetEvents(data.EventsFilter{Topics: [][]byte{nil, {}}, ToBlock: core.OptionalUint64{Value: 1, HasValue: true}})
+		require.Nil(t, err)
+		require.Equal(t, []string{"h1/0"}, getIDs(page))
+
+		page, err = connector.GetEvents(data.EventsFilter{Topics: [][]byte{[]byte("alice"), {1}}})
+		require.Nil(t, err)
+		require.Empty(t, page.Events)
+	})
+	t.Run("pages should be chained by cursors", func(t *testing.T) {
+		filter := data.EventsFilter{Identifier: "deposit", Limit: 2}
+		ids := make([]string, 0)
+		numPages := 0
+		for {
+			page, err := connector.GetEvents(filter)
+			require.Nil(t, err)
+			ids = append(ids, getIDs(page)...)
+			numPages++
+			if len(page.NextCursor) == 0 {
+				break
+			}
+			filter.Cursor = page.NextCursor
+		}
+
+		require.Equal(t, 3, numPages)
+		require.Equal(t, []string{"h1/0", "h1/2", "h2/0", "h2/2", "h3/0", "h3/2"}, ids)
+	})
+	t.Run("reindexing a hyperblock should replace its events", func(t *testing.T) {
+		err := connector.IndexHyperblock(&data.IndexedHyperblock{Nonce: 3, Hash: "hyperblock3"})
+		require.Nil(t, err)
+
+		page, err := connector.GetEvents(data.EventsFilter{FromBlock: core.OptionalUint64{Value: 3, HasValue: true}})
+		require.Nil(t, err)
+		require.Empty(t, page.Events)
+
+		var numTopics int
+		require.Nil(t, connector.db.QueryRow("SELECT COUNT(*) FROM event_topics WHERE block_nonce = 3").Scan(&numTopics))
+		require.Zero(t, numTopics)
+	})
+	t.Run("invalid cursor should error", func(t *testing.T) {
+		cursor, _ := encodeHistoryCursor([]interface{}{1, 0, "h1"})
+		page, err := connector.GetEvents(data.EventsFilter{Cursor: cursor})
+		require.Equal(t, data.ErrInvalidEventsCursor, err)
+		require.Nil(t, page)
+
+		page, err = connector.GetEvents(data.EventsFilter{Cursor: "not a cursor"})
+		require.Equal(t, data.ErrInvalidEventsCursor, err)
+		require.Nil(t, page)
+	})
+}
+
+func TestSQLiteConnector_GetTokenHolders(t *testing.T) {
+	t.Parallel()
+
+	connector := createTestSQLiteConnector(t)
+
+	err := connector.IndexHyperblock(&data.IndexedHyperblock{
+		Nonce: 1,
+		Hash:  "hyperblock1",
+		TokenBalances: []data.DatabaseTokenBalance{
+			{Token: "TKN-abcdef", Address: "alice", Balance: "100", BlockNonce: 1},
+			{Token: "TKN-abcdef", Address: "bob", Balance: "20", BlockNonce: 1},
+			{Token: "TKN-abcdef", Address: "carol", Balance: "1000", BlockNonce: 1},
+			{Token: "NFT-abcdef", Nonce: 1, Address: "alice", Balance: "1", BlockNonce: 1},
+			{Token: "NFT-abcdef", Nonce: 2, Address: "bob", Balance: "1", BlockNonce: 1},
+		},
+	})
+	require.Nil(t, err)
+
+	getHolders := func(page *data.TokenHoldersPage) []string {
+		holders := make([]string, 0, len(page.Holders))
+		for _, holder := range page.Holders {
+			holders = append(holders, fmt.Sprintf("%s/%d/%s", holder.Address, holder.Nonce, holder.Balance))
+		}
+		return holders
+	}
+
+	t.Run("holders should be sorted by balance, the highest first", func(t *testing.T) {
+		page, err := connector.GetTokenHolders(data.TokenHoldersFilter{Token: "TKN-abcdef"})
+		require.Nil(t, err)
+		require.Equal(t, []string{"carol/0/1000", "alice/0/100", "bob/0/20"}, getHolders(page))
+		require.Empty(t, page.NextCursor)
+		require.True(t, page.Incomplete)
+	})
+	t.Run("nonce should select a single token of the collection", func(t *testing.T) {
+		page, err := connector.GetTokenHolders(data.TokenHoldersFilter{Token: "NFT-abcdef"})
+		require.Nil(t, err)
+		require.Len(t, page.Holders, 2)
+
+		page, err = connector.GetTokenHolders(data.TokenHoldersFilter{Token: "NFT-abcdef", Nonce: core.OptionalUint64{Value: 2, HasValue: true}})
+		require.Nil(t, err)
+		require.Equal(t, []string{"bob/2/1"}, getHolders(page))
+	})
+	t.Run("pages should be chained by cursors", func(t *testing.T) {
+		filter := data.TokenHoldersFilter{Token: "TKN-abcdef", Limit: 2}
+		page, err := connector.GetTokenHolders(filter)
+		require.Nil(t, err)
+		require.Equal(t, []string{"carol/0/1000", "alice/0/100"}, getHolders(page))
+		require.NotEmpty(t, page.NextCursor)
+
+		filter.Cursor = page.NextCursor
+		page, err = connector.GetTokenHolders(filter)
+		require.Nil(t, err)
+		require.Equal(t, []string{"bob/0/20"}, getHolders(page))
+		require.Empty(t, page.NextCursor)
+	})
+	t.Run("newer balances should replace the older ones and zero balances should remove the holders", func(t *testing.T) {
+		err := connector.IndexHyperblock(&data.IndexedHyperblock{
+			Nonce: 2,
+			Hash:  "hyperblock2",
+			TokenBalances: []data.DatabaseTokenBalance{
+				{Token: "NFT-abcdef", Nonce: 1, Address: "alice", Balance: "0", BlockNonce: 2},
+				{Token: "NFT-abcdef", Nonce: 2, Address: "bob", Balance: "", BlockNonce: 2},
+				{Token: "NFT-abcdef", Nonce: 2, Address: "carol", Balance: "1", BlockNonce: 2},
+			},
+		})
+		require.Nil(t, err)
+
+		page, err := connector.GetTokenHolders(data.TokenHoldersFilter{Token: "NFT-abcdef"})
+		require.Nil(t, err)
+		require.Equal(t, []string{"carol/2/1"}, getHolders(page))
+		require.Equal(t, uint64(2), page.Holders[0].BlockNonce)
+
+		// an older balance, such as the one of a reindexed hyperblock, does not replace a newer one
+		err = connector.IndexHyperblock(&data.IndexedHyperblock{
+			Nonce: 1,
+			Hash:  "hyperblock1",
+			TokenBalances: []data.DatabaseTokenBalance{
+				{Token: "NFT-abcdef", Nonce: 2, Address: "carol", Balance: "5", BlockNonce: 1},
+			},
+		})
+		require.Nil(t, err)
+
+		page, err = connector.GetTokenHolders(data.TokenHoldersFilter{Token: "NFT-abcdef"})
+		require.Nil(t, err)
+		require.Equal(t, []string{"carol/2/1"}, getHolders(page))
+	})
+	t.Run("invalid balance should error", func(t *testing.T) {
+		err := connector.IndexHyperblock(&data.IndexedHyperblock{
+			Nonce: 3,
+			Hash:  "hyperblock3",
+			TokenBalances: []data.DatabaseTokenBalance{
+				{Token: "TKN-abcdef", Address: "dave", Balance: "not a number", BlockNonce: 3},
+			},
+		})
+		require.NotNil(t, err)
+	})
+	t.Run("holders indexed since the genesis should be complete", func(t *testing.T) {
+		connectorSinceGenesis := createTestSQLiteConnector(t)
+		for nonce := uint64(0); nonce < 2; nonce++ {
+			err := connectorSinceGenesis.IndexHyperblock(&data.IndexedHyperblock{
+				Nonce: nonce,
+				Hash:  fmt.Sprintf("hyperblock%d", nonce),
+				TokenBalances: []data.DatabaseTokenBalance{
+					{Token: "TKN-abcdef", Address: "alice", Balance: "100", BlockNonce: nonce},
+				},
+			})
+			require.Nil(t, err)
+		}
+
+		page, err := connectorSinceGenesis.GetTokenHolders(data.TokenHoldersFilter{Token: "TKN-abcdef"})
+		require.Nil(t, err)
+		require.Equal(t, []string{"alice/0/100"}, getHolders(page))
+		require.False(t, page.Incomplete)
+	})
+	t.Run("invalid cursor should error", func(t *testing.T) {
+		cursor, _ := encodeHistoryCursor([]interface{}{"100", 0, "alice"})
+		page, err := connector.GetTokenHolders(data.TokenHoldersFilter{Token: "TKN-abcdef", Cursor: cursor})
+		require.Equal(t, data.ErrInvalidTokenCursor, err)
+		require.Nil(t, page)
+
+		page, err = connector.GetTokenHolders(data.TokenHoldersFilter{Token: "TKN-abcdef", Cursor: "not a cursor"})
+		require.Equal(t, data.ErrInvalidTokenCursor, err)
+		require.Nil(t, page)
+	})
+}
+
+func TestSQLiteConnector_GetTokenTransfers(t *testing.T) {
+	t.Parallel()
+
+	connector := createTestSQLiteConnector(t)
+
+	for nonce := uint64(1); nonce <= 3; nonce++ {
+		err := connector.IndexHyperblock(&data.IndexedHyperblock{
+			Nonce: nonce,
+			Hash:  fmt.Sprintf("hyperblock%d", nonce),
+			TokenTransfers: []data.DatabaseTokenTransfer{
+				{TxHash: fmt.Sprintf("h%d", nonce), EventIndex: 0, Index: 0, Token: "TKN-abcdef", Sender: "alice", Receiver: "bob", Value: "10", BlockNonce: nonce},
+				{TxHash: fmt.Sprintf("h%d", nonce), EventIndex: 0, Index: 1, Token: "NFT-abcdef", Nonce: nonce, Sender: "alice", Receiver: "bob", Value: "1", BlockNonce: nonce},
+			},
+		})
+		require.Nil(t, err)
+	}
+
+	getIDs := func(page *data.TokenTransfersPage) []string {
+		ids := make([]string, 0, len(page.Transfers))
+		for _, transfer := range page.Transfers {
+			ids = append(ids, fmt.Sprintf("%s/%d/%d", transfer.TxHash, transfer.EventIndex, transfer.Index))
+		}
+		return ids
+	}
+
+	t.Run("token and block range", func(t *testing.T) {
+		page, err := connector.GetTokenTransfers(data.TokenTransfersFilter{Token: "TKN-abcdef"})
+		require.Nil(t, err)
+		require.Equal(t, []string{"h1/0/0", "h2/0/0", "h3/0/0"}, getIDs(page))
+		require.Equal(t, "alice", page.Transfers[0].Sender)
+		require.Equal(t, "10", page.Transfers[0].Value)
+
+		page, err = connector.GetTokenTransfers(data.TokenTransfersFilter{
+			Token:     "TKN-abcdef",
+			FromBlock: core.OptionalUint64{Value: 2, HasValue: true},
+			Order:     data.SortOrderDescending,
+		})
+		require.Nil(t, err)
+		require.Equal(t, []string{"h3/0/0", "h2/0/0"}, getIDs(page))
+
+		page, err = connector.GetTokenTransfers(data.TokenTransfersFilter{Token: "NFT-abcdef", Nonce: core.OptionalUint64{Value: 2, HasValue: true}})
+		require.Nil(t, err)
+		require.Equal(t, []string{"h2/0/1"}, getIDs(page))
+	})
+	t.Run("pages should be chained by cursors", func(t *testing.T) {
+		filter := data.TokenTransfersFilter{Token: "NFT-abcdef", Order: data.SortOrderDescending, Limit: 2}
+		ids := make([]string, 0)
+		numPages := 0
+		for {
+			page, err := connector.GetTokenTransfers(filter)
+			require.Nil(t, err)
+			ids = append(ids, getIDs(page)...)
+			numPages++
+			if len(page.NextCursor) == 0 {
+				break
+			}
+			filter.Cursor = page.NextCursor
+		}
+
+		require.Equal(t, 2, numPages)
+		require.Equal(t, []string{"h3/0/1", "h2/0/1", "h1/0/1"}, ids)
+	})
+	t.Run("invalid cursor should error", func(t *testing.T) {
+		cursor, _ := encodeHistoryCursor([]interface{}{1, "h1", 0})
+		page, err := connector.GetTokenTransfers(data.TokenTransfersFilter{Token: "TKN-abcdef", Cursor: cursor})
+		require.Equal(t, data.ErrInvalidTokenCursor, err)
+		require.Nil(t, page)
+	})
+}
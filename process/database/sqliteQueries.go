@@ -0,0 +1,276 @@
+package database
+
+import (
+	"strings"
+
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+// sqliteSchema holds the statements creating the tables of the SQLite history backend. The transactions are stored as
+// JSON payloads, the columns and the auxiliary tables only being used for selecting them
+var sqliteSchema = []string{
+	`CREATE TABLE IF NOT EXISTS blocks (
+		shard_id INTEGER NOT NULL,
+		nonce INTEGER NOT NULL,
+		hash TEXT NOT NULL,
+		PRIMARY KEY (shard_id, nonce)
+	)`,
+	`CREATE TABLE IF NOT EXISTS transactions (
+		hash TEXT NOT NULL PRIMARY KEY,
+		block_shard_id INTEGER NOT NULL,
+		block_nonce INTEGER NOT NULL,
+		status TEXT NOT NULL,
+		function TEXT NOT NULL,
+		payload TEXT NOT NULL
+	)`,
+	`CREATE INDEX IF NOT EXISTS transactions_by_block ON transactions (block_shard_id, block_nonce)`,
+	`CREATE TABLE IF NOT EXISTS address_transactions (
+		address TEXT NOT NULL,
+		tx_hash TEXT NOT NULL,
+		is_sender INTEGER NOT NULL,
+		is_receiver INTEGER NOT NULL,
+		timestamp INTEGER NOT NULL,
+		nonce INTEGER NOT NULL,
+		PRIMARY KEY (address, tx_hash)
+	)`,
+	`CREATE INDEX IF NOT EXISTS address_transactions_by_time ON address_transactions (address, timestamp, nonce, tx_hash)`,
+	`CREATE TABLE IF NOT EXISTS transaction_tokens (
+		tx_hash TEXT NOT NULL,
+		token TEXT NOT NULL,
+		PRIMARY KEY (tx_hash, token)
+	)`,
+	`CREATE TABLE IF NOT EXISTS events (
+		tx_hash TEXT NOT NULL,
+		event_index INTEGER NOT NULL,
+		block_nonce INTEGER NOT NULL,
+		address TEXT NOT NULL,
+		identifier TEXT NOT NULL,
+		timestamp INTEGER NOT NULL,
+		payload TEXT NOT NULL,
+		PRIMARY KEY (tx_hash, event_index)
+	)`,
+	`CREATE INDEX IF NOT EXISTS events_by_block ON events (block_nonce)`,
+	`CREATE INDEX IF NOT EXISTS events_by_identifier ON events (identifier, block_nonce)`,
+	`CREATE INDEX IF NOT EXISTS events_by_address ON events (address, block_nonce)`,
+	`CREATE TABLE IF NOT EXISTS event_topics (
+		tx_hash TEXT NOT NULL,
+		event_index INTEGER NOT NULL,
+		position INTEGER NOT NULL,
+		block_nonce INTEGER NOT NULL,
+		topic BLOB NOT NULL,
+		PRIMARY KEY (tx_hash, event_index, position)
+	)`,
+	`CREATE INDEX IF NOT EXISTS event_topics_by_block ON event_topics (block_nonce)`,
+	`CREATE INDEX IF NOT EXISTS event_topics_by_value ON event_topics (position, topic)`,
+	`CREATE TABLE IF NOT EXISTS token_transfers (
+		tx_hash TEXT NOT NULL,
+		event_index INTEGER NOT NULL,
+		transfer_index INTEGER NOT NULL,
+		block_nonce INTEGER NOT NULL,
+		token TEXT NOT NULL,
+		token_nonce INTEGER NOT NULL,
+		sender TEXT NOT NULL,
+		receiver TEXT NOT NULL,
+		value TEXT NOT NULL,
+		timestamp INTEGER NOT NULL,
+		PRIMARY KEY (tx_hash, event_index, transfer_index)
+	)`,
+	`CREATE INDEX IF NOT EXISTS token_transfers_by_block ON token_transfers (block_nonce)`,
+	`CREATE INDEX IF NOT EXISTS token_transfers_by_token ON token_transfers (token, token_nonce, block_nonce)`,
+	`CREATE TABLE IF NOT EXISTS token_balances (
+		token TEXT NOT NULL,
+		token_nonce INTEGER NOT NULL,
+		address TEXT NOT NULL,
+		balance TEXT NOT NULL,
+		sortable_balance TEXT NOT NULL,
+		block_nonce INTEGER NOT NULL,
+		PRIMARY KEY (token, token_nonce, address)
+	)`,
+	`CREATE INDEX IF NOT EXISTS token_balances_by_balance ON token_balances (token, sortable_balance, token_nonce, address)`,
+	`CREATE TABLE IF NOT EXISTS checkpoints (
+		name TEXT NOT NULL PRIMARY KEY,
+		nonce INTEGER NOT NULL
+	)`,
+}
+
+// txsByAddressSQLQuery returns the statement, along with its arguments, selecting at most limit transactions of an
+// address matching the filter, sorted by timestamp, nonce and hash, starting after the given sort values, if any
+func txsByAddressSQLQuery(address string, filter data.TransactionsHistoryFilter, after []interface{}, limit int) (string, []interface{}) {
+	conditions := []string{"a.address = ?"}
+	args := []interface{}{address}
+
+	switch filter.Direction {
+	case data.TransactionsDirectionOut:
+		conditions = append(conditions, "a.is_sender = 1")
+	case data.TransactionsDirectionIn:
+		conditions = append(conditions, "a.is_receiver = 1")
+	}
+	if len(filter.Status) > 0 {
+		conditions = append(conditions, "t.status = ?")
+		args = append(args, filter.Status)
+	}
+	if len(filter.Function) > 0 {
+		conditions = append(conditions, "t.function = ?")
+		args = append(args, filter.Function)
+	}
+	if len(filter.Token) > 0 {
+		conditions = append(conditions, "EXISTS (SELECT 1 FROM transaction_tokens k WHERE k.tx_hash = a.tx_hash AND k.token = ?)")
+		args = append(args, filter.Token)
+	}
+	if filter.From > 0 {
+		conditions = append(conditions, "a.timestamp >= ?")
+		args = append(args, filter.From)
+	}
+	if filter.To > 0 {
+		conditions = append(conditions, "a.timestamp <= ?")
+		args = append(args, filter.To)
+	}
+	if filter.FromNonce.HasValue {
+		conditions = append(conditions, "a.nonce >= ?")
+		args = append(args, filter.FromNonce.Value)
+	}
+	if filter.ToNonce.HasValue {
+		conditions = append(conditions, "a.nonce <= ?")
+		args = append(args, filter.ToNonce.Value)
+	}
+
+	order := "DESC"
+	comparison := "<"
+	if filter.Order == data.SortOrderAscending {
+		order = "ASC"
+		comparison = ">"
+	}
+	if len(after) > 0 {
+		conditions = append(conditions, "(a.timestamp, a.nonce, a.tx_hash) "+comparison+" (?, ?, ?)")
+		args = append(args, after...)
+	}
+	args = append(args, limit)
+
+	query := "SELECT t.payload, a.timestamp, a.nonce, a.tx_hash FROM address_transactions a " +
+		"JOIN transactions t ON t.hash = a.tx_hash " +
+		"WHERE " + strings.Join(conditions, " AND ") + " " +
+		"ORDER BY a.timestamp " + order + ", a.nonce " + order + ", a.tx_hash " + order + " " +
+		"LIMIT ?"
+
+	return query, args
+}
+
+// eventsSQLQuery returns the statement, along with its arguments, selecting at most limit events matching the filter,
+// sorted by block nonce, transaction hash and index, starting after the given sort values, if any
+func eventsSQLQuery(filter data.EventsFilter, after []interface{}, limit int) (string, []interface{}) {
+	conditions := make([]string, 0)
+	args := make([]interface{}, 0)
+
+	if len(filter.Address) > 0 {
+		conditions = append(conditions, "e.address = ?")
+		args = append(args, filter.Address)
+	}
+	if len(filter.Identifier) > 0 {
+		conditions = append(conditions, "e.identifier = ?")
+		args = append(args, filter.Identifier)
+	}
+	for position, topic := range filter.Topics {
+		if topic == nil {
+			continue
+		}
+
+		conditions = append(conditions, "EXISTS (SELECT 1 FROM event_topics p WHERE p.tx_hash = e.tx_hash AND "+
+			"p.event_index = e.event_index AND p.position = ? AND p.topic = ?)")
+		args = append(args, position, topic)
+	}
+	if filter.FromBlock.HasValue {
+		conditions = append(conditions, "e.block_nonce >= ?")
+		args = append(args, filter.FromBlock.Value)
+	}
+	if filter.ToBlock.HasValue {
+		conditions = append(conditions, "e.block_nonce <= ?")
+		args = append(args, filter.ToBlock.Value)
+	}
+
+	order := "ASC"
+	comparison := ">"
+	if filter.Order == data.SortOrderDescending {
+		order = "DESC"
+		comparison = "<"
+	}
+	if len(after) > 0 {
+		conditions = append(conditions, "(e.block_nonce, e.tx_hash, e.event_index) "+comparison+" (?, ?, ?)")
+		args = append(args, after...)
+	}
+	args = append(args, limit)
+
+	where := ""
+	if len(conditions) > 0 {
+		where = "WHERE " + strings.Join(conditions, " AND ") + " "
+	}
+	query := "SELECT e.payload, e.block_nonce, e.tx_hash, e.event_index FROM events e " + where +
+		"ORDER BY e.block_nonce " + order + ", e.tx_hash " + order + ", e.event_index " + order + " " +
+		"LIMIT ?"
+
+	return query, args
+}
+
+// tokenHoldersSQLQuery returns the statement, along with its arguments, selecting at most limit holders matching the
+// filter, sorted by balance, token nonce and address, the highest balance first, starting after the given sort values,
+// if any
+func tokenHoldersSQLQuery(filter data.TokenHoldersFilter, after []interface{}, limit int) (string, []interface{}) {
+	conditions := []string{"token = ?"}
+	args := []interface{}{filter.Token}
+
+	if filter.Nonce.HasValue {
+		conditions = append(conditions, "token_nonce = ?")
+		args = append(args, filter.Nonce.Value)
+	}
+	if len(after) > 0 {
+		conditions = append(conditions, "(sortable_balance, token_nonce, address) < (?, ?, ?)")
+		args = append(args, after...)
+	}
+	args = append(args, limit)
+
+	query := "SELECT address, token_nonce, balance, block_nonce, sortable_balance FROM token_balances " +
+		"WHERE " + strings.Join(conditions, " AND ") + " " +
+		"ORDER BY sortable_balance DESC, token_nonce DESC, address DESC " +
+		"LIMIT ?"
+
+	return query, args
+}
+
+// tokenTransfersSQLQuery returns the statement, along with its arguments, selecting at most limit token transfers
+// matching the filter, sorted by block nonce, transaction hash, event index and transfer index, starting after the given
+// sort values, if any
+func tokenTransfersSQLQuery(filter data.TokenTransfersFilter, after []interface{}, limit int) (string, []interface{}) {
+	conditions := []string{"token = ?"}
+	args := []interface{}{filter.Token}
+
+	if filter.Nonce.HasValue {
+		conditions = append(conditions, "token_nonce = ?")
+		args = append(args, filter.Nonce.Value)
+	}
+	if filter.FromBlock.HasValue {
+		conditions = append(conditions, "block_nonce >= ?")
+		args = append(args, filter.FromBlock.Value)
+	}
+	if filter.ToBlock.HasValue {
+		conditions = append(conditions, "block_nonce <= ?")
+		args = append(args, filter.ToBlock.Value)
+	}
+
+	order := "ASC"
+	comparison := ">"
+	if filter.Order == data.SortOrderDescending {
+		order = "DESC"
+		comparison = "<"
+	}
+	if len(after) > 0 {
+		conditions = append(conditions, "(block_nonce, tx_hash, event_index, transfer_index) "+comparison+" (?, ?, ?, ?)")
+		args = append(args, after...)
+	}
+	args = append(args, limit)
+
+	query := "SELECT tx_hash, event_index, transfer_index, block_nonce, token, token_nonce, sender, receiver, value, timestamp " +
+		"FROM token_transfers WHERE " + strings.Join(conditions, " AND ") + " " +
+		"ORDER BY block_nonce " + order + ", tx_hash " + order + ", event_index " + order + ", transfer_index " + order + " " +
+		"LIMIT ?"
+
+	return query, args
+}
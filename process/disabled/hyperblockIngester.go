@@ -0,0 +1,28 @@
+package disabled
+
+import (
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+// HyperblockIngester represents a disabled struct that implements the HyperblockIngesterHandler interface
+type HyperblockIngester struct {
+}
+
+// StartIngesting won't do anything as this is a disabled component
+func (hi *HyperblockIngester) StartIngesting() {
+}
+
+// GetMetrics returns empty metrics as this is a disabled component
+func (hi *HyperblockIngester) GetMetrics() data.HyperblockIngesterMetrics {
+	return data.HyperblockIngesterMetrics{}
+}
+
+// Close returns nil as this is a disabled component
+func (hi *HyperblockIngester) Close() error {
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (hi *HyperblockIngester) IsInterfaceNil() bool {
+	return hi == nil
+}
@@ -0,0 +1,15 @@
+package disabled
+
+// ShardBlocksCache represents a disabled struct that implements the ShardBlocksCache interface
+type ShardBlocksCache struct {
+}
+
+// GetOrFetch always calls the fetch handler as this is a disabled component
+func (sbc *ShardBlocksCache) GetOrFetch(_ string, fetch func() (interface{}, error)) (interface{}, error) {
+	return fetch()
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (sbc *ShardBlocksCache) IsInterfaceNil() bool {
+	return sbc == nil
+}
@@ -15,7 +15,33 @@ const thresholdCountConsecutiveFails = 10
 
 // GetEconomicsDataMetrics will return the economic metrics from cache
 func (nsp *NodeStatusProcessor) GetEconomicsDataMetrics() (*data.GenericAPIResponse, error) {
-	return nsp.economicMetricsCacher.Load()
+	economicMetrics, err := nsp.economicMetricsCacher.Load()
+	if err != nil {
+		return nil, err
+	}
+
+	return addCacheAgeToResponse(economicMetrics, nsp.economicMetricsCacher.GetCacheAge()), nil
+}
+
+// addCacheAgeToResponse returns a copy of the cached response, having the cache age set in its data field
+func addCacheAgeToResponse(response *data.GenericAPIResponse, cacheAge time.Duration) *data.GenericAPIResponse {
+	cacheAgeSec := uint64(cacheAge.Seconds())
+	responseData, ok := response.Data.(map[string]interface{})
+	if !ok || cacheAgeSec == 0 {
+		return response
+	}
+
+	dataWithCacheAge := make(map[string]interface{}, len(responseData)+1)
+	for key, value := range responseData {
+		dataWithCacheAge[key] = value
+	}
+	dataWithCacheAge["cacheAgeSec"] = cacheAgeSec
+
+	return &data.GenericAPIResponse{
+		Data:  dataWithCacheAge,
+		Error: response.Error,
+		Code:  response.Code,
+	}
 }
 
 func (nsp *NodeStatusProcessor) getEconomicsDataMetricsFromApi() (*data.GenericAPIResponse, error) {
@@ -32,6 +32,32 @@ func TestNodeStatusProcessor_GetEconomicsDataMetricsShouldReturnDataFromCacher(t
 	assert.Equal(t, res, respInCache)
 }
 
+func TestNodeStatusProcessor_GetEconomicsDataMetricsShouldReturnCacheAge(t *testing.T) {
+	t.Parallel()
+
+	respInCache := &data.GenericAPIResponse{
+		Data: map[string]interface{}{
+			"metrics": "test data",
+		},
+		Code: data.ReturnCodeSuccess,
+	}
+
+	cacher := &mock.GenericApiResponseCacherMock{
+		Data:     respInCache,
+		CacheAge: 30 * time.Second,
+	}
+	hp, err := process.NewNodeStatusProcessor(&mock.ProcessorStub{}, cacher, time.Millisecond)
+	assert.Nil(t, err)
+
+	res, err := hp.GetEconomicsDataMetrics()
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]interface{}{"metrics": "test data", "cacheAgeSec": uint64(30)}, res.Data)
+	assert.Equal(t, data.ReturnCodeSuccess, res.Code)
+
+	// the cached response should not be altered
+	assert.Equal(t, map[string]interface{}{"metrics": "test data"}, respInCache.Data)
+}
+
 func TestNodeStatusProcessor_CacheShouldUpdate(t *testing.T) {
 	t.Parallel()
 
@@ -1,6 +1,11 @@
 package process
 
-import "errors"
+import (
+	"errors"
+	"fmt"
+
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
 
 // ErrMissingObserver signals that no observers have been provided for provided shard ID
 var ErrMissingObserver = errors.New("missing observer")
@@ -109,3 +114,144 @@ var ErrEmptyAppVersionString = errors.New("empty app version string")
 
 // ErrEmptyCommitString signals than an empty commit id string has been provided
 var ErrEmptyCommitString = errors.New("empty commit id string")
+
+// ErrInvalidMaxParallelRequests signals that an invalid maximum number of parallel requests has been provided
+var ErrInvalidMaxParallelRequests = errors.New("invalid maximum number of parallel requests")
+
+// ErrInvalidPerShardTimeout signals that an invalid per shard timeout has been provided
+var ErrInvalidPerShardTimeout = errors.New("invalid per shard timeout")
+
+// ErrInvalidPartialResultsPolicy signals that an invalid partial results policy has been provided
+var ErrInvalidPartialResultsPolicy = errors.New("invalid partial results policy")
+
+// ErrInvalidMaxQueriesPerBatch signals that an invalid maximum number of queries per batch has been provided
+var ErrInvalidMaxQueriesPerBatch = errors.New("invalid maximum number of queries per batch")
+
+// ErrTooManyQueries signals that the batch holds more queries than allowed
+var ErrTooManyQueries = errors.New("too many queries")
+
+// ErrShardQueryTimeout signals that a shard did not respond in the allotted time
+var ErrShardQueryTimeout = errors.New("shard query timeout")
+
+// ErrNilShardsFanOut signals that a nil shards fan-out component has been provided
+var ErrNilShardsFanOut = errors.New("nil shards fan-out component")
+
+// ErrNilABIRegistry signals that a nil ABI registry has been provided
+var ErrNilABIRegistry = errors.New("nil ABI registry")
+
+// ErrNilABICodec signals that a nil ABI codec has been provided
+var ErrNilABICodec = errors.New("nil ABI codec")
+
+// ErrMissingABI signals that neither an inline ABI, nor the name of a loaded ABI has been provided
+var ErrMissingABI = errors.New("missing ABI: either the inline ABI or the ABI name should be provided")
+
+// ErrInlineABITooLarge signals that the ABI provided in the request is too large
+var ErrInlineABITooLarge = errors.New("inline ABI too large")
+
+// ErrInvalidMaxCacheEntries signals that an invalid maximum number of cache entries has been provided
+var ErrInvalidMaxCacheEntries = errors.New("invalid maximum number of cache entries")
+
+// ErrInvalidNonceRefreshInterval signals that an invalid block nonce refresh interval has been provided
+var ErrInvalidNonceRefreshInterval = errors.New("invalid block nonce refresh interval")
+
+// ErrNilFaucetGuard signals that a nil faucet guard has been provided
+var ErrNilFaucetGuard = errors.New("nil faucet guard")
+
+// ErrInvalidFaucetSenderWaitTimeout signals that an invalid wait timeout for the faucet senders has been provided
+var ErrInvalidFaucetSenderWaitTimeout = errors.New("invalid faucet sender wait timeout")
+
+// ErrFaucetSendersBusy signals that all the faucet senders of the receiver's shard remained busy for too long
+var ErrFaucetSendersBusy = fmt.Errorf("%w: all the faucet senders are busy", data.ErrFaucetRateLimited)
+
+// ErrInvalidFaucetToken signals that an invalid faucet token has been provided
+var ErrInvalidFaucetToken = errors.New("invalid faucet token")
+
+// ErrDuplicatedFaucetToken signals that the same faucet token has been provided more than once
+var ErrDuplicatedFaucetToken = errors.New("duplicated faucet token")
+
+// ErrUnknownFaucetToken signals that the requested token is not dispensed by the faucet
+var ErrUnknownFaucetToken = fmt.Errorf("%w: unknown token", data.ErrFaucetRequestRejected)
+
+// ErrFaucetValueWithTokens signals that both a value and tokens were requested from the faucet
+var ErrFaucetValueWithTokens = fmt.Errorf("%w: value and tokens cannot be requested at the same time", data.ErrFaucetRequestRejected)
+
+// ErrFaucetZeroValue signals that a zero value was requested from the faucet
+var ErrFaucetZeroValue = fmt.Errorf("%w: zero value requested", data.ErrFaucetRequestRejected)
+
+// ErrNilNetworkConfigsHandler signals that a nil network configs handler has been provided
+var ErrNilNetworkConfigsHandler = errors.New("nil network configs handler")
+
+// ErrNilGasConfigsHandler signals that a nil gas configs handler has been provided
+var ErrNilGasConfigsHandler = errors.New("nil gas configs handler")
+
+// ErrMissingBuiltInFunctionCost signals that the gas configs do not hold the cost of a built-in function
+var ErrMissingBuiltInFunctionCost = errors.New("missing built-in function cost")
+
+// ErrInvalidGasPriceModifier signals that the network config holds an invalid gas price modifier
+var ErrInvalidGasPriceModifier = errors.New("invalid gas price modifier")
+
+// ErrUnknownHistoryBackend signals that the configured history backend type is not registered
+var ErrUnknownHistoryBackend = errors.New("unknown history backend")
+
+// ErrHistoryBackendAlreadyRegistered signals that a history backend with the same type has already been registered
+var ErrHistoryBackendAlreadyRegistered = errors.New("history backend already registered")
+
+// ErrNilHistoryBackendCreator signals that a nil history backend creator has been provided
+var ErrNilHistoryBackendCreator = errors.New("nil history backend creator")
+
+// ErrNilHyperblockProvider signals that a nil hyperblock provider has been provided
+var ErrNilHyperblockProvider = errors.New("nil hyperblock provider")
+
+// ErrNilLatestHyperblockNonceProvider signals that a nil latest hyperblock nonce provider has been provided
+var ErrNilLatestHyperblockNonceProvider = errors.New("nil latest hyperblock nonce provider")
+
+// ErrNilHistoryIndexer signals that a nil history indexer has been provided
+var ErrNilHistoryIndexer = errors.New("nil history indexer")
+
+// ErrIncompleteHyperblock signals that the blocks of some shards are missing from a hyperblock
+var ErrIncompleteHyperblock = errors.New("incomplete hyperblock")
+
+// ErrInvalidPollingInterval signals that an invalid polling interval has been provided
+var ErrInvalidPollingInterval = errors.New("invalid polling interval")
+
+// ErrHistoryBackendCannotBeIndexed signals that the configured history backend cannot be fed by the hyperblock ingester
+var ErrHistoryBackendCannotBeIndexed = errors.New("the history backend cannot be fed by the hyperblock ingester")
+
+// ErrNilHyperblockIngesterMetricsProvider signals that a nil hyperblock ingester metrics provider has been provided
+var ErrNilHyperblockIngesterMetricsProvider = errors.New("nil hyperblock ingester metrics provider")
+
+// ErrNilBlockByNonceProvider signals that a nil block by nonce provider has been provided
+var ErrNilBlockByNonceProvider = errors.New("nil block by nonce provider")
+
+// ErrInvalidMaxRangeSize signals that an invalid maximum range size has been provided
+var ErrInvalidMaxRangeSize = errors.New("invalid maximum range size")
+
+// ErrInvalidMaxTrackedHyperblocks signals that an invalid maximum number of tracked hyperblocks has been provided
+var ErrInvalidMaxTrackedHyperblocks = errors.New("invalid maximum number of tracked hyperblocks")
+
+// ErrInvalidMaxReorgs signals that an invalid maximum number of remembered reorgs has been provided
+var ErrInvalidMaxReorgs = errors.New("invalid maximum number of remembered reorgs")
+
+// ErrIncompleteAtlasBlock signals that some of the shard blocks notarized by a metablock could not be fetched
+var ErrIncompleteAtlasBlock = errors.New("incomplete atlas block")
+
+// ErrNilShardBlocksCache signals that a nil shard blocks cache has been provided
+var ErrNilShardBlocksCache = errors.New("nil shard blocks cache")
+
+// ErrInvalidCacheTTL signals that an invalid cache time to live has been provided
+var ErrInvalidCacheTTL = errors.New("invalid cache time to live")
+
+// ErrInvalidShardBlock signals that an invalid shard block has been provided by the shard blocks cache
+var ErrInvalidShardBlock = errors.New("invalid shard block")
+
+// ErrInvalidTokenProperties signals that the properties of a token, as returned by the ESDT system smart contract, are not valid
+var ErrInvalidTokenProperties = errors.New("invalid token properties")
+
+// ErrNoBlockFetched signals that none of the observers of a shard returned the requested block
+var ErrNoBlockFetched = errors.New("no block could be fetched")
+
+// ErrCoalescedCallPanicked signals that the handler of a coalesced call panicked
+var ErrCoalescedCallPanicked = errors.New("the coalesced call panicked")
+
+// ErrInvalidCoalescedResult signals that a coalesced call returned a result of an unexpected type
+var ErrInvalidCoalescedResult = errors.New("invalid coalesced call result")
@@ -61,6 +61,7 @@ func (esp *esdtSupplyProcessor) GetESDTSupply(tokenIdentifier string) (*data.ESD
 	res.Data.Supply = sumStr(totalSupply.Supply, initialSupply.String())
 	res.Data.Burned = totalSupply.Burned
 	res.Data.Minted = totalSupply.Minted
+	res.Data.MissingShards = totalSupply.MissingShards
 
 	makeInitialMintedNotEmpty(res)
 	return res, nil
@@ -73,20 +74,32 @@ func makeInitialMintedNotEmpty(resp *data.ESDTSupplyResponse) {
 }
 
 func (esp *esdtSupplyProcessor) getSupplyFromShards(tokenIdentifier string) (*data.ESDTSupply, error) {
-	totalSupply := &data.ESDTSupply{}
-	shardIDs := esp.baseProc.GetShardIDs()
-	for _, shardID := range shardIDs {
+	shardIDs := make([]uint32, 0)
+	for _, shardID := range esp.baseProc.GetShardIDs() {
 		if shardID == core.MetachainShardId {
 			continue
 		}
 
-		supply, err := esp.getShardSupply(tokenIdentifier, shardID)
-		if err != nil {
-			return nil, err
+		shardIDs = append(shardIDs, shardID)
+	}
+
+	shardsResponse, err := esp.baseProc.QueryShards(shardIDs, func(_ int, shardID uint32) (interface{}, error) {
+		return esp.getShardSupply(tokenIdentifier, shardID)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	totalSupply := &data.ESDTSupply{}
+	for _, response := range shardsResponse.Responses {
+		supply, ok := response.(*data.ESDTSupply)
+		if !ok {
+			continue
 		}
 
 		addToSupply(totalSupply, supply)
 	}
+	totalSupply.MissingShards = shardsResponse.MissingShards
 
 	return totalSupply, nil
 }
@@ -119,7 +132,7 @@ func (esp *esdtSupplyProcessor) getInitialSupplyFromMeta(token string) (*big.Int
 		Arguments: [][]byte{[]byte(token)},
 	}
 
-	res, err := esp.scQueryProc.ExecuteQuery(scQuery)
+	res, _, err := esp.scQueryProc.ExecuteQuery(scQuery)
 	if err != nil {
 		return nil, err
 	}
@@ -56,10 +56,10 @@ func TestEsdtSupplyProcessor_GetESDTSupplyFungible(t *testing.T) {
 		},
 	}
 	scQueryProc := &mock.SCQueryServiceStub{
-		ExecuteQueryCalled: func(query *data.SCQuery) (*vm.VMOutputApi, error) {
+		ExecuteQueryCalled: func(query *data.SCQuery) (*vm.VMOutputApi, data.BlockInfo, error) {
 			return &vm.VMOutputApi{
 				ReturnData: [][]byte{nil, nil, nil, []byte("500")},
-			}, nil
+			}, data.BlockInfo{}, nil
 		},
 	}
 	esdtProc, err := NewESDTSupplyProcessor(baseProc, scQueryProc)
@@ -119,3 +119,45 @@ func TestEsdtSupplyProcessor_GetESDTSupplyNonFungible(t *testing.T) {
 	require.Equal(t, "2000", supplyRes.Data.Supply)
 	require.Equal(t, "0", supplyRes.Data.InitialMinted)
 }
+
+func TestEsdtSupplyProcessor_GetESDTSupplyWithMissingShards(t *testing.T) {
+	t.Parallel()
+
+	baseProc := &mock.ProcessorStub{
+		GetShardIDsCalled: func() []uint32 {
+			return []uint32{0, 1, core.MetachainShardId}
+		},
+		QueryShardsCalled: func(shardIDs []uint32, queryHandler data.ShardQueryHandler) (*data.ShardsQueryResponse, error) {
+			require.Equal(t, []uint32{0, 1}, shardIDs)
+
+			response, err := queryHandler(0, shardIDs[0])
+			require.Nil(t, err)
+
+			return &data.ShardsQueryResponse{
+				Responses:     []interface{}{response, nil},
+				MissingShards: []uint32{1},
+			}, nil
+		},
+		GetObserversCalled: func(shardID uint32) ([]*data.NodeData, error) {
+			return []*data.NodeData{
+				{
+					ShardId: shardID,
+					Address: fmt.Sprintf("shard-%d", shardID),
+				},
+			}, nil
+		},
+		CallGetRestEndPointCalled: func(address string, path string, value interface{}) (int, error) {
+			valResp := value.(*data.ESDTSupplyResponse)
+			valResp.Data.Supply = "1000"
+			return 200, nil
+		},
+	}
+	scQueryProc := &mock.SCQueryServiceStub{}
+	esdtProc, err := NewESDTSupplyProcessor(baseProc, scQueryProc)
+	require.Nil(t, err)
+
+	supplyRes, err := esdtProc.GetESDTSupply("SEMI-ABCD-0A")
+	require.Nil(t, err)
+	require.Equal(t, "1000", supplyRes.Data.Supply)
+	require.Equal(t, []uint32{1}, supplyRes.Data.MissingShards)
+}
@@ -0,0 +1,96 @@
+package process
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+const (
+	// topicEncodingHex is the prefix of the hex encoded topics, the encoding of the topics without prefix
+	topicEncodingHex = "hex:"
+	// topicEncodingBech32 is the prefix of the topics holding an address
+	topicEncodingBech32 = "bech32:"
+	// topicEncodingString is the prefix of the topics holding a plain string
+	topicEncodingString = "str:"
+)
+
+// EventsProcessor handles the search of the events stored by the history backend
+type EventsProcessor struct {
+	dbReader        ExternalStorageConnector
+	pubKeyConverter core.PubkeyConverter
+}
+
+// NewEventsProcessor creates a new events processor
+func NewEventsProcessor(dbReader ExternalStorageConnector, pubKeyConverter core.PubkeyConverter) (*EventsProcessor, error) {
+	if check.IfNil(dbReader) {
+		return nil, ErrNilDatabaseConnector
+	}
+	if check.IfNil(pubKeyConverter) {
+		return nil, ErrNilPubKeyConverter
+	}
+
+	return &EventsProcessor{
+		dbReader:        dbReader,
+		pubKeyConverter: pubKeyConverter,
+	}, nil
+}
+
+// GetEvents decodes the topics of the query and returns a page of the events matching it
+func (ep *EventsProcessor) GetEvents(query data.EventsQuery) (*data.EventsPage, error) {
+	filter := query.Filter
+	if len(filter.Address) > 0 {
+		_, err := ep.pubKeyConverter.Decode(filter.Address)
+		if err != nil {
+			return nil, fmt.Errorf("%w: invalid address %s: %s", data.ErrInvalidEventsFilter, filter.Address, err.Error())
+		}
+	}
+	if filter.FromBlock.HasValue && filter.ToBlock.HasValue && filter.FromBlock.Value > filter.ToBlock.Value {
+		return nil, fmt.Errorf("%w: the block range start is greater than its end", data.ErrInvalidEventsFilter)
+	}
+
+	filter.Topics = make([][]byte, 0, len(query.Topics))
+	for position, encodedTopic := range query.Topics {
+		topic, err := ep.decodeTopic(encodedTopic)
+		if err != nil {
+			return nil, fmt.Errorf("%w: invalid topic at position %d: %s", data.ErrInvalidEventsFilter, position, err.Error())
+		}
+
+		filter.Topics = append(filter.Topics, topic)
+	}
+
+	return ep.dbReader.GetEvents(filter)
+}
+
+// decodeTopic returns nil for an empty topic, which matches any value
+func (ep *EventsProcessor) decodeTopic(encodedTopic string) ([]byte, error) {
+	if len(encodedTopic) == 0 {
+		return nil, nil
+	}
+
+	var topic []byte
+	var err error
+	switch {
+	case strings.HasPrefix(encodedTopic, topicEncodingBech32):
+		topic, err = ep.pubKeyConverter.Decode(strings.TrimPrefix(encodedTopic, topicEncodingBech32))
+	case strings.HasPrefix(encodedTopic, topicEncodingString):
+		topic = []byte(strings.TrimPrefix(encodedTopic, topicEncodingString))
+	default:
+		topic, err = hex.DecodeString(strings.TrimPrefix(encodedTopic, topicEncodingHex))
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// an empty value, such as a zero nonce, is matched explicitly, unlike the empty topic
+	return append(make([]byte, 0, len(topic)), topic...), nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ep *EventsProcessor) IsInterfaceNil() bool {
+	return ep == nil
+}
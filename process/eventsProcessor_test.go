@@ -0,0 +1,93 @@
+package process_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/pubkeyConverter"
+	logger "github.com/multiversx/mx-chain-logger-go"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/multiversx/mx-chain-proxy-go/process"
+	"github.com/multiversx/mx-chain-proxy-go/process/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewEventsProcessor(t *testing.T) {
+	t.Parallel()
+
+	converter, _ := pubkeyConverter.NewBech32PubkeyConverter(32, logger.GetOrCreate("test"))
+
+	ep, err := process.NewEventsProcessor(nil, converter)
+	require.Equal(t, process.ErrNilDatabaseConnector, err)
+	require.True(t, ep.IsInterfaceNil())
+
+	ep, err = process.NewEventsProcessor(&mock.ExternalStorageConnectorStub{}, nil)
+	require.Equal(t, process.ErrNilPubKeyConverter, err)
+	require.True(t, ep.IsInterfaceNil())
+
+	ep, err = process.NewEventsProcessor(&mock.ExternalStorageConnectorStub{}, converter)
+	require.Nil(t, err)
+	require.False(t, ep.IsInterfaceNil())
+}
+
+func TestEventsProcessor_GetEvents(t *testing.T) {
+	t.Parallel()
+
+	converter, _ := pubkeyConverter.NewBech32PubkeyConverter(32, logger.GetOrCreate("test"))
+	address := "erd1qqqqqqqqqqqqqpgqp699jngundfqw07d8jzkepucvpzush6k3wvqyc44rx"
+	addressBytes, _ := converter.Decode(address)
+
+	t.Run("topics should be decoded by position", func(t *testing.T) {
+		t.Parallel()
+
+		var providedFilter data.EventsFilter
+		ep, _ := process.NewEventsProcessor(&mock.ExternalStorageConnectorStub{
+			GetEventsCalled: func(filter data.EventsFilter) (*data.EventsPage, error) {
+				providedFilter = filter
+				return &data.EventsPage{NextCursor: "next"}, nil
+			},
+		}, converter)
+
+		query := data.EventsQuery{
+			Topics: []string{"", "0a0b", "hex:", "bech32:" + address, "str:ESDT-123456"},
+			Filter: data.EventsFilter{
+				Address:    address,
+				Identifier: "ESDTTransfer",
+				FromBlock:  core.OptionalUint64{Value: 1, HasValue: true},
+				ToBlock:    core.OptionalUint64{Value: 2, HasValue: true},
+				Limit:      5,
+				Cursor:     "cursor",
+			},
+		}
+		page, err := ep.GetEvents(query)
+		require.Nil(t, err)
+		require.Equal(t, "next", page.NextCursor)
+
+		expectedFilter := query.Filter
+		expectedFilter.Topics = [][]byte{nil, {10, 11}, {}, addressBytes, []byte("ESDT-123456")}
+		require.Equal(t, expectedFilter, providedFilter)
+	})
+	t.Run("invalid filters should error", func(t *testing.T) {
+		t.Parallel()
+
+		ep, _ := process.NewEventsProcessor(&mock.ExternalStorageConnectorStub{
+			GetEventsCalled: func(filter data.EventsFilter) (*data.EventsPage, error) {
+				require.Fail(t, "should have not been called")
+				return nil, nil
+			},
+		}, converter)
+
+		queries := []data.EventsQuery{
+			{Filter: data.EventsFilter{Address: "not an address"}},
+			{Filter: data.EventsFilter{FromBlock: core.OptionalUint64{Value: 3, HasValue: true}, ToBlock: core.OptionalUint64{Value: 2, HasValue: true}}},
+			{Topics: []string{"not hex"}},
+			{Topics: []string{"", "bech32:erd1invalid"}},
+		}
+		for _, query := range queries {
+			page, err := ep.GetEvents(query)
+			require.True(t, errors.Is(err, data.ErrInvalidEventsFilter))
+			require.Nil(t, page)
+		}
+	})
+}
@@ -1,6 +1,8 @@
 package process
 
 import (
+	"context"
+	"math/big"
 	"time"
 
 	"github.com/multiversx/mx-chain-core-go/data/transaction"
@@ -31,3 +33,13 @@ func GetShortHashSize() int {
 func (tp *TransactionProcessor) ComputeTransactionStatus(tx *transaction.ApiTransactionResult, withResults bool) transaction.TxStatus {
 	return tp.computeTransactionStatus(tx, withResults)
 }
+
+// FormatAmountWithDecimals -
+func FormatAmountWithDecimals(amount *big.Int, decimals int) string {
+	return formatAmountWithDecimals(amount, decimals)
+}
+
+// IngestAvailableHyperblocks -
+func (hi *HyperblockIngester) IngestAvailableHyperblocks() {
+	hi.ingestAvailableHyperblocks(context.Background())
+}
@@ -18,9 +18,32 @@ func (d *disabledFaucetProcessor) IsEnabled() bool {
 	return false
 }
 
-// SenderDetailsFromPem will return an error that signals that faucet is not enabled
-func (d *disabledFaucetProcessor) SenderDetailsFromPem(_ string) (crypto.PrivateKey, string, error) {
-	return nil, "", errNotEnabled
+// ReserveFunds will return an error that signals that faucet is not enabled
+func (d *disabledFaucetProcessor) ReserveFunds(_ *data.FundsRequest, _ string) (*data.FaucetDisbursement, error) {
+	return nil, errNotEnabled
+}
+
+// ConfirmFunds will return an error that signals that faucet is not enabled
+func (d *disabledFaucetProcessor) ConfirmFunds(_ *data.FaucetDisbursement) error {
+	return errNotEnabled
+}
+
+// CancelFunds does nothing
+func (d *disabledFaucetProcessor) CancelFunds(_ *data.FaucetDisbursement) {
+}
+
+// GetDisbursements will return an error that signals that faucet is not enabled
+func (d *disabledFaucetProcessor) GetDisbursements(_ data.FaucetDisbursementsFilter) ([]*data.FaucetDisbursement, error) {
+	return nil, errNotEnabled
+}
+
+// AcquireSender will return an error that signals that faucet is not enabled
+func (d *disabledFaucetProcessor) AcquireSender(_ string) (crypto.PrivateKey, string, uint64, error) {
+	return nil, "", 0, errNotEnabled
+}
+
+// ReleaseSender does nothing
+func (d *disabledFaucetProcessor) ReleaseSender(_ string, _ bool) {
 }
 
 // GenerateTxForSendUserFunds will return an error that signals that faucet is not enabled
@@ -34,3 +57,25 @@ func (d *disabledFaucetProcessor) GenerateTxForSendUserFunds(
 ) (*data.Transaction, error) {
 	return nil, errNotEnabled
 }
+
+// Close does nothing
+func (d *disabledFaucetProcessor) Close() error {
+	return nil
+}
+
+// GenerateTxForSendUserTokens will return an error that signals that faucet is not enabled
+func (d *disabledFaucetProcessor) GenerateTxForSendUserTokens(
+	_ crypto.PrivateKey,
+	_ string,
+	_ uint64,
+	_ string,
+	_ []*data.FaucetToken,
+	_ *data.NetworkConfig,
+) (*data.Transaction, error) {
+	return nil, errNotEnabled
+}
+
+// GetTokens returns an empty slice
+func (d *disabledFaucetProcessor) GetTokens() []*data.FaucetToken {
+	return make([]*data.FaucetToken, 0)
+}
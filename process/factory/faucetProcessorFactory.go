@@ -1,16 +1,28 @@
 package factory
 
 import (
+	"fmt"
 	"math/big"
+	"time"
 
 	"github.com/multiversx/mx-chain-core-go/core"
 	logger "github.com/multiversx/mx-chain-logger-go"
 	"github.com/multiversx/mx-chain-proxy-go/common"
+	"github.com/multiversx/mx-chain-proxy-go/config"
+	"github.com/multiversx/mx-chain-proxy-go/data"
 	"github.com/multiversx/mx-chain-proxy-go/facade"
 	"github.com/multiversx/mx-chain-proxy-go/faucet"
 	"github.com/multiversx/mx-chain-proxy-go/process"
 )
 
+const (
+	challengeTypeNone         = "none"
+	challengeTypeProofOfWork  = "pow"
+	challengeTypeCaptcha      = "captcha"
+	faucetMaxValueUnspecified = ""
+	defaultPoWWindowSec       = 600
+)
+
 var log = logger.GetOrCreate("process/factory")
 
 // CreateFaucetProcessor will return the faucet processor needed for current settings
@@ -19,18 +31,127 @@ func CreateFaucetProcessor(
 	shardCoordinator common.Coordinator,
 	defaultFaucetValue *big.Int,
 	pubKeyConverter core.PubkeyConverter,
+	gasConfigsHandler process.GasConfigsHandler,
 	pemFileLocation string,
+	faucetConfig config.FaucetConfig,
 ) (facade.FaucetProcessor, error) {
 	if defaultFaucetValue.Cmp(big.NewInt(0)) == 0 {
 		log.Info("faucet is disabled")
 		return &disabledFaucetProcessor{}, nil
 	}
 
-	log.Info("faucet is enabled", "pem file location", pemFileLocation)
+	log.Info("faucet is enabled", "pem file location", pemFileLocation, "challenge", faucetConfig.Challenge.Type)
 	privKeysLoader, err := faucet.NewPrivateKeysLoader(shardCoordinator, pemFileLocation, pubKeyConverter)
 	if err != nil {
 		return nil, err
 	}
 
-	return process.NewFaucetProcessor(baseProc, privKeysLoader, defaultFaucetValue, pubKeyConverter)
+	guard, err := createFaucetGuard(faucetConfig, defaultFaucetValue)
+	if err != nil {
+		return nil, err
+	}
+
+	senderWaitTimeout := time.Duration(faucetConfig.SenderWaitTimeoutMs) * time.Millisecond
+	faucetProc, err := process.NewFaucetProcessor(
+		baseProc,
+		privKeysLoader,
+		defaultFaucetValue,
+		pubKeyConverter,
+		guard,
+		gasConfigsHandler,
+		senderWaitTimeout,
+		createFaucetTokens(faucetConfig.Tokens),
+	)
+	if err != nil {
+		_ = guard.Close()
+		return nil, err
+	}
+
+	return faucetProc, nil
+}
+
+func createFaucetGuard(faucetConfig config.FaucetConfig, defaultFaucetValue *big.Int) (process.FaucetGuard, error) {
+	challengeVerifier, err := createChallengeVerifier(faucetConfig.Challenge)
+	if err != nil {
+		return nil, err
+	}
+
+	maxValuePerRequest, err := parseFaucetMaxValue(faucetConfig.MaxValuePerRequest)
+	if err != nil {
+		return nil, err
+	}
+	if maxValuePerRequest == nil || maxValuePerRequest.Sign() == 0 {
+		// a single request can never ask for more than the default faucet value, unless configured otherwise
+		maxValuePerRequest = defaultFaucetValue
+	}
+
+	maxValuePerDay, err := parseFaucetMaxValue(faucetConfig.MaxValuePerDay)
+	if err != nil {
+		return nil, err
+	}
+
+	ledger, err := faucet.NewLevelDBLedger(faucetConfig.LedgerPath)
+	if err != nil {
+		return nil, err
+	}
+
+	guard, err := faucet.NewGuard(faucet.ArgsGuard{
+		Ledger:             ledger,
+		ChallengeVerifier:  challengeVerifier,
+		ReceiverCooldown:   time.Duration(faucetConfig.ReceiverCooldownSec) * time.Second,
+		ClientIPCooldown:   time.Duration(faucetConfig.ClientIPCooldownSec) * time.Second,
+		MaxValuePerRequest: maxValuePerRequest,
+		MaxValuePerDay:     maxValuePerDay,
+	})
+	if err != nil {
+		_ = ledger.Close()
+		return nil, err
+	}
+
+	return guard, nil
+}
+
+func createFaucetTokens(tokensConfig []config.FaucetTokenConfig) []*data.FaucetToken {
+	tokens := make([]*data.FaucetToken, 0, len(tokensConfig))
+	for _, tokenConfig := range tokensConfig {
+		tokens = append(tokens, &data.FaucetToken{
+			TokenIdentifier: tokenConfig.Identifier,
+			Nonce:           tokenConfig.Nonce,
+			Amount:          tokenConfig.Amount,
+			MaxAmountPerDay: tokenConfig.MaxAmountPerDay,
+		})
+	}
+
+	return tokens
+}
+
+func createChallengeVerifier(challengeConfig config.FaucetChallengeConfig) (faucet.ChallengeVerifier, error) {
+	switch challengeConfig.Type {
+	case challengeTypeNone, "":
+		return faucet.NewDisabledChallengeVerifier(), nil
+	case challengeTypeProofOfWork:
+		powWindowSec := challengeConfig.PoWWindowSec
+		if powWindowSec == 0 {
+			powWindowSec = defaultPoWWindowSec
+		}
+
+		return faucet.NewProofOfWorkVerifier(challengeConfig.PoWDifficulty, time.Duration(powWindowSec)*time.Second)
+	case challengeTypeCaptcha:
+		return faucet.NewCaptchaVerifier(challengeConfig.CaptchaVerifyURL, challengeConfig.CaptchaSecret)
+	default:
+		return nil, fmt.Errorf("%w: %s", faucet.ErrUnknownChallengeType, challengeConfig.Type)
+	}
+}
+
+func parseFaucetMaxValue(value string) (*big.Int, error) {
+	if value == faucetMaxValueUnspecified {
+		return nil, nil
+	}
+
+	maxValue, ok := big.NewInt(0).SetString(value, 10)
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", faucet.ErrInvalidMaxValue, value)
+	}
+
+	return maxValue, nil
 }
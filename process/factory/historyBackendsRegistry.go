@@ -0,0 +1,124 @@
+package factory
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/multiversx/mx-chain-proxy-go/config"
+	"github.com/multiversx/mx-chain-proxy-go/process"
+	"github.com/multiversx/mx-chain-proxy-go/process/database"
+)
+
+const (
+	// HistoryBackendDisabled is the type of the history backend which answers every request with an error
+	HistoryBackendDisabled = "disabled"
+	// HistoryBackendElasticSearch is the type of the history backend which queries an Elasticsearch cluster
+	HistoryBackendElasticSearch = "elasticsearch"
+	// HistoryBackendSQLite is the type of the history backend which queries an embedded SQLite database
+	HistoryBackendSQLite = "sqlite"
+)
+
+// HistoryBackendCreator defines a function able to create a history backend from the external config
+type HistoryBackendCreator func(exCfg config.ExternalConfig) (process.ExternalStorageConnector, error)
+
+type historyBackendsRegistry struct {
+	mut      sync.RWMutex
+	creators map[string]HistoryBackendCreator
+}
+
+var defaultHistoryBackendsRegistry = NewHistoryBackendsRegistry()
+
+// RegisterHistoryBackend adds a new history backend type to the registry used by CreateHistoryBackend. It should be
+// called before the proxy creates its history backend
+func RegisterHistoryBackend(backendType string, creator HistoryBackendCreator) error {
+	return defaultHistoryBackendsRegistry.Register(backendType, creator)
+}
+
+// CreateHistoryBackend creates the history backend selected by the external config, choosing between the built-in
+// backends and the ones added through RegisterHistoryBackend
+func CreateHistoryBackend(exCfg config.ExternalConfig) (process.ExternalStorageConnector, error) {
+	return defaultHistoryBackendsRegistry.Create(exCfg)
+}
+
+// NewHistoryBackendsRegistry returns a registry holding the built-in history backends
+func NewHistoryBackendsRegistry() *historyBackendsRegistry {
+	return &historyBackendsRegistry{
+		creators: map[string]HistoryBackendCreator{
+			HistoryBackendDisabled:      createDisabledHistoryBackend,
+			HistoryBackendElasticSearch: createElasticSearchHistoryBackend,
+			HistoryBackendSQLite:        createSQLiteHistoryBackend,
+		},
+	}
+}
+
+// Register adds a new history backend type to the registry
+func (registry *historyBackendsRegistry) Register(backendType string, creator HistoryBackendCreator) error {
+	if creator == nil {
+		return process.ErrNilHistoryBackendCreator
+	}
+
+	registry.mut.Lock()
+	defer registry.mut.Unlock()
+
+	_, exists := registry.creators[backendType]
+	if exists {
+		return fmt.Errorf("%w: %s", process.ErrHistoryBackendAlreadyRegistered, backendType)
+	}
+
+	registry.creators[backendType] = creator
+
+	return nil
+}
+
+// Create creates the history backend selected by the external config. When no type is configured, the Elasticsearch
+// backend is used if its connector is enabled, while the disabled one is used otherwise
+func (registry *historyBackendsRegistry) Create(exCfg config.ExternalConfig) (process.ExternalStorageConnector, error) {
+	backendType := exCfg.HistoryBackend.Type
+	if len(backendType) == 0 {
+		backendType = HistoryBackendDisabled
+		if exCfg.ElasticSearchConnector.Enabled {
+			backendType = HistoryBackendElasticSearch
+		}
+	}
+
+	registry.mut.RLock()
+	creator, exists := registry.creators[backendType]
+	registry.mut.RUnlock()
+	if !exists {
+		return nil, fmt.Errorf("%w: %s, available types: %v", process.ErrUnknownHistoryBackend, backendType, registry.types())
+	}
+
+	log.Info("history backend", "type", backendType)
+
+	return creator(exCfg)
+}
+
+func (registry *historyBackendsRegistry) types() []string {
+	registry.mut.RLock()
+	defer registry.mut.RUnlock()
+
+	backendTypes := make([]string, 0, len(registry.creators))
+	for backendType := range registry.creators {
+		backendTypes = append(backendTypes, backendType)
+	}
+	sort.Strings(backendTypes)
+
+	return backendTypes
+}
+
+func createDisabledHistoryBackend(_ config.ExternalConfig) (process.ExternalStorageConnector, error) {
+	return database.NewDisabledElasticSearchConnector(), nil
+}
+
+func createElasticSearchHistoryBackend(exCfg config.ExternalConfig) (process.ExternalStorageConnector, error) {
+	return database.NewElasticSearchConnector(
+		exCfg.ElasticSearchConnector.URL,
+		exCfg.ElasticSearchConnector.Username,
+		exCfg.ElasticSearchConnector.Password,
+	)
+}
+
+func createSQLiteHistoryBackend(exCfg config.ExternalConfig) (process.ExternalStorageConnector, error) {
+	return database.NewSQLiteConnector(exCfg.SQLiteConnector.Path)
+}
@@ -0,0 +1,44 @@
+package factory
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-proxy-go/config"
+	"github.com/multiversx/mx-chain-proxy-go/process"
+	"github.com/multiversx/mx-chain-proxy-go/process/disabled"
+)
+
+// CreateHyperblockIngester will return the hyperblock ingester needed for current settings. The history backend has
+// to be able to store the indexed hyperblocks
+func CreateHyperblockIngester(
+	ingesterConfig config.HyperblockIngesterConfig,
+	historyBackend process.ExternalStorageConnector,
+	hyperblockProvider process.HyperblockProvider,
+	nonceProvider process.LatestHyperblockNonceProvider,
+	pubKeyConverter core.PubkeyConverter,
+) (HyperblockIngesterHandler, error) {
+	if !ingesterConfig.Enabled {
+		return &disabled.HyperblockIngester{}, nil
+	}
+
+	indexer, ok := historyBackend.(process.HistoryIndexer)
+	if !ok {
+		return nil, fmt.Errorf("%w: %T", process.ErrHistoryBackendCannotBeIndexed, historyBackend)
+	}
+
+	log.Info("hyperblock ingester is enabled", "start nonce", ingesterConfig.StartNonce,
+		"finality margin", ingesterConfig.FinalityMargin, "index token balances", ingesterConfig.IndexTokenBalances)
+
+	return process.NewHyperblockIngester(process.ArgsHyperblockIngester{
+		HyperblockProvider: hyperblockProvider,
+		NonceProvider:      nonceProvider,
+		Indexer:            indexer,
+		PubKeyConverter:    pubKeyConverter,
+		StartNonce:         ingesterConfig.StartNonce,
+		PollingInterval:    time.Duration(ingesterConfig.PollingIntervalMs) * time.Millisecond,
+		FinalityMargin:     ingesterConfig.FinalityMargin,
+		IndexTokenBalances: ingesterConfig.IndexTokenBalances,
+	})
+}
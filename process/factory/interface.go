@@ -24,6 +24,18 @@ type Processor interface {
 	GetPubKeyConverter() core.PubkeyConverter
 	GetObserverProvider() observer.NodesProviderHandler
 	GetFullHistoryNodesProvider() observer.NodesProviderHandler
+	QueryShards(shardIDs []uint32, queryHandler data.ShardQueryHandler) (*data.ShardsQueryResponse, error)
+	QueryShardsStrict(shardIDs []uint32, queryHandler data.ShardQueryHandler) (*data.ShardsQueryResponse, error)
+	QueryShardsBestEffort(shardIDs []uint32, queryHandler data.ShardQueryHandler) *data.ShardsQueryResponse
+	GetRequestsCoalescingMetrics() data.RequestsCoalescingMetrics
+	IsInterfaceNil() bool
+}
+
+// HyperblockIngesterHandler defines what the hyperblock ingester should be able to do
+type HyperblockIngesterHandler interface {
+	StartIngesting()
+	GetMetrics() data.HyperblockIngesterMetrics
+	Close() error
 	IsInterfaceNil() bool
 }
 
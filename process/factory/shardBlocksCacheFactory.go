@@ -0,0 +1,21 @@
+package factory
+
+import (
+	"time"
+
+	"github.com/multiversx/mx-chain-proxy-go/config"
+	"github.com/multiversx/mx-chain-proxy-go/process"
+	"github.com/multiversx/mx-chain-proxy-go/process/disabled"
+)
+
+// CreateShardBlocksCache will return the cache of the shard blocks notarized by hyperblocks needed for current settings
+func CreateShardBlocksCache(cacheConfig config.ShardBlocksCacheConfig) (process.ShardBlocksCache, error) {
+	if !cacheConfig.Enabled {
+		return &disabled.ShardBlocksCache{}, nil
+	}
+
+	return process.NewShardBlocksCache(process.ArgsShardBlocksCache{
+		TTL:        time.Duration(cacheConfig.TTLMs) * time.Millisecond,
+		MaxEntries: cacheConfig.MaxEntries,
+	})
+}
@@ -17,11 +17,13 @@ func CreateTransactionProcessor(
 	hasher hashing.Hasher,
 	marshalizer marshal.Marshalizer,
 	allowEntireTxPoolFetch bool,
+	networkConfigsHandler process.NetworkConfigsHandler,
 ) (facade.TransactionProcessor, error) {
 	newTxCostProcessor := func() (process.TransactionCostHandler, error) {
 		return txcost.NewTransactionCostProcessor(
 			proc,
 			pubKeyConverter,
+			networkConfigsHandler,
 		)
 	}
 
@@ -37,6 +39,7 @@ func CreateTransactionProcessor(
 		marshalizer,
 		newTxCostProcessor,
 		logsMerger,
+		networkConfigsHandler,
 		allowEntireTxPoolFetch,
 	)
 }
@@ -3,9 +3,9 @@ package process
 import (
 	"encoding/hex"
 	"encoding/json"
+	"fmt"
 	"math/big"
-	"math/rand"
-	"sync"
+	"time"
 
 	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/core/check"
@@ -21,11 +21,13 @@ func getSingleSigner() crypto.SingleSigner {
 // FaucetProcessor will handle the faucet operation
 type FaucetProcessor struct {
 	baseProc           Processor
-	accMapByShard      map[uint32][]crypto.PrivateKey
-	mutMap             sync.RWMutex
 	singleSigner       crypto.SingleSigner
 	defaultFaucetValue *big.Int
 	pubKeyConverter    core.PubkeyConverter
+	guard              FaucetGuard
+	gasConfigsHandler  GasConfigsHandler
+	sendersPool        *faucetSendersPool
+	tokens             *faucetTokens
 }
 
 // NewFaucetProcessor will return a new instance of FaucetProcessor
@@ -34,6 +36,10 @@ func NewFaucetProcessor(
 	privKeysLoader PrivateKeysLoaderHandler,
 	defaultFaucetValue *big.Int,
 	pubKeyConverter core.PubkeyConverter,
+	guard FaucetGuard,
+	gasConfigsHandler GasConfigsHandler,
+	senderWaitTimeout time.Duration,
+	tokens []*data.FaucetToken,
 ) (*FaucetProcessor, error) {
 	if baseProc == nil {
 		return nil, ErrNilCoreProcessor
@@ -50,6 +56,20 @@ func NewFaucetProcessor(
 	if check.IfNil(pubKeyConverter) {
 		return nil, ErrNilPubKeyConverter
 	}
+	if check.IfNil(guard) {
+		return nil, ErrNilFaucetGuard
+	}
+	if check.IfNil(gasConfigsHandler) {
+		return nil, ErrNilGasConfigsHandler
+	}
+	if senderWaitTimeout <= 0 {
+		return nil, ErrInvalidFaucetSenderWaitTimeout
+	}
+
+	faucetTokens, err := newFaucetTokens(tokens)
+	if err != nil {
+		return nil, err
+	}
 
 	accMap, err := privKeysLoader.PrivateKeysByShard()
 	if err != nil {
@@ -63,11 +83,13 @@ func NewFaucetProcessor(
 	singleSigner := getSingleSigner()
 	return &FaucetProcessor{
 		baseProc:           baseProc,
-		accMapByShard:      accMap,
-		mutMap:             sync.RWMutex{},
 		singleSigner:       singleSigner,
 		defaultFaucetValue: defaultFaucetValue,
 		pubKeyConverter:    pubKeyConverter,
+		guard:              guard,
+		gasConfigsHandler:  gasConfigsHandler,
+		sendersPool:        newFaucetSendersPool(baseProc, pubKeyConverter, accMap, senderWaitTimeout),
+		tokens:             faucetTokens,
 	}, nil
 }
 
@@ -76,32 +98,92 @@ func (fp *FaucetProcessor) IsEnabled() bool {
 	return true
 }
 
-// SenderDetailsFromPem will return details for a sender in the same shard with the receiver
-func (fp *FaucetProcessor) SenderDetailsFromPem(receiver string) (crypto.PrivateKey, string, error) {
-	receiverBytes, err := fp.pubKeyConverter.Decode(receiver)
+// ReserveFunds checks the funds request against the faucet's rules and reserves the requested value (or the default
+// faucet value, if not provided) or the requested tokens for the receiver. The returned disbursement should be either
+// confirmed, after the transaction is sent, or canceled
+func (fp *FaucetProcessor) ReserveFunds(request *data.FundsRequest, clientIP string) (*data.FaucetDisbursement, error) {
+	_, err := fp.pubKeyConverter.Decode(request.Receiver)
 	if err != nil {
-		return nil, "", err
+		return nil, err
 	}
 
-	receiverShardID, err := fp.baseProc.ComputeShardId(receiverBytes)
+	if len(request.Tokens) > 0 {
+		return fp.reserveTokens(request, clientIP)
+	}
+
+	value := request.Value
+	if value == nil {
+		value = fp.defaultFaucetValue
+	}
+	if value.Sign() == 0 {
+		return nil, ErrFaucetZeroValue
+	}
+
+	return fp.guard.Reserve(request.Receiver, clientIP, value, nil, request.ChallengeToken)
+}
+
+func (fp *FaucetProcessor) reserveTokens(request *data.FundsRequest, clientIP string) (*data.FaucetDisbursement, error) {
+	if request.Value != nil {
+		return nil, ErrFaucetValueWithTokens
+	}
+
+	tokens, err := fp.tokens.resolve(request.Tokens)
 	if err != nil {
-		return nil, "", err
+		return nil, err
+	}
+
+	// the tokens transfers do not count towards the value limits, but are subject to the cooldowns and to the tokens'
+	// daily limits
+	return fp.guard.Reserve(request.Receiver, clientIP, big.NewInt(0), tokens, request.ChallengeToken)
+}
+
+// GetTokens returns the tokens dispensed by the faucet
+func (fp *FaucetProcessor) GetTokens() []*data.FaucetToken {
+	return fp.tokens.getAll()
+}
+
+// ConfirmFunds records the disbursement in the faucet's ledger
+func (fp *FaucetProcessor) ConfirmFunds(disbursement *data.FaucetDisbursement) error {
+	return fp.guard.Confirm(disbursement)
+}
+
+// CancelFunds releases the value reserved for the disbursement
+func (fp *FaucetProcessor) CancelFunds(disbursement *data.FaucetDisbursement) {
+	fp.guard.Cancel(disbursement)
+}
+
+// GetDisbursements returns the disbursements from the faucet's ledger matching the provided filter
+func (fp *FaucetProcessor) GetDisbursements(filter data.FaucetDisbursementsFilter) ([]*data.FaucetDisbursement, error) {
+	return fp.guard.GetDisbursements(filter)
+}
+
+// AcquireSender returns the details of a sender located in the same shard with the receiver, along with the nonce to
+// be used by the next transaction. The sender is held exclusively until released, the call waiting for a sender to
+// become available if all the senders of the shard are busy
+func (fp *FaucetProcessor) AcquireSender(receiver string) (crypto.PrivateKey, string, uint64, error) {
+	receiverBytes, err := fp.pubKeyConverter.Decode(receiver)
+	if err != nil {
+		return nil, "", 0, err
 	}
 
-	senderPrivKey, err := fp.getPrivKeyFromShard(receiverShardID)
+	receiverShardID, err := fp.baseProc.ComputeShardId(receiverBytes)
 	if err != nil {
-		return nil, "", err
+		return nil, "", 0, err
 	}
 
-	senderPubKeyPubKey := senderPrivKey.GeneratePublic()
-	senderPubKeyBytes, err := senderPubKeyPubKey.ToByteArray()
+	sender, err := fp.sendersPool.acquire(receiverShardID)
 	if err != nil {
-		return nil, "", err
+		return nil, "", 0, err
 	}
 
-	senderPubKeyString := fp.pubKeyConverter.Encode(senderPubKeyBytes)
+	return sender.privKey, sender.address, sender.nonce, nil
+}
 
-	return senderPrivKey, senderPubKeyString, nil
+// ReleaseSender makes the sender available for the next requests. The flag should be true if the transaction was
+// accepted by the network, in which case the sender's nonce is incremented. Otherwise, the nonce is resynchronized
+// from the observers before the sender is used again
+func (fp *FaucetProcessor) ReleaseSender(sender string, txSent bool) {
+	fp.sendersPool.release(sender, txSent)
 }
 
 // GenerateTxForSendUserFunds transmits a request to the right observer to load a provided address with some predefined balance
@@ -138,6 +220,75 @@ func (fp *FaucetProcessor) GenerateTxForSendUserFunds(
 	return signedTx, nil
 }
 
+// GenerateTxForSendUserTokens generates the transaction transferring the provided tokens to the receiver. A single
+// fungible token is sent with ESDTTransfer, while SFTs and multiple tokens are sent with MultiESDTNFTTransfer
+func (fp *FaucetProcessor) GenerateTxForSendUserTokens(
+	senderSk crypto.PrivateKey,
+	senderPk string,
+	senderNonce uint64,
+	receiver string,
+	tokens []*data.FaucetToken,
+	networkConfig *data.NetworkConfig,
+) (*data.Transaction, error) {
+	if len(tokens) == 0 {
+		return nil, ErrInvalidFaucetToken
+	}
+
+	receiverBytes, err := fp.pubKeyConverter.Decode(receiver)
+	if err != nil {
+		return nil, err
+	}
+
+	dataField, isMultiTransfer := buildESDTTransferDataField(receiverBytes, tokens)
+	txReceiver := receiver
+	if isMultiTransfer {
+		txReceiver = senderPk
+	}
+
+	gasLimit, err := fp.computeESDTTransferGasLimit(dataField, len(tokens), isMultiTransfer)
+	if err != nil {
+		return nil, err
+	}
+
+	genTx := data.Transaction{
+		Nonce:     senderNonce,
+		Value:     "0",
+		Receiver:  txReceiver,
+		Sender:    senderPk,
+		Data:      []byte(dataField),
+		Signature: "",
+		ChainID:   networkConfig.Config.ChainID,
+		Version:   networkConfig.Config.MinTransactionVersion,
+		GasPrice:  networkConfig.Config.MinGasPrice,
+		GasLimit:  gasLimit,
+	}
+
+	return fp.getSignedTx(&genTx, senderSk)
+}
+
+// computeESDTTransferGasLimit returns the gas limit of the transaction transferring the tokens, based on the cost of the
+// built-in function, charged for each token in the case of the multi transfers
+func (fp *FaucetProcessor) computeESDTTransferGasLimit(dataField string, numTokens int, isMultiTransfer bool) (uint64, error) {
+	gasConfigs, err := fp.gasConfigsHandler.GetGasConfigs()
+	if err != nil {
+		return 0, err
+	}
+
+	function, numTransfers := esdtTransferFunction, uint64(1)
+	if isMultiTransfer {
+		function, numTransfers = multiESDTNFTTransferFunction, uint64(numTokens)
+	}
+
+	builtInFunctionCost, ok := gasConfigs.BuiltInCost[function]
+	if !ok {
+		return 0, fmt.Errorf("%w: %s", ErrMissingBuiltInFunctionCost, function)
+	}
+
+	moveBalanceCost := gasConfigs.MinGasLimit + gasConfigs.GasPerDataByte*uint64(len(dataField))
+
+	return moveBalanceCost + builtInFunctionCost*numTransfers, nil
+}
+
 func (fp *FaucetProcessor) getSignedTx(tx *data.Transaction, privKey crypto.PrivateKey) (*data.Transaction, error) {
 	marshalizedTxBeforeSigning, err := fp.marshalTxForSigning(tx)
 	if err != nil {
@@ -171,15 +322,7 @@ func (fp *FaucetProcessor) marshalTxForSigning(tx *data.Transaction) ([]byte, er
 	return json.Marshal(erdTx)
 }
 
-func (fp *FaucetProcessor) getPrivKeyFromShard(shardID uint32) (crypto.PrivateKey, error) {
-	fp.mutMap.Lock()
-	defer fp.mutMap.Unlock()
-
-	accountsInShard, ok := fp.accMapByShard[shardID]
-	if !ok || len(accountsInShard) == 0 {
-		return nil, ErrNoFaucetAccountForGivenShard
-	}
-
-	randomPrivKeyIdx := rand.Intn(len(accountsInShard))
-	return fp.accMapByShard[shardID][randomPrivKeyIdx], nil
+// Close closes the faucet's ledger
+func (fp *FaucetProcessor) Close() error {
+	return fp.guard.Close()
 }
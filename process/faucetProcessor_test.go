@@ -4,7 +4,11 @@ import (
 	"encoding/hex"
 	"errors"
 	"math/big"
+	"net/http"
+	"strings"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/multiversx/mx-chain-crypto-go"
 	"github.com/multiversx/mx-chain-crypto-go/signing"
@@ -13,6 +17,7 @@ import (
 	"github.com/multiversx/mx-chain-proxy-go/process"
 	"github.com/multiversx/mx-chain-proxy-go/process/mock"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestNewFaucetProcessor_NilBaseProcessorShouldErr(t *testing.T) {
@@ -24,6 +29,10 @@ func TestNewFaucetProcessor_NilBaseProcessorShouldErr(t *testing.T) {
 		&mock.PrivateKeysLoaderStub{},
 		big.NewInt(1),
 		&mock.PubKeyConverterMock{},
+		&mock.FaucetGuardStub{},
+		&mock.GasConfigsHandlerStub{},
+		time.Second,
+		nil,
 	)
 
 	assert.Nil(t, fp)
@@ -38,6 +47,10 @@ func TestNewFaucetProcessor_NilPrivateKeysLoaderShouldErr(t *testing.T) {
 		nil,
 		big.NewInt(1),
 		&mock.PubKeyConverterMock{},
+		&mock.FaucetGuardStub{},
+		&mock.GasConfigsHandlerStub{},
+		time.Second,
+		nil,
 	)
 
 	assert.Nil(t, fp)
@@ -52,6 +65,10 @@ func TestNewFaucetProcessor_NilDefaultFaucetValueShouldErr(t *testing.T) {
 		&mock.PrivateKeysLoaderStub{},
 		nil,
 		&mock.PubKeyConverterMock{},
+		&mock.FaucetGuardStub{},
+		&mock.GasConfigsHandlerStub{},
+		time.Second,
+		nil,
 	)
 
 	assert.Nil(t, fp)
@@ -66,6 +83,10 @@ func TestNewFaucetProcessor_ZeroDefaultFaucetValueShouldErr(t *testing.T) {
 		&mock.PrivateKeysLoaderStub{},
 		big.NewInt(0),
 		&mock.PubKeyConverterMock{},
+		&mock.FaucetGuardStub{},
+		&mock.GasConfigsHandlerStub{},
+		time.Second,
+		nil,
 	)
 
 	assert.Nil(t, fp)
@@ -80,6 +101,10 @@ func TestNewFaucetProcessor_NegativeDefaultFaucetValueShouldErr(t *testing.T) {
 		&mock.PrivateKeysLoaderStub{},
 		big.NewInt(-1),
 		&mock.PubKeyConverterMock{},
+		&mock.FaucetGuardStub{},
+		&mock.GasConfigsHandlerStub{},
+		time.Second,
+		nil,
 	)
 
 	assert.Nil(t, fp)
@@ -94,12 +119,52 @@ func TestNewFaucetProcessor_NilPubKeyConverterShouldErr(t *testing.T) {
 		&mock.PrivateKeysLoaderStub{},
 		big.NewInt(10),
 		nil,
+		&mock.FaucetGuardStub{},
+		&mock.GasConfigsHandlerStub{},
+		time.Second,
+		nil,
 	)
 
 	assert.Nil(t, fp)
 	assert.Equal(t, process.ErrNilPubKeyConverter, err)
 }
 
+func TestNewFaucetProcessor_NilFaucetGuardShouldErr(t *testing.T) {
+	t.Parallel()
+
+	fp, err := process.NewFaucetProcessor(
+		&mock.ProcessorStub{},
+		&mock.PrivateKeysLoaderStub{},
+		big.NewInt(1),
+		&mock.PubKeyConverterMock{},
+		nil,
+		&mock.GasConfigsHandlerStub{},
+		time.Second,
+		nil,
+	)
+
+	assert.Nil(t, fp)
+	assert.Equal(t, process.ErrNilFaucetGuard, err)
+}
+
+func TestNewFaucetProcessor_NilGasConfigsHandlerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	fp, err := process.NewFaucetProcessor(
+		&mock.ProcessorStub{},
+		&mock.PrivateKeysLoaderStub{},
+		big.NewInt(1),
+		&mock.PubKeyConverterMock{},
+		&mock.FaucetGuardStub{},
+		nil,
+		time.Second,
+		nil,
+	)
+
+	assert.Nil(t, fp)
+	assert.Equal(t, process.ErrNilGasConfigsHandler, err)
+}
+
 func TestNewFaucetProcessor_EmptyAccMapShouldErr(t *testing.T) {
 	t.Parallel()
 
@@ -112,6 +177,10 @@ func TestNewFaucetProcessor_EmptyAccMapShouldErr(t *testing.T) {
 		},
 		big.NewInt(1),
 		&mock.PubKeyConverterMock{},
+		&mock.FaucetGuardStub{},
+		&mock.GasConfigsHandlerStub{},
+		time.Second,
+		nil,
 	)
 
 	assert.Nil(t, fp)
@@ -133,13 +202,17 @@ func TestNewFaucetProcessor_OkValsShouldWork(t *testing.T) {
 		},
 		big.NewInt(1),
 		&mock.PubKeyConverterMock{},
+		&mock.FaucetGuardStub{},
+		&mock.GasConfigsHandlerStub{},
+		time.Second,
+		nil,
 	)
 
 	assert.NotNil(t, fp)
 	assert.Nil(t, err)
 }
 
-func TestFaucetProcessor_SenderDetailsFromPemWrongReceiverHexShouldErr(t *testing.T) {
+func TestFaucetProcessor_AcquireSenderWrongReceiverHexShouldErr(t *testing.T) {
 	t.Parallel()
 
 	receiver := "wrong receiver public key hex"
@@ -159,15 +232,19 @@ func TestFaucetProcessor_SenderDetailsFromPemWrongReceiverHexShouldErr(t *testin
 		},
 		big.NewInt(1),
 		&mock.PubKeyConverterMock{},
+		&mock.FaucetGuardStub{},
+		&mock.GasConfigsHandlerStub{},
+		time.Second,
+		nil,
 	)
 
-	sk, pkHex, err := fp.SenderDetailsFromPem(receiver)
+	sk, pkHex, _, err := fp.AcquireSender(receiver)
 	assert.Nil(t, sk)
 	assert.Equal(t, "", pkHex)
 	assert.NotNil(t, err)
 }
 
-func TestFaucetProcessor_SenderDetailsFromPemShardIdComputationWrongShouldErr(t *testing.T) {
+func TestFaucetProcessor_AcquireSenderShardIdComputationWrongShouldErr(t *testing.T) {
 	t.Parallel()
 
 	expectedErr := errors.New("error computing shard id")
@@ -188,15 +265,19 @@ func TestFaucetProcessor_SenderDetailsFromPemShardIdComputationWrongShouldErr(t
 		},
 		big.NewInt(1),
 		&mock.PubKeyConverterMock{},
+		&mock.FaucetGuardStub{},
+		&mock.GasConfigsHandlerStub{},
+		time.Second,
+		nil,
 	)
 
-	sk, pkHex, err := fp.SenderDetailsFromPem(receiver)
+	sk, pkHex, _, err := fp.AcquireSender(receiver)
 	assert.Nil(t, sk)
 	assert.Equal(t, "", pkHex)
 	assert.Equal(t, expectedErr, err)
 }
 
-func TestFaucetProcessor_SenderDetailsFromPemComputedShardIdNotFoundInAccountsShouldErr(t *testing.T) {
+func TestFaucetProcessor_AcquireSenderComputedShardIdNotFoundInAccountsShouldErr(t *testing.T) {
 	t.Parallel()
 
 	receiver := "05702a5fd947a9ddb861ce7ffebfea86c2ca8906df3065ae295f283477ae4e43"
@@ -216,19 +297,27 @@ func TestFaucetProcessor_SenderDetailsFromPemComputedShardIdNotFoundInAccountsSh
 		},
 		big.NewInt(1),
 		&mock.PubKeyConverterMock{},
+		&mock.FaucetGuardStub{},
+		&mock.GasConfigsHandlerStub{},
+		time.Second,
+		nil,
 	)
 
-	sk, pkHex, err := fp.SenderDetailsFromPem(receiver)
+	sk, pkHex, _, err := fp.AcquireSender(receiver)
 	assert.Nil(t, sk)
 	assert.Equal(t, "", pkHex)
 	assert.Equal(t, process.ErrNoFaucetAccountForGivenShard, err)
 }
 
-func TestFaucetProcessor_SenderDetailsFromPemShouldWork(t *testing.T) {
+func TestFaucetProcessor_GenerateTxForSendUserFundsNilFaucetValueShouldUseDefault(t *testing.T) {
 	t.Parallel()
 
+	senderSk := getPrivKey()
+	senderHexPk := hexPubKeyFromSk(senderSk)
+	senderNonce := uint64(25)
 	receiver := "05702a5fd947a9ddb861ce7ffebfea86c2ca8906df3065ae295f283477ae4e43"
-	expectedPrivKey := getPrivKey()
+	defaultFaucetValue := big.NewInt(100000000000)
+
 	fp, _ := process.NewFaucetProcessor(
 		&mock.ProcessorStub{
 			ComputeShardIdCalled: func(addressBuff []byte) (uint32, error) {
@@ -238,22 +327,27 @@ func TestFaucetProcessor_SenderDetailsFromPemShouldWork(t *testing.T) {
 		&mock.PrivateKeysLoaderStub{
 			PrivateKeysByShardCalled: func() (map[uint32][]crypto.PrivateKey, error) {
 				mapToReturn := make(map[uint32][]crypto.PrivateKey)
-				mapToReturn[0] = append(mapToReturn[0], expectedPrivKey)
+				mapToReturn[0] = append(mapToReturn[0], getPrivKey())
 
 				return mapToReturn, nil
 			},
 		},
-		big.NewInt(1),
+		defaultFaucetValue,
 		&mock.PubKeyConverterMock{},
+		&mock.FaucetGuardStub{},
+		&mock.GasConfigsHandlerStub{},
+		time.Second,
+		nil,
 	)
 
-	sk, pkHex, err := fp.SenderDetailsFromPem(receiver)
-	assert.Equal(t, expectedPrivKey, sk)
-	assert.NotEqual(t, "", pkHex)
+	tx, err := fp.GenerateTxForSendUserFunds(senderSk, senderHexPk, senderNonce, receiver, nil, &data.NetworkConfig{})
 	assert.Nil(t, err)
+	assert.Equal(t, senderHexPk, tx.Sender)
+	assert.Equal(t, receiver, tx.Receiver)
+	assert.Equal(t, defaultFaucetValue.String(), tx.Value)
 }
 
-func TestFaucetProcessor_GenerateTxForSendUserFundsNilFaucetValueShouldUseDefault(t *testing.T) {
+func TestFaucetProcessor_GenerateTxForSendUserFundsShouldWork(t *testing.T) {
 	t.Parallel()
 
 	senderSk := getPrivKey()
@@ -261,6 +355,7 @@ func TestFaucetProcessor_GenerateTxForSendUserFundsNilFaucetValueShouldUseDefaul
 	senderNonce := uint64(25)
 	receiver := "05702a5fd947a9ddb861ce7ffebfea86c2ca8906df3065ae295f283477ae4e43"
 	defaultFaucetValue := big.NewInt(100000000000)
+	faucetValue := big.NewInt(12345)
 
 	fp, _ := process.NewFaucetProcessor(
 		&mock.ProcessorStub{
@@ -278,31 +373,212 @@ func TestFaucetProcessor_GenerateTxForSendUserFundsNilFaucetValueShouldUseDefaul
 		},
 		defaultFaucetValue,
 		&mock.PubKeyConverterMock{},
+		&mock.FaucetGuardStub{},
+		&mock.GasConfigsHandlerStub{},
+		time.Second,
+		nil,
 	)
 
-	tx, err := fp.GenerateTxForSendUserFunds(senderSk, senderHexPk, senderNonce, receiver, nil, &data.NetworkConfig{})
+	tx, err := fp.GenerateTxForSendUserFunds(senderSk, senderHexPk, senderNonce, receiver, faucetValue, &data.NetworkConfig{})
 	assert.Nil(t, err)
 	assert.Equal(t, senderHexPk, tx.Sender)
 	assert.Equal(t, receiver, tx.Receiver)
-	assert.Equal(t, defaultFaucetValue.String(), tx.Value)
+	assert.Equal(t, faucetValue.String(), tx.Value)
 }
 
-func TestFaucetProcessor_GenerateTxForSendUserFundsShouldWork(t *testing.T) {
+func TestFaucetProcessor_ReserveFunds(t *testing.T) {
 	t.Parallel()
 
-	senderSk := getPrivKey()
-	senderHexPk := hexPubKeyFromSk(senderSk)
-	senderNonce := uint64(25)
 	receiver := "05702a5fd947a9ddb861ce7ffebfea86c2ca8906df3065ae295f283477ae4e43"
 	defaultFaucetValue := big.NewInt(100000000000)
-	faucetValue := big.NewInt(12345)
+	privKeysLoader := &mock.PrivateKeysLoaderStub{
+		PrivateKeysByShardCalled: func() (map[uint32][]crypto.PrivateKey, error) {
+			mapToReturn := make(map[uint32][]crypto.PrivateKey)
+			mapToReturn[0] = append(mapToReturn[0], getPrivKey())
 
-	fp, _ := process.NewFaucetProcessor(
+			return mapToReturn, nil
+		},
+	}
+
+	t.Run("invalid receiver should err", func(t *testing.T) {
+		t.Parallel()
+
+		guard := &mock.FaucetGuardStub{
+			ReserveCalled: func(receiver string, clientIP string, value *big.Int, tokens []*data.FaucetToken, challengeToken string) (*data.FaucetDisbursement, error) {
+				assert.Fail(t, "should have not been called")
+				return nil, nil
+			},
+		}
+		fp, _ := process.NewFaucetProcessor(&mock.ProcessorStub{}, privKeysLoader, defaultFaucetValue, &mock.PubKeyConverterMock{}, guard, &mock.GasConfigsHandlerStub{}, time.Second, nil)
+
+		disbursement, err := fp.ReserveFunds(&data.FundsRequest{Receiver: "not hex"}, "127.0.0.1")
+		assert.Nil(t, disbursement)
+		assert.NotNil(t, err)
+	})
+
+	t.Run("nil value should reserve the default faucet value", func(t *testing.T) {
+		t.Parallel()
+
+		guard := &mock.FaucetGuardStub{
+			ReserveCalled: func(receiver string, clientIP string, value *big.Int, tokens []*data.FaucetToken, challengeToken string) (*data.FaucetDisbursement, error) {
+				assert.Equal(t, "127.0.0.1", clientIP)
+				assert.Equal(t, "token", challengeToken)
+				assert.Equal(t, defaultFaucetValue, value)
+
+				return &data.FaucetDisbursement{Receiver: receiver, Value: value.String()}, nil
+			},
+		}
+		fp, _ := process.NewFaucetProcessor(&mock.ProcessorStub{}, privKeysLoader, defaultFaucetValue, &mock.PubKeyConverterMock{}, guard, &mock.GasConfigsHandlerStub{}, time.Second, nil)
+
+		disbursement, err := fp.ReserveFunds(&data.FundsRequest{Receiver: receiver, ChallengeToken: "token"}, "127.0.0.1")
+		assert.Nil(t, err)
+		assert.Equal(t, receiver, disbursement.Receiver)
+		assert.Equal(t, defaultFaucetValue.String(), disbursement.Value)
+	})
+
+	t.Run("guard rejection should err", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		guard := &mock.FaucetGuardStub{
+			ReserveCalled: func(receiver string, clientIP string, value *big.Int, tokens []*data.FaucetToken, challengeToken string) (*data.FaucetDisbursement, error) {
+				assert.Equal(t, big.NewInt(5), value)
+				return nil, expectedErr
+			},
+		}
+		fp, _ := process.NewFaucetProcessor(&mock.ProcessorStub{}, privKeysLoader, defaultFaucetValue, &mock.PubKeyConverterMock{}, guard, &mock.GasConfigsHandlerStub{}, time.Second, nil)
+
+		disbursement, err := fp.ReserveFunds(&data.FundsRequest{Receiver: receiver, Value: big.NewInt(5)}, "")
+		assert.Nil(t, disbursement)
+		assert.Equal(t, expectedErr, err)
+	})
+}
+
+func createFaucetProcessorForSenders(t *testing.T, numKeys int, accountNonce uint64, lastPoolNonce *uint64, numNonceRequests *int32) *process.FaucetProcessor {
+	fp, err := process.NewFaucetProcessor(
 		&mock.ProcessorStub{
 			ComputeShardIdCalled: func(addressBuff []byte) (uint32, error) {
 				return uint32(0), nil
 			},
+			GetObserversCalled: func(shardId uint32) ([]*data.NodeData, error) {
+				return []*data.NodeData{{Address: "observer", ShardId: shardId}}, nil
+			},
+			CallGetRestEndPointCalled: func(address string, path string, value interface{}) (int, error) {
+				if strings.HasPrefix(path, "/transaction/pool") {
+					if lastPoolNonce == nil {
+						return http.StatusInternalServerError, errors.New("no txs in pool")
+					}
+
+					value.(*data.TransactionsPoolLastNonceForSenderApiResponse).Data.Nonce = *lastPoolNonce
+					return http.StatusOK, nil
+				}
+
+				require.True(t, strings.HasSuffix(path, "/nonce"))
+				atomic.AddInt32(numNonceRequests, 1)
+				value.(*data.AccountNonceApiResponse).Data.Nonce = accountNonce
+				return http.StatusOK, nil
+			},
 		},
+		&mock.PrivateKeysLoaderStub{
+			PrivateKeysByShardCalled: func() (map[uint32][]crypto.PrivateKey, error) {
+				mapToReturn := make(map[uint32][]crypto.PrivateKey)
+				for i := 0; i < numKeys; i++ {
+					mapToReturn[0] = append(mapToReturn[0], getPrivKey())
+				}
+
+				return mapToReturn, nil
+			},
+		},
+		big.NewInt(1),
+		&mock.PubKeyConverterMock{},
+		&mock.FaucetGuardStub{},
+		&mock.GasConfigsHandlerStub{},
+		50*time.Millisecond,
+		nil,
+	)
+	require.Nil(t, err)
+
+	return fp
+}
+
+func TestFaucetProcessor_AcquireSender(t *testing.T) {
+	t.Parallel()
+
+	receiver := "05702a5fd947a9ddb861ce7ffebfea86c2ca8906df3065ae295f283477ae4e43"
+
+	t.Run("nonce should be tracked locally and resynchronized after failures", func(t *testing.T) {
+		t.Parallel()
+
+		numNonceRequests := int32(0)
+		fp := createFaucetProcessorForSenders(t, 1, 5, nil, &numNonceRequests)
+
+		_, sender, nonce, err := fp.AcquireSender(receiver)
+		require.Nil(t, err)
+		require.Equal(t, uint64(5), nonce)
+		fp.ReleaseSender(sender, true)
+
+		_, sender, nonce, err = fp.AcquireSender(receiver)
+		require.Nil(t, err)
+		require.Equal(t, uint64(6), nonce)
+		require.Equal(t, int32(1), atomic.LoadInt32(&numNonceRequests))
+		fp.ReleaseSender(sender, false)
+
+		_, _, nonce, err = fp.AcquireSender(receiver)
+		require.Nil(t, err)
+		require.Equal(t, uint64(5), nonce)
+		require.Equal(t, int32(2), atomic.LoadInt32(&numNonceRequests))
+	})
+
+	t.Run("pending transactions in pool should be taken into account", func(t *testing.T) {
+		t.Parallel()
+
+		numNonceRequests := int32(0)
+		lastPoolNonce := uint64(9)
+		fp := createFaucetProcessorForSenders(t, 1, 5, &lastPoolNonce, &numNonceRequests)
+
+		_, _, nonce, err := fp.AcquireSender(receiver)
+		require.Nil(t, err)
+		require.Equal(t, uint64(10), nonce)
+	})
+
+	t.Run("senders should be rotated and requests should wait for a free sender", func(t *testing.T) {
+		t.Parallel()
+
+		numNonceRequests := int32(0)
+		fp := createFaucetProcessorForSenders(t, 2, 0, nil, &numNonceRequests)
+
+		_, sender1, _, err := fp.AcquireSender(receiver)
+		require.Nil(t, err)
+		_, sender2, _, err := fp.AcquireSender(receiver)
+		require.Nil(t, err)
+		require.NotEqual(t, sender1, sender2)
+
+		_, _, _, err = fp.AcquireSender(receiver)
+		require.Equal(t, process.ErrFaucetSendersBusy, err)
+		require.True(t, errors.Is(err, data.ErrFaucetRateLimited))
+
+		chanAcquired := make(chan string, 1)
+		go func() {
+			_, sender, _, errAcquire := fp.AcquireSender(receiver)
+			require.Nil(t, errAcquire)
+			chanAcquired <- sender
+		}()
+
+		time.Sleep(10 * time.Millisecond)
+		fp.ReleaseSender(sender2, true)
+
+		select {
+		case sender := <-chanAcquired:
+			require.Equal(t, sender2, sender)
+		case <-time.After(time.Second):
+			require.Fail(t, "the waiting request should have received the released sender")
+		}
+	})
+}
+
+func createFaucetProcessorWithTokens(t *testing.T, guard process.FaucetGuard, gasConfigs *data.TxCostGasConfigs) *process.FaucetProcessor {
+	fp, err := process.NewFaucetProcessor(
+		&mock.ProcessorStub{},
 		&mock.PrivateKeysLoaderStub{
 			PrivateKeysByShardCalled: func() (map[uint32][]crypto.PrivateKey, error) {
 				mapToReturn := make(map[uint32][]crypto.PrivateKey)
@@ -311,15 +587,187 @@ func TestFaucetProcessor_GenerateTxForSendUserFundsShouldWork(t *testing.T) {
 				return mapToReturn, nil
 			},
 		},
-		defaultFaucetValue,
+		big.NewInt(1),
 		&mock.PubKeyConverterMock{},
+		guard,
+		&mock.GasConfigsHandlerStub{
+			GetGasConfigsCalled: func() (*data.TxCostGasConfigs, error) {
+				return gasConfigs, nil
+			},
+		},
+		time.Second,
+		[]*data.FaucetToken{
+			{TokenIdentifier: "TST-abcdef", Amount: "1000", MaxAmountPerDay: "3000"},
+			{TokenIdentifier: "SFT-abcdef", Nonce: 1, Amount: "10"},
+		},
 	)
+	require.Nil(t, err)
 
-	tx, err := fp.GenerateTxForSendUserFunds(senderSk, senderHexPk, senderNonce, receiver, faucetValue, &data.NetworkConfig{})
-	assert.Nil(t, err)
-	assert.Equal(t, senderHexPk, tx.Sender)
-	assert.Equal(t, receiver, tx.Receiver)
-	assert.Equal(t, faucetValue.String(), tx.Value)
+	return fp
+}
+
+func TestNewFaucetProcessor_InvalidTokensShouldErr(t *testing.T) {
+	t.Parallel()
+
+	privKeysLoader := &mock.PrivateKeysLoaderStub{
+		PrivateKeysByShardCalled: func() (map[uint32][]crypto.PrivateKey, error) {
+			mapToReturn := make(map[uint32][]crypto.PrivateKey)
+			mapToReturn[0] = append(mapToReturn[0], getPrivKey())
+
+			return mapToReturn, nil
+		},
+	}
+	testInvalidTokens := func(tokens []*data.FaucetToken, expectedErr error) {
+		fp, err := process.NewFaucetProcessor(
+			&mock.ProcessorStub{},
+			privKeysLoader,
+			big.NewInt(1),
+			&mock.PubKeyConverterMock{},
+			&mock.FaucetGuardStub{},
+			&mock.GasConfigsHandlerStub{},
+			time.Second,
+			tokens,
+		)
+		require.Nil(t, fp)
+		require.True(t, errors.Is(err, expectedErr))
+	}
+
+	testInvalidTokens([]*data.FaucetToken{{Amount: "10"}}, process.ErrInvalidFaucetToken)
+	testInvalidTokens([]*data.FaucetToken{{TokenIdentifier: "TST-abcdef", Amount: "0"}}, process.ErrInvalidFaucetToken)
+	testInvalidTokens([]*data.FaucetToken{{TokenIdentifier: "TST-abcdef", Amount: "ten"}}, process.ErrInvalidFaucetToken)
+	testInvalidTokens([]*data.FaucetToken{{TokenIdentifier: "TST-abcdef", Amount: "10", MaxAmountPerDay: "ten"}}, process.ErrInvalidFaucetToken)
+	testInvalidTokens([]*data.FaucetToken{{TokenIdentifier: "TST-abcdef", Amount: "10", MaxAmountPerDay: "5"}}, process.ErrInvalidFaucetToken)
+	testInvalidTokens([]*data.FaucetToken{
+		{TokenIdentifier: "SFT-abcdef", Nonce: 1, Amount: "10"},
+		{TokenIdentifier: "SFT-abcdef", Nonce: 1, Amount: "20"},
+	}, process.ErrDuplicatedFaucetToken)
+}
+
+func TestFaucetProcessor_GetTokens(t *testing.T) {
+	t.Parallel()
+
+	fp := createFaucetProcessorWithTokens(t, &mock.FaucetGuardStub{}, nil)
+
+	expectedTokens := []*data.FaucetToken{
+		{Identifier: "TST-abcdef", TokenIdentifier: "TST-abcdef", Amount: "1000", MaxAmountPerDay: "3000"},
+		{Identifier: "SFT-abcdef-01", TokenIdentifier: "SFT-abcdef", Nonce: 1, Amount: "10"},
+	}
+	require.Equal(t, expectedTokens, fp.GetTokens())
+}
+
+func TestFaucetProcessor_ReserveFundsForTokens(t *testing.T) {
+	t.Parallel()
+
+	receiver := "05702a5fd947a9ddb861ce7ffebfea86c2ca8906df3065ae295f283477ae4e43"
+
+	t.Run("unknown token should err", func(t *testing.T) {
+		t.Parallel()
+
+		fp := createFaucetProcessorWithTokens(t, &mock.FaucetGuardStub{}, nil)
+		disbursement, err := fp.ReserveFunds(&data.FundsRequest{Receiver: receiver, Tokens: []string{"UNKNOWN-abcdef"}}, "")
+		require.Nil(t, disbursement)
+		require.True(t, errors.Is(err, process.ErrUnknownFaucetToken))
+		require.True(t, errors.Is(err, data.ErrFaucetRequestRejected))
+	})
+
+	t.Run("value and tokens should err", func(t *testing.T) {
+		t.Parallel()
+
+		fp := createFaucetProcessorWithTokens(t, &mock.FaucetGuardStub{}, nil)
+		request := &data.FundsRequest{Receiver: receiver, Value: big.NewInt(1), Tokens: []string{"TST-abcdef"}}
+		disbursement, err := fp.ReserveFunds(request, "")
+		require.Nil(t, disbursement)
+		require.Equal(t, process.ErrFaucetValueWithTokens, err)
+	})
+
+	t.Run("zero value should err", func(t *testing.T) {
+		t.Parallel()
+
+		fp := createFaucetProcessorWithTokens(t, &mock.FaucetGuardStub{}, nil)
+		disbursement, err := fp.ReserveFunds(&data.FundsRequest{Receiver: receiver, Value: big.NewInt(0)}, "")
+		require.Nil(t, disbursement)
+		require.Equal(t, process.ErrFaucetZeroValue, err)
+	})
+
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		guard := &mock.FaucetGuardStub{
+			ReserveCalled: func(receiver string, clientIP string, value *big.Int, tokens []*data.FaucetToken, challengeToken string) (*data.FaucetDisbursement, error) {
+				require.Equal(t, big.NewInt(0), value)
+				return &data.FaucetDisbursement{Receiver: receiver, Value: value.String(), Tokens: tokens}, nil
+			},
+		}
+		fp := createFaucetProcessorWithTokens(t, guard, nil)
+		request := &data.FundsRequest{Receiver: receiver, Tokens: []string{"SFT-abcdef-01", "TST-abcdef", "SFT-abcdef-01"}}
+		disbursement, err := fp.ReserveFunds(request, "")
+		require.Nil(t, err)
+		require.Equal(t, "0", disbursement.Value)
+		require.Len(t, disbursement.Tokens, 2)
+		require.Equal(t, "SFT-abcdef-01", disbursement.Tokens[0].Identifier)
+		require.Equal(t, "TST-abcdef", disbursement.Tokens[1].Identifier)
+		require.Equal(t, "3000", disbursement.Tokens[1].MaxAmountPerDay)
+	})
+}
+
+func TestFaucetProcessor_GenerateTxForSendUserTokens(t *testing.T) {
+	t.Parallel()
+
+	senderSk := getPrivKey()
+	senderHexPk := hexPubKeyFromSk(senderSk)
+	receiver := "05702a5fd947a9ddb861ce7ffebfea86c2ca8906df3065ae295f283477ae4e43"
+	networkConfig := &data.NetworkConfig{}
+	gasConfigs := &data.TxCostGasConfigs{
+		MinGasLimit:    50000,
+		GasPerDataByte: 1500,
+		BuiltInCost: map[string]uint64{
+			"ESDTTransfer":         250000,
+			"MultiESDTNFTTransfer": 200000,
+		},
+	}
+
+	fp := createFaucetProcessorWithTokens(t, &mock.FaucetGuardStub{}, gasConfigs)
+	fungibleToken := &data.FaucetToken{Identifier: "TST-abcdef", TokenIdentifier: "TST-abcdef", Amount: "1000"}
+	semiFungibleToken := &data.FaucetToken{Identifier: "SFT-abcdef-01", TokenIdentifier: "SFT-abcdef", Nonce: 1, Amount: "10"}
+
+	t.Run("single fungible token should use ESDTTransfer", func(t *testing.T) {
+		t.Parallel()
+
+		tx, err := fp.GenerateTxForSendUserTokens(senderSk, senderHexPk, 7, receiver, []*data.FaucetToken{fungibleToken}, networkConfig)
+		require.Nil(t, err)
+
+		expectedData := "ESDTTransfer@" + hex.EncodeToString([]byte("TST-abcdef")) + "@03e8"
+		require.Equal(t, expectedData, string(tx.Data))
+		require.Equal(t, receiver, tx.Receiver)
+		require.Equal(t, "0", tx.Value)
+		require.Equal(t, uint64(7), tx.Nonce)
+		require.Equal(t, uint64(50000+1500*len(expectedData)+250000), tx.GasLimit)
+		require.NotEmpty(t, tx.Signature)
+	})
+
+	t.Run("semi fungible and multiple tokens should use MultiESDTNFTTransfer", func(t *testing.T) {
+		t.Parallel()
+
+		tokens := []*data.FaucetToken{fungibleToken, semiFungibleToken}
+		tx, err := fp.GenerateTxForSendUserTokens(senderSk, senderHexPk, 7, receiver, tokens, networkConfig)
+		require.Nil(t, err)
+
+		expectedData := "MultiESDTNFTTransfer@" + receiver + "@02" +
+			"@" + hex.EncodeToString([]byte("TST-abcdef")) + "@00@03e8" +
+			"@" + hex.EncodeToString([]byte("SFT-abcdef")) + "@01@0a"
+		require.Equal(t, expectedData, string(tx.Data))
+		require.Equal(t, senderHexPk, tx.Receiver)
+		require.Equal(t, uint64(50000+1500*len(expectedData)+2*200000), tx.GasLimit)
+	})
+
+	t.Run("missing built-in function cost should err", func(t *testing.T) {
+		t.Parallel()
+
+		fpWithoutCosts := createFaucetProcessorWithTokens(t, &mock.FaucetGuardStub{}, &data.TxCostGasConfigs{MinGasLimit: 50000})
+		tx, err := fpWithoutCosts.GenerateTxForSendUserTokens(senderSk, senderHexPk, 7, receiver, []*data.FaucetToken{fungibleToken}, networkConfig)
+		require.Nil(t, tx)
+		require.True(t, errors.Is(err, process.ErrMissingBuiltInFunctionCost))
+	})
 }
 
 func getPrivKey() crypto.PrivateKey {
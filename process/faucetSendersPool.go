@@ -0,0 +1,194 @@
+package process
+
+import (
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	crypto "github.com/multiversx/mx-chain-crypto-go"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+type faucetSender struct {
+	privKey     crypto.PrivateKey
+	shardID     uint32
+	address     string
+	nonce       uint64
+	isNonceSync bool
+}
+
+// faucetSendersPool hands out the faucet's keys of a shard, one request at a time for each key, so the transactions
+// sent from the same key get consecutive nonces. The nonces are tracked locally and are resynchronized from the
+// observers on first use and after each failed transaction. The requests arriving while all the keys of the shard
+// are busy wait for a key to be released, up to the configured timeout
+type faucetSendersPool struct {
+	baseProc        Processor
+	pubKeyConverter core.PubkeyConverter
+	waitTimeout     time.Duration
+	senders         map[uint32]chan *faucetSender
+
+	mutBusySenders sync.Mutex
+	busySenders    map[string]*faucetSender
+}
+
+func newFaucetSendersPool(
+	baseProc Processor,
+	pubKeyConverter core.PubkeyConverter,
+	privKeysByShard map[uint32][]crypto.PrivateKey,
+	waitTimeout time.Duration,
+) *faucetSendersPool {
+	pool := &faucetSendersPool{
+		baseProc:        baseProc,
+		pubKeyConverter: pubKeyConverter,
+		waitTimeout:     waitTimeout,
+		senders:         make(map[uint32]chan *faucetSender),
+		busySenders:     make(map[string]*faucetSender),
+	}
+
+	for shardID, privKeys := range privKeysByShard {
+		senders := make(chan *faucetSender, len(privKeys))
+		for _, privKey := range privKeys {
+			senders <- &faucetSender{
+				privKey: privKey,
+				shardID: shardID,
+			}
+		}
+		pool.senders[shardID] = senders
+	}
+
+	return pool
+}
+
+// acquire returns a sender of the shard, waiting for one to be released if all of them are busy. The sender is
+// exclusively held by the caller until released
+func (pool *faucetSendersPool) acquire(shardID uint32) (*faucetSender, error) {
+	senders, ok := pool.senders[shardID]
+	if !ok || cap(senders) == 0 {
+		return nil, ErrNoFaucetAccountForGivenShard
+	}
+
+	var sender *faucetSender
+	select {
+	case sender = <-senders:
+	default:
+		log.Debug("faucet: all the senders of the shard are busy, waiting", "shard", shardID)
+
+		timer := time.NewTimer(pool.waitTimeout)
+		defer timer.Stop()
+
+		select {
+		case sender = <-senders:
+		case <-timer.C:
+			return nil, ErrFaucetSendersBusy
+		}
+	}
+
+	err := pool.prepareSender(sender)
+	if err != nil {
+		senders <- sender
+		return nil, err
+	}
+
+	pool.mutBusySenders.Lock()
+	pool.busySenders[sender.address] = sender
+	pool.mutBusySenders.Unlock()
+
+	return sender, nil
+}
+
+// release gives the sender back to the pool. If the transaction was sent, the local nonce is incremented, otherwise the
+// nonce will be resynchronized from the observers when the sender is used again
+func (pool *faucetSendersPool) release(address string, txSent bool) {
+	pool.mutBusySenders.Lock()
+	sender, ok := pool.busySenders[address]
+	delete(pool.busySenders, address)
+	pool.mutBusySenders.Unlock()
+
+	if !ok {
+		log.Warn("faucet: released an unknown sender", "address", address)
+		return
+	}
+
+	if txSent {
+		sender.nonce++
+	} else {
+		sender.isNonceSync = false
+	}
+
+	pool.senders[sender.shardID] <- sender
+}
+
+func (pool *faucetSendersPool) prepareSender(sender *faucetSender) error {
+	if len(sender.address) == 0 {
+		pubKeyBytes, err := sender.privKey.GeneratePublic().ToByteArray()
+		if err != nil {
+			return err
+		}
+
+		sender.address = pool.pubKeyConverter.Encode(pubKeyBytes)
+	}
+
+	if sender.isNonceSync {
+		return nil
+	}
+
+	nonce, err := pool.fetchNonce(sender.address, sender.shardID)
+	if err != nil {
+		return err
+	}
+
+	log.Debug("faucet: resynchronized the sender's nonce", "address", sender.address, "local nonce", sender.nonce, "nonce", nonce)
+	sender.nonce = nonce
+	sender.isNonceSync = true
+
+	return nil
+}
+
+// fetchNonce returns the next nonce to be used by the sender: the account's nonce, unless the observer's pool already
+// holds transactions of the sender with higher nonces
+func (pool *faucetSendersPool) fetchNonce(address string, shardID uint32) (uint64, error) {
+	observers, err := pool.baseProc.GetObservers(shardID)
+	if err != nil {
+		return 0, err
+	}
+
+	for _, observer := range observers {
+		accountNonce, ok := pool.fetchAccountNonce(observer, address)
+		if !ok {
+			continue
+		}
+
+		lastPoolNonce, ok := pool.fetchLastPoolNonce(observer, address)
+		if ok && lastPoolNonce > 0 && lastPoolNonce >= accountNonce {
+			return lastPoolNonce + 1, nil
+		}
+
+		return accountNonce, nil
+	}
+
+	return 0, ErrSendingRequest
+}
+
+func (pool *faucetSendersPool) fetchAccountNonce(observer *data.NodeData, address string) (uint64, bool) {
+	response := &data.AccountNonceApiResponse{}
+	respCode, err := pool.baseProc.CallGetRestEndPoint(observer.Address, addressPath+address+"/nonce", response)
+	if err != nil || respCode != http.StatusOK {
+		log.Trace("faucet: cannot get the sender's nonce", "observer", observer.Address, "address", address, "error", err)
+		return 0, false
+	}
+
+	return response.Data.Nonce, true
+}
+
+func (pool *faucetSendersPool) fetchLastPoolNonce(observer *data.NodeData, address string) (uint64, bool) {
+	response := &data.TransactionsPoolLastNonceForSenderApiResponse{}
+	apiPath := TransactionsPoolPath + lastNonceParam + bySenderParam + address
+	respCode, err := pool.baseProc.CallGetRestEndPoint(observer.Address, apiPath, response)
+	if err != nil || respCode != http.StatusOK {
+		log.Trace("faucet: cannot get the sender's last nonce from the tx pool", "observer", observer.Address, "address", address, "error", err)
+		return 0, false
+	}
+
+	return response.Data.Nonce, true
+}
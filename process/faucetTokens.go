@@ -0,0 +1,163 @@
+package process
+
+import (
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+const (
+	esdtTransferFunction         = "ESDTTransfer"
+	multiESDTNFTTransferFunction = "MultiESDTNFTTransfer"
+	dataFieldSeparator           = "@"
+)
+
+type faucetTokens struct {
+	tokens       []*data.FaucetToken
+	byIdentifier map[string]*data.FaucetToken
+}
+
+func newFaucetTokens(tokens []*data.FaucetToken) (*faucetTokens, error) {
+	ft := &faucetTokens{
+		tokens:       make([]*data.FaucetToken, 0, len(tokens)),
+		byIdentifier: make(map[string]*data.FaucetToken),
+	}
+
+	for _, token := range tokens {
+		if token == nil || len(token.TokenIdentifier) == 0 {
+			return nil, ErrInvalidFaucetToken
+		}
+
+		amount, ok := big.NewInt(0).SetString(token.Amount, 10)
+		if !ok || amount.Sign() <= 0 {
+			return nil, fmt.Errorf("%w: invalid amount %s for %s", ErrInvalidFaucetToken, token.Amount, token.TokenIdentifier)
+		}
+
+		maxAmountPerDay, err := parseFaucetTokenMaxAmountPerDay(token, amount)
+		if err != nil {
+			return nil, err
+		}
+
+		identifier := computeFaucetTokenIdentifier(token.TokenIdentifier, token.Nonce)
+		_, exists := ft.byIdentifier[identifier]
+		if exists {
+			return nil, fmt.Errorf("%w: %s", ErrDuplicatedFaucetToken, identifier)
+		}
+
+		faucetToken := &data.FaucetToken{
+			Identifier:      identifier,
+			TokenIdentifier: token.TokenIdentifier,
+			Nonce:           token.Nonce,
+			Amount:          amount.String(),
+			MaxAmountPerDay: maxAmountPerDay,
+		}
+		ft.tokens = append(ft.tokens, faucetToken)
+		ft.byIdentifier[identifier] = faucetToken
+	}
+
+	return ft, nil
+}
+
+// parseFaucetTokenMaxAmountPerDay returns the normalized maximum amount per day of the token, empty if not limited. The
+// maximum cannot be lower than the amount sent for each request
+func parseFaucetTokenMaxAmountPerDay(token *data.FaucetToken, amount *big.Int) (string, error) {
+	if len(token.MaxAmountPerDay) == 0 {
+		return "", nil
+	}
+
+	maxAmountPerDay, ok := big.NewInt(0).SetString(token.MaxAmountPerDay, 10)
+	if !ok || maxAmountPerDay.Sign() < 0 {
+		return "", fmt.Errorf("%w: invalid maximum amount per day %s for %s", ErrInvalidFaucetToken, token.MaxAmountPerDay, token.TokenIdentifier)
+	}
+	if maxAmountPerDay.Sign() == 0 {
+		return "", nil
+	}
+	if maxAmountPerDay.Cmp(amount) < 0 {
+		return "", fmt.Errorf("%w: maximum amount per day %s for %s is lower than the amount", ErrInvalidFaucetToken, token.MaxAmountPerDay, token.TokenIdentifier)
+	}
+
+	return maxAmountPerDay.String(), nil
+}
+
+// resolve returns the faucet tokens matching the requested identifiers, ignoring the duplicates
+func (ft *faucetTokens) resolve(identifiers []string) ([]*data.FaucetToken, error) {
+	resolved := make([]*data.FaucetToken, 0, len(identifiers))
+	seen := make(map[string]struct{})
+	for _, identifier := range identifiers {
+		token, ok := ft.byIdentifier[identifier]
+		if !ok {
+			return nil, fmt.Errorf("%w %s", ErrUnknownFaucetToken, identifier)
+		}
+
+		_, isDuplicate := seen[identifier]
+		if isDuplicate {
+			continue
+		}
+
+		seen[identifier] = struct{}{}
+		resolved = append(resolved, token)
+	}
+
+	return resolved, nil
+}
+
+func (ft *faucetTokens) getAll() []*data.FaucetToken {
+	tokens := make([]*data.FaucetToken, 0, len(ft.tokens))
+	for _, token := range ft.tokens {
+		tokenCopy := *token
+		tokens = append(tokens, &tokenCopy)
+	}
+
+	return tokens
+}
+
+func computeFaucetTokenIdentifier(tokenIdentifier string, nonce uint64) string {
+	if nonce == 0 {
+		return tokenIdentifier
+	}
+
+	return tokenIdentifier + "-" + hex.EncodeToString(big.NewInt(0).SetUint64(nonce).Bytes())
+}
+
+// buildESDTTransferDataField returns the data field of the transaction transferring the tokens and whether the
+// transaction should be sent to the sender itself (multi transfers), instead of the tokens' receiver
+func buildESDTTransferDataField(receiverBytes []byte, tokens []*data.FaucetToken) (string, bool) {
+	if len(tokens) == 1 && tokens[0].Nonce == 0 {
+		return strings.Join([]string{
+			esdtTransferFunction,
+			hex.EncodeToString([]byte(tokens[0].TokenIdentifier)),
+			encodeBigIntArgument(tokens[0].Amount),
+		}, dataFieldSeparator), false
+	}
+
+	arguments := []string{
+		multiESDTNFTTransferFunction,
+		hex.EncodeToString(receiverBytes),
+		encodeUint64Argument(uint64(len(tokens))),
+	}
+	for _, token := range tokens {
+		arguments = append(arguments,
+			hex.EncodeToString([]byte(token.TokenIdentifier)),
+			encodeUint64Argument(token.Nonce),
+			encodeBigIntArgument(token.Amount),
+		)
+	}
+
+	return strings.Join(arguments, dataFieldSeparator), true
+}
+
+func encodeUint64Argument(value uint64) string {
+	return encodeBigIntArgument(big.NewInt(0).SetUint64(value).String())
+}
+
+func encodeBigIntArgument(value string) string {
+	bigValue, _ := big.NewInt(0).SetString(value, 10)
+	if bigValue == nil || bigValue.Sign() == 0 {
+		return "00"
+	}
+
+	return hex.EncodeToString(bigValue.Bytes())
+}
@@ -0,0 +1,386 @@
+package process
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data/api"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	indexerData "github.com/multiversx/mx-chain-es-indexer-go/data"
+	"github.com/multiversx/mx-chain-proxy-go/common"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+// numTopicsPerTransferredToken is the number of topics describing a token in a transfer event: identifier, nonce and value
+const numTopicsPerTransferredToken = 3
+
+// ArgsHyperblockIngester holds the arguments needed to create a hyperblock ingester
+type ArgsHyperblockIngester struct {
+	HyperblockProvider HyperblockProvider
+	NonceProvider      LatestHyperblockNonceProvider
+	Indexer            HistoryIndexer
+	PubKeyConverter    core.PubkeyConverter
+	StartNonce         uint64
+	PollingInterval    time.Duration
+	FinalityMargin     uint64
+	IndexTokenBalances bool
+}
+
+// HyperblockIngester walks the hyperblocks, starting from a configured nonce and following the latest fully
+// synchronized one, minus a finality margin, and feeds their transactions, events and token transfers to the history
+// storage, along with the token balances of the altered accounts, if enabled. It resumes from the last indexed
+// hyperblock after restarts
+type HyperblockIngester struct {
+	hyperblockProvider HyperblockProvider
+	nonceProvider      LatestHyperblockNonceProvider
+	indexer            HistoryIndexer
+	pubKeyConverter    core.PubkeyConverter
+	pollingInterval    time.Duration
+	finalityMargin     uint64
+	queryOptions       common.HyperblockQueryOptions
+
+	mutProgress sync.RWMutex
+	nextNonce   uint64
+	headNonce   uint64
+	cancelFunc  func()
+	chanDone    chan struct{}
+}
+
+// NewHyperblockIngester creates a new hyperblock ingester, which resumes from the last indexed hyperblock, if any
+func NewHyperblockIngester(args ArgsHyperblockIngester) (*HyperblockIngester, error) {
+	if check.IfNil(args.HyperblockProvider) {
+		return nil, ErrNilHyperblockProvider
+	}
+	if check.IfNil(args.NonceProvider) {
+		return nil, ErrNilLatestHyperblockNonceProvider
+	}
+	if check.IfNil(args.Indexer) {
+		return nil, ErrNilHistoryIndexer
+	}
+	if check.IfNil(args.PubKeyConverter) {
+		return nil, ErrNilPubKeyConverter
+	}
+	if args.PollingInterval <= 0 {
+		return nil, ErrInvalidPollingInterval
+	}
+
+	lastIndexedNonce, found, err := args.Indexer.GetLastIndexedHyperblockNonce()
+	if err != nil {
+		return nil, err
+	}
+
+	nextNonce := args.StartNonce
+	if found {
+		nextNonce = lastIndexedNonce + 1
+		log.Info("resuming the hyperblock ingestion", "last indexed nonce", lastIndexedNonce)
+	}
+
+	// the altered accounts provide the token balances
+	queryOptions := common.HyperblockQueryOptions{
+		WithLogs:            true,
+		WithAlteredAccounts: args.IndexTokenBalances,
+	}
+
+	return &HyperblockIngester{
+		hyperblockProvider: args.HyperblockProvider,
+		nonceProvider:      args.NonceProvider,
+		indexer:            args.Indexer,
+		pubKeyConverter:    args.PubKeyConverter,
+		pollingInterval:    args.PollingInterval,
+		finalityMargin:     args.FinalityMargin,
+		queryOptions:       queryOptions,
+		nextNonce:          nextNonce,
+	}, nil
+}
+
+// StartIngesting starts the go routine which indexes the hyperblocks
+func (hi *HyperblockIngester) StartIngesting() {
+	if hi.cancelFunc != nil {
+		log.Error("HyperblockIngester - ingestion already started")
+		return
+	}
+
+	var ctx context.Context
+	ctx, hi.cancelFunc = context.WithCancel(context.Background())
+	hi.chanDone = make(chan struct{})
+
+	go func(ctx context.Context) {
+		timer := time.NewTimer(hi.pollingInterval)
+		defer func() {
+			timer.Stop()
+			close(hi.chanDone)
+		}()
+
+		for {
+			hi.ingestAvailableHyperblocks(ctx)
+			timer.Reset(hi.pollingInterval)
+
+			select {
+			case <-timer.C:
+			case <-ctx.Done():
+				log.Debug("finishing HyperblockIngester ingestion...")
+				return
+			}
+		}
+	}(ctx)
+}
+
+// ingestAvailableHyperblocks indexes the hyperblocks up to the latest fully synchronized one minus the finality
+// margin, so that the indexed hyperblocks are not reverted afterwards. It stops at the first failure, as the
+// hyperblocks are indexed strictly in order
+func (hi *HyperblockIngester) ingestAvailableHyperblocks(ctx context.Context) {
+	headNonce, err := hi.nonceProvider.GetLatestFullySynchronizedHyperblockNonce()
+	if err != nil {
+		log.Warn("HyperblockIngester: cannot get the latest fully synchronized hyperblock nonce", "error", err)
+		return
+	}
+
+	hi.mutProgress.Lock()
+	hi.headNonce = headNonce
+	nonce := hi.nextNonce
+	hi.mutProgress.Unlock()
+
+	if headNonce < hi.finalityMargin {
+		return
+	}
+
+	lastFinalNonce := headNonce - hi.finalityMargin
+	for ; nonce <= lastFinalNonce; nonce++ {
+		select {
+		case <-ctx.Done():
+			return
+		default:
+		}
+
+		err = hi.ingestHyperblock(nonce)
+		if err != nil {
+			log.Warn("HyperblockIngester: cannot index hyperblock", "nonce", nonce, "error", err)
+			return
+		}
+
+		hi.mutProgress.Lock()
+		hi.nextNonce = nonce + 1
+		hi.mutProgress.Unlock()
+	}
+}
+
+func (hi *HyperblockIngester) ingestHyperblock(nonce uint64) error {
+	response, err := hi.hyperblockProvider.GetHyperBlockByNonce(nonce, hi.queryOptions)
+	if err != nil {
+		return err
+	}
+	if response.Error != "" {
+		return errors.New(response.Error)
+	}
+	// the hyperblock is not indexed until all its shard blocks are available, as it would never be indexed again
+	if len(response.Data.MissingShards) > 0 {
+		return fmt.Errorf("%w, missing shards: %v", ErrIncompleteHyperblock, response.Data.MissingShards)
+	}
+
+	indexedHyperblock := hi.convertHyperblock(&response.Data.Hyperblock)
+
+	return hi.indexer.IndexHyperblock(indexedHyperblock)
+}
+
+func (hi *HyperblockIngester) convertHyperblock(hyperblock *api.Hyperblock) *data.IndexedHyperblock {
+	indexedHyperblock := &data.IndexedHyperblock{
+		Nonce:          hyperblock.Nonce,
+		Hash:           hyperblock.Hash,
+		Transactions:   make([]data.DatabaseTransaction, 0, len(hyperblock.Transactions)),
+		Events:         make([]data.DatabaseEvent, 0),
+		TokenTransfers: make([]data.DatabaseTokenTransfer, 0),
+		TokenBalances:  extractTokenBalances(hyperblock),
+	}
+
+	for _, tx := range hyperblock.Transactions {
+		if tx == nil {
+			continue
+		}
+
+		timestamp := tx.Timestamp
+		if timestamp == 0 {
+			timestamp = int64(hyperblock.Timestamp)
+		}
+
+		indexedHyperblock.Transactions = append(indexedHyperblock.Transactions, convertApiTransaction(tx, timestamp))
+		if tx.Logs == nil {
+			continue
+		}
+
+		for index, event := range tx.Logs.Events {
+			if event == nil {
+				continue
+			}
+
+			indexedHyperblock.Events = append(indexedHyperblock.Events, data.DatabaseEvent{
+				TxHash:     tx.Hash,
+				Index:      index,
+				Address:    event.Address,
+				Identifier: event.Identifier,
+				Topics:     event.Topics,
+				Data:       event.Data,
+				BlockNonce: hyperblock.Nonce,
+				Timestamp:  timestamp,
+			})
+
+			transfers := hi.extractTokenTransfers(event)
+			for transferIndex := range transfers {
+				transfers[transferIndex].TxHash = tx.Hash
+				transfers[transferIndex].EventIndex = index
+				transfers[transferIndex].Index = transferIndex
+				transfers[transferIndex].BlockNonce = hyperblock.Nonce
+				transfers[transferIndex].Timestamp = timestamp
+			}
+			indexedHyperblock.TokenTransfers = append(indexedHyperblock.TokenTransfers, transfers...)
+		}
+	}
+
+	return indexedHyperblock
+}
+
+// extractTokenBalances returns the token balances of the accounts altered by the shard blocks of the hyperblock, which
+// are only provided when requested
+func extractTokenBalances(hyperblock *api.Hyperblock) []data.DatabaseTokenBalance {
+	balances := make([]data.DatabaseTokenBalance, 0)
+	for _, shardBlock := range hyperblock.ShardBlocks {
+		if shardBlock == nil {
+			continue
+		}
+
+		for _, account := range shardBlock.AlteredAccounts {
+			if account == nil {
+				continue
+			}
+
+			for _, token := range account.Tokens {
+				if token == nil {
+					continue
+				}
+
+				balances = append(balances, data.DatabaseTokenBalance{
+					Token:      token.Identifier,
+					Nonce:      token.Nonce,
+					Address:    account.Address,
+					Balance:    token.Balance,
+					BlockNonce: hyperblock.Nonce,
+				})
+			}
+		}
+	}
+
+	return balances
+}
+
+func convertApiTransaction(tx *transaction.ApiTransactionResult, timestamp int64) data.DatabaseTransaction {
+	return data.DatabaseTransaction{
+		Hash: tx.Hash,
+		Fee:  tx.Fee,
+		Transaction: indexerData.Transaction{
+			MBHash:            tx.MiniBlockHash,
+			Nonce:             tx.Nonce,
+			Round:             tx.Round,
+			Value:             tx.Value,
+			Receiver:          tx.Receiver,
+			Sender:            tx.Sender,
+			ReceiverShard:     tx.DestinationShard,
+			SenderShard:       tx.SourceShard,
+			GasPrice:          tx.GasPrice,
+			GasLimit:          tx.GasLimit,
+			GasUsed:           tx.GasUsed,
+			Fee:               tx.Fee,
+			InitialPaidFee:    tx.InitiallyPaidFee,
+			Data:              tx.Data,
+			Signature:         tx.Signature,
+			Timestamp:         time.Duration(timestamp),
+			Status:            string(tx.Status),
+			SenderUserName:    tx.SenderUsername,
+			ReceiverUserName:  tx.ReceiverUsername,
+			HasSCR:            len(tx.SmartContractResults) > 0,
+			HasLogs:           tx.Logs != nil && len(tx.Logs.Events) > 0,
+			Tokens:            tx.Tokens,
+			ESDTValues:        tx.ESDTValues,
+			Receivers:         tx.Receivers,
+			ReceiversShardIDs: tx.ReceiversShardIDs,
+			Type:              tx.Type,
+			Operation:         tx.Operation,
+			Function:          tx.Function,
+			IsRelayed:         tx.IsRelayed,
+			Version:           tx.Version,
+		},
+	}
+}
+
+// extractTokenTransfers returns the token transfers described by a transfer event, whose topics hold the identifier,
+// the nonce and the value of each transferred token, followed by the receiver
+func (hi *HyperblockIngester) extractTokenTransfers(event *transaction.Events) []data.DatabaseTokenTransfer {
+	switch event.Identifier {
+	case core.BuiltInFunctionESDTTransfer, core.BuiltInFunctionESDTNFTTransfer, core.BuiltInFunctionMultiESDTNFTTransfer:
+	default:
+		return nil
+	}
+
+	numTopics := len(event.Topics)
+	if numTopics <= numTopicsPerTransferredToken || (numTopics-1)%numTopicsPerTransferredToken != 0 {
+		return nil
+	}
+
+	receiverBytes := event.Topics[numTopics-1]
+	if len(receiverBytes) != hi.pubKeyConverter.Len() {
+		return nil
+	}
+	receiver := hi.pubKeyConverter.Encode(receiverBytes)
+
+	transfers := make([]data.DatabaseTokenTransfer, 0, numTopics/numTopicsPerTransferredToken)
+	for i := 0; i+numTopicsPerTransferredToken < numTopics; i += numTopicsPerTransferredToken {
+		transfers = append(transfers, data.DatabaseTokenTransfer{
+			Token:    string(event.Topics[i]),
+			Nonce:    big.NewInt(0).SetBytes(event.Topics[i+1]).Uint64(),
+			Value:    big.NewInt(0).SetBytes(event.Topics[i+2]).String(),
+			Sender:   event.Address,
+			Receiver: receiver,
+		})
+	}
+
+	return transfers
+}
+
+// GetMetrics returns the progress of the ingestion
+func (hi *HyperblockIngester) GetMetrics() data.HyperblockIngesterMetrics {
+	hi.mutProgress.RLock()
+	defer hi.mutProgress.RUnlock()
+
+	metrics := data.HyperblockIngesterMetrics{
+		Enabled:   true,
+		HeadNonce: hi.headNonce,
+	}
+	if hi.nextNonce > 0 {
+		metrics.LastIndexedNonce = hi.nextNonce - 1
+	}
+	if hi.headNonce >= hi.nextNonce {
+		metrics.Lag = hi.headNonce - hi.nextNonce + 1
+	}
+
+	return metrics
+}
+
+// Close will handle the closing of the ingestion go routine, waiting for the hyperblock being indexed, if any, so that
+// the history storage can be safely closed afterwards
+func (hi *HyperblockIngester) Close() error {
+	if hi.cancelFunc != nil {
+		hi.cancelFunc()
+		<-hi.chanDone
+	}
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (hi *HyperblockIngester) IsInterfaceNil() bool {
+	return hi == nil
+}
@@ -0,0 +1,515 @@
+package process_test
+
+import (
+	"errors"
+	"math/big"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/pubkeyConverter"
+	"github.com/multiversx/mx-chain-core-go/data/api"
+	"github.com/multiversx/mx-chain-core-go/data/outport"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	logger "github.com/multiversx/mx-chain-logger-go"
+	"github.com/multiversx/mx-chain-proxy-go/common"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/multiversx/mx-chain-proxy-go/process"
+	"github.com/multiversx/mx-chain-proxy-go/process/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockArgsHyperblockIngester() process.ArgsHyperblockIngester {
+	converter, _ := pubkeyConverter.NewBech32PubkeyConverter(32, logger.GetOrCreate("test"))
+
+	return process.ArgsHyperblockIngester{
+		HyperblockProvider: &mock.HyperblockProviderStub{},
+		NonceProvider:      &mock.LatestHyperblockNonceProviderStub{},
+		Indexer:            &mock.HistoryIndexerStub{},
+		PubKeyConverter:    converter,
+		StartNonce:         10,
+		PollingInterval:    time.Second,
+	}
+}
+
+func createHyperblockResponse(nonce uint64, txs ...*transaction.ApiTransactionResult) *data.HyperblockApiResponse {
+	return data.NewHyperblockApiResponse(api.Hyperblock{
+		Nonce:        nonce,
+		Hash:         "hash",
+		Timestamp:    1000,
+		Transactions: txs,
+	})
+}
+
+func TestNewHyperblockIngester(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil hyperblock provider should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsHyperblockIngester()
+		args.HyperblockProvider = nil
+		ingester, err := process.NewHyperblockIngester(args)
+		require.Nil(t, ingester)
+		require.Equal(t, process.ErrNilHyperblockProvider, err)
+	})
+	t.Run("nil nonce provider should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsHyperblockIngester()
+		args.NonceProvider = nil
+		ingester, err := process.NewHyperblockIngester(args)
+		require.Nil(t, ingester)
+		require.Equal(t, process.ErrNilLatestHyperblockNonceProvider, err)
+	})
+	t.Run("nil indexer should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsHyperblockIngester()
+		args.Indexer = nil
+		ingester, err := process.NewHyperblockIngester(args)
+		require.Nil(t, ingester)
+		require.Equal(t, process.ErrNilHistoryIndexer, err)
+	})
+	t.Run("nil pub key converter should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsHyperblockIngester()
+		args.PubKeyConverter = nil
+		ingester, err := process.NewHyperblockIngester(args)
+		require.Nil(t, ingester)
+		require.Equal(t, process.ErrNilPubKeyConverter, err)
+	})
+	t.Run("invalid polling interval should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsHyperblockIngester()
+		args.PollingInterval = 0
+		ingester, err := process.NewHyperblockIngester(args)
+		require.Nil(t, ingester)
+		require.Equal(t, process.ErrInvalidPollingInterval, err)
+	})
+	t.Run("checkpoint read failure should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		args := createMockArgsHyperblockIngester()
+		args.Indexer = &mock.HistoryIndexerStub{
+			GetLastIndexedHyperblockNonceCalled: func() (uint64, bool, error) {
+				return 0, false, expectedErr
+			},
+		}
+		ingester, err := process.NewHyperblockIngester(args)
+		require.Nil(t, ingester)
+		require.Equal(t, expectedErr, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		ingester, err := process.NewHyperblockIngester(createMockArgsHyperblockIngester())
+		require.Nil(t, err)
+		require.False(t, ingester.IsInterfaceNil())
+	})
+}
+
+func TestHyperblockIngester_IngestAvailableHyperblocks(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should start from the configured nonce and follow the head", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsHyperblockIngester()
+		headNonce := uint64(12)
+		args.NonceProvider = &mock.LatestHyperblockNonceProviderStub{
+			GetLatestFullySynchronizedHyperblockNonceCalled: func() (uint64, error) {
+				return headNonce, nil
+			},
+		}
+		args.HyperblockProvider = &mock.HyperblockProviderStub{
+			GetHyperBlockByNonceCalled: func(nonce uint64, options common.HyperblockQueryOptions) (*data.HyperblockApiResponse, error) {
+				require.True(t, options.WithLogs)
+				return createHyperblockResponse(nonce), nil
+			},
+		}
+		indexedNonces := make([]uint64, 0)
+		args.Indexer = &mock.HistoryIndexerStub{
+			IndexHyperblockCalled: func(hyperblock *data.IndexedHyperblock) error {
+				indexedNonces = append(indexedNonces, hyperblock.Nonce)
+				return nil
+			},
+		}
+		ingester, _ := process.NewHyperblockIngester(args)
+
+		require.Equal(t, data.HyperblockIngesterMetrics{Enabled: true, LastIndexedNonce: 9}, ingester.GetMetrics())
+
+		ingester.IngestAvailableHyperblocks()
+		require.Equal(t, []uint64{10, 11, 12}, indexedNonces)
+		require.Equal(t, data.HyperblockIngesterMetrics{Enabled: true, LastIndexedNonce: 12, HeadNonce: 12}, ingester.GetMetrics())
+
+		headNonce = 14
+		ingester.IngestAvailableHyperblocks()
+		require.Equal(t, []uint64{10, 11, 12, 13, 14}, indexedNonces)
+	})
+	t.Run("should resume after the last indexed nonce", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsHyperblockIngester()
+		args.NonceProvider = &mock.LatestHyperblockNonceProviderStub{
+			GetLatestFullySynchronizedHyperblockNonceCalled: func() (uint64, error) {
+				return 21, nil
+			},
+		}
+		args.HyperblockProvider = &mock.HyperblockProviderStub{
+			GetHyperBlockByNonceCalled: func(nonce uint64, _ common.HyperblockQueryOptions) (*data.HyperblockApiResponse, error) {
+				return createHyperblockResponse(nonce), nil
+			},
+		}
+		indexedNonces := make([]uint64, 0)
+		args.Indexer = &mock.HistoryIndexerStub{
+			GetLastIndexedHyperblockNonceCalled: func() (uint64, bool, error) {
+				return 19, true, nil
+			},
+			IndexHyperblockCalled: func(hyperblock *data.IndexedHyperblock) error {
+				indexedNonces = append(indexedNonces, hyperblock.Nonce)
+				return nil
+			},
+		}
+		ingester, _ := process.NewHyperblockIngester(args)
+
+		ingester.IngestAvailableHyperblocks()
+		require.Equal(t, []uint64{20, 21}, indexedNonces)
+	})
+	t.Run("failure should stop the ingestion and report the lag", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsHyperblockIngester()
+		args.NonceProvider = &mock.LatestHyperblockNonceProviderStub{
+			GetLatestFullySynchronizedHyperblockNonceCalled: func() (uint64, error) {
+				return 15, nil
+			},
+		}
+		shouldFail := true
+		args.HyperblockProvider = &mock.HyperblockProviderStub{
+			GetHyperBlockByNonceCalled: func(nonce uint64, _ common.HyperblockQueryOptions) (*data.HyperblockApiResponse, error) {
+				if nonce == 12 && shouldFail {
+					return nil, errors.New("observer down")
+				}
+				return createHyperblockResponse(nonce), nil
+			},
+		}
+		indexedNonces := make([]uint64, 0)
+		args.Indexer = &mock.HistoryIndexerStub{
+			IndexHyperblockCalled: func(hyperblock *data.IndexedHyperblock) error {
+				indexedNonces = append(indexedNonces, hyperblock.Nonce)
+				return nil
+			},
+		}
+		ingester, _ := process.NewHyperblockIngester(args)
+
+		ingester.IngestAvailableHyperblocks()
+		require.Equal(t, []uint64{10, 11}, indexedNonces)
+		require.Equal(t, data.HyperblockIngesterMetrics{Enabled: true, LastIndexedNonce: 11, HeadNonce: 15, Lag: 4}, ingester.GetMetrics())
+
+		shouldFail = false
+		ingester.IngestAvailableHyperblocks()
+		require.Equal(t, []uint64{10, 11, 12, 13, 14, 15}, indexedNonces)
+		require.Equal(t, uint64(0), ingester.GetMetrics().Lag)
+	})
+	t.Run("should stay behind the head by the finality margin", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsHyperblockIngester()
+		args.FinalityMargin = 2
+		headNonce := uint64(11)
+		args.NonceProvider = &mock.LatestHyperblockNonceProviderStub{
+			GetLatestFullySynchronizedHyperblockNonceCalled: func() (uint64, error) {
+				return headNonce, nil
+			},
+		}
+		args.HyperblockProvider = &mock.HyperblockProviderStub{
+			GetHyperBlockByNonceCalled: func(nonce uint64, _ common.HyperblockQueryOptions) (*data.HyperblockApiResponse, error) {
+				return createHyperblockResponse(nonce), nil
+			},
+		}
+		indexedNonces := make([]uint64, 0)
+		args.Indexer = &mock.HistoryIndexerStub{
+			IndexHyperblockCalled: func(hyperblock *data.IndexedHyperblock) error {
+				indexedNonces = append(indexedNonces, hyperblock.Nonce)
+				return nil
+			},
+		}
+		ingester, _ := process.NewHyperblockIngester(args)
+
+		ingester.IngestAvailableHyperblocks()
+		require.Empty(t, indexedNonces)
+
+		headNonce = 13
+		ingester.IngestAvailableHyperblocks()
+		require.Equal(t, []uint64{10, 11}, indexedNonces)
+		require.Equal(t, data.HyperblockIngesterMetrics{Enabled: true, LastIndexedNonce: 11, HeadNonce: 13, Lag: 2}, ingester.GetMetrics())
+	})
+	t.Run("incomplete hyperblock should not be indexed and should be retried", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsHyperblockIngester()
+		args.NonceProvider = &mock.LatestHyperblockNonceProviderStub{
+			GetLatestFullySynchronizedHyperblockNonceCalled: func() (uint64, error) {
+				return 12, nil
+			},
+		}
+		isIncomplete := true
+		args.HyperblockProvider = &mock.HyperblockProviderStub{
+			GetHyperBlockByNonceCalled: func(nonce uint64, _ common.HyperblockQueryOptions) (*data.HyperblockApiResponse, error) {
+				response := createHyperblockResponse(nonce)
+				if nonce == 11 && isIncomplete {
+					response.Data.MissingShards = []uint32{1}
+				}
+				return response, nil
+			},
+		}
+		indexedNonces := make([]uint64, 0)
+		args.Indexer = &mock.HistoryIndexerStub{
+			IndexHyperblockCalled: func(hyperblock *data.IndexedHyperblock) error {
+				indexedNonces = append(indexedNonces, hyperblock.Nonce)
+				return nil
+			},
+		}
+		ingester, _ := process.NewHyperblockIngester(args)
+
+		ingester.IngestAvailableHyperblocks()
+		require.Equal(t, []uint64{10}, indexedNonces)
+		require.Equal(t, uint64(10), ingester.GetMetrics().LastIndexedNonce)
+
+		isIncomplete = false
+		ingester.IngestAvailableHyperblocks()
+		require.Equal(t, []uint64{10, 11, 12}, indexedNonces)
+	})
+}
+
+func TestHyperblockIngester_ConvertsTransactionsEventsAndTransfers(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsHyperblockIngester()
+	sender := "erd1qyu5wthldzr8wx5c9ucg8kjagg0jfs53s8nr3zpz3hypefsdd8ssycr6th"
+	receiverBytes := make([]byte, 32)
+	receiverBytes[31] = 1
+	receiver := args.PubKeyConverter.Encode(receiverBytes)
+
+	tx := &transaction.ApiTransactionResult{
+		Hash:     "txHash",
+		Nonce:    3,
+		Sender:   sender,
+		Receiver: receiver,
+		Fee:      "50000",
+		Status:   transaction.TxStatusSuccess,
+		Function: core.BuiltInFunctionMultiESDTNFTTransfer,
+		Logs: &transaction.ApiLogs{
+			Events: []*transaction.Events{
+				{
+					Address:    sender,
+					Identifier: core.BuiltInFunctionMultiESDTNFTTransfer,
+					Topics: [][]byte{
+						[]byte("TKN-123456"), {}, big.NewInt(1000).Bytes(),
+						[]byte("NFT-abcdef"), {5}, {1},
+						receiverBytes,
+					},
+				},
+				{
+					Address:    receiver,
+					Identifier: "custom",
+					Topics:     [][]byte{[]byte("topic")},
+					Data:       []byte("data"),
+				},
+				{
+					Address:    sender,
+					Identifier: core.BuiltInFunctionESDTTransfer,
+					Topics:     [][]byte{[]byte("TKN-123456"), {}, {1}, []byte("not an address")},
+				},
+			},
+		},
+	}
+	args.NonceProvider = &mock.LatestHyperblockNonceProviderStub{
+		GetLatestFullySynchronizedHyperblockNonceCalled: func() (uint64, error) {
+			return 10, nil
+		},
+	}
+	args.HyperblockProvider = &mock.HyperblockProviderStub{
+		GetHyperBlockByNonceCalled: func(nonce uint64, _ common.HyperblockQueryOptions) (*data.HyperblockApiResponse, error) {
+			return createHyperblockResponse(nonce, tx), nil
+		},
+	}
+	var indexedHyperblock *data.IndexedHyperblock
+	args.Indexer = &mock.HistoryIndexerStub{
+		IndexHyperblockCalled: func(hyperblock *data.IndexedHyperblock) error {
+			indexedHyperblock = hyperblock
+			return nil
+		},
+	}
+	ingester, _ := process.NewHyperblockIngester(args)
+	ingester.IngestAvailableHyperblocks()
+
+	require.NotNil(t, indexedHyperblock)
+	require.Equal(t, uint64(10), indexedHyperblock.Nonce)
+	require.Equal(t, "hash", indexedHyperblock.Hash)
+
+	require.Len(t, indexedHyperblock.Transactions, 1)
+	indexedTx := indexedHyperblock.Transactions[0]
+	require.Equal(t, "txHash", indexedTx.Hash)
+	require.Equal(t, "50000", indexedTx.Fee)
+	require.Equal(t, sender, indexedTx.Sender)
+	require.Equal(t, "success", indexedTx.Status)
+	require.Equal(t, time.Duration(1000), indexedTx.Timestamp)
+	require.True(t, indexedTx.HasLogs)
+
+	require.Len(t, indexedHyperblock.Events, 3)
+	require.Equal(t, data.DatabaseEvent{
+		TxHash:     "txHash",
+		Index:      1,
+		Address:    receiver,
+		Identifier: "custom",
+		Topics:     [][]byte{[]byte("topic")},
+		Data:       []byte("data"),
+		BlockNonce: 10,
+		Timestamp:  1000,
+	}, indexedHyperblock.Events[1])
+
+	require.Equal(t, []data.DatabaseTokenTransfer{
+		{TxHash: "txHash", EventIndex: 0, Index: 0, Token: "TKN-123456", Nonce: 0, Sender: sender, Receiver: receiver, Value: "1000", BlockNonce: 10, Timestamp: 1000},
+		{TxHash: "txHash", EventIndex: 0, Index: 1, Token: "NFT-abcdef", Nonce: 5, Sender: sender, Receiver: receiver, Value: "1", BlockNonce: 10, Timestamp: 1000},
+	}, indexedHyperblock.TokenTransfers)
+}
+
+func TestHyperblockIngester_IndexTokenBalances(t *testing.T) {
+	t.Parallel()
+
+	t.Run("disabled should not request altered accounts", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsHyperblockIngester()
+		args.NonceProvider = &mock.LatestHyperblockNonceProviderStub{
+			GetLatestFullySynchronizedHyperblockNonceCalled: func() (uint64, error) {
+				return 10, nil
+			},
+		}
+		args.HyperblockProvider = &mock.HyperblockProviderStub{
+			GetHyperBlockByNonceCalled: func(nonce uint64, options common.HyperblockQueryOptions) (*data.HyperblockApiResponse, error) {
+				require.False(t, options.WithAlteredAccounts)
+				return createHyperblockResponse(nonce), nil
+			},
+		}
+		var indexedHyperblock *data.IndexedHyperblock
+		args.Indexer = &mock.HistoryIndexerStub{
+			IndexHyperblockCalled: func(hyperblock *data.IndexedHyperblock) error {
+				indexedHyperblock = hyperblock
+				return nil
+			},
+		}
+		ingester, _ := process.NewHyperblockIngester(args)
+		ingester.IngestAvailableHyperblocks()
+
+		require.NotNil(t, indexedHyperblock)
+		require.Empty(t, indexedHyperblock.TokenBalances)
+	})
+	t.Run("enabled should extract the balances of the altered accounts", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsHyperblockIngester()
+		args.IndexTokenBalances = true
+		args.NonceProvider = &mock.LatestHyperblockNonceProviderStub{
+			GetLatestFullySynchronizedHyperblockNonceCalled: func() (uint64, error) {
+				return 10, nil
+			},
+		}
+		args.HyperblockProvider = &mock.HyperblockProviderStub{
+			GetHyperBlockByNonceCalled: func(nonce uint64, options common.HyperblockQueryOptions) (*data.HyperblockApiResponse, error) {
+				require.True(t, options.WithAlteredAccounts)
+				return data.NewHyperblockApiResponse(api.Hyperblock{
+					Nonce: nonce,
+					Hash:  "hash",
+					ShardBlocks: []*api.NotarizedBlock{
+						{
+							Shard: 0,
+							AlteredAccounts: []*outport.AlteredAccount{
+								{
+									Address: "erd1alice",
+									Tokens: []*outport.AccountTokenData{
+										{Identifier: "TKN-123456", Balance: "1000"},
+										{Identifier: "NFT-abcdef", Nonce: 5, Balance: "1"},
+									},
+								},
+								nil,
+							},
+						},
+						nil,
+						{
+							Shard: 1,
+							AlteredAccounts: []*outport.AlteredAccount{
+								{
+									Address: "erd1bob",
+									Tokens:  []*outport.AccountTokenData{{Identifier: "TKN-123456", Balance: "0"}},
+								},
+							},
+						},
+					},
+				}), nil
+			},
+		}
+		var indexedHyperblock *data.IndexedHyperblock
+		args.Indexer = &mock.HistoryIndexerStub{
+			IndexHyperblockCalled: func(hyperblock *data.IndexedHyperblock) error {
+				indexedHyperblock = hyperblock
+				return nil
+			},
+		}
+		ingester, _ := process.NewHyperblockIngester(args)
+		ingester.IngestAvailableHyperblocks()
+
+		require.NotNil(t, indexedHyperblock)
+		require.Equal(t, []data.DatabaseTokenBalance{
+			{Token: "TKN-123456", Nonce: 0, Address: "erd1alice", Balance: "1000", BlockNonce: 10},
+			{Token: "NFT-abcdef", Nonce: 5, Address: "erd1alice", Balance: "1", BlockNonce: 10},
+			{Token: "TKN-123456", Nonce: 0, Address: "erd1bob", Balance: "0", BlockNonce: 10},
+		}, indexedHyperblock.TokenBalances)
+	})
+}
+
+func TestHyperblockIngester_StartIngestingAndClose(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsHyperblockIngester()
+	args.PollingInterval = time.Millisecond * 10
+	args.NonceProvider = &mock.LatestHyperblockNonceProviderStub{
+		GetLatestFullySynchronizedHyperblockNonceCalled: func() (uint64, error) {
+			return 12, nil
+		},
+	}
+	args.HyperblockProvider = &mock.HyperblockProviderStub{
+		GetHyperBlockByNonceCalled: func(nonce uint64, _ common.HyperblockQueryOptions) (*data.HyperblockApiResponse, error) {
+			return createHyperblockResponse(nonce), nil
+		},
+	}
+	mut := sync.Mutex{}
+	numIndexed := 0
+	args.Indexer = &mock.HistoryIndexerStub{
+		IndexHyperblockCalled: func(hyperblock *data.IndexedHyperblock) error {
+			mut.Lock()
+			numIndexed++
+			mut.Unlock()
+			return nil
+		},
+	}
+	ingester, _ := process.NewHyperblockIngester(args)
+
+	ingester.StartIngesting()
+	require.Eventually(t, func() bool {
+		return ingester.GetMetrics().LastIndexedNonce == 12
+	}, time.Second, time.Millisecond*5)
+
+	err := ingester.Close()
+	require.Nil(t, err)
+
+	mut.Lock()
+	require.Equal(t, 3, numIndexed)
+	mut.Unlock()
+}
@@ -0,0 +1,280 @@
+package process
+
+import (
+	"sync"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-proxy-go/common"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+// reorgsSubscriptionBufferSize is the number of reorgs a subscriber can lag behind before the new ones get dropped
+const reorgsSubscriptionBufferSize = 16
+
+// ArgsHyperblockReorgDetector holds the arguments needed to create a hyperblock reorg detector
+type ArgsHyperblockReorgDetector struct {
+	HyperblockProvider    HyperblockProvider
+	NonceProvider         LatestHyperblockNonceProvider
+	MaxTrackedHyperblocks int
+	MaxReorgs             int
+	FinalNonceValidity    time.Duration
+}
+
+// finalNonceFetch is a fetch of the latest fully synchronized hyperblock nonce, shared by the concurrent callers
+type finalNonceFetch struct {
+	done       chan struct{}
+	finalNonce uint64
+	err        error
+}
+
+type servedHyperblock struct {
+	hash    string
+	isFinal bool
+}
+
+// HyperblockReorgDetector serves the hyperblocks built by the wrapped provider, flagging the ones not newer than the
+// latest fully synchronized hyperblock nonce as final. It remembers the hashes of the recently served nonces and, when a
+// nonce is served again with a different hash, it records a reorg and notifies the subscribers
+type HyperblockReorgDetector struct {
+	hyperblockProvider    HyperblockProvider
+	nonceProvider         LatestHyperblockNonceProvider
+	maxTrackedHyperblocks int
+	maxReorgs             int
+	finalNonceValidity    time.Duration
+
+	mutFinalNonce       sync.Mutex
+	finalNonce          uint64
+	finalNonceTimestamp time.Time
+	// pendingFinalNonceFetch is the fetch in progress, if any
+	pendingFinalNonceFetch *finalNonceFetch
+
+	mut              sync.RWMutex
+	served           map[uint64]*servedHyperblock
+	servedNonces     []uint64
+	reorgs           []*data.HyperblockReorg
+	subscribers      map[int]chan *data.HyperblockReorg
+	nextSubscriberID int
+	isClosed         bool
+}
+
+// NewHyperblockReorgDetector creates a new hyperblock reorg detector
+func NewHyperblockReorgDetector(args ArgsHyperblockReorgDetector) (*HyperblockReorgDetector, error) {
+	if check.IfNil(args.HyperblockProvider) {
+		return nil, ErrNilHyperblockProvider
+	}
+	if check.IfNil(args.NonceProvider) {
+		return nil, ErrNilLatestHyperblockNonceProvider
+	}
+	if args.MaxTrackedHyperblocks <= 0 {
+		return nil, ErrInvalidMaxTrackedHyperblocks
+	}
+	if args.MaxReorgs <= 0 {
+		return nil, ErrInvalidMaxReorgs
+	}
+
+	return &HyperblockReorgDetector{
+		hyperblockProvider:    args.HyperblockProvider,
+		nonceProvider:         args.NonceProvider,
+		maxTrackedHyperblocks: args.MaxTrackedHyperblocks,
+		maxReorgs:             args.MaxReorgs,
+		finalNonceValidity:    args.FinalNonceValidity,
+		served:                make(map[uint64]*servedHyperblock),
+		servedNonces:          make([]uint64, 0, args.MaxTrackedHyperblocks),
+		reorgs:                make([]*data.HyperblockReorg, 0, args.MaxReorgs),
+		subscribers:           make(map[int]chan *data.HyperblockReorg),
+	}, nil
+}
+
+// GetHyperBlockByNonce returns the hyperblock by nonce, along with its finality, and remembers its hash
+func (hrd *HyperblockReorgDetector) GetHyperBlockByNonce(nonce uint64, options common.HyperblockQueryOptions) (*data.HyperblockApiResponse, error) {
+	response, err := hrd.hyperblockProvider.GetHyperBlockByNonce(nonce, options)
+	if err != nil {
+		return nil, err
+	}
+
+	hyperblock := &response.Data.Hyperblock
+	response.Data.IsFinal = hrd.isFinal(hyperblock.Nonce)
+	hrd.track(hyperblock.Nonce, hyperblock.Hash, response.Data.IsFinal)
+
+	return response, nil
+}
+
+// GetHyperBlockByHash returns the hyperblock by hash, along with its finality. Since any block can be requested by
+// hash, including the ones that have been reorged out, the served hashes are not remembered
+func (hrd *HyperblockReorgDetector) GetHyperBlockByHash(hash string, options common.HyperblockQueryOptions) (*data.HyperblockApiResponse, error) {
+	response, err := hrd.hyperblockProvider.GetHyperBlockByHash(hash, options)
+	if err != nil {
+		return nil, err
+	}
+
+	hyperblock := &response.Data.Hyperblock
+	response.Data.IsFinal = hrd.isFinal(hyperblock.Nonce) && !hrd.wasReplaced(hyperblock.Nonce, hyperblock.Hash)
+
+	return response, nil
+}
+
+// isFinal compares the nonce against the latest fully synchronized hyperblock nonce, which is only fetched again when
+// the cached one is older than the nonce and has expired, since computing it involves all the shards. The fetch is done
+// outside the lock and the concurrent callers needing it wait for the one already in progress
+func (hrd *HyperblockReorgDetector) isFinal(nonce uint64) bool {
+	hrd.mutFinalNonce.Lock()
+	if nonce <= hrd.finalNonce {
+		hrd.mutFinalNonce.Unlock()
+		return true
+	}
+	if time.Since(hrd.finalNonceTimestamp) < hrd.finalNonceValidity {
+		hrd.mutFinalNonce.Unlock()
+		return false
+	}
+
+	fetch := hrd.pendingFinalNonceFetch
+	if fetch != nil {
+		hrd.mutFinalNonce.Unlock()
+		<-fetch.done
+
+		return fetch.err == nil && nonce <= fetch.finalNonce
+	}
+
+	fetch = &finalNonceFetch{
+		done: make(chan struct{}),
+	}
+	hrd.pendingFinalNonceFetch = fetch
+	hrd.mutFinalNonce.Unlock()
+
+	fetch.finalNonce, fetch.err = hrd.nonceProvider.GetLatestFullySynchronizedHyperblockNonce()
+
+	hrd.mutFinalNonce.Lock()
+	if fetch.err == nil {
+		hrd.finalNonce = fetch.finalNonce
+		hrd.finalNonceTimestamp = time.Now()
+	}
+	hrd.pendingFinalNonceFetch = nil
+	hrd.mutFinalNonce.Unlock()
+	close(fetch.done)
+
+	if fetch.err != nil {
+		log.Debug("HyperblockReorgDetector: cannot get the latest fully synchronized hyperblock nonce", "error", fetch.err)
+		return false
+	}
+
+	return nonce <= fetch.finalNonce
+}
+
+func (hrd *HyperblockReorgDetector) wasReplaced(nonce uint64, hash string) bool {
+	hrd.mut.RLock()
+	defer hrd.mut.RUnlock()
+
+	served, ok := hrd.served[nonce]
+
+	return ok && served.hash != hash
+}
+
+func (hrd *HyperblockReorgDetector) track(nonce uint64, hash string, isFinal bool) {
+	hrd.mut.Lock()
+	defer hrd.mut.Unlock()
+
+	served, ok := hrd.served[nonce]
+	if !ok {
+		hrd.served[nonce] = &servedHyperblock{hash: hash, isFinal: isFinal}
+		hrd.servedNonces = append(hrd.servedNonces, nonce)
+		if len(hrd.servedNonces) > hrd.maxTrackedHyperblocks {
+			delete(hrd.served, hrd.servedNonces[0])
+			hrd.servedNonces = hrd.servedNonces[1:]
+		}
+		return
+	}
+
+	if served.hash == hash {
+		served.isFinal = served.isFinal || isFinal
+		return
+	}
+
+	reorg := &data.HyperblockReorg{
+		Nonce:            nonce,
+		PreviousHash:     served.hash,
+		NewHash:          hash,
+		PreviousWasFinal: served.isFinal,
+		DetectedAt:       time.Now().Unix(),
+	}
+	log.Warn("HyperblockReorgDetector: hyperblock reorg detected", "nonce", nonce,
+		"previous hash", reorg.PreviousHash, "new hash", reorg.NewHash, "previous was final", reorg.PreviousWasFinal)
+
+	served.hash = hash
+	served.isFinal = isFinal
+
+	hrd.reorgs = append(hrd.reorgs, reorg)
+	if len(hrd.reorgs) > hrd.maxReorgs {
+		hrd.reorgs = hrd.reorgs[1:]
+	}
+
+	for _, subscriber := range hrd.subscribers {
+		select {
+		case subscriber <- reorg:
+		default:
+			log.Debug("HyperblockReorgDetector: subscriber is lagging behind, dropping reorg", "nonce", nonce)
+		}
+	}
+}
+
+// GetHyperblockReorgs returns the most recent reorgs, the latest one first
+func (hrd *HyperblockReorgDetector) GetHyperblockReorgs() []*data.HyperblockReorg {
+	hrd.mut.RLock()
+	defer hrd.mut.RUnlock()
+
+	reorgs := make([]*data.HyperblockReorg, 0, len(hrd.reorgs))
+	for i := len(hrd.reorgs) - 1; i >= 0; i-- {
+		reorgs = append(reorgs, hrd.reorgs[i])
+	}
+
+	return reorgs
+}
+
+// SubscribeToHyperblockReorgs returns a channel receiving the reorgs detected from now on and the function that ends
+// the subscription, closing the channel. After the detector is closed, the returned channel is already closed
+func (hrd *HyperblockReorgDetector) SubscribeToHyperblockReorgs() (<-chan *data.HyperblockReorg, func()) {
+	hrd.mut.Lock()
+	defer hrd.mut.Unlock()
+
+	subscriber := make(chan *data.HyperblockReorg, reorgsSubscriptionBufferSize)
+	if hrd.isClosed {
+		close(subscriber)
+		return subscriber, func() {}
+	}
+
+	id := hrd.nextSubscriberID
+	hrd.nextSubscriberID++
+	hrd.subscribers[id] = subscriber
+
+	unsubscribe := func() {
+		hrd.mut.Lock()
+		defer hrd.mut.Unlock()
+
+		_, isSubscribed := hrd.subscribers[id]
+		if isSubscribed {
+			delete(hrd.subscribers, id)
+			close(subscriber)
+		}
+	}
+
+	return subscriber, unsubscribe
+}
+
+// Close ends all the subscriptions, closing their channels, so the streaming requests return when the proxy shuts down
+func (hrd *HyperblockReorgDetector) Close() error {
+	hrd.mut.Lock()
+	defer hrd.mut.Unlock()
+
+	hrd.isClosed = true
+	for id, subscriber := range hrd.subscribers {
+		delete(hrd.subscribers, id)
+		close(subscriber)
+	}
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (hrd *HyperblockReorgDetector) IsInterfaceNil() bool {
+	return hrd == nil
+}
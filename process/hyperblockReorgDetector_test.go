@@ -0,0 +1,302 @@
+package process_test
+
+import (
+	"errors"
+	"fmt"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/data/api"
+	"github.com/multiversx/mx-chain-proxy-go/common"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/multiversx/mx-chain-proxy-go/process"
+	"github.com/multiversx/mx-chain-proxy-go/process/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockArgsHyperblockReorgDetector() process.ArgsHyperblockReorgDetector {
+	return process.ArgsHyperblockReorgDetector{
+		HyperblockProvider:    &mock.HyperblockProviderStub{},
+		NonceProvider:         &mock.LatestHyperblockNonceProviderStub{},
+		MaxTrackedHyperblocks: 10,
+		MaxReorgs:             10,
+		FinalNonceValidity:    time.Minute,
+	}
+}
+
+// createHyperblocksProviderWithHashes returns a provider building, for each nonce, a hyperblock whose hash is the
+// one currently set in the hashes map
+func createHyperblocksProviderWithHashes(hashes map[uint64]string) *mock.HyperblockProviderStub {
+	return &mock.HyperblockProviderStub{
+		GetHyperBlockByNonceCalled: func(nonce uint64, options common.HyperblockQueryOptions) (*data.HyperblockApiResponse, error) {
+			return data.NewHyperblockApiResponse(api.Hyperblock{Nonce: nonce, Hash: hashes[nonce]}), nil
+		},
+	}
+}
+
+func TestNewHyperblockReorgDetector(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil hyperblock provider should err", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsHyperblockReorgDetector()
+		args.HyperblockProvider = nil
+		hrd, err := process.NewHyperblockReorgDetector(args)
+		require.Equal(t, process.ErrNilHyperblockProvider, err)
+		require.True(t, hrd.IsInterfaceNil())
+	})
+	t.Run("nil nonce provider should err", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsHyperblockReorgDetector()
+		args.NonceProvider = nil
+		hrd, err := process.NewHyperblockReorgDetector(args)
+		require.Equal(t, process.ErrNilLatestHyperblockNonceProvider, err)
+		require.Nil(t, hrd)
+	})
+	t.Run("invalid max tracked hyperblocks should err", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsHyperblockReorgDetector()
+		args.MaxTrackedHyperblocks = 0
+		hrd, err := process.NewHyperblockReorgDetector(args)
+		require.Equal(t, process.ErrInvalidMaxTrackedHyperblocks, err)
+		require.Nil(t, hrd)
+	})
+	t.Run("invalid max reorgs should err", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsHyperblockReorgDetector()
+		args.MaxReorgs = 0
+		hrd, err := process.NewHyperblockReorgDetector(args)
+		require.Equal(t, process.ErrInvalidMaxReorgs, err)
+		require.Nil(t, hrd)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		hrd, err := process.NewHyperblockReorgDetector(createMockArgsHyperblockReorgDetector())
+		require.Nil(t, err)
+		require.False(t, hrd.IsInterfaceNil())
+	})
+}
+
+func TestHyperblockReorgDetector_Finality(t *testing.T) {
+	t.Parallel()
+
+	t.Run("the latest synchronized nonce should only be fetched again for newer expired nonces", func(t *testing.T) {
+		t.Parallel()
+
+		numCalls := int32(0)
+		args := createMockArgsHyperblockReorgDetector()
+		args.HyperblockProvider = createHyperblocksProviderWithHashes(map[uint64]string{})
+		args.NonceProvider = &mock.LatestHyperblockNonceProviderStub{
+			GetLatestFullySynchronizedHyperblockNonceCalled: func() (uint64, error) {
+				atomic.AddInt32(&numCalls, 1)
+				return 10, nil
+			},
+		}
+		hrd, _ := process.NewHyperblockReorgDetector(args)
+
+		response, err := hrd.GetHyperBlockByNonce(10, common.HyperblockQueryOptions{})
+		require.Nil(t, err)
+		require.True(t, response.Data.IsFinal)
+
+		response, _ = hrd.GetHyperBlockByNonce(5, common.HyperblockQueryOptions{})
+		require.True(t, response.Data.IsFinal)
+
+		response, _ = hrd.GetHyperBlockByNonce(11, common.HyperblockQueryOptions{})
+		require.False(t, response.Data.IsFinal)
+		require.Equal(t, int32(1), atomic.LoadInt32(&numCalls))
+	})
+	t.Run("concurrent callers should share the same fetch, without blocking the final nonces", func(t *testing.T) {
+		t.Parallel()
+
+		numCalls := int32(0)
+		chanFetchStarted := make(chan struct{})
+		chanReleaseFetch := make(chan struct{})
+		args := createMockArgsHyperblockReorgDetector()
+		args.HyperblockProvider = createHyperblocksProviderWithHashes(map[uint64]string{})
+		args.NonceProvider = &mock.LatestHyperblockNonceProviderStub{
+			GetLatestFullySynchronizedHyperblockNonceCalled: func() (uint64, error) {
+				if atomic.AddInt32(&numCalls, 1) == 1 {
+					close(chanFetchStarted)
+				}
+				<-chanReleaseFetch
+				return 10, nil
+			},
+		}
+		hrd, _ := process.NewHyperblockReorgDetector(args)
+
+		numCallers := 10
+		results := make(chan bool, numCallers)
+		for i := 0; i < numCallers; i++ {
+			go func() {
+				response, _ := hrd.GetHyperBlockByNonce(5, common.HyperblockQueryOptions{})
+				results <- response.Data.IsFinal
+			}()
+		}
+
+		<-chanFetchStarted
+		response, err := hrd.GetHyperBlockByNonce(0, common.HyperblockQueryOptions{})
+		require.Nil(t, err)
+		require.True(t, response.Data.IsFinal)
+
+		close(chanReleaseFetch)
+		for i := 0; i < numCallers; i++ {
+			require.True(t, <-results)
+		}
+		require.Equal(t, int32(1), atomic.LoadInt32(&numCalls))
+	})
+	t.Run("nonce provider error should not flag the hyperblock as final", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsHyperblockReorgDetector()
+		args.HyperblockProvider = createHyperblocksProviderWithHashes(map[uint64]string{})
+		args.NonceProvider = &mock.LatestHyperblockNonceProviderStub{
+			GetLatestFullySynchronizedHyperblockNonceCalled: func() (uint64, error) {
+				return 0, errors.New("expected error")
+			},
+		}
+		hrd, _ := process.NewHyperblockReorgDetector(args)
+
+		response, err := hrd.GetHyperBlockByNonce(1, common.HyperblockQueryOptions{})
+		require.Nil(t, err)
+		require.False(t, response.Data.IsFinal)
+	})
+	t.Run("hyperblock by hash replaced at its nonce should not be final", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsHyperblockReorgDetector()
+		args.HyperblockProvider = &mock.HyperblockProviderStub{
+			GetHyperBlockByNonceCalled: func(nonce uint64, options common.HyperblockQueryOptions) (*data.HyperblockApiResponse, error) {
+				return data.NewHyperblockApiResponse(api.Hyperblock{Nonce: nonce, Hash: "canonical"}), nil
+			},
+			GetHyperBlockByHashCalled: func(hash string, options common.HyperblockQueryOptions) (*data.HyperblockApiResponse, error) {
+				return data.NewHyperblockApiResponse(api.Hyperblock{Nonce: 3, Hash: hash}), nil
+			},
+		}
+		args.NonceProvider = &mock.LatestHyperblockNonceProviderStub{
+			GetLatestFullySynchronizedHyperblockNonceCalled: func() (uint64, error) {
+				return 10, nil
+			},
+		}
+		hrd, _ := process.NewHyperblockReorgDetector(args)
+
+		_, _ = hrd.GetHyperBlockByNonce(3, common.HyperblockQueryOptions{})
+
+		response, err := hrd.GetHyperBlockByHash("canonical", common.HyperblockQueryOptions{})
+		require.Nil(t, err)
+		require.True(t, response.Data.IsFinal)
+
+		response, err = hrd.GetHyperBlockByHash("orphan", common.HyperblockQueryOptions{})
+		require.Nil(t, err)
+		require.False(t, response.Data.IsFinal)
+		require.Empty(t, hrd.GetHyperblockReorgs())
+	})
+	t.Run("provider error should be returned", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		args := createMockArgsHyperblockReorgDetector()
+		args.HyperblockProvider = &mock.HyperblockProviderStub{
+			GetHyperBlockByNonceCalled: func(nonce uint64, options common.HyperblockQueryOptions) (*data.HyperblockApiResponse, error) {
+				return nil, expectedErr
+			},
+		}
+		hrd, _ := process.NewHyperblockReorgDetector(args)
+
+		response, err := hrd.GetHyperBlockByNonce(1, common.HyperblockQueryOptions{})
+		require.Equal(t, expectedErr, err)
+		require.Nil(t, response)
+	})
+}
+
+func TestHyperblockReorgDetector_Reorgs(t *testing.T) {
+	t.Parallel()
+
+	t.Run("a changed hash should be reported to the feed and to the subscribers", func(t *testing.T) {
+		t.Parallel()
+
+		hashes := map[uint64]string{5: "aa", 6: "bb"}
+		args := createMockArgsHyperblockReorgDetector()
+		args.HyperblockProvider = createHyperblocksProviderWithHashes(hashes)
+		args.NonceProvider = &mock.LatestHyperblockNonceProviderStub{
+			GetLatestFullySynchronizedHyperblockNonceCalled: func() (uint64, error) {
+				return 5, nil
+			},
+		}
+		hrd, _ := process.NewHyperblockReorgDetector(args)
+		reorgs, unsubscribe := hrd.SubscribeToHyperblockReorgs()
+
+		_, _ = hrd.GetHyperBlockByNonce(5, common.HyperblockQueryOptions{})
+		_, _ = hrd.GetHyperBlockByNonce(6, common.HyperblockQueryOptions{})
+		_, _ = hrd.GetHyperBlockByNonce(6, common.HyperblockQueryOptions{})
+		require.Empty(t, hrd.GetHyperblockReorgs())
+
+		hashes[5] = "cc"
+		hashes[6] = "dd"
+		_, _ = hrd.GetHyperBlockByNonce(5, common.HyperblockQueryOptions{})
+		_, _ = hrd.GetHyperBlockByNonce(6, common.HyperblockQueryOptions{})
+
+		feed := hrd.GetHyperblockReorgs()
+		require.Len(t, feed, 2)
+		require.Equal(t, uint64(6), feed[0].Nonce)
+		require.Equal(t, "bb", feed[0].PreviousHash)
+		require.Equal(t, "dd", feed[0].NewHash)
+		require.False(t, feed[0].PreviousWasFinal)
+		require.Equal(t, uint64(5), feed[1].Nonce)
+		require.True(t, feed[1].PreviousWasFinal)
+
+		require.Equal(t, feed[1], <-reorgs)
+		require.Equal(t, feed[0], <-reorgs)
+
+		unsubscribe()
+		unsubscribe()
+		_, ok := <-reorgs
+		require.False(t, ok)
+	})
+	t.Run("close should end the subscriptions", func(t *testing.T) {
+		t.Parallel()
+
+		hrd, _ := process.NewHyperblockReorgDetector(createMockArgsHyperblockReorgDetector())
+		reorgs, unsubscribe := hrd.SubscribeToHyperblockReorgs()
+
+		require.Nil(t, hrd.Close())
+		_, ok := <-reorgs
+		require.False(t, ok)
+		unsubscribe()
+
+		reorgs, unsubscribe = hrd.SubscribeToHyperblockReorgs()
+		_, ok = <-reorgs
+		require.False(t, ok)
+		unsubscribe()
+	})
+	t.Run("the oldest tracked hyperblocks and reorgs should be evicted", func(t *testing.T) {
+		t.Parallel()
+
+		hashes := make(map[uint64]string)
+		args := createMockArgsHyperblockReorgDetector()
+		args.HyperblockProvider = createHyperblocksProviderWithHashes(hashes)
+		args.MaxTrackedHyperblocks = 3
+		args.MaxReorgs = 2
+		hrd, _ := process.NewHyperblockReorgDetector(args)
+
+		for nonce := uint64(0); nonce < 4; nonce++ {
+			hashes[nonce] = fmt.Sprintf("first%d", nonce)
+			_, _ = hrd.GetHyperBlockByNonce(nonce, common.HyperblockQueryOptions{})
+		}
+		for nonce := 0; nonce < 4; nonce++ {
+			hashes[uint64(3-nonce)] = fmt.Sprintf("second%d", 3-nonce)
+			_, _ = hrd.GetHyperBlockByNonce(uint64(3-nonce), common.HyperblockQueryOptions{})
+		}
+
+		// nonce 0 was evicted before being served again, while the reorg of nonce 3 was pushed out of the feed
+		feed := hrd.GetHyperblockReorgs()
+		require.Len(t, feed, 2)
+		require.Equal(t, uint64(1), feed[0].Nonce)
+		require.Equal(t, uint64(2), feed[1].Nonce)
+	})
+}
@@ -1,6 +1,10 @@
 package process
 
 import (
+	"encoding/json"
+	"math/big"
+	"time"
+
 	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/data/transaction"
 	"github.com/multiversx/mx-chain-core-go/data/vm"
@@ -8,6 +12,7 @@ import (
 	"github.com/multiversx/mx-chain-proxy-go/common"
 	"github.com/multiversx/mx-chain-proxy-go/data"
 	"github.com/multiversx/mx-chain-proxy-go/observer"
+	"github.com/multiversx/mx-chain-proxy-go/process/abi"
 )
 
 // Processor defines what a processor should be able to do
@@ -26,13 +31,67 @@ type Processor interface {
 	GetPubKeyConverter() core.PubkeyConverter
 	GetObserverProvider() observer.NodesProviderHandler
 	GetFullHistoryNodesProvider() observer.NodesProviderHandler
+	QueryShards(shardIDs []uint32, queryHandler data.ShardQueryHandler) (*data.ShardsQueryResponse, error)
+	QueryShardsStrict(shardIDs []uint32, queryHandler data.ShardQueryHandler) (*data.ShardsQueryResponse, error)
+	QueryShardsBestEffort(shardIDs []uint32, queryHandler data.ShardQueryHandler) *data.ShardsQueryResponse
+	GetRequestsCoalescingMetrics() data.RequestsCoalescingMetrics
+	IsInterfaceNil() bool
+}
+
+// ShardsFanOutHandler defines what a component able to query multiple shards concurrently should do
+type ShardsFanOutHandler interface {
+	QueryShards(shardIDs []uint32, queryHandler data.ShardQueryHandler) (*data.ShardsQueryResponse, error)
+	QueryShardsStrict(shardIDs []uint32, queryHandler data.ShardQueryHandler) (*data.ShardsQueryResponse, error)
+	QueryShardsBestEffort(shardIDs []uint32, queryHandler data.ShardQueryHandler) *data.ShardsQueryResponse
 	IsInterfaceNil() bool
 }
 
 // ExternalStorageConnector defines what a external storage connector should be able to do
 type ExternalStorageConnector interface {
-	GetTransactionsByAddress(address string) ([]data.DatabaseTransaction, error)
+	GetTransactionsByAddress(address string, filter data.TransactionsHistoryFilter) (*data.TransactionsHistoryPage, error)
 	GetAtlasBlockByShardIDAndNonce(shardID uint32, nonce uint64) (data.AtlasBlock, error)
+	GetEvents(filter data.EventsFilter) (*data.EventsPage, error)
+	GetTokenHolders(filter data.TokenHoldersFilter) (*data.TokenHoldersPage, error)
+	GetTokenTransfers(filter data.TokenTransfersFilter) (*data.TokenTransfersPage, error)
+	IsInterfaceNil() bool
+}
+
+// HistoryIndexer defines what an external storage fed by the hyperblock ingester should be able to do
+type HistoryIndexer interface {
+	IndexHyperblock(hyperblock *data.IndexedHyperblock) error
+	GetLastIndexedHyperblockNonce() (uint64, bool, error)
+	IsInterfaceNil() bool
+}
+
+// HyperblockProvider defines what a component able to build hyperblocks should do
+type HyperblockProvider interface {
+	GetHyperBlockByNonce(nonce uint64, options common.HyperblockQueryOptions) (*data.HyperblockApiResponse, error)
+	GetHyperBlockByHash(hash string, options common.HyperblockQueryOptions) (*data.HyperblockApiResponse, error)
+	IsInterfaceNil() bool
+}
+
+// ShardBlocksCache defines what a cache of the shard blocks notarized by hyperblocks should do
+type ShardBlocksCache interface {
+	GetOrFetch(key string, fetch func() (interface{}, error)) (interface{}, error)
+	IsInterfaceNil() bool
+}
+
+// BlockByNonceProvider defines what a component able to fetch shard blocks by nonce should do
+type BlockByNonceProvider interface {
+	GetBlockByNonce(shardID uint32, nonce uint64, options common.BlockQueryOptions) (*data.BlockApiResponse, error)
+	IsInterfaceNil() bool
+}
+
+// LatestHyperblockNonceProvider defines what a component able to compute the latest fully synchronized hyperblock
+// nonce should do
+type LatestHyperblockNonceProvider interface {
+	GetLatestFullySynchronizedHyperblockNonce() (uint64, error)
+	IsInterfaceNil() bool
+}
+
+// HyperblockIngesterMetricsProvider defines what a component exposing the progress of the hyperblock ingester should do
+type HyperblockIngesterMetricsProvider interface {
+	GetMetrics() data.HyperblockIngesterMetrics
 	IsInterfaceNil() bool
 }
 
@@ -41,10 +100,34 @@ type PrivateKeysLoaderHandler interface {
 	PrivateKeysByShard() (map[uint32][]crypto.PrivateKey, error)
 }
 
+// FaucetGuard defines what a component able to enforce the faucet's rules and to keep its ledger should do
+type FaucetGuard interface {
+	Reserve(receiver string, clientIP string, value *big.Int, tokens []*data.FaucetToken, challengeToken string) (*data.FaucetDisbursement, error)
+	Confirm(disbursement *data.FaucetDisbursement) error
+	Cancel(disbursement *data.FaucetDisbursement)
+	GetDisbursements(filter data.FaucetDisbursementsFilter) ([]*data.FaucetDisbursement, error)
+	Close() error
+	IsInterfaceNil() bool
+}
+
+// GasConfigsHandler defines what a component able to provide the network's gas configs should do
+type GasConfigsHandler interface {
+	GetGasConfigs() (*data.TxCostGasConfigs, error)
+	IsInterfaceNil() bool
+}
+
+// NetworkConfigsHandler defines what a component able to provide the network config and the gas configs should do
+type NetworkConfigsHandler interface {
+	GetNetworkConfig() (*data.NetworkConfig, error)
+	GetGasConfigs() (*data.TxCostGasConfigs, error)
+	IsInterfaceNil() bool
+}
+
 // HeartbeatCacheHandler will define what a real heartbeat cacher should do
 type HeartbeatCacheHandler interface {
 	LoadHeartbeats() (*data.HeartbeatResponse, error)
 	StoreHeartbeats(hbts *data.HeartbeatResponse) error
+	GetCacheAge() time.Duration
 	IsInterfaceNil() bool
 }
 
@@ -52,6 +135,7 @@ type HeartbeatCacheHandler interface {
 type ValidatorStatisticsCacheHandler interface {
 	LoadValStats() (map[string]*data.ValidatorApiResponse, error)
 	StoreValStats(valStats map[string]*data.ValidatorApiResponse) error
+	GetCacheAge() time.Duration
 	IsInterfaceNil() bool
 }
 
@@ -59,6 +143,7 @@ type ValidatorStatisticsCacheHandler interface {
 type GenericApiResponseCacheHandler interface {
 	Load() (*data.GenericAPIResponse, error)
 	Store(response *data.GenericAPIResponse)
+	GetCacheAge() time.Duration
 	IsInterfaceNil() bool
 }
 
@@ -75,7 +160,21 @@ type LogsMergerHandler interface {
 
 // SCQueryService defines how data should be get from a SC account
 type SCQueryService interface {
-	ExecuteQuery(query *data.SCQuery) (*vm.VMOutputApi, error)
+	ExecuteQuery(query *data.SCQuery) (*vm.VMOutputApi, data.BlockInfo, error)
+	ExecuteQueries(queries []*data.SCQuery) ([]*data.SCQueryResult, error)
+	IsInterfaceNil() bool
+}
+
+// ABIRegistry defines what an ABI registry should be able to do
+type ABIRegistry interface {
+	GetDefinition(name string) (*abi.Definition, error)
+	IsInterfaceNil() bool
+}
+
+// ABICodec defines what an ABI codec should be able to do
+type ABICodec interface {
+	EncodeArguments(definition *abi.Definition, endpointName string, args []json.RawMessage) ([][]byte, error)
+	DecodeResults(definition *abi.Definition, endpointName string, returnData [][]byte) ([]interface{}, error)
 	IsInterfaceNil() bool
 }
 
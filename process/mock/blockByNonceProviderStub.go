@@ -0,0 +1,25 @@
+package mock
+
+import (
+	"github.com/multiversx/mx-chain-proxy-go/common"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+// BlockByNonceProviderStub -
+type BlockByNonceProviderStub struct {
+	GetBlockByNonceCalled func(shardID uint32, nonce uint64, options common.BlockQueryOptions) (*data.BlockApiResponse, error)
+}
+
+// GetBlockByNonce -
+func (stub *BlockByNonceProviderStub) GetBlockByNonce(shardID uint32, nonce uint64, options common.BlockQueryOptions) (*data.BlockApiResponse, error) {
+	if stub.GetBlockByNonceCalled != nil {
+		return stub.GetBlockByNonceCalled(shardID, nonce, options)
+	}
+
+	return nil, errNotImplemented
+}
+
+// IsInterfaceNil -
+func (stub *BlockByNonceProviderStub) IsInterfaceNil() bool {
+	return stub == nil
+}
@@ -6,8 +6,23 @@ type ElasticSearchConnectorMock struct {
 }
 
 // GetTransactionsByAddress -
-func (escm *ElasticSearchConnectorMock) GetTransactionsByAddress(_ string) ([]data.DatabaseTransaction, error) {
-	return nil, nil
+func (escm *ElasticSearchConnectorMock) GetTransactionsByAddress(_ string, _ data.TransactionsHistoryFilter) (*data.TransactionsHistoryPage, error) {
+	return &data.TransactionsHistoryPage{}, nil
+}
+
+// GetEvents -
+func (escm *ElasticSearchConnectorMock) GetEvents(_ data.EventsFilter) (*data.EventsPage, error) {
+	return &data.EventsPage{}, nil
+}
+
+// GetTokenHolders -
+func (escm *ElasticSearchConnectorMock) GetTokenHolders(_ data.TokenHoldersFilter) (*data.TokenHoldersPage, error) {
+	return &data.TokenHoldersPage{}, nil
+}
+
+// GetTokenTransfers -
+func (escm *ElasticSearchConnectorMock) GetTokenTransfers(_ data.TokenTransfersFilter) (*data.TokenTransfersPage, error) {
+	return &data.TokenTransfersPage{}, nil
 }
 
 // GetAtlasBlockByShardIDAndNonce -
@@ -3,17 +3,20 @@ package mock
 import "github.com/multiversx/mx-chain-proxy-go/data"
 
 type ExternalStorageConnectorStub struct {
-	GetTransactionsByAddressCalled       func(address string) ([]data.DatabaseTransaction, error)
+	GetTransactionsByAddressCalled       func(address string, filter data.TransactionsHistoryFilter) (*data.TransactionsHistoryPage, error)
 	GetAtlasBlockByShardIDAndNonceCalled func(shardID uint32, nonce uint64) (data.AtlasBlock, error)
+	GetEventsCalled                      func(filter data.EventsFilter) (*data.EventsPage, error)
+	GetTokenHoldersCalled                func(filter data.TokenHoldersFilter) (*data.TokenHoldersPage, error)
+	GetTokenTransfersCalled              func(filter data.TokenTransfersFilter) (*data.TokenTransfersPage, error)
 }
 
 // GetTransactionsByAddress -
-func (e *ExternalStorageConnectorStub) GetTransactionsByAddress(address string) ([]data.DatabaseTransaction, error) {
+func (e *ExternalStorageConnectorStub) GetTransactionsByAddress(address string, filter data.TransactionsHistoryFilter) (*data.TransactionsHistoryPage, error) {
 	if e.GetTransactionsByAddressCalled != nil {
-		return e.GetTransactionsByAddressCalled(address)
+		return e.GetTransactionsByAddressCalled(address, filter)
 	}
 
-	return []data.DatabaseTransaction{{Fee: "0"}}, nil
+	return &data.TransactionsHistoryPage{Transactions: []data.DatabaseTransaction{{Fee: "0"}}}, nil
 }
 
 // GetAtlasBlockByShardIDAndNonce -
@@ -25,6 +28,33 @@ func (e *ExternalStorageConnectorStub) GetAtlasBlockByShardIDAndNonce(shardID ui
 	return data.AtlasBlock{Hash: "hash"}, nil
 }
 
+// GetEvents -
+func (e *ExternalStorageConnectorStub) GetEvents(filter data.EventsFilter) (*data.EventsPage, error) {
+	if e.GetEventsCalled != nil {
+		return e.GetEventsCalled(filter)
+	}
+
+	return &data.EventsPage{}, nil
+}
+
+// GetTokenHolders -
+func (e *ExternalStorageConnectorStub) GetTokenHolders(filter data.TokenHoldersFilter) (*data.TokenHoldersPage, error) {
+	if e.GetTokenHoldersCalled != nil {
+		return e.GetTokenHoldersCalled(filter)
+	}
+
+	return &data.TokenHoldersPage{}, nil
+}
+
+// GetTokenTransfers -
+func (e *ExternalStorageConnectorStub) GetTokenTransfers(filter data.TokenTransfersFilter) (*data.TokenTransfersPage, error) {
+	if e.GetTokenTransfersCalled != nil {
+		return e.GetTokenTransfersCalled(filter)
+	}
+
+	return &data.TokenTransfersPage{}, nil
+}
+
 // IsInterfaceNil -
 func (e *ExternalStorageConnectorStub) IsInterfaceNil() bool {
 	return e == nil
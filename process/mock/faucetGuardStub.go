@@ -0,0 +1,64 @@
+package mock
+
+import (
+	"math/big"
+
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+// FaucetGuardStub -
+type FaucetGuardStub struct {
+	ReserveCalled          func(receiver string, clientIP string, value *big.Int, tokens []*data.FaucetToken, challengeToken string) (*data.FaucetDisbursement, error)
+	ConfirmCalled          func(disbursement *data.FaucetDisbursement) error
+	CancelCalled           func(disbursement *data.FaucetDisbursement)
+	GetDisbursementsCalled func(filter data.FaucetDisbursementsFilter) ([]*data.FaucetDisbursement, error)
+}
+
+// Reserve -
+func (stub *FaucetGuardStub) Reserve(receiver string, clientIP string, value *big.Int, tokens []*data.FaucetToken, challengeToken string) (*data.FaucetDisbursement, error) {
+	if stub.ReserveCalled != nil {
+		return stub.ReserveCalled(receiver, clientIP, value, tokens, challengeToken)
+	}
+
+	return &data.FaucetDisbursement{
+		Receiver: receiver,
+		ClientIP: clientIP,
+		Value:    value.String(),
+		Tokens:   tokens,
+	}, nil
+}
+
+// Confirm -
+func (stub *FaucetGuardStub) Confirm(disbursement *data.FaucetDisbursement) error {
+	if stub.ConfirmCalled != nil {
+		return stub.ConfirmCalled(disbursement)
+	}
+
+	return nil
+}
+
+// Cancel -
+func (stub *FaucetGuardStub) Cancel(disbursement *data.FaucetDisbursement) {
+	if stub.CancelCalled != nil {
+		stub.CancelCalled(disbursement)
+	}
+}
+
+// GetDisbursements -
+func (stub *FaucetGuardStub) GetDisbursements(filter data.FaucetDisbursementsFilter) ([]*data.FaucetDisbursement, error) {
+	if stub.GetDisbursementsCalled != nil {
+		return stub.GetDisbursementsCalled(filter)
+	}
+
+	return make([]*data.FaucetDisbursement, 0), nil
+}
+
+// Close -
+func (stub *FaucetGuardStub) Close() error {
+	return nil
+}
+
+// IsInterfaceNil -
+func (stub *FaucetGuardStub) IsInterfaceNil() bool {
+	return stub == nil
+}
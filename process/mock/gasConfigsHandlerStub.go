@@ -0,0 +1,22 @@
+package mock
+
+import "github.com/multiversx/mx-chain-proxy-go/data"
+
+// GasConfigsHandlerStub -
+type GasConfigsHandlerStub struct {
+	GetGasConfigsCalled func() (*data.TxCostGasConfigs, error)
+}
+
+// GetGasConfigs -
+func (stub *GasConfigsHandlerStub) GetGasConfigs() (*data.TxCostGasConfigs, error) {
+	if stub.GetGasConfigsCalled != nil {
+		return stub.GetGasConfigsCalled()
+	}
+
+	return nil, errNotImplemented
+}
+
+// IsInterfaceNil -
+func (stub *GasConfigsHandlerStub) IsInterfaceNil() bool {
+	return stub == nil
+}
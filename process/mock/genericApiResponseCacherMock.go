@@ -3,13 +3,15 @@ package mock
 import (
 	"errors"
 	"sync"
+	"time"
 
 	"github.com/multiversx/mx-chain-proxy-go/data"
 )
 
 // GenericApiResponseCacherMock -
 type GenericApiResponseCacherMock struct {
-	Data *data.GenericAPIResponse
+	Data     *data.GenericAPIResponse
+	CacheAge time.Duration
 	sync.RWMutex
 }
 
@@ -32,6 +34,14 @@ func (g *GenericApiResponseCacherMock) Store(response *data.GenericAPIResponse)
 	g.Unlock()
 }
 
+// GetCacheAge -
+func (g *GenericApiResponseCacherMock) GetCacheAge() time.Duration {
+	g.RLock()
+	defer g.RUnlock()
+
+	return g.CacheAge
+}
+
 // IsInterfaceNil -
 func (g *GenericApiResponseCacherMock) IsInterfaceNil() bool {
 	return g == nil
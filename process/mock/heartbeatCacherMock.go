@@ -2,12 +2,14 @@ package mock
 
 import (
 	"errors"
+	"time"
 
 	"github.com/multiversx/mx-chain-proxy-go/data"
 )
 
 type HeartbeatCacherMock struct {
-	Data *data.HeartbeatResponse
+	Data     *data.HeartbeatResponse
+	CacheAge time.Duration
 }
 
 func (hcm *HeartbeatCacherMock) LoadHeartbeats() (*data.HeartbeatResponse, error) {
@@ -23,6 +25,10 @@ func (hcm *HeartbeatCacherMock) StoreHeartbeats(data *data.HeartbeatResponse) er
 	return nil
 }
 
+func (hcm *HeartbeatCacherMock) GetCacheAge() time.Duration {
+	return hcm.CacheAge
+}
+
 func (hcm *HeartbeatCacherMock) IsInterfaceNil() bool {
 	return hcm == nil
 }
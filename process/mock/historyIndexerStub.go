@@ -0,0 +1,34 @@
+package mock
+
+import (
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+// HistoryIndexerStub -
+type HistoryIndexerStub struct {
+	IndexHyperblockCalled               func(hyperblock *data.IndexedHyperblock) error
+	GetLastIndexedHyperblockNonceCalled func() (uint64, bool, error)
+}
+
+// IndexHyperblock -
+func (stub *HistoryIndexerStub) IndexHyperblock(hyperblock *data.IndexedHyperblock) error {
+	if stub.IndexHyperblockCalled != nil {
+		return stub.IndexHyperblockCalled(hyperblock)
+	}
+
+	return nil
+}
+
+// GetLastIndexedHyperblockNonce -
+func (stub *HistoryIndexerStub) GetLastIndexedHyperblockNonce() (uint64, bool, error) {
+	if stub.GetLastIndexedHyperblockNonceCalled != nil {
+		return stub.GetLastIndexedHyperblockNonceCalled()
+	}
+
+	return 0, false, nil
+}
+
+// IsInterfaceNil -
+func (stub *HistoryIndexerStub) IsInterfaceNil() bool {
+	return stub == nil
+}
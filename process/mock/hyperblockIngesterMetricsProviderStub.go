@@ -0,0 +1,24 @@
+package mock
+
+import (
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+// HyperblockIngesterMetricsProviderStub -
+type HyperblockIngesterMetricsProviderStub struct {
+	GetMetricsCalled func() data.HyperblockIngesterMetrics
+}
+
+// GetMetrics -
+func (stub *HyperblockIngesterMetricsProviderStub) GetMetrics() data.HyperblockIngesterMetrics {
+	if stub.GetMetricsCalled != nil {
+		return stub.GetMetricsCalled()
+	}
+
+	return data.HyperblockIngesterMetrics{}
+}
+
+// IsInterfaceNil -
+func (stub *HyperblockIngesterMetricsProviderStub) IsInterfaceNil() bool {
+	return stub == nil
+}
@@ -0,0 +1,35 @@
+package mock
+
+import (
+	"github.com/multiversx/mx-chain-proxy-go/common"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+// HyperblockProviderStub -
+type HyperblockProviderStub struct {
+	GetHyperBlockByNonceCalled func(nonce uint64, options common.HyperblockQueryOptions) (*data.HyperblockApiResponse, error)
+	GetHyperBlockByHashCalled  func(hash string, options common.HyperblockQueryOptions) (*data.HyperblockApiResponse, error)
+}
+
+// GetHyperBlockByNonce -
+func (stub *HyperblockProviderStub) GetHyperBlockByNonce(nonce uint64, options common.HyperblockQueryOptions) (*data.HyperblockApiResponse, error) {
+	if stub.GetHyperBlockByNonceCalled != nil {
+		return stub.GetHyperBlockByNonceCalled(nonce, options)
+	}
+
+	return nil, errNotImplemented
+}
+
+// GetHyperBlockByHash -
+func (stub *HyperblockProviderStub) GetHyperBlockByHash(hash string, options common.HyperblockQueryOptions) (*data.HyperblockApiResponse, error) {
+	if stub.GetHyperBlockByHashCalled != nil {
+		return stub.GetHyperBlockByHashCalled(hash, options)
+	}
+
+	return nil, errNotImplemented
+}
+
+// IsInterfaceNil -
+func (stub *HyperblockProviderStub) IsInterfaceNil() bool {
+	return stub == nil
+}
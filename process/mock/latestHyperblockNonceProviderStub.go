@@ -0,0 +1,20 @@
+package mock
+
+// LatestHyperblockNonceProviderStub -
+type LatestHyperblockNonceProviderStub struct {
+	GetLatestFullySynchronizedHyperblockNonceCalled func() (uint64, error)
+}
+
+// GetLatestFullySynchronizedHyperblockNonce -
+func (stub *LatestHyperblockNonceProviderStub) GetLatestFullySynchronizedHyperblockNonce() (uint64, error) {
+	if stub.GetLatestFullySynchronizedHyperblockNonceCalled != nil {
+		return stub.GetLatestFullySynchronizedHyperblockNonceCalled()
+	}
+
+	return 0, errNotImplemented
+}
+
+// IsInterfaceNil -
+func (stub *LatestHyperblockNonceProviderStub) IsInterfaceNil() bool {
+	return stub == nil
+}
@@ -0,0 +1,32 @@
+package mock
+
+import "github.com/multiversx/mx-chain-proxy-go/data"
+
+// NetworkConfigsHandlerStub -
+type NetworkConfigsHandlerStub struct {
+	GetNetworkConfigCalled func() (*data.NetworkConfig, error)
+	GetGasConfigsCalled    func() (*data.TxCostGasConfigs, error)
+}
+
+// GetNetworkConfig -
+func (stub *NetworkConfigsHandlerStub) GetNetworkConfig() (*data.NetworkConfig, error) {
+	if stub.GetNetworkConfigCalled != nil {
+		return stub.GetNetworkConfigCalled()
+	}
+
+	return nil, errNotImplemented
+}
+
+// GetGasConfigs -
+func (stub *NetworkConfigsHandlerStub) GetGasConfigs() (*data.TxCostGasConfigs, error) {
+	if stub.GetGasConfigsCalled != nil {
+		return stub.GetGasConfigsCalled()
+	}
+
+	return nil, errNotImplemented
+}
+
+// IsInterfaceNil -
+func (stub *NetworkConfigsHandlerStub) IsInterfaceNil() bool {
+	return stub == nil
+}
@@ -27,6 +27,10 @@ type ProcessorStub struct {
 	GetPubKeyConverterCalled             func() core.PubkeyConverter
 	GetObserverProviderCalled            func() observer.NodesProviderHandler
 	GetFullHistoryNodesProviderCalled    func() observer.NodesProviderHandler
+	QueryShardsCalled                    func(shardIDs []uint32, queryHandler data.ShardQueryHandler) (*data.ShardsQueryResponse, error)
+	QueryShardsStrictCalled              func(shardIDs []uint32, queryHandler data.ShardQueryHandler) (*data.ShardsQueryResponse, error)
+	QueryShardsBestEffortCalled          func(shardIDs []uint32, queryHandler data.ShardQueryHandler) *data.ShardsQueryResponse
+	GetRequestsCoalescingMetricsCalled   func() data.RequestsCoalescingMetrics
 }
 
 // GetShardCoordinator -
@@ -164,6 +168,42 @@ func (ps *ProcessorStub) GetAllFullHistoryNodes() ([]*data.NodeData, error) {
 	return nil, errNotImplemented
 }
 
+// QueryShards will call the QueryShardsCalled handler if not nil, otherwise it will query the shards sequentially
+func (ps *ProcessorStub) QueryShards(shardIDs []uint32, queryHandler data.ShardQueryHandler) (*data.ShardsQueryResponse, error) {
+	if ps.QueryShardsCalled != nil {
+		return ps.QueryShardsCalled(shardIDs, queryHandler)
+	}
+
+	return queryShardsSequentially(shardIDs, queryHandler)
+}
+
+// QueryShardsStrict will call the QueryShardsStrictCalled handler if not nil, otherwise it will query the shards sequentially
+func (ps *ProcessorStub) QueryShardsStrict(shardIDs []uint32, queryHandler data.ShardQueryHandler) (*data.ShardsQueryResponse, error) {
+	if ps.QueryShardsStrictCalled != nil {
+		return ps.QueryShardsStrictCalled(shardIDs, queryHandler)
+	}
+
+	return queryShardsSequentially(shardIDs, queryHandler)
+}
+
+// QueryShardsBestEffort will call the QueryShardsBestEffortCalled handler if not nil, otherwise it will query the shards sequentially
+func (ps *ProcessorStub) QueryShardsBestEffort(shardIDs []uint32, queryHandler data.ShardQueryHandler) *data.ShardsQueryResponse {
+	if ps.QueryShardsBestEffortCalled != nil {
+		return ps.QueryShardsBestEffortCalled(shardIDs, queryHandler)
+	}
+
+	return queryShardsSequentiallyBestEffort(shardIDs, queryHandler)
+}
+
+// GetRequestsCoalescingMetrics -
+func (ps *ProcessorStub) GetRequestsCoalescingMetrics() data.RequestsCoalescingMetrics {
+	if ps.GetRequestsCoalescingMetricsCalled != nil {
+		return ps.GetRequestsCoalescingMetricsCalled()
+	}
+
+	return data.RequestsCoalescingMetrics{}
+}
+
 // IsInterfaceNil -
 func (ps *ProcessorStub) IsInterfaceNil() bool {
 	return ps == nil
@@ -7,14 +7,24 @@ import (
 
 // SCQueryServiceStub is a stub
 type SCQueryServiceStub struct {
-	ExecuteQueryCalled func(*data.SCQuery) (*vm.VMOutputApi, error)
+	ExecuteQueryCalled   func(*data.SCQuery) (*vm.VMOutputApi, data.BlockInfo, error)
+	ExecuteQueriesCalled func(queries []*data.SCQuery) ([]*data.SCQueryResult, error)
 }
 
 // ExecuteQuery is a stub
-func (serviceStub *SCQueryServiceStub) ExecuteQuery(query *data.SCQuery) (*vm.VMOutputApi, error) {
+func (serviceStub *SCQueryServiceStub) ExecuteQuery(query *data.SCQuery) (*vm.VMOutputApi, data.BlockInfo, error) {
 	return serviceStub.ExecuteQueryCalled(query)
 }
 
+// ExecuteQueries is a stub
+func (serviceStub *SCQueryServiceStub) ExecuteQueries(queries []*data.SCQuery) ([]*data.SCQueryResult, error) {
+	if serviceStub.ExecuteQueriesCalled != nil {
+		return serviceStub.ExecuteQueriesCalled(queries)
+	}
+
+	return make([]*data.SCQueryResult, 0), nil
+}
+
 // IsInterfaceNil returns true if the value under the interface is nil
 func (serviceStub *SCQueryServiceStub) IsInterfaceNil() bool {
 	return serviceStub == nil
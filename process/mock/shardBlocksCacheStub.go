@@ -0,0 +1,20 @@
+package mock
+
+// ShardBlocksCacheStub -
+type ShardBlocksCacheStub struct {
+	GetOrFetchCalled func(key string, fetch func() (interface{}, error)) (interface{}, error)
+}
+
+// GetOrFetch -
+func (stub *ShardBlocksCacheStub) GetOrFetch(key string, fetch func() (interface{}, error)) (interface{}, error) {
+	if stub.GetOrFetchCalled != nil {
+		return stub.GetOrFetchCalled(key, fetch)
+	}
+
+	return fetch()
+}
+
+// IsInterfaceNil -
+func (stub *ShardBlocksCacheStub) IsInterfaceNil() bool {
+	return stub == nil
+}
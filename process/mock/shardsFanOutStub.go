@@ -0,0 +1,79 @@
+package mock
+
+import "github.com/multiversx/mx-chain-proxy-go/data"
+
+// ShardsFanOutStub -
+type ShardsFanOutStub struct {
+	QueryShardsCalled           func(shardIDs []uint32, queryHandler data.ShardQueryHandler) (*data.ShardsQueryResponse, error)
+	QueryShardsStrictCalled     func(shardIDs []uint32, queryHandler data.ShardQueryHandler) (*data.ShardsQueryResponse, error)
+	QueryShardsBestEffortCalled func(shardIDs []uint32, queryHandler data.ShardQueryHandler) *data.ShardsQueryResponse
+}
+
+// QueryShards -
+func (stub *ShardsFanOutStub) QueryShards(shardIDs []uint32, queryHandler data.ShardQueryHandler) (*data.ShardsQueryResponse, error) {
+	if stub.QueryShardsCalled != nil {
+		return stub.QueryShardsCalled(shardIDs, queryHandler)
+	}
+
+	return queryShardsSequentially(shardIDs, queryHandler)
+}
+
+// QueryShardsStrict -
+func (stub *ShardsFanOutStub) QueryShardsStrict(shardIDs []uint32, queryHandler data.ShardQueryHandler) (*data.ShardsQueryResponse, error) {
+	if stub.QueryShardsStrictCalled != nil {
+		return stub.QueryShardsStrictCalled(shardIDs, queryHandler)
+	}
+
+	return queryShardsSequentially(shardIDs, queryHandler)
+}
+
+// QueryShardsBestEffort -
+func (stub *ShardsFanOutStub) QueryShardsBestEffort(shardIDs []uint32, queryHandler data.ShardQueryHandler) *data.ShardsQueryResponse {
+	if stub.QueryShardsBestEffortCalled != nil {
+		return stub.QueryShardsBestEffortCalled(shardIDs, queryHandler)
+	}
+
+	return queryShardsSequentiallyBestEffort(shardIDs, queryHandler)
+}
+
+// IsInterfaceNil -
+func (stub *ShardsFanOutStub) IsInterfaceNil() bool {
+	return stub == nil
+}
+
+// queryShardsSequentially is the default behaviour of the stubs: the shards are queried one by one and the first
+// error is returned
+func queryShardsSequentially(shardIDs []uint32, queryHandler data.ShardQueryHandler) (*data.ShardsQueryResponse, error) {
+	response := &data.ShardsQueryResponse{
+		Responses: make([]interface{}, 0, len(shardIDs)),
+	}
+	for idx, shardID := range shardIDs {
+		shardResponse, err := queryHandler(idx, shardID)
+		if err != nil {
+			return nil, err
+		}
+
+		response.Responses = append(response.Responses, shardResponse)
+	}
+
+	return response, nil
+}
+
+// queryShardsSequentiallyBestEffort queries the shards one by one, the shards which returned an error being reported as
+// missing
+func queryShardsSequentiallyBestEffort(shardIDs []uint32, queryHandler data.ShardQueryHandler) *data.ShardsQueryResponse {
+	response := &data.ShardsQueryResponse{
+		Responses: make([]interface{}, len(shardIDs)),
+	}
+	for idx, shardID := range shardIDs {
+		shardResponse, err := queryHandler(idx, shardID)
+		if err != nil {
+			response.MissingShards = append(response.MissingShards, shardID)
+			continue
+		}
+
+		response.Responses[idx] = shardResponse
+	}
+
+	return response
+}
@@ -2,13 +2,15 @@ package mock
 
 import (
 	"errors"
+	"time"
 
 	"github.com/multiversx/mx-chain-proxy-go/data"
 )
 
 // ValStatsCacherMock --
 type ValStatsCacherMock struct {
-	Data map[string]*data.ValidatorApiResponse
+	Data     map[string]*data.ValidatorApiResponse
+	CacheAge time.Duration
 }
 
 // LoadValStats --
@@ -26,6 +28,11 @@ func (vscm *ValStatsCacherMock) StoreValStats(valStats map[string]*data.Validato
 	return nil
 }
 
+// GetCacheAge --
+func (vscm *ValStatsCacherMock) GetCacheAge() time.Duration {
+	return vscm.CacheAge
+}
+
 // IsInterfaceNil --
 func (vscm *ValStatsCacherMock) IsInterfaceNil() bool {
 	return vscm == nil
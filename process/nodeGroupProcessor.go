@@ -107,6 +107,7 @@ func computeTokenStorageKey(tokenID string, nonce uint64) string {
 func (hbp *NodeGroupProcessor) GetHeartbeatData() (*data.HeartbeatResponse, error) {
 	heartbeatsToReturn, err := hbp.cacher.LoadHeartbeats()
 	if err == nil {
+		heartbeatsToReturn.CacheAgeSec = uint64(hbp.cacher.GetCacheAge().Seconds())
 		return heartbeatsToReturn, nil
 	}
 
@@ -118,44 +119,58 @@ func (hbp *NodeGroupProcessor) GetHeartbeatData() (*data.HeartbeatResponse, erro
 func (hbp *NodeGroupProcessor) getHeartbeatsFromApi() (*data.HeartbeatResponse, error) {
 	shardIDs := hbp.proc.GetShardIDs()
 
+	shardsResponse, err := hbp.proc.QueryShards(shardIDs, func(_ int, shardID uint32) (interface{}, error) {
+		return hbp.getHeartbeatsFromShard(shardID)
+	})
+	if err != nil {
+		return nil, err
+	}
+
 	responseMap := make(map[string]data.PubKeyHeartbeat)
-	for _, shard := range shardIDs {
-		observers, err := hbp.proc.GetObservers(shard)
-		if err != nil {
-			log.Error("could not get observers", "shard", shard, "error", err.Error())
+	for idx, response := range shardsResponse.Responses {
+		heartbeats, ok := response.([]data.PubKeyHeartbeat)
+		if !ok {
 			continue
 		}
 
-		errorsCount := 0
-		var response data.HeartbeatApiResponse
-		for _, observer := range observers {
-			_, err = hbp.proc.CallGetRestEndPoint(observer.Address, HeartBeatPath, &response)
-			heartbeats := response.Data.Heartbeats
-			if err == nil && len(heartbeats) > 0 {
-				hbp.addMessagesToMap(responseMap, heartbeats, shard)
-				break
-			}
-
-			errorsCount++
-			errorMsg := "no heartbeat messages"
-			if err != nil {
-				errorMsg = err.Error()
-			}
-			log.Error("heartbeat", "observer", observer.Address, "shard", shard, "error", errorMsg)
-		}
-
-		// If no observer responded from a specific shard, log and return error
-		if errorsCount == len(observers) {
-			log.Error("heartbeat", "error", ErrHeartbeatNotAvailable.Error(), "shard", shard)
-			return nil, ErrHeartbeatNotAvailable
-		}
+		hbp.addMessagesToMap(responseMap, heartbeats, shardIDs[idx])
 	}
 
 	if len(responseMap) == 0 {
 		return nil, ErrHeartbeatNotAvailable
 	}
 
-	return hbp.mapToResponse(responseMap), nil
+	heartbeatResponse := hbp.mapToResponse(responseMap)
+	heartbeatResponse.MissingShards = shardsResponse.MissingShards
+
+	return heartbeatResponse, nil
+}
+
+func (hbp *NodeGroupProcessor) getHeartbeatsFromShard(shardID uint32) ([]data.PubKeyHeartbeat, error) {
+	observers, err := hbp.proc.GetObservers(shardID)
+	if err != nil {
+		log.Error("could not get observers", "shard", shardID, "error", err.Error())
+		return nil, err
+	}
+
+	for _, observer := range observers {
+		var response data.HeartbeatApiResponse
+		_, err = hbp.proc.CallGetRestEndPoint(observer.Address, HeartBeatPath, &response)
+		heartbeats := response.Data.Heartbeats
+		if err == nil && len(heartbeats) > 0 {
+			return heartbeats, nil
+		}
+
+		errorMsg := "no heartbeat messages"
+		if err != nil {
+			errorMsg = err.Error()
+		}
+		log.Error("heartbeat", "observer", observer.Address, "shard", shardID, "error", errorMsg)
+	}
+
+	// If no observer responded from a specific shard, log and return error
+	log.Error("heartbeat", "error", ErrHeartbeatNotAvailable.Error(), "shard", shardID)
+	return nil, ErrHeartbeatNotAvailable
 }
 
 func (hbp *NodeGroupProcessor) addMessagesToMap(responseMap map[string]data.PubKeyHeartbeat, heartbeats []data.PubKeyHeartbeat, observerShard uint32) {
@@ -517,6 +517,59 @@ func TestNodeGroupProcessor_NoDataForAShardShouldNotUpdateCache(t *testing.T) {
 	assert.Nil(t, messages)
 }
 
+func TestNodeGroupProcessor_NoDataForAShardWithPartialResultsShouldReturnMissingShards(t *testing.T) {
+	t.Parallel()
+
+	providedHeartbeatsShard0 := data.HeartbeatResponse{
+		Heartbeats: []data.PubKeyHeartbeat{
+			{
+				NodeDisplayName: "node01",
+				PublicKey:       "pk01",
+				ReceivedShardID: 0,
+			},
+		},
+	}
+
+	shardsFanOut, _ := process.NewShardsFanOut(process.ArgsShardsFanOut{
+		MaxParallelRequests:  2,
+		PerShardTimeout:      time.Second,
+		PartialResultsPolicy: process.ReturnPartialResultsPolicy,
+	})
+	hp, err := process.NewNodeGroupProcessor(
+		&mock.ProcessorStub{
+			GetShardIDsCalled: func() []uint32 {
+				return []uint32{0, 1}
+			},
+			GetObserversCalled: func(shardId uint32) ([]*data.NodeData, error) {
+				return []*data.NodeData{
+					{
+						ShardId: shardId,
+						Address: fmt.Sprintf("addr_%d", shardId),
+					},
+				}, nil
+			},
+			CallGetRestEndPointCalled: func(address string, path string, value interface{}) (int, error) {
+				if address == "addr_1" {
+					return 0, errors.New("expected error")
+				}
+
+				valResponse := value.(*data.HeartbeatApiResponse)
+				valResponse.Data = providedHeartbeatsShard0
+				return 0, nil
+			},
+			QueryShardsCalled: shardsFanOut.QueryShards,
+		},
+		&mock.HeartbeatCacherMock{Data: nil},
+		time.Second,
+	)
+	assert.Nil(t, err)
+
+	messages, err := hp.GetHeartbeatData()
+	assert.Nil(t, err)
+	assert.Equal(t, providedHeartbeatsShard0.Heartbeats, messages.Heartbeats)
+	assert.Equal(t, []uint32{1}, messages.MissingShards)
+}
+
 func TestNodeGroupProcessor_IsOldStorageForToken(t *testing.T) {
 	t.Parallel()
 
@@ -632,3 +685,55 @@ func TestComputeTokenStorageKey(t *testing.T) {
 	expectedKey := append(append([]byte(core.ProtectedKeyPrefix+"esdt"), []byte(testTokenID)...), big.NewInt(int64(testNonce)).Bytes()...)
 	require.Equal(t, hex.EncodeToString(expectedKey), process.ComputeTokenStorageKey(testTokenID, testNonce))
 }
+
+func TestNodeGroupProcessor_NoObserversForAShardWithPartialResultsShouldReturnMissingShards(t *testing.T) {
+	t.Parallel()
+
+	providedHeartbeatsShard0 := data.HeartbeatResponse{
+		Heartbeats: []data.PubKeyHeartbeat{
+			{
+				NodeDisplayName: "node01",
+				PublicKey:       "pk01",
+				ReceivedShardID: 0,
+			},
+		},
+	}
+
+	shardsFanOut, _ := process.NewShardsFanOut(process.ArgsShardsFanOut{
+		MaxParallelRequests:  2,
+		PerShardTimeout:      time.Second,
+		PartialResultsPolicy: process.ReturnPartialResultsPolicy,
+	})
+	hp, _ := process.NewNodeGroupProcessor(
+		&mock.ProcessorStub{
+			GetShardIDsCalled: func() []uint32 {
+				return []uint32{0, 1}
+			},
+			GetObserversCalled: func(shardId uint32) ([]*data.NodeData, error) {
+				if shardId == 1 {
+					return nil, errors.New("expected error")
+				}
+
+				return []*data.NodeData{
+					{
+						ShardId: shardId,
+						Address: fmt.Sprintf("addr_%d", shardId),
+					},
+				}, nil
+			},
+			CallGetRestEndPointCalled: func(address string, path string, value interface{}) (int, error) {
+				valResponse := value.(*data.HeartbeatApiResponse)
+				valResponse.Data = providedHeartbeatsShard0
+				return 0, nil
+			},
+			QueryShardsCalled: shardsFanOut.QueryShards,
+		},
+		&mock.HeartbeatCacherMock{Data: nil},
+		time.Second,
+	)
+
+	messages, err := hp.GetHeartbeatData()
+	assert.Nil(t, err)
+	assert.Equal(t, providedHeartbeatsShard0.Heartbeats, messages.Heartbeats)
+	assert.Equal(t, []uint32{1}, messages.MissingShards)
+}
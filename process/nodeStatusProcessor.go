@@ -293,24 +293,22 @@ func (nsp *NodeStatusProcessor) GetLatestFullySynchronizedHyperblockNonce() (uin
 		return 0, err
 	}
 
-	nonces := make([]uint64, 0)
+	shardIDsSlice := make([]uint32, 0, len(shardsIDs))
 	for shardID := range shardsIDs {
-		nodeStatusResponse, err := nsp.getNodeStatusMetrics(shardID)
-		if err != nil {
-			return 0, err
-		}
+		shardIDsSlice = append(shardIDsSlice, shardID)
+	}
 
-		if nodeStatusResponse.Error != "" {
-			return 0, errors.New(nodeStatusResponse.Error)
-		}
+	// all the shards are required, otherwise the computed nonce might not be fully synchronized
+	shardsResponse, err := nsp.proc.QueryShardsStrict(shardIDsSlice, func(_ int, shardID uint32) (interface{}, error) {
+		return nsp.getLatestNonce(shardID)
+	})
+	if err != nil {
+		return 0, err
+	}
 
-		var nonce uint64
-		var ok bool
-		if shardID == core.MetachainShardId {
-			nonce, ok = getNonceFromMetachainStatus(nodeStatusResponse.Data)
-		} else {
-			nonce, ok = getNonceFromShardStatus(nodeStatusResponse.Data)
-		}
+	nonces := make([]uint64, 0, len(shardsResponse.Responses))
+	for _, response := range shardsResponse.Responses {
+		nonce, ok := response.(uint64)
 		if !ok {
 			return 0, ErrCannotParseNodeStatusMetrics
 		}
@@ -321,6 +319,30 @@ func (nsp *NodeStatusProcessor) GetLatestFullySynchronizedHyperblockNonce() (uin
 	return getMinNonce(nonces), nil
 }
 
+func (nsp *NodeStatusProcessor) getLatestNonce(shardID uint32) (uint64, error) {
+	nodeStatusResponse, err := nsp.getNodeStatusMetrics(shardID)
+	if err != nil {
+		return 0, err
+	}
+
+	if nodeStatusResponse.Error != "" {
+		return 0, errors.New(nodeStatusResponse.Error)
+	}
+
+	var nonce uint64
+	var ok bool
+	if shardID == core.MetachainShardId {
+		nonce, ok = getNonceFromMetachainStatus(nodeStatusResponse.Data)
+	} else {
+		nonce, ok = getNonceFromShardStatus(nodeStatusResponse.Data)
+	}
+	if !ok {
+		return 0, ErrCannotParseNodeStatusMetrics
+	}
+
+	return nonce, nil
+}
+
 // GetTriesStatistics will return trie statistics
 func (nsp *NodeStatusProcessor) GetTriesStatistics(shardID uint32) (*data.TrieStatisticsAPIResponse, error) {
 	nodeStatusResponse, err := nsp.getNodeStatusMetrics(shardID)
@@ -515,3 +537,8 @@ func (nsp *NodeStatusProcessor) GetEpochStartData(epoch uint32, shardID uint32)
 
 	return nil, ErrSendingRequest
 }
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (nsp *NodeStatusProcessor) IsInterfaceNil() bool {
+	return nsp == nil
+}
@@ -0,0 +1,64 @@
+package process
+
+import (
+	"fmt"
+	"runtime/debug"
+	"sync"
+)
+
+type coalescedCall struct {
+	wg    sync.WaitGroup
+	value interface{}
+	err   error
+}
+
+// requestsCoalescer makes sure that concurrent calls sharing the same key result in a single execution of the handler,
+// all the callers receiving the same result
+type requestsCoalescer struct {
+	mut   sync.Mutex
+	calls map[string]*coalescedCall
+}
+
+func newRequestsCoalescer() *requestsCoalescer {
+	return &requestsCoalescer{
+		calls: make(map[string]*coalescedCall),
+	}
+}
+
+// do executes the handler, unless a call with the same key is already in progress, in which case it waits for that
+// call to finish and returns its result. The returned flag is true if the result was shared with another caller. A
+// panic of the handler is recovered and returned, as error, to all the callers
+func (rc *requestsCoalescer) do(key string, handler func() (interface{}, error)) (value interface{}, isShared bool, err error) {
+	rc.mut.Lock()
+	call, found := rc.calls[key]
+	if found {
+		rc.mut.Unlock()
+		call.wg.Wait()
+
+		return call.value, true, call.err
+	}
+
+	call = &coalescedCall{}
+	call.wg.Add(1)
+	rc.calls[key] = call
+	rc.mut.Unlock()
+
+	defer func() {
+		r := recover()
+		if r != nil {
+			log.Error("requestsCoalescer.do: the handler panicked", "key", key, "panic", r, "stack", string(debug.Stack()))
+			call.value, call.err = nil, fmt.Errorf("%w: %v", ErrCoalescedCallPanicked, r)
+			value, err = call.value, call.err
+		}
+
+		rc.mut.Lock()
+		delete(rc.calls, key)
+		rc.mut.Unlock()
+
+		call.wg.Done()
+	}()
+
+	call.value, call.err = handler()
+
+	return call.value, false, call.err
+}
@@ -0,0 +1,105 @@
+package process
+
+import (
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRequestsCoalescer_ConcurrentCallsShouldExecuteOnce(t *testing.T) {
+	t.Parallel()
+
+	rc := newRequestsCoalescer()
+	numExecutions := uint32(0)
+	numShared := uint32(0)
+	release := make(chan struct{})
+
+	numCalls := 10
+	wg := sync.WaitGroup{}
+	wg.Add(numCalls)
+	for i := 0; i < numCalls; i++ {
+		go func() {
+			defer wg.Done()
+
+			value, shared, err := rc.do("key", func() (interface{}, error) {
+				atomic.AddUint32(&numExecutions, 1)
+				<-release
+				return "value", nil
+			})
+			require.Nil(t, err)
+			require.Equal(t, "value", value)
+			if shared {
+				atomic.AddUint32(&numShared, 1)
+			}
+		}()
+	}
+
+	time.Sleep(time.Millisecond * 100)
+	close(release)
+	wg.Wait()
+
+	require.Equal(t, uint32(1), atomic.LoadUint32(&numExecutions))
+	require.Equal(t, uint32(numCalls-1), atomic.LoadUint32(&numShared))
+}
+
+func TestRequestsCoalescer_SequentialCallsShouldExecuteEachTime(t *testing.T) {
+	t.Parallel()
+
+	rc := newRequestsCoalescer()
+	expectedErr := errors.New("expected error")
+	numExecutions := 0
+	for i := 0; i < 3; i++ {
+		_, shared, err := rc.do("key", func() (interface{}, error) {
+			numExecutions++
+			return nil, expectedErr
+		})
+		require.Equal(t, expectedErr, err)
+		require.False(t, shared)
+	}
+
+	require.Equal(t, 3, numExecutions)
+	require.Empty(t, rc.calls)
+}
+
+func TestRequestsCoalescer_PanickingHandlerShouldErrForAllTheCallers(t *testing.T) {
+	t.Parallel()
+
+	rc := newRequestsCoalescer()
+	numExecutions := uint32(0)
+	release := make(chan struct{})
+	numCalls := 10
+	wg := sync.WaitGroup{}
+	wg.Add(numCalls)
+	for i := 0; i < numCalls; i++ {
+		go func() {
+			defer wg.Done()
+
+			value, _, err := rc.do("key", func() (interface{}, error) {
+				atomic.AddUint32(&numExecutions, 1)
+				<-release
+				panic("handler panic")
+			})
+			require.True(t, errors.Is(err, ErrCoalescedCallPanicked))
+			require.Contains(t, err.Error(), "handler panic")
+			require.Nil(t, value)
+		}()
+	}
+
+	time.Sleep(time.Millisecond * 100)
+	close(release)
+	wg.Wait()
+
+	require.Equal(t, uint32(1), atomic.LoadUint32(&numExecutions))
+	require.Empty(t, rc.calls)
+
+	value, shared, err := rc.do("key", func() (interface{}, error) {
+		return "value", nil
+	})
+	require.Nil(t, err)
+	require.False(t, shared)
+	require.Equal(t, "value", value)
+}
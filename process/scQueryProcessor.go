@@ -8,6 +8,7 @@ import (
 	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/core/check"
 	"github.com/multiversx/mx-chain-core-go/data/vm"
+	"github.com/multiversx/mx-chain-proxy-go/common"
 	"github.com/multiversx/mx-chain-proxy-go/data"
 )
 
@@ -16,47 +17,196 @@ const SCQueryServicePath = "/vm-values/query"
 
 // SCQueryProcessor is able to process smart contract queries
 type SCQueryProcessor struct {
-	proc            Processor
-	pubKeyConverter core.PubkeyConverter
+	proc               Processor
+	pubKeyConverter    core.PubkeyConverter
+	maxQueriesPerBatch int
 }
 
 // NewSCQueryProcessor creates a new instance of SCQueryProcessor
-func NewSCQueryProcessor(proc Processor, pubKeyConverter core.PubkeyConverter) (*SCQueryProcessor, error) {
+func NewSCQueryProcessor(proc Processor, pubKeyConverter core.PubkeyConverter, maxQueriesPerBatch int) (*SCQueryProcessor, error) {
 	if check.IfNil(proc) {
 		return nil, ErrNilCoreProcessor
 	}
 	if check.IfNil(pubKeyConverter) {
 		return nil, ErrNilPubKeyConverter
 	}
+	if maxQueriesPerBatch <= 0 {
+		return nil, ErrInvalidMaxQueriesPerBatch
+	}
 
 	return &SCQueryProcessor{
-		proc:            proc,
-		pubKeyConverter: pubKeyConverter,
+		proc:               proc,
+		pubKeyConverter:    pubKeyConverter,
+		maxQueriesPerBatch: maxQueriesPerBatch,
 	}, nil
 }
 
-// ExecuteQuery resolves the request by sending the request to the right observer and replies back the answer
-func (scQueryProcessor *SCQueryProcessor) ExecuteQuery(query *data.SCQuery) (*vm.VMOutputApi, error) {
-	addressBytes, err := scQueryProcessor.pubKeyConverter.Decode(query.ScAddress)
+// ExecuteQuery resolves the request by sending the request to the right observer and replies back the answer. The
+// queries targeting a past block are sent to the full history nodes, if any is available for the shard
+func (scQueryProcessor *SCQueryProcessor) ExecuteQuery(query *data.SCQuery) (*vm.VMOutputApi, data.BlockInfo, error) {
+	shardID, err := scQueryProcessor.computeShardID(query)
 	if err != nil {
-		return nil, err
+		return nil, data.BlockInfo{}, err
+	}
+
+	return scQueryProcessor.executeQueryInShard(query, shardID, core.OptionalUint64{})
+}
+
+// ExecuteQueries executes a batch of queries concurrently and returns the results in the same order. For each shard,
+// a first query is executed on the latest state, the other queries of the same shard being pinned to the block nonce
+// the first one was executed against, so the results are consistent. The queries that explicitly target a past block
+// are not pinned, while the ones which cannot be executed at the pinned block nonce are executed on the latest state
+// and flagged as such. The failure of a query, including the timeout of its shard, is only reported in its result
+func (scQueryProcessor *SCQueryProcessor) ExecuteQueries(queries []*data.SCQuery) ([]*data.SCQueryResult, error) {
+	if len(queries) > scQueryProcessor.maxQueriesPerBatch {
+		return nil, fmt.Errorf("%w: %d queries provided, maximum %d", ErrTooManyQueries, len(queries), scQueryProcessor.maxQueriesPerBatch)
+	}
+
+	results := make([]*data.SCQueryResult, len(queries))
+	queriesShards := make([]uint32, len(queries))
+	firstQueryOfShard := make(map[uint32]int)
+	firstQueriesIndices := make([]int, 0)
+	for idx, query := range queries {
+		shardID, err := scQueryProcessor.computeShardID(query)
+		if err != nil {
+			results[idx] = &data.SCQueryResult{Error: err.Error()}
+			continue
+		}
+
+		queriesShards[idx] = shardID
+		_, found := firstQueryOfShard[shardID]
+		if !found {
+			firstQueryOfShard[shardID] = idx
+			firstQueriesIndices = append(firstQueriesIndices, idx)
+		}
+	}
+
+	scQueryProcessor.executeQueriesInShards(queries, queriesShards, firstQueriesIndices, results, nil)
+
+	pinnedBlockNonces := make(map[uint32]core.OptionalUint64)
+	for shardID, idx := range firstQueryOfShard {
+		blockNonce := results[idx].BlockInfo.Nonce
+		if len(results[idx].Error) == 0 && blockNonce > 0 && !queries[idx].IsHistorical() {
+			pinnedBlockNonces[shardID] = core.OptionalUint64{Value: blockNonce, HasValue: true}
+		}
 	}
 
-	shardID, err := scQueryProcessor.proc.ComputeShardId(addressBytes)
+	remainingIndices := make([]int, 0, len(queries))
+	for idx := range queries {
+		if results[idx] == nil {
+			remainingIndices = append(remainingIndices, idx)
+		}
+	}
+
+	scQueryProcessor.executeQueriesInShards(queries, queriesShards, remainingIndices, results, pinnedBlockNonces)
+
+	return results, nil
+}
+
+func (scQueryProcessor *SCQueryProcessor) executeQueriesInShards(
+	queries []*data.SCQuery,
+	queriesShards []uint32,
+	indices []int,
+	results []*data.SCQueryResult,
+	pinnedBlockNonces map[uint32]core.OptionalUint64,
+) {
+	if len(indices) == 0 {
+		return
+	}
+
+	shardIDs := make([]uint32, len(indices))
+	for i, idx := range indices {
+		shardIDs[i] = queriesShards[idx]
+	}
+
+	response := scQueryProcessor.proc.QueryShardsBestEffort(shardIDs, func(index int, shardID uint32) (interface{}, error) {
+		return scQueryProcessor.executeBatchQuery(queries[indices[index]], shardID, pinnedBlockNonces[shardID]), nil
+	})
+
+	for i, idx := range indices {
+		result, ok := response.Responses[i].(*data.SCQueryResult)
+		if !ok {
+			// the shard did not respond in time
+			result = &data.SCQueryResult{
+				Error: fmt.Errorf("%w, shard %d", ErrShardQueryTimeout, shardIDs[i]).Error(),
+			}
+		}
+
+		results[idx] = result
+	}
+}
+
+// executeBatchQuery executes the query at the pinned block nonce, if any, falling back to the latest state, in which
+// case the result is flagged as unpinned
+func (scQueryProcessor *SCQueryProcessor) executeBatchQuery(
+	query *data.SCQuery,
+	shardID uint32,
+	pinnedBlockNonce core.OptionalUint64,
+) *data.SCQueryResult {
+	isUnpinned := false
+	if pinnedBlockNonce.HasValue && !query.IsHistorical() {
+		vmOutput, blockInfo, errPinned := scQueryProcessor.executeQueryInShard(query, shardID, pinnedBlockNonce)
+		if errPinned == nil {
+			return &data.SCQueryResult{
+				Data:      vmOutput,
+				BlockInfo: blockInfo,
+			}
+		}
+
+		log.Debug("SC query: cannot execute the query at the pinned block nonce, retrying on the latest state",
+			"shard", shardID, "block nonce", pinnedBlockNonce.Value, "error", errPinned.Error())
+		isUnpinned = true
+	}
+
+	vmOutput, blockInfo, err := scQueryProcessor.executeQueryInShard(query, shardID, core.OptionalUint64{})
 	if err != nil {
-		return nil, err
+		return &data.SCQueryResult{
+			Error:    err.Error(),
+			Unpinned: isUnpinned,
+		}
+	}
+
+	return &data.SCQueryResult{
+		Data:      vmOutput,
+		BlockInfo: blockInfo,
+		Unpinned:  isUnpinned,
 	}
+}
+
+func (scQueryProcessor *SCQueryProcessor) computeShardID(query *data.SCQuery) (uint32, error) {
+	addressBytes, err := scQueryProcessor.pubKeyConverter.Decode(query.ScAddress)
+	if err != nil {
+		return 0, err
+	}
+
+	return scQueryProcessor.proc.ComputeShardId(addressBytes)
+}
 
-	observers, err := scQueryProcessor.proc.GetObservers(shardID)
+func (scQueryProcessor *SCQueryProcessor) executeQueryInShard(
+	query *data.SCQuery,
+	shardID uint32,
+	pinnedBlockNonce core.OptionalUint64,
+) (*vm.VMOutputApi, data.BlockInfo, error) {
+	observers, err := scQueryProcessor.getNodesForQuery(query, shardID)
 	if err != nil {
-		return nil, err
+		return nil, data.BlockInfo{}, err
 	}
 
+	return scQueryProcessor.sendQuery(query, pinnedBlockNonce, shardID, observers)
+}
+
+func (scQueryProcessor *SCQueryProcessor) sendQuery(
+	query *data.SCQuery,
+	pinnedBlockNonce core.OptionalUint64,
+	shardID uint32,
+	observers []*data.NodeData,
+) (*vm.VMOutputApi, data.BlockInfo, error) {
+	path := scQueryProcessor.createPathFromQuery(query, pinnedBlockNonce)
 	for _, observer := range observers {
 		request := scQueryProcessor.createRequestFromQuery(query)
 		response := &data.ResponseVmValue{}
 
-		httpStatus, err := scQueryProcessor.proc.CallPostRestEndPoint(observer.Address, SCQueryServicePath, request, response)
+		httpStatus, err := scQueryProcessor.proc.CallPostRestEndPoint(observer.Address, path, request, response)
 		isObserverDown := httpStatus == http.StatusNotFound || httpStatus == http.StatusRequestTimeout
 		isOk := httpStatus == http.StatusOK
 		responseHasExplicitError := len(response.Error) > 0
@@ -68,25 +218,61 @@ func (scQueryProcessor *SCQueryProcessor) ExecuteQuery(query *data.SCQuery) (*vm
 
 		if isOk {
 			log.Debug("SC query sent successfully, received response", "observer", observer.Address, "shard", shardID)
-			return response.Data.Data, nil
+			return response.Data.Data, response.Data.BlockInfo, nil
 		}
 
 		if responseHasExplicitError {
-			return nil, fmt.Errorf(response.Error)
+			return nil, data.BlockInfo{}, fmt.Errorf(response.Error)
 		}
 
-		return nil, err
+		return nil, data.BlockInfo{}, err
+	}
+
+	return nil, data.BlockInfo{}, ErrSendingRequest
+}
+
+func (scQueryProcessor *SCQueryProcessor) getNodesForQuery(query *data.SCQuery, shardID uint32) ([]*data.NodeData, error) {
+	if !query.IsHistorical() {
+		return scQueryProcessor.proc.GetObservers(shardID)
+	}
+
+	fullHistoryNodes, err := scQueryProcessor.proc.GetFullHistoryNodes(shardID)
+	if err == nil {
+		return fullHistoryNodes, nil
+	}
+
+	log.Debug("SC query: no full history node available, the historical query will be sent to the observers",
+		"shard", shardID, "error", err.Error())
+
+	return scQueryProcessor.proc.GetObservers(shardID)
+}
+
+func (scQueryProcessor *SCQueryProcessor) createPathFromQuery(query *data.SCQuery, pinnedBlockNonce core.OptionalUint64) string {
+	blockNonce := query.BlockNonce
+	if pinnedBlockNonce.HasValue {
+		blockNonce = pinnedBlockNonce
+	}
+
+	options := common.AccountQueryOptions{
+		BlockNonce:    blockNonce,
+		BlockHash:     query.BlockHash,
+		BlockRootHash: query.BlockRootHash,
+		HintEpoch:     query.HintEpoch,
 	}
 
-	return nil, ErrSendingRequest
+	return common.BuildUrlWithAccountQueryOptions(SCQueryServicePath, options)
 }
 
+// createRequestFromQuery builds the request sent to the observers. The sameScState and shouldBeSynced flags of the
+// query are forwarded as well, so the observers honor them instead of using their defaults
 func (scQueryProcessor *SCQueryProcessor) createRequestFromQuery(query *data.SCQuery) data.VmValueRequest {
 	request := data.VmValueRequest{}
 	request.Address = query.ScAddress
 	request.FuncName = query.FuncName
 	request.CallValue = query.CallValue
 	request.CallerAddr = query.CallerAddr
+	request.SameScState = query.SameScState
+	request.ShouldBeSynced = query.ShouldBeSynced
 	request.Args = make([]string, len(query.Arguments))
 	for i, argument := range query.Arguments {
 		argumentAsHex := hex.EncodeToString(argument)
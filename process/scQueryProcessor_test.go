@@ -2,9 +2,13 @@ package process
 
 import (
 	"errors"
+	"fmt"
 	"net/http"
+	"sync"
 	"testing"
+	"time"
 
+	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/core/pubkeyConverter"
 	"github.com/multiversx/mx-chain-core-go/data/vm"
 	"github.com/multiversx/mx-chain-proxy-go/data"
@@ -18,7 +22,7 @@ var dummyScAddress = "erd1l453hd0gt5gzdp7czpuall8ggt2dcv5zwmfdf3sd3lguxseux2fsms
 func TestNewSCQueryProcessor_NilCoreProcessorShouldErr(t *testing.T) {
 	t.Parallel()
 
-	processor, err := NewSCQueryProcessor(nil, testPubKeyConverter)
+	processor, err := NewSCQueryProcessor(nil, testPubKeyConverter, 10)
 	require.Nil(t, processor)
 	require.Equal(t, ErrNilCoreProcessor, err)
 }
@@ -26,7 +30,7 @@ func TestNewSCQueryProcessor_NilCoreProcessorShouldErr(t *testing.T) {
 func TestNewSCQueryProcessor_NilPubConverterShouldErr(t *testing.T) {
 	t.Parallel()
 
-	processor, err := NewSCQueryProcessor(&mock.ProcessorStub{}, nil)
+	processor, err := NewSCQueryProcessor(&mock.ProcessorStub{}, nil, 10)
 	require.Nil(t, processor)
 	require.Equal(t, ErrNilPubKeyConverter, err)
 }
@@ -34,11 +38,19 @@ func TestNewSCQueryProcessor_NilPubConverterShouldErr(t *testing.T) {
 func TestNewSCQueryProcessor_WithCoreProcessor(t *testing.T) {
 	t.Parallel()
 
-	processor, err := NewSCQueryProcessor(&mock.ProcessorStub{}, testPubKeyConverter)
+	processor, err := NewSCQueryProcessor(&mock.ProcessorStub{}, testPubKeyConverter, 10)
 	require.NotNil(t, processor)
 	require.Nil(t, err)
 }
 
+func TestNewSCQueryProcessor_InvalidMaxQueriesPerBatchShouldErr(t *testing.T) {
+	t.Parallel()
+
+	processor, err := NewSCQueryProcessor(&mock.ProcessorStub{}, testPubKeyConverter, 0)
+	require.Nil(t, processor)
+	require.Equal(t, ErrInvalidMaxQueriesPerBatch, err)
+}
+
 func TestSCQueryProcessor_ExecuteQueryComputeShardIdFailsShouldErr(t *testing.T) {
 	t.Parallel()
 
@@ -47,9 +59,9 @@ func TestSCQueryProcessor_ExecuteQueryComputeShardIdFailsShouldErr(t *testing.T)
 		ComputeShardIdCalled: func(addressBuff []byte) (u uint32, e error) {
 			return 0, errExpected
 		},
-	}, testPubKeyConverter)
+	}, testPubKeyConverter, 10)
 
-	value, err := processor.ExecuteQuery(&data.SCQuery{ScAddress: dummyScAddress})
+	value, _, err := processor.ExecuteQuery(&data.SCQuery{ScAddress: dummyScAddress})
 	require.Empty(t, value)
 	require.Equal(t, errExpected, err)
 }
@@ -65,9 +77,9 @@ func TestSCQueryProcessor_ExecuteQueryGetObserversFailsShouldErr(t *testing.T) {
 		GetObserversCalled: func(shardId uint32) (observers []*data.NodeData, e error) {
 			return nil, errExpected
 		},
-	}, testPubKeyConverter)
+	}, testPubKeyConverter, 10)
 
-	value, err := processor.ExecuteQuery(&data.SCQuery{ScAddress: dummyScAddress})
+	value, _, err := processor.ExecuteQuery(&data.SCQuery{ScAddress: dummyScAddress})
 	require.Empty(t, value)
 	require.Equal(t, errExpected, err)
 }
@@ -89,9 +101,9 @@ func TestSCQueryProcessor_ExecuteQuerySendingFailsOnAllObserversShouldErr(t *tes
 		CallPostRestEndPointCalled: func(address string, path string, data interface{}, response interface{}) (int, error) {
 			return http.StatusNotFound, errExpected
 		},
-	}, testPubKeyConverter)
+	}, testPubKeyConverter, 10)
 
-	value, err := processor.ExecuteQuery(&data.SCQuery{ScAddress: dummyScAddress})
+	value, _, err := processor.ExecuteQuery(&data.SCQuery{ScAddress: dummyScAddress})
 	require.Empty(t, value)
 	require.Equal(t, ErrSendingRequest, err)
 }
@@ -115,9 +127,9 @@ func TestSCQueryProcessor_ExecuteQuery(t *testing.T) {
 
 			return http.StatusOK, nil
 		},
-	}, testPubKeyConverter)
+	}, testPubKeyConverter, 10)
 
-	value, err := processor.ExecuteQuery(&data.SCQuery{
+	value, _, err := processor.ExecuteQuery(&data.SCQuery{
 		ScAddress: dummyScAddress,
 		FuncName:  "function",
 		Arguments: [][]byte{[]byte("aa")},
@@ -127,6 +139,39 @@ func TestSCQueryProcessor_ExecuteQuery(t *testing.T) {
 	require.Equal(t, byte(42), value.ReturnData[0][0])
 }
 
+func TestSCQueryProcessor_ExecuteQueryShouldForwardTheStateFlags(t *testing.T) {
+	t.Parallel()
+
+	var sentRequest data.VmValueRequest
+	processor, _ := NewSCQueryProcessor(&mock.ProcessorStub{
+		ComputeShardIdCalled: func(addressBuff []byte) (u uint32, e error) {
+			return 0, nil
+		},
+		GetObserversCalled: func(shardId uint32) (observers []*data.NodeData, e error) {
+			return []*data.NodeData{
+				{Address: "adress1", ShardId: 0},
+			}, nil
+		},
+		CallPostRestEndPointCalled: func(address string, path string, dataValue interface{}, response interface{}) (int, error) {
+			sentRequest = dataValue.(data.VmValueRequest)
+			response.(*data.ResponseVmValue).Data.Data = &vm.VMOutputApi{}
+
+			return http.StatusOK, nil
+		},
+	}, testPubKeyConverter, 10)
+
+	_, _, err := processor.ExecuteQuery(&data.SCQuery{
+		ScAddress:      dummyScAddress,
+		FuncName:       "function",
+		SameScState:    true,
+		ShouldBeSynced: true,
+	})
+
+	require.Nil(t, err)
+	require.True(t, sentRequest.SameScState)
+	require.True(t, sentRequest.ShouldBeSynced)
+}
+
 func TestSCQueryProcessor_ExecuteQueryFailsOnRandomErrorShouldErr(t *testing.T) {
 	t.Parallel()
 
@@ -144,9 +189,9 @@ func TestSCQueryProcessor_ExecuteQueryFailsOnRandomErrorShouldErr(t *testing.T)
 		CallPostRestEndPointCalled: func(address string, path string, data interface{}, response interface{}) (int, error) {
 			return http.StatusInternalServerError, errExpected
 		},
-	}, testPubKeyConverter)
+	}, testPubKeyConverter, 10)
 
-	value, err := processor.ExecuteQuery(&data.SCQuery{ScAddress: dummyScAddress})
+	value, _, err := processor.ExecuteQuery(&data.SCQuery{ScAddress: dummyScAddress})
 	require.Empty(t, value)
 	require.Equal(t, errExpected, err)
 }
@@ -169,9 +214,260 @@ func TestSCQueryProcessor_ExecuteQueryFailsOnBadRequestWithExplicitErrorShouldEr
 			response.(*data.ResponseVmValue).Error = errExpected.Error()
 			return http.StatusBadRequest, nil
 		},
-	}, testPubKeyConverter)
+	}, testPubKeyConverter, 10)
 
-	value, err := processor.ExecuteQuery(&data.SCQuery{ScAddress: dummyScAddress})
+	value, _, err := processor.ExecuteQuery(&data.SCQuery{ScAddress: dummyScAddress})
 	require.Empty(t, value)
 	require.Equal(t, errExpected, err)
 }
+
+func TestSCQueryProcessor_ExecuteQueryAtPastBlock(t *testing.T) {
+	t.Parallel()
+
+	providedBlockInfo := data.BlockInfo{
+		Nonce:    37,
+		Hash:     "abcd",
+		RootHash: "ef01",
+	}
+
+	t.Run("should send the query to the full history nodes", func(t *testing.T) {
+		t.Parallel()
+
+		calledPath := ""
+		calledAddress := ""
+		processor, _ := NewSCQueryProcessor(&mock.ProcessorStub{
+			ComputeShardIdCalled: func(addressBuff []byte) (u uint32, e error) {
+				return 0, nil
+			},
+			GetObserversCalled: func(shardId uint32) (observers []*data.NodeData, e error) {
+				require.Fail(t, "should have not been called")
+				return nil, nil
+			},
+			GetFullHistoryNodesCalled: func(shardId uint32) ([]*data.NodeData, error) {
+				return []*data.NodeData{
+					{Address: "full history node", ShardId: 0},
+				}, nil
+			},
+			CallPostRestEndPointCalled: func(address string, path string, dataValue interface{}, response interface{}) (int, error) {
+				calledAddress = address
+				calledPath = path
+				response.(*data.ResponseVmValue).Data.Data = &vm.VMOutputApi{
+					ReturnData: [][]byte{{42}},
+				}
+				response.(*data.ResponseVmValue).Data.BlockInfo = providedBlockInfo
+
+				return http.StatusOK, nil
+			},
+		}, testPubKeyConverter, 10)
+
+		value, blockInfo, err := processor.ExecuteQuery(&data.SCQuery{
+			ScAddress:  dummyScAddress,
+			FuncName:   "function",
+			BlockNonce: core.OptionalUint64{Value: 37, HasValue: true},
+			HintEpoch:  core.OptionalUint32{Value: 2, HasValue: true},
+		})
+		require.Nil(t, err)
+		require.Equal(t, byte(42), value.ReturnData[0][0])
+		require.Equal(t, providedBlockInfo, blockInfo)
+		require.Equal(t, "full history node", calledAddress)
+		require.Equal(t, "/vm-values/query?blockNonce=37&hintEpoch=2", calledPath)
+	})
+
+	t.Run("no full history node should fallback to observers", func(t *testing.T) {
+		t.Parallel()
+
+		calledPath := ""
+		calledAddress := ""
+		processor, _ := NewSCQueryProcessor(&mock.ProcessorStub{
+			ComputeShardIdCalled: func(addressBuff []byte) (u uint32, e error) {
+				return 0, nil
+			},
+			GetObserversCalled: func(shardId uint32) (observers []*data.NodeData, e error) {
+				return []*data.NodeData{
+					{Address: "observer", ShardId: 0},
+				}, nil
+			},
+			GetFullHistoryNodesCalled: func(shardId uint32) ([]*data.NodeData, error) {
+				return nil, errors.New("no full history node")
+			},
+			CallPostRestEndPointCalled: func(address string, path string, dataValue interface{}, response interface{}) (int, error) {
+				calledAddress = address
+				calledPath = path
+				response.(*data.ResponseVmValue).Data.BlockInfo = providedBlockInfo
+
+				return http.StatusOK, nil
+			},
+		}, testPubKeyConverter, 10)
+
+		_, blockInfo, err := processor.ExecuteQuery(&data.SCQuery{
+			ScAddress: dummyScAddress,
+			FuncName:  "function",
+			BlockHash: []byte{0xab, 0xcd},
+		})
+		require.Nil(t, err)
+		require.Equal(t, providedBlockInfo, blockInfo)
+		require.Equal(t, "observer", calledAddress)
+		require.Equal(t, "/vm-values/query?blockHash=abcd", calledPath)
+	})
+
+	t.Run("hint epoch alone should not route to the full history nodes", func(t *testing.T) {
+		t.Parallel()
+
+		processor, _ := NewSCQueryProcessor(&mock.ProcessorStub{
+			ComputeShardIdCalled: func(addressBuff []byte) (u uint32, e error) {
+				return 0, nil
+			},
+			GetObserversCalled: func(shardId uint32) (observers []*data.NodeData, e error) {
+				return []*data.NodeData{
+					{Address: "observer", ShardId: 0},
+				}, nil
+			},
+			GetFullHistoryNodesCalled: func(shardId uint32) ([]*data.NodeData, error) {
+				require.Fail(t, "should have not been called")
+				return nil, nil
+			},
+			CallPostRestEndPointCalled: func(address string, path string, dataValue interface{}, response interface{}) (int, error) {
+				return http.StatusOK, nil
+			},
+		}, testPubKeyConverter, 10)
+
+		_, _, err := processor.ExecuteQuery(&data.SCQuery{
+			ScAddress: dummyScAddress,
+			HintEpoch: core.OptionalUint32{Value: 2, HasValue: true},
+		})
+		require.Nil(t, err)
+	})
+}
+
+func TestSCQueryProcessor_ExecuteQueries(t *testing.T) {
+	t.Parallel()
+
+	shard0Address := "erd1qqqqqqqqqqqqqpgqp699jngundfqw07d8jzkepucvpzush6k3wvqyc44rx"
+	shard1Address := dummyScAddress
+	invalidAddress := "invalid address"
+
+	mutPaths := sync.Mutex{}
+	calledPaths := make(map[string]int)
+	processor, _ := NewSCQueryProcessor(&mock.ProcessorStub{
+		ComputeShardIdCalled: func(addressBuff []byte) (u uint32, e error) {
+			if testPubKeyConverter.Encode(addressBuff) == shard0Address {
+				return 0, nil
+			}
+
+			return 1, nil
+		},
+		GetObserversCalled: func(shardId uint32) (observers []*data.NodeData, e error) {
+			return []*data.NodeData{
+				{Address: fmt.Sprintf("observer%d", shardId), ShardId: shardId},
+			}, nil
+		},
+		CallPostRestEndPointCalled: func(address string, path string, dataValue interface{}, response interface{}) (int, error) {
+			mutPaths.Lock()
+			calledPaths[address+path]++
+			mutPaths.Unlock()
+
+			request := dataValue.(data.VmValueRequest)
+			vmResponse := response.(*data.ResponseVmValue)
+			if request.FuncName == "fail" {
+				vmResponse.Error = "execution failed"
+				return http.StatusBadRequest, nil
+			}
+
+			vmResponse.Data.Data = &vm.VMOutputApi{
+				ReturnMessage: request.FuncName,
+			}
+			vmResponse.Data.BlockInfo = data.BlockInfo{Nonce: 100}
+			if address == "observer1" {
+				vmResponse.Data.BlockInfo.Nonce = 200
+			}
+
+			return http.StatusOK, nil
+		},
+	}, testPubKeyConverter, 10)
+
+	results, err := processor.ExecuteQueries([]*data.SCQuery{
+		{ScAddress: shard0Address, FuncName: "first"},
+		{ScAddress: shard1Address, FuncName: "second"},
+		{ScAddress: invalidAddress, FuncName: "third"},
+		{ScAddress: shard0Address, FuncName: "fourth"},
+		{ScAddress: shard1Address, FuncName: "fail"},
+	})
+	require.Nil(t, err)
+	require.Equal(t, 5, len(results))
+
+	require.Equal(t, "first", results[0].Data.ReturnMessage)
+	require.Equal(t, "second", results[1].Data.ReturnMessage)
+	require.Nil(t, results[2].Data)
+	require.NotEmpty(t, results[2].Error)
+	require.Equal(t, "fourth", results[3].Data.ReturnMessage)
+	require.Equal(t, uint64(100), results[3].BlockInfo.Nonce)
+	require.False(t, results[3].Unpinned)
+	require.Nil(t, results[4].Data)
+	require.Equal(t, "execution failed", results[4].Error)
+	require.True(t, results[4].Unpinned)
+
+	mutPaths.Lock()
+	defer mutPaths.Unlock()
+	// the first query of each shard is executed on the latest state, the other ones are pinned
+	require.Equal(t, 1, calledPaths["observer0/vm-values/query"])
+	require.Equal(t, 1, calledPaths["observer0/vm-values/query?blockNonce=100"])
+	require.Equal(t, 1, calledPaths["observer1/vm-values/query?blockNonce=200"])
+	// the failed pinned query is retried on the latest state
+	require.Equal(t, 2, calledPaths["observer1/vm-values/query"])
+}
+
+func TestSCQueryProcessor_ExecuteQueriesTooManyQueriesShouldErr(t *testing.T) {
+	t.Parallel()
+
+	processor, _ := NewSCQueryProcessor(&mock.ProcessorStub{}, testPubKeyConverter, 2)
+
+	results, err := processor.ExecuteQueries([]*data.SCQuery{{}, {}, {}})
+	require.Nil(t, results)
+	require.True(t, errors.Is(err, ErrTooManyQueries))
+}
+
+func TestSCQueryProcessor_ExecuteQueriesShardTimeoutShouldOnlyFailItsQueries(t *testing.T) {
+	t.Parallel()
+
+	shard0Address := "erd1qqqqqqqqqqqqqpgqp699jngundfqw07d8jzkepucvpzush6k3wvqyc44rx"
+	shard1Address := dummyScAddress
+
+	shardsFanOut, _ := NewShardsFanOut(ArgsShardsFanOut{
+		MaxParallelRequests:  2,
+		PerShardTimeout:      50 * time.Millisecond,
+		PartialResultsPolicy: FailOnMissingShardsPolicy,
+	})
+	processor, _ := NewSCQueryProcessor(&mock.ProcessorStub{
+		ComputeShardIdCalled: func(addressBuff []byte) (u uint32, e error) {
+			if testPubKeyConverter.Encode(addressBuff) == shard0Address {
+				return 0, nil
+			}
+
+			return 1, nil
+		},
+		GetObserversCalled: func(shardId uint32) (observers []*data.NodeData, e error) {
+			return []*data.NodeData{
+				{Address: fmt.Sprintf("observer%d", shardId), ShardId: shardId},
+			}, nil
+		},
+		CallPostRestEndPointCalled: func(address string, path string, dataValue interface{}, response interface{}) (int, error) {
+			if address == "observer1" {
+				time.Sleep(200 * time.Millisecond)
+			}
+
+			response.(*data.ResponseVmValue).Data.Data = &vm.VMOutputApi{ReturnMessage: "ok"}
+			return http.StatusOK, nil
+		},
+		QueryShardsBestEffortCalled: shardsFanOut.QueryShardsBestEffort,
+	}, testPubKeyConverter, 10)
+
+	results, err := processor.ExecuteQueries([]*data.SCQuery{
+		{ScAddress: shard0Address, FuncName: "first"},
+		{ScAddress: shard1Address, FuncName: "second"},
+	})
+	require.Nil(t, err)
+	require.Equal(t, 2, len(results))
+	require.Equal(t, "ok", results[0].Data.ReturnMessage)
+	require.Nil(t, results[1].Data)
+	require.Contains(t, results[1].Error, ErrShardQueryTimeout.Error())
+}
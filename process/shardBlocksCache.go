@@ -0,0 +1,108 @@
+package process
+
+import (
+	"sync"
+	"time"
+)
+
+// ArgsShardBlocksCache holds the arguments needed to create a shard blocks cache
+type ArgsShardBlocksCache struct {
+	TTL        time.Duration
+	MaxEntries int
+}
+
+type cachedShardBlock struct {
+	value    interface{}
+	storedAt time.Time
+}
+
+// shardBlocksCache keeps the shard blocks fetched while building hyperblocks for a short while, so the neighbouring
+// hyperblocks, as well as the same hyperblock requested again, reuse them. Since the entries are keyed by block hash,
+// they cannot become stale, the time to live only bounding the memory usage. Concurrent fetches of the same block are
+// coalesced into a single call
+type shardBlocksCache struct {
+	ttl        time.Duration
+	maxEntries int
+	coalescer  *requestsCoalescer
+
+	mut     sync.Mutex
+	entries map[string]*cachedShardBlock
+	keys    []string
+}
+
+// NewShardBlocksCache creates a new shard blocks cache
+func NewShardBlocksCache(args ArgsShardBlocksCache) (*shardBlocksCache, error) {
+	if args.TTL <= 0 {
+		return nil, ErrInvalidCacheTTL
+	}
+	if args.MaxEntries <= 0 {
+		return nil, ErrInvalidMaxCacheEntries
+	}
+
+	return &shardBlocksCache{
+		ttl:        args.TTL,
+		maxEntries: args.MaxEntries,
+		coalescer:  newRequestsCoalescer(),
+		entries:    make(map[string]*cachedShardBlock),
+		keys:       make([]string, 0, args.MaxEntries),
+	}, nil
+}
+
+// GetOrFetch returns the cached value for the key or, if missing or expired, calls the fetch handler and caches its
+// result. The errors are not cached
+func (sbc *shardBlocksCache) GetOrFetch(key string, fetch func() (interface{}, error)) (interface{}, error) {
+	value, ok := sbc.get(key)
+	if ok {
+		return value, nil
+	}
+
+	value, _, err := sbc.coalescer.do(key, func() (interface{}, error) {
+		fetchedValue, errFetch := fetch()
+		if errFetch != nil {
+			return nil, errFetch
+		}
+
+		sbc.put(key, fetchedValue)
+		return fetchedValue, nil
+	})
+
+	return value, err
+}
+
+func (sbc *shardBlocksCache) get(key string) (interface{}, bool) {
+	sbc.mut.Lock()
+	defer sbc.mut.Unlock()
+
+	entry, ok := sbc.entries[key]
+	if !ok || time.Since(entry.storedAt) > sbc.ttl {
+		return nil, false
+	}
+
+	return entry.value, true
+}
+
+func (sbc *shardBlocksCache) put(key string, value interface{}) {
+	sbc.mut.Lock()
+	defer sbc.mut.Unlock()
+
+	_, exists := sbc.entries[key]
+	sbc.entries[key] = &cachedShardBlock{
+		value:    value,
+		storedAt: time.Now(),
+	}
+	if exists {
+		return
+	}
+
+	// the keys are kept in insertion order, so the oldest entries are the first ones to be evicted
+	sbc.keys = append(sbc.keys, key)
+	for len(sbc.keys) > sbc.maxEntries {
+		delete(sbc.entries, sbc.keys[0])
+		sbc.keys = sbc.keys[1:]
+	}
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (sbc *shardBlocksCache) IsInterfaceNil() bool {
+	return sbc == nil
+}
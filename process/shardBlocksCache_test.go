@@ -0,0 +1,145 @@
+package process
+
+import (
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewShardBlocksCache(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid TTL should err", func(t *testing.T) {
+		t.Parallel()
+
+		sbc, err := NewShardBlocksCache(ArgsShardBlocksCache{TTL: 0, MaxEntries: 1})
+		require.Nil(t, sbc)
+		require.Equal(t, ErrInvalidCacheTTL, err)
+	})
+	t.Run("invalid max entries should err", func(t *testing.T) {
+		t.Parallel()
+
+		sbc, err := NewShardBlocksCache(ArgsShardBlocksCache{TTL: time.Second, MaxEntries: 0})
+		require.Nil(t, sbc)
+		require.Equal(t, ErrInvalidMaxCacheEntries, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		sbc, err := NewShardBlocksCache(ArgsShardBlocksCache{TTL: time.Second, MaxEntries: 1})
+		require.Nil(t, err)
+		require.False(t, sbc.IsInterfaceNil())
+	})
+}
+
+func TestShardBlocksCache_GetOrFetch(t *testing.T) {
+	t.Parallel()
+
+	t.Run("cached value should be returned", func(t *testing.T) {
+		t.Parallel()
+
+		sbc, _ := NewShardBlocksCache(ArgsShardBlocksCache{TTL: time.Minute, MaxEntries: 10})
+		numFetches := 0
+		fetch := func() (interface{}, error) {
+			numFetches++
+			return "block", nil
+		}
+
+		for i := 0; i < 3; i++ {
+			value, err := sbc.GetOrFetch("key", fetch)
+			require.Nil(t, err)
+			require.Equal(t, "block", value)
+		}
+		require.Equal(t, 1, numFetches)
+	})
+	t.Run("expired value should be fetched again", func(t *testing.T) {
+		t.Parallel()
+
+		sbc, _ := NewShardBlocksCache(ArgsShardBlocksCache{TTL: time.Millisecond * 10, MaxEntries: 10})
+		numFetches := 0
+		fetch := func() (interface{}, error) {
+			numFetches++
+			return numFetches, nil
+		}
+
+		value, _ := sbc.GetOrFetch("key", fetch)
+		require.Equal(t, 1, value)
+
+		time.Sleep(time.Millisecond * 20)
+		value, _ = sbc.GetOrFetch("key", fetch)
+		require.Equal(t, 2, value)
+	})
+	t.Run("oldest entries should be evicted", func(t *testing.T) {
+		t.Parallel()
+
+		sbc, _ := NewShardBlocksCache(ArgsShardBlocksCache{TTL: time.Minute, MaxEntries: 2})
+		numFetches := 0
+		fetch := func() (interface{}, error) {
+			numFetches++
+			return "block", nil
+		}
+
+		_, _ = sbc.GetOrFetch("key1", fetch)
+		_, _ = sbc.GetOrFetch("key2", fetch)
+		_, _ = sbc.GetOrFetch("key3", fetch)
+		require.Equal(t, 3, numFetches)
+
+		_, _ = sbc.GetOrFetch("key3", fetch)
+		_, _ = sbc.GetOrFetch("key2", fetch)
+		require.Equal(t, 3, numFetches)
+
+		_, _ = sbc.GetOrFetch("key1", fetch)
+		require.Equal(t, 4, numFetches)
+	})
+	t.Run("errors should not be cached", func(t *testing.T) {
+		t.Parallel()
+
+		sbc, _ := NewShardBlocksCache(ArgsShardBlocksCache{TTL: time.Minute, MaxEntries: 10})
+		expectedErr := errors.New("expected error")
+		numFetches := 0
+		fetch := func() (interface{}, error) {
+			numFetches++
+			return nil, expectedErr
+		}
+
+		_, err := sbc.GetOrFetch("key", fetch)
+		require.Equal(t, expectedErr, err)
+		_, err = sbc.GetOrFetch("key", fetch)
+		require.Equal(t, expectedErr, err)
+		require.Equal(t, 2, numFetches)
+	})
+	t.Run("concurrent fetches of the same key should be coalesced", func(t *testing.T) {
+		t.Parallel()
+
+		sbc, _ := NewShardBlocksCache(ArgsShardBlocksCache{TTL: time.Minute, MaxEntries: 10})
+		numFetches := uint32(0)
+		release := make(chan struct{})
+
+		numCalls := 10
+		wg := sync.WaitGroup{}
+		wg.Add(numCalls)
+		for i := 0; i < numCalls; i++ {
+			go func() {
+				defer wg.Done()
+
+				value, err := sbc.GetOrFetch("key", func() (interface{}, error) {
+					atomic.AddUint32(&numFetches, 1)
+					<-release
+					return "block", nil
+				})
+				require.Nil(t, err)
+				require.Equal(t, "block", value)
+			}()
+		}
+
+		time.Sleep(time.Millisecond * 100)
+		close(release)
+		wg.Wait()
+
+		require.Equal(t, uint32(1), atomic.LoadUint32(&numFetches))
+	})
+}
@@ -0,0 +1,204 @@
+package process
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+const (
+	// FailOnMissingShardsPolicy will make a multi-shard query fail if at least one shard did not respond
+	FailOnMissingShardsPolicy = "fail"
+	// ReturnPartialResultsPolicy will make a multi-shard query return the available data, alongside the missing shards
+	ReturnPartialResultsPolicy = "partial"
+)
+
+// ArgsShardsFanOut holds the arguments needed to create a new shards fan-out component
+type ArgsShardsFanOut struct {
+	MaxParallelRequests  int
+	PerShardTimeout      time.Duration
+	PartialResultsPolicy string
+}
+
+type shardQueryResult struct {
+	response interface{}
+	err      error
+}
+
+type shardsFanOut struct {
+	maxParallelRequests int
+	perShardTimeout     time.Duration
+	allowPartialResults bool
+}
+
+// NewShardsFanOut returns a component able to query multiple shards concurrently, using a bounded number of workers
+func NewShardsFanOut(args ArgsShardsFanOut) (*shardsFanOut, error) {
+	if args.MaxParallelRequests <= 0 {
+		return nil, ErrInvalidMaxParallelRequests
+	}
+	if args.PerShardTimeout <= 0 {
+		return nil, ErrInvalidPerShardTimeout
+	}
+
+	allowPartialResults := false
+	switch args.PartialResultsPolicy {
+	case FailOnMissingShardsPolicy, "":
+	case ReturnPartialResultsPolicy:
+		allowPartialResults = true
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrInvalidPartialResultsPolicy, args.PartialResultsPolicy)
+	}
+
+	return &shardsFanOut{
+		maxParallelRequests: args.MaxParallelRequests,
+		perShardTimeout:     args.PerShardTimeout,
+		allowPartialResults: allowPartialResults,
+	}, nil
+}
+
+// QueryShards will call the query handler for all the provided shards concurrently. Depending on the configured
+// policy, if some shards do not respond, it will either return an error or the available responses alongside the
+// missing shards. An error is returned whenever none of the shards responded
+func (sfo *shardsFanOut) QueryShards(shardIDs []uint32, queryHandler data.ShardQueryHandler) (*data.ShardsQueryResponse, error) {
+	return sfo.queryShards(shardIDs, queryHandler, sfo.allowPartialResults)
+}
+
+// QueryShardsStrict will call the query handler for all the provided shards concurrently and will return an error if
+// at least one shard did not respond, regardless of the configured policy
+func (sfo *shardsFanOut) QueryShardsStrict(shardIDs []uint32, queryHandler data.ShardQueryHandler) (*data.ShardsQueryResponse, error) {
+	return sfo.queryShards(shardIDs, queryHandler, false)
+}
+
+// QueryShardsBestEffort will call the query handler for all the provided shards concurrently and will return the
+// available responses alongside the missing shards, regardless of the configured policy, even if none of the shards
+// responded
+func (sfo *shardsFanOut) QueryShardsBestEffort(shardIDs []uint32, queryHandler data.ShardQueryHandler) *data.ShardsQueryResponse {
+	response, _, _ := collectResponses(shardIDs, sfo.queryShardsConcurrently(shardIDs, queryHandler))
+
+	return response
+}
+
+func (sfo *shardsFanOut) queryShards(
+	shardIDs []uint32,
+	queryHandler data.ShardQueryHandler,
+	allowPartialResults bool,
+) (*data.ShardsQueryResponse, error) {
+	results := sfo.queryShardsConcurrently(shardIDs, queryHandler)
+
+	return sfo.aggregateResults(shardIDs, results, allowPartialResults)
+}
+
+func (sfo *shardsFanOut) queryShardsConcurrently(shardIDs []uint32, queryHandler data.ShardQueryHandler) []shardQueryResult {
+	results := make([]shardQueryResult, len(shardIDs))
+	workersSemaphore := make(chan struct{}, sfo.maxParallelRequests)
+
+	wg := &sync.WaitGroup{}
+	wg.Add(len(shardIDs))
+	for idx, shardID := range shardIDs {
+		workersSemaphore <- struct{}{}
+
+		// buffered, so the query go routine won't block if the result is not awaited anymore
+		chanResult := make(chan shardQueryResult, 1)
+		go func(index int, shard uint32) {
+			// the slot is released only after the handler returns, even if the result was not awaited anymore, so
+			// that no more than maxParallelRequests handlers are running at any time. A timed out handler delays the
+			// queries of the next shards until it returns, which is bounded by the requests timeout
+			defer func() {
+				<-workersSemaphore
+			}()
+
+			response, err := queryHandler(index, shard)
+			chanResult <- shardQueryResult{
+				response: response,
+				err:      err,
+			}
+		}(idx, shardID)
+
+		go func(index int, shard uint32) {
+			defer wg.Done()
+
+			results[index] = sfo.waitShardResult(shard, chanResult)
+		}(idx, shardID)
+	}
+	wg.Wait()
+
+	return results
+}
+
+func (sfo *shardsFanOut) waitShardResult(shardID uint32, chanResult chan shardQueryResult) shardQueryResult {
+	timer := time.NewTimer(sfo.perShardTimeout)
+	defer timer.Stop()
+
+	select {
+	case result := <-chanResult:
+		return result
+	case <-timer.C:
+		return shardQueryResult{
+			err: fmt.Errorf("%w, shard %d", ErrShardQueryTimeout, shardID),
+		}
+	}
+}
+
+func (sfo *shardsFanOut) aggregateResults(
+	shardIDs []uint32,
+	results []shardQueryResult,
+	allowPartialResults bool,
+) (*data.ShardsQueryResponse, error) {
+	response, numResponses, firstErr := collectResponses(shardIDs, results)
+	if firstErr == nil {
+		return response, nil
+	}
+
+	if !allowPartialResults || numResponses == 0 {
+		return nil, firstErr
+	}
+
+	return response, nil
+}
+
+// collectResponses returns the responses of the shards which responded, the shards which did not being reported as
+// missing, along with the number of responses and the first error encountered, if any
+func collectResponses(shardIDs []uint32, results []shardQueryResult) (*data.ShardsQueryResponse, int, error) {
+	response := &data.ShardsQueryResponse{
+		Responses: make([]interface{}, len(results)),
+	}
+
+	var firstErr error
+	numResponses := 0
+	missingShards := make(map[uint32]struct{})
+	for idx, result := range results {
+		if result.err == nil {
+			response.Responses[idx] = result.response
+			numResponses++
+			continue
+		}
+
+		log.Debug("shards fan-out: shard query failed", "shard", shardIDs[idx], "error", result.err.Error())
+		if firstErr == nil {
+			firstErr = result.err
+		}
+		missingShards[shardIDs[idx]] = struct{}{}
+	}
+
+	if firstErr == nil {
+		return response, numResponses, nil
+	}
+
+	response.MissingShards = make([]uint32, 0, len(missingShards))
+	for shardID := range missingShards {
+		response.MissingShards = append(response.MissingShards, shardID)
+	}
+	sort.Slice(response.MissingShards, func(i, j int) bool {
+		return response.MissingShards[i] < response.MissingShards[j]
+	})
+
+	return response, numResponses, firstErr
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (sfo *shardsFanOut) IsInterfaceNil() bool {
+	return sfo == nil
+}
@@ -0,0 +1,237 @@
+package process_test
+
+import (
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-proxy-go/process"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockArgsShardsFanOut() process.ArgsShardsFanOut {
+	return process.ArgsShardsFanOut{
+		MaxParallelRequests:  2,
+		PerShardTimeout:      time.Second,
+		PartialResultsPolicy: process.FailOnMissingShardsPolicy,
+	}
+}
+
+func TestNewShardsFanOut(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid max parallel requests should err", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsShardsFanOut()
+		args.MaxParallelRequests = 0
+		sfo, err := process.NewShardsFanOut(args)
+		require.True(t, check.IfNil(sfo))
+		require.Equal(t, process.ErrInvalidMaxParallelRequests, err)
+	})
+
+	t.Run("invalid per shard timeout should err", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsShardsFanOut()
+		args.PerShardTimeout = 0
+		sfo, err := process.NewShardsFanOut(args)
+		require.True(t, check.IfNil(sfo))
+		require.Equal(t, process.ErrInvalidPerShardTimeout, err)
+	})
+
+	t.Run("invalid partial results policy should err", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsShardsFanOut()
+		args.PartialResultsPolicy = "ignore"
+		sfo, err := process.NewShardsFanOut(args)
+		require.True(t, check.IfNil(sfo))
+		require.True(t, errors.Is(err, process.ErrInvalidPartialResultsPolicy))
+	})
+
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		sfo, err := process.NewShardsFanOut(createMockArgsShardsFanOut())
+		require.NoError(t, err)
+		require.False(t, check.IfNil(sfo))
+	})
+}
+
+func TestShardsFanOut_QueryShards(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+
+	t.Run("should keep the order of the shards and respect the max parallel requests", func(t *testing.T) {
+		t.Parallel()
+
+		sfo, _ := process.NewShardsFanOut(createMockArgsShardsFanOut())
+
+		numInProgress := int32(0)
+		maxInProgress := int32(0)
+		response, err := sfo.QueryShards([]uint32{2, 0, 1, 0}, func(index int, shardID uint32) (interface{}, error) {
+			inProgress := atomic.AddInt32(&numInProgress, 1)
+			defer atomic.AddInt32(&numInProgress, -1)
+
+			for {
+				currentMax := atomic.LoadInt32(&maxInProgress)
+				if inProgress <= currentMax || atomic.CompareAndSwapInt32(&maxInProgress, currentMax, inProgress) {
+					break
+				}
+			}
+
+			// the first queried shards respond last
+			time.Sleep(time.Duration(10*(4-index)) * time.Millisecond)
+			return index*10 + int(shardID), nil
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []interface{}{2, 10, 21, 30}, response.Responses)
+		assert.Empty(t, response.MissingShards)
+		assert.LessOrEqual(t, atomic.LoadInt32(&maxInProgress), int32(2))
+	})
+
+	t.Run("timed out queries should hold their slot until they return", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsShardsFanOut()
+		args.MaxParallelRequests = 1
+		args.PerShardTimeout = 20 * time.Millisecond
+		args.PartialResultsPolicy = process.ReturnPartialResultsPolicy
+		sfo, _ := process.NewShardsFanOut(args)
+
+		numInProgress := int32(0)
+		maxInProgress := int32(0)
+		response, err := sfo.QueryShards([]uint32{0, 1, 2}, func(_ int, shardID uint32) (interface{}, error) {
+			inProgress := atomic.AddInt32(&numInProgress, 1)
+			defer atomic.AddInt32(&numInProgress, -1)
+
+			for {
+				currentMax := atomic.LoadInt32(&maxInProgress)
+				if inProgress <= currentMax || atomic.CompareAndSwapInt32(&maxInProgress, currentMax, inProgress) {
+					break
+				}
+			}
+
+			if shardID == 0 {
+				time.Sleep(100 * time.Millisecond)
+			}
+
+			return shardID, nil
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []interface{}{nil, uint32(1), uint32(2)}, response.Responses)
+		assert.Equal(t, []uint32{0}, response.MissingShards)
+		assert.Equal(t, int32(1), atomic.LoadInt32(&maxInProgress))
+	})
+
+	t.Run("fail policy should return the first error", func(t *testing.T) {
+		t.Parallel()
+
+		sfo, _ := process.NewShardsFanOut(createMockArgsShardsFanOut())
+		response, err := sfo.QueryShards([]uint32{0, 1}, func(_ int, shardID uint32) (interface{}, error) {
+			if shardID == 1 {
+				return nil, expectedErr
+			}
+
+			return shardID, nil
+		})
+		require.Nil(t, response)
+		require.Equal(t, expectedErr, err)
+	})
+
+	t.Run("partial policy should return the available responses and the missing shards", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsShardsFanOut()
+		args.PartialResultsPolicy = process.ReturnPartialResultsPolicy
+		args.PerShardTimeout = 50 * time.Millisecond
+		sfo, _ := process.NewShardsFanOut(args)
+
+		response, err := sfo.QueryShards([]uint32{2, 0, 1}, func(_ int, shardID uint32) (interface{}, error) {
+			switch shardID {
+			case 2:
+				return nil, expectedErr
+			case 1:
+				time.Sleep(time.Second)
+			}
+
+			return shardID, nil
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []interface{}{nil, uint32(0), nil}, response.Responses)
+		assert.Equal(t, []uint32{1, 2}, response.MissingShards)
+	})
+
+	t.Run("partial policy with no responses should err", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsShardsFanOut()
+		args.PartialResultsPolicy = process.ReturnPartialResultsPolicy
+		sfo, _ := process.NewShardsFanOut(args)
+
+		response, err := sfo.QueryShards([]uint32{0, 1}, func(_ int, _ uint32) (interface{}, error) {
+			return nil, expectedErr
+		})
+		require.Nil(t, response)
+		require.Equal(t, expectedErr, err)
+	})
+}
+
+func TestShardsFanOut_QueryShardsStrict(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsShardsFanOut()
+	args.PartialResultsPolicy = process.ReturnPartialResultsPolicy
+	args.PerShardTimeout = 50 * time.Millisecond
+	sfo, _ := process.NewShardsFanOut(args)
+
+	response, err := sfo.QueryShardsStrict([]uint32{0, 1}, func(_ int, shardID uint32) (interface{}, error) {
+		if shardID == 1 {
+			time.Sleep(time.Second)
+		}
+
+		return shardID, nil
+	})
+	require.Nil(t, response)
+	require.True(t, errors.Is(err, process.ErrShardQueryTimeout))
+}
+
+func TestShardsFanOut_QueryShardsBestEffort(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsShardsFanOut()
+	args.PerShardTimeout = 50 * time.Millisecond
+	sfo, _ := process.NewShardsFanOut(args)
+	expectedErr := errors.New("expected error")
+
+	t.Run("should return the available responses regardless of the policy", func(t *testing.T) {
+		t.Parallel()
+
+		response := sfo.QueryShardsBestEffort([]uint32{0, 1, 2}, func(_ int, shardID uint32) (interface{}, error) {
+			switch shardID {
+			case 1:
+				time.Sleep(time.Second)
+			case 2:
+				return nil, expectedErr
+			}
+
+			return shardID, nil
+		})
+		assert.Equal(t, []interface{}{uint32(0), nil, nil}, response.Responses)
+		assert.Equal(t, []uint32{1, 2}, response.MissingShards)
+	})
+	t.Run("no responses should not err", func(t *testing.T) {
+		t.Parallel()
+
+		response := sfo.QueryShardsBestEffort([]uint32{0, 1}, func(_ int, _ uint32) (interface{}, error) {
+			return nil, expectedErr
+		})
+		assert.Equal(t, []interface{}{nil, nil}, response.Responses)
+		assert.Equal(t, []uint32{0, 1}, response.MissingShards)
+	})
+}
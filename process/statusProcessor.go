@@ -1,28 +1,40 @@
 package process
 
 import (
+	"fmt"
+	"strings"
+
 	"github.com/multiversx/mx-chain-core-go/core/check"
 	"github.com/multiversx/mx-chain-proxy-go/data"
 )
 
 // StatusProcessor is able to process status requests
 type StatusProcessor struct {
-	proc                  Processor
-	statusMetricsProvider StatusMetricsProvider
+	proc                    Processor
+	statusMetricsProvider   StatusMetricsProvider
+	ingesterMetricsProvider HyperblockIngesterMetricsProvider
 }
 
 // NewStatusProcessor creates a new instance of AccountProcessor
-func NewStatusProcessor(proc Processor, statusMetricsProvider StatusMetricsProvider) (*StatusProcessor, error) {
+func NewStatusProcessor(
+	proc Processor,
+	statusMetricsProvider StatusMetricsProvider,
+	ingesterMetricsProvider HyperblockIngesterMetricsProvider,
+) (*StatusProcessor, error) {
 	if check.IfNil(proc) {
 		return nil, ErrNilCoreProcessor
 	}
 	if check.IfNil(statusMetricsProvider) {
 		return nil, ErrNilStatusMetricsProvider
 	}
+	if check.IfNil(ingesterMetricsProvider) {
+		return nil, ErrNilHyperblockIngesterMetricsProvider
+	}
 
 	return &StatusProcessor{
-		proc:                  proc,
-		statusMetricsProvider: statusMetricsProvider,
+		proc:                    proc,
+		statusMetricsProvider:   statusMetricsProvider,
+		ingesterMetricsProvider: ingesterMetricsProvider,
 	}, nil
 }
 
@@ -31,7 +43,22 @@ func (sp *StatusProcessor) GetMetrics() map[string]*data.EndpointMetrics {
 	return sp.statusMetricsProvider.GetAll()
 }
 
-// GetMetricsForPrometheus returns the metrics in a prometheus format
+// GetMetricsForPrometheus returns the metrics in a prometheus format, including the statistics about the coalescing of
+// the identical requests sent to the nodes and the progress of the hyperblock ingester, when enabled
 func (sp *StatusProcessor) GetMetricsForPrometheus() string {
-	return sp.statusMetricsProvider.GetMetricsForPrometheus()
+	coalescingMetrics := sp.proc.GetRequestsCoalescingMetrics()
+
+	stringBuilder := strings.Builder{}
+	stringBuilder.WriteString(sp.statusMetricsProvider.GetMetricsForPrometheus())
+	stringBuilder.WriteString(fmt.Sprintf("num_nodes_get_requests_upstream %d\n", coalescingMetrics.NumUpstreamCalls))
+	stringBuilder.WriteString(fmt.Sprintf("num_nodes_get_requests_deduplicated %d\n", coalescingMetrics.NumDeduplicatedCalls))
+
+	ingesterMetrics := sp.ingesterMetricsProvider.GetMetrics()
+	if ingesterMetrics.Enabled {
+		stringBuilder.WriteString(fmt.Sprintf("hyperblock_ingester_last_indexed_nonce %d\n", ingesterMetrics.LastIndexedNonce))
+		stringBuilder.WriteString(fmt.Sprintf("hyperblock_ingester_head_nonce %d\n", ingesterMetrics.HeadNonce))
+		stringBuilder.WriteString(fmt.Sprintf("hyperblock_ingester_lag %d\n", ingesterMetrics.Lag))
+	}
+
+	return stringBuilder.String()
 }
@@ -14,7 +14,7 @@ func TestNewStatusProcessor(t *testing.T) {
 	t.Run("nil base processor - should error", func(t *testing.T) {
 		t.Parallel()
 
-		sp, err := NewStatusProcessor(nil, &mock.StatusMetricsProviderStub{})
+		sp, err := NewStatusProcessor(nil, &mock.StatusMetricsProviderStub{}, &mock.HyperblockIngesterMetricsProviderStub{})
 		require.Nil(t, sp)
 		require.Equal(t, ErrNilCoreProcessor, err)
 	})
@@ -22,15 +22,23 @@ func TestNewStatusProcessor(t *testing.T) {
 	t.Run("nil status metric provider - should error", func(t *testing.T) {
 		t.Parallel()
 
-		sp, err := NewStatusProcessor(&mock.ProcessorStub{}, nil)
+		sp, err := NewStatusProcessor(&mock.ProcessorStub{}, nil, &mock.HyperblockIngesterMetricsProviderStub{})
 		require.Nil(t, sp)
 		require.Equal(t, ErrNilStatusMetricsProvider, err)
 	})
 
+	t.Run("nil hyperblock ingester metrics provider - should error", func(t *testing.T) {
+		t.Parallel()
+
+		sp, err := NewStatusProcessor(&mock.ProcessorStub{}, &mock.StatusMetricsProviderStub{}, nil)
+		require.Nil(t, sp)
+		require.Equal(t, ErrNilHyperblockIngesterMetricsProvider, err)
+	})
+
 	t.Run("should work", func(t *testing.T) {
 		t.Parallel()
 
-		sp, err := NewStatusProcessor(&mock.ProcessorStub{}, &mock.StatusMetricsProviderStub{})
+		sp, err := NewStatusProcessor(&mock.ProcessorStub{}, &mock.StatusMetricsProviderStub{}, &mock.HyperblockIngesterMetricsProviderStub{})
 		require.NoError(t, err)
 		require.NotNil(t, sp)
 	})
@@ -48,7 +56,7 @@ func TestStatusProcessor_GetMetrics(t *testing.T) {
 			return expectedMetrics
 		},
 	}
-	sp, err := NewStatusProcessor(&mock.ProcessorStub{}, statusProvider)
+	sp, err := NewStatusProcessor(&mock.ProcessorStub{}, statusProvider, &mock.HyperblockIngesterMetricsProviderStub{})
 	require.NoError(t, err)
 	require.NotNil(t, sp)
 
@@ -60,17 +68,51 @@ func TestStatusProcessor_GetMetrics(t *testing.T) {
 func TestStatusProcessor_GetMetricsForPrometheus(t *testing.T) {
 	t.Parallel()
 
-	expectedOutput := "metrics"
 	statusProvider := &mock.StatusMetricsProviderStub{
 		GetMetricsForPrometheusCalled: func() string {
-			return expectedOutput
+			return "num_requests{endpoint=\"/network/config\"} 5\n"
+		},
+	}
+	proc := &mock.ProcessorStub{
+		GetRequestsCoalescingMetricsCalled: func() data.RequestsCoalescingMetrics {
+			return data.RequestsCoalescingMetrics{
+				NumUpstreamCalls:     10,
+				NumDeduplicatedCalls: 7,
+			}
 		},
 	}
-	sp, err := NewStatusProcessor(&mock.ProcessorStub{}, statusProvider)
+	sp, err := NewStatusProcessor(proc, statusProvider, &mock.HyperblockIngesterMetricsProviderStub{})
 	require.NoError(t, err)
 	require.NotNil(t, sp)
 
+	expectedOutput := "num_requests{endpoint=\"/network/config\"} 5\n" +
+		"num_nodes_get_requests_upstream 10\n" +
+		"num_nodes_get_requests_deduplicated 7\n"
 	metrics := sp.GetMetricsForPrometheus()
+	require.Equal(t, expectedOutput, metrics)
+}
+
+func TestStatusProcessor_GetMetricsForPrometheusWithHyperblockIngester(t *testing.T) {
+	t.Parallel()
+
+	ingesterMetricsProvider := &mock.HyperblockIngesterMetricsProviderStub{
+		GetMetricsCalled: func() data.HyperblockIngesterMetrics {
+			return data.HyperblockIngesterMetrics{
+				Enabled:          true,
+				LastIndexedNonce: 90,
+				HeadNonce:        100,
+				Lag:              10,
+			}
+		},
+	}
+	sp, err := NewStatusProcessor(&mock.ProcessorStub{}, &mock.StatusMetricsProviderStub{}, ingesterMetricsProvider)
 	require.NoError(t, err)
+
+	expectedOutput := "num_nodes_get_requests_upstream 0\n" +
+		"num_nodes_get_requests_deduplicated 0\n" +
+		"hyperblock_ingester_last_indexed_nonce 90\n" +
+		"hyperblock_ingester_head_nonce 100\n" +
+		"hyperblock_ingester_lag 10\n"
+	metrics := sp.GetMetricsForPrometheus()
 	require.Equal(t, expectedOutput, metrics)
 }
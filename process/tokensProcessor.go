@@ -0,0 +1,264 @@
+package process
+
+import (
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data/vm"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+const (
+	getSpecialRolesFunc = "getSpecialRoles"
+
+	// numTokenPropertiesValues is the number of values returned by getTokenProperties before the flags of the token: the
+	// name, the type, the owner, the initially minted supply and the burned value
+	numTokenPropertiesValues = 5
+	numDecimalsProperty      = "NumDecimals"
+	// tokenRandomSequenceLength is the length of the random suffix of the token tickers
+	tokenRandomSequenceLength = 6
+)
+
+// ArgsTokensProcessor holds the arguments needed to create a tokens processor
+type ArgsTokensProcessor struct {
+	SCQueryService  SCQueryService
+	DBReader        ExternalStorageConnector
+	PubKeyConverter core.PubkeyConverter
+}
+
+// TokensProcessor serves the properties and roles of the tokens, as stored by the ESDT system smart contract, along
+// with their holders and transfers, as stored by the history backend
+type TokensProcessor struct {
+	scQueryService  SCQueryService
+	dbReader        ExternalStorageConnector
+	pubKeyConverter core.PubkeyConverter
+}
+
+// NewTokensProcessor creates a new tokens processor
+func NewTokensProcessor(args ArgsTokensProcessor) (*TokensProcessor, error) {
+	if check.IfNil(args.SCQueryService) {
+		return nil, ErrNilSCQueryService
+	}
+	if check.IfNil(args.DBReader) {
+		return nil, ErrNilDatabaseConnector
+	}
+	if check.IfNil(args.PubKeyConverter) {
+		return nil, ErrNilPubKeyConverter
+	}
+
+	return &TokensProcessor{
+		scQueryService:  args.SCQueryService,
+		dbReader:        args.DBReader,
+		pubKeyConverter: args.PubKeyConverter,
+	}, nil
+}
+
+// GetTokenProperties returns the properties of the token, or of its collection when the identifier holds a nonce, along
+// with the special roles of the addresses
+func (tp *TokensProcessor) GetTokenProperties(identifier string) (*data.TokenProperties, error) {
+	collection, _, err := parseTokenIdentifier(identifier)
+	if err != nil {
+		return nil, err
+	}
+
+	properties, err := tp.getTokenProperties(collection)
+	if err != nil {
+		return nil, err
+	}
+
+	properties.Roles, err = tp.getSpecialRoles(collection)
+	if err != nil {
+		return nil, err
+	}
+
+	return properties, nil
+}
+
+// GetTokenHolders returns a page of the holders of the token or, when the identifier does not hold a nonce, of all the
+// tokens of the collection
+func (tp *TokensProcessor) GetTokenHolders(identifier string, filter data.TokenHoldersFilter) (*data.TokenHoldersPage, error) {
+	collection, nonce, err := parseTokenIdentifier(identifier)
+	if err != nil {
+		return nil, err
+	}
+
+	properties, err := tp.getTokenProperties(collection)
+	if err != nil {
+		return nil, err
+	}
+
+	filter.Token = collection
+	filter.Nonce = nonce
+	page, err := tp.dbReader.GetTokenHolders(filter)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range page.Holders {
+		page.Holders[i].Amount = formatTokenAmount(page.Holders[i].Balance, properties.Decimals)
+	}
+
+	return page, nil
+}
+
+// GetTokenTransfers returns a page of the transfers of the token or, when the identifier does not hold a nonce, of all
+// the tokens of the collection
+func (tp *TokensProcessor) GetTokenTransfers(identifier string, filter data.TokenTransfersFilter) (*data.TokenTransfersPage, error) {
+	collection, nonce, err := parseTokenIdentifier(identifier)
+	if err != nil {
+		return nil, err
+	}
+	if filter.FromBlock.HasValue && filter.ToBlock.HasValue && filter.FromBlock.Value > filter.ToBlock.Value {
+		return nil, fmt.Errorf("%w: the block range start is greater than its end", data.ErrInvalidTokenFilter)
+	}
+
+	properties, err := tp.getTokenProperties(collection)
+	if err != nil {
+		return nil, err
+	}
+
+	filter.Token = collection
+	filter.Nonce = nonce
+	page, err := tp.dbReader.GetTokenTransfers(filter)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range page.Transfers {
+		page.Transfers[i].Amount = formatTokenAmount(page.Transfers[i].Value, properties.Decimals)
+	}
+
+	return page, nil
+}
+
+func (tp *TokensProcessor) getTokenProperties(collection string) (*data.TokenProperties, error) {
+	vmOutput, err := tp.queryESDTSystemSC(initialESDTSupplyFunc, collection)
+	if err != nil {
+		return nil, err
+	}
+	if vmOutput.ReturnCode != vmOutputReturnCodeOk {
+		return nil, fmt.Errorf("%w: %s: %s", data.ErrTokenNotFound, collection, vmOutput.ReturnMessage)
+	}
+
+	returnData := vmOutput.ReturnData
+	if len(returnData) < numTokenPropertiesValues {
+		return nil, fmt.Errorf("%w for token %s", ErrInvalidTokenProperties, collection)
+	}
+
+	properties := &data.TokenProperties{
+		Identifier:    collection,
+		Name:          string(returnData[0]),
+		Type:          string(returnData[1]),
+		Owner:         tp.encodeAddress(returnData[2]),
+		InitialMinted: string(returnData[3]),
+		Burned:        string(returnData[4]),
+		Properties:    make(map[string]string),
+	}
+
+	// the flags are returned as name-value pairs, such as CanMint-true or NumDecimals-18
+	for _, property := range returnData[numTokenPropertiesValues:] {
+		nameAndValue := strings.SplitN(string(property), "-", 2)
+		if len(nameAndValue) != 2 {
+			continue
+		}
+
+		properties.Properties[nameAndValue[0]] = nameAndValue[1]
+		if nameAndValue[0] != numDecimalsProperty {
+			continue
+		}
+
+		properties.Decimals, err = strconv.Atoi(nameAndValue[1])
+		if err != nil {
+			return nil, fmt.Errorf("%w for token %s: invalid number of decimals %s", ErrInvalidTokenProperties, collection, nameAndValue[1])
+		}
+	}
+
+	return properties, nil
+}
+
+// getSpecialRoles returns the roles of each address, as returned by getSpecialRoles in the address:role1,role2 format
+func (tp *TokensProcessor) getSpecialRoles(collection string) (map[string][]string, error) {
+	vmOutput, err := tp.queryESDTSystemSC(getSpecialRolesFunc, collection)
+	if err != nil {
+		return nil, err
+	}
+	if vmOutput.ReturnCode != vmOutputReturnCodeOk {
+		return nil, fmt.Errorf("cannot get the special roles of token %s: %s", collection, vmOutput.ReturnMessage)
+	}
+
+	roles := make(map[string][]string)
+	for _, addressRoles := range vmOutput.ReturnData {
+		addressAndRoles := strings.SplitN(string(addressRoles), ":", 2)
+		if len(addressAndRoles) != 2 || len(addressAndRoles[1]) == 0 {
+			continue
+		}
+
+		roles[addressAndRoles[0]] = strings.Split(addressAndRoles[1], ",")
+	}
+
+	return roles, nil
+}
+
+func (tp *TokensProcessor) queryESDTSystemSC(funcName string, collection string) (*vm.VMOutputApi, error) {
+	vmOutput, _, err := tp.scQueryService.ExecuteQuery(&data.SCQuery{
+		ScAddress: esdtContractAddress,
+		FuncName:  funcName,
+		Arguments: [][]byte{[]byte(collection)},
+	})
+
+	return vmOutput, err
+}
+
+func (tp *TokensProcessor) encodeAddress(address []byte) string {
+	if len(address) != tp.pubKeyConverter.Len() {
+		return string(address)
+	}
+
+	return tp.pubKeyConverter.Encode(address)
+}
+
+// parseTokenIdentifier splits the identifier into the collection (TICKER-random) and the nonce, only set when the
+// identifier holds a hex encoded nonce (TICKER-random-nonce)
+func parseTokenIdentifier(identifier string) (string, core.OptionalUint64, error) {
+	parts := strings.Split(identifier, "-")
+	if len(parts) != 2 && len(parts) != 3 {
+		return "", core.OptionalUint64{}, fmt.Errorf("%w: %s", data.ErrInvalidTokenIdentifier, identifier)
+	}
+
+	_, err := hex.DecodeString(parts[1])
+	if len(parts[0]) == 0 || len(parts[1]) != tokenRandomSequenceLength || err != nil {
+		return "", core.OptionalUint64{}, fmt.Errorf("%w: %s", data.ErrInvalidTokenIdentifier, identifier)
+	}
+
+	collection := parts[0] + "-" + parts[1]
+	if len(parts) == 2 {
+		return collection, core.OptionalUint64{}, nil
+	}
+
+	nonce, err := strconv.ParseUint(parts[2], 16, 64)
+	if err != nil || nonce == 0 {
+		return "", core.OptionalUint64{}, fmt.Errorf("%w: invalid nonce of %s", data.ErrInvalidTokenIdentifier, identifier)
+	}
+
+	return collection, core.OptionalUint64{Value: nonce, HasValue: true}, nil
+}
+
+// formatTokenAmount returns the value as a decimal number, or an empty string if the value is not a number
+func formatTokenAmount(value string, decimals int) string {
+	amount, ok := big.NewInt(0).SetString(value, 10)
+	if !ok {
+		return ""
+	}
+
+	return formatAmountWithDecimals(amount, decimals)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (tp *TokensProcessor) IsInterfaceNil() bool {
+	return tp == nil
+}
@@ -0,0 +1,214 @@
+package process_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/pubkeyConverter"
+	"github.com/multiversx/mx-chain-core-go/data/vm"
+	logger "github.com/multiversx/mx-chain-logger-go"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/multiversx/mx-chain-proxy-go/process"
+	"github.com/multiversx/mx-chain-proxy-go/process/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const tokensTestOwner = "erd1qqqqqqqqqqqqqpgqp699jngundfqw07d8jzkepucvpzush6k3wvqyc44rx"
+
+func createTokensProcessorArgs() process.ArgsTokensProcessor {
+	converter, _ := pubkeyConverter.NewBech32PubkeyConverter(32, logger.GetOrCreate("test"))
+	ownerBytes, _ := converter.Decode(tokensTestOwner)
+
+	return process.ArgsTokensProcessor{
+		SCQueryService: &mock.SCQueryServiceStub{
+			ExecuteQueryCalled: func(query *data.SCQuery) (*vm.VMOutputApi, data.BlockInfo, error) {
+				if string(query.Arguments[0]) != "TKN-abcdef" {
+					return &vm.VMOutputApi{ReturnCode: "user error", ReturnMessage: "no ticker with given name"}, data.BlockInfo{}, nil
+				}
+
+				switch query.FuncName {
+				case "getTokenProperties":
+					return &vm.VMOutputApi{
+						ReturnCode: "ok",
+						ReturnData: [][]byte{
+							[]byte("Token"),
+							[]byte("FungibleESDT"),
+							ownerBytes,
+							[]byte("1000000"),
+							[]byte("10"),
+							[]byte("NumDecimals-6"),
+							[]byte("IsPaused-false"),
+							[]byte("CanMint-true"),
+						},
+					}, data.BlockInfo{}, nil
+				case "getSpecialRoles":
+					return &vm.VMOutputApi{
+						ReturnCode: "ok",
+						ReturnData: [][]byte{
+							[]byte(tokensTestOwner + ":ESDTRoleLocalMint,ESDTRoleLocalBurn"),
+						},
+					}, data.BlockInfo{}, nil
+				default:
+					return nil, data.BlockInfo{}, errors.New("unexpected function " + query.FuncName)
+				}
+			},
+		},
+		DBReader:        &mock.ExternalStorageConnectorStub{},
+		PubKeyConverter: converter,
+	}
+}
+
+func TestNewTokensProcessor(t *testing.T) {
+	t.Parallel()
+
+	args := createTokensProcessorArgs()
+	args.SCQueryService = nil
+	tp, err := process.NewTokensProcessor(args)
+	require.Equal(t, process.ErrNilSCQueryService, err)
+	require.True(t, tp.IsInterfaceNil())
+
+	args = createTokensProcessorArgs()
+	args.DBReader = nil
+	tp, err = process.NewTokensProcessor(args)
+	require.Equal(t, process.ErrNilDatabaseConnector, err)
+	require.True(t, tp.IsInterfaceNil())
+
+	args = createTokensProcessorArgs()
+	args.PubKeyConverter = nil
+	tp, err = process.NewTokensProcessor(args)
+	require.Equal(t, process.ErrNilPubKeyConverter, err)
+	require.True(t, tp.IsInterfaceNil())
+
+	tp, err = process.NewTokensProcessor(createTokensProcessorArgs())
+	require.Nil(t, err)
+	require.False(t, tp.IsInterfaceNil())
+}
+
+func TestTokensProcessor_GetTokenProperties(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid identifier should err", func(t *testing.T) {
+		t.Parallel()
+
+		tp, _ := process.NewTokensProcessor(createTokensProcessorArgs())
+		for _, identifier := range []string{"", "TKN", "TKN-abc", "TKN-abcdez", "-abcdef", "TKN-abcdef-zz", "TKN-abcdef-00", "A-B-C-D"} {
+			properties, err := tp.GetTokenProperties(identifier)
+			require.Nil(t, properties)
+			require.True(t, errors.Is(err, data.ErrInvalidTokenIdentifier), identifier)
+		}
+	})
+	t.Run("unknown token should err", func(t *testing.T) {
+		t.Parallel()
+
+		tp, _ := process.NewTokensProcessor(createTokensProcessorArgs())
+		properties, err := tp.GetTokenProperties("OTHER-abcdef")
+		require.Nil(t, properties)
+		require.True(t, errors.Is(err, data.ErrTokenNotFound))
+	})
+	t.Run("query error should not be reported as unknown token", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		args := createTokensProcessorArgs()
+		args.SCQueryService = &mock.SCQueryServiceStub{
+			ExecuteQueryCalled: func(query *data.SCQuery) (*vm.VMOutputApi, data.BlockInfo, error) {
+				return nil, data.BlockInfo{}, expectedErr
+			},
+		}
+		tp, _ := process.NewTokensProcessor(args)
+
+		_, err := tp.GetTokenProperties("TKN-abcdef")
+		require.Equal(t, expectedErr, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		tp, _ := process.NewTokensProcessor(createTokensProcessorArgs())
+
+		// the identifier of a token of a collection resolves to the collection
+		properties, err := tp.GetTokenProperties("TKN-abcdef-0a")
+		require.Nil(t, err)
+		require.Equal(t, &data.TokenProperties{
+			Identifier:    "TKN-abcdef",
+			Name:          "Token",
+			Type:          "FungibleESDT",
+			Owner:         tokensTestOwner,
+			Decimals:      6,
+			InitialMinted: "1000000",
+			Burned:        "10",
+			Properties: map[string]string{
+				"NumDecimals": "6",
+				"IsPaused":    "false",
+				"CanMint":     "true",
+			},
+			Roles: map[string][]string{
+				tokensTestOwner: {"ESDTRoleLocalMint", "ESDTRoleLocalBurn"},
+			},
+		}, properties)
+	})
+}
+
+func TestTokensProcessor_GetTokenHolders(t *testing.T) {
+	t.Parallel()
+
+	var providedFilter data.TokenHoldersFilter
+	args := createTokensProcessorArgs()
+	args.DBReader = &mock.ExternalStorageConnectorStub{
+		GetTokenHoldersCalled: func(filter data.TokenHoldersFilter) (*data.TokenHoldersPage, error) {
+			providedFilter = filter
+			return &data.TokenHoldersPage{
+				Holders: []data.TokenHolder{
+					{Address: "erd1a", Balance: "1500000"},
+					{Address: "erd1b", Balance: "7"},
+				},
+				NextCursor: "next",
+			}, nil
+		},
+	}
+	tp, _ := process.NewTokensProcessor(args)
+
+	page, err := tp.GetTokenHolders("TKN-abcdef-0a", data.TokenHoldersFilter{Limit: 2, Cursor: "c"})
+	require.Nil(t, err)
+	require.Equal(t, data.TokenHoldersFilter{
+		Token:  "TKN-abcdef",
+		Nonce:  core.OptionalUint64{Value: 10, HasValue: true},
+		Limit:  2,
+		Cursor: "c",
+	}, providedFilter)
+	require.Equal(t, "1.5", page.Holders[0].Amount)
+	require.Equal(t, "0.000007", page.Holders[1].Amount)
+	require.Equal(t, "next", page.NextCursor)
+
+	_, err = tp.GetTokenHolders("OTHER-abcdef", data.TokenHoldersFilter{})
+	require.True(t, errors.Is(err, data.ErrTokenNotFound))
+}
+
+func TestTokensProcessor_GetTokenTransfers(t *testing.T) {
+	t.Parallel()
+
+	var providedFilter data.TokenTransfersFilter
+	args := createTokensProcessorArgs()
+	args.DBReader = &mock.ExternalStorageConnectorStub{
+		GetTokenTransfersCalled: func(filter data.TokenTransfersFilter) (*data.TokenTransfersPage, error) {
+			providedFilter = filter
+			return &data.TokenTransfersPage{
+				Transfers: []data.TokenTransfer{
+					{DatabaseTokenTransfer: data.DatabaseTokenTransfer{TxHash: "h1", Value: "2000000"}},
+				},
+			}, nil
+		},
+	}
+	tp, _ := process.NewTokensProcessor(args)
+
+	page, err := tp.GetTokenTransfers("TKN-abcdef", data.TokenTransfersFilter{Order: data.SortOrderDescending})
+	require.Nil(t, err)
+	require.Equal(t, data.TokenTransfersFilter{Token: "TKN-abcdef", Order: data.SortOrderDescending}, providedFilter)
+	require.Equal(t, "2", page.Transfers[0].Amount)
+
+	_, err = tp.GetTokenTransfers("TKN-abcdef", data.TokenTransfersFilter{
+		FromBlock: core.OptionalUint64{Value: 10, HasValue: true},
+		ToBlock:   core.OptionalUint64{Value: 5, HasValue: true},
+	})
+	require.True(t, errors.Is(err, data.ErrInvalidTokenFilter))
+}
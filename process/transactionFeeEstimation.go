@@ -0,0 +1,201 @@
+package process
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+const (
+	// feeEstimationPoolFields holds the fields requested from the pool, only the number of transactions being needed
+	feeEstimationPoolFields = "hash"
+
+	// congestedPoolSize is the number of transactions in the sender shard's pool starting with which the fast gas price
+	// reaches its maximum
+	congestedPoolSize = 20000
+
+	// maxGasPriceMultiplier is the maximum multiplier applied to the minimum gas price for the fast suggestion, the
+	// normal suggestion using half of it
+	maxGasPriceMultiplier = 2.0
+
+	egldDecimals = 18
+
+	// defaultRoundDuration is the validity of the cached pool sizes when the network config does not hold the round
+	// duration
+	defaultRoundDuration = 6 * time.Second
+)
+
+type cachedPoolSize struct {
+	size      int
+	timestamp time.Time
+}
+
+// GetTransactionFeeEstimate returns the gas units needed by the transaction along with the suggested gas prices and the
+// corresponding fees. The suggested prices grow with the number of transactions found in the sender shard's pool
+func (tp *TransactionProcessor) GetTransactionFeeEstimate(tx *data.Transaction) (*data.TransactionFeeEstimate, error) {
+	senderShardID, err := tp.getShardByAddress(tx.Sender)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", data.ErrCannotEstimateTransactionFee, err.Error())
+	}
+
+	networkConfig, err := tp.networkConfigsHandler.GetNetworkConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	gasConfigs, err := tp.networkConfigsHandler.GetGasConfigs()
+	if err != nil {
+		return nil, err
+	}
+
+	if len(tx.ChainID) == 0 {
+		tx.ChainID = networkConfig.Config.ChainID
+	}
+	if tx.Version == 0 {
+		tx.Version = networkConfig.Config.MinTransactionVersion
+	}
+
+	txCost, err := tp.TransactionCostRequest(tx)
+	if err != nil {
+		return nil, err
+	}
+	if len(txCost.RetMessage) > 0 {
+		return nil, fmt.Errorf("%w: %s", data.ErrCannotEstimateTransactionFee, txCost.RetMessage)
+	}
+
+	gasPriceModifier, err := parseGasPriceModifier(networkConfig.Config.GasPriceModifier.String())
+	if err != nil {
+		return nil, err
+	}
+
+	poolSize := tp.getPoolSizeForShard(senderShardID, computeRoundDuration(networkConfig))
+	congestion := math.Min(1, float64(poolSize)/congestedPoolSize)
+	minGasPrice := networkConfig.Config.MinGasPrice
+	normalGasPrice := minGasPrice + uint64(float64(minGasPrice)*(maxGasPriceMultiplier-1)*congestion/2)
+	fastGasPrice := minGasPrice + uint64(float64(minGasPrice)*(maxGasPriceMultiplier-1)*congestion)
+
+	moveBalanceGasUnits := computeMoveBalanceGasUnits(tx, gasConfigs)
+	createSuggestion := func(gasPrice uint64) *data.GasPriceSuggestion {
+		fee := computeTransactionFee(txCost.TxCost, moveBalanceGasUnits, gasPrice, gasPriceModifier)
+		return &data.GasPriceSuggestion{
+			GasPrice:       gasPrice,
+			Fee:            fee.String(),
+			FeeDenominated: formatAmountWithDecimals(fee, egldDecimals),
+		}
+	}
+
+	return &data.TransactionFeeEstimate{
+		GasUnits:         txCost.TxCost,
+		EstimationMethod: txCost.EstimationMethod,
+		PoolSize:         poolSize,
+		Low:              createSuggestion(minGasPrice),
+		Normal:           createSuggestion(normalGasPrice),
+		Fast:             createSuggestion(fastGasPrice),
+	}, nil
+}
+
+// getPoolSizeForShard returns the number of transactions found in the shard's pool, fetched at most once per round. The
+// pool is considered empty if no observer of the shard responds
+func (tp *TransactionProcessor) getPoolSizeForShard(shardID uint32, roundDuration time.Duration) int {
+	tp.mutPoolSizes.Lock()
+	cached, ok := tp.poolSizes[shardID]
+	tp.mutPoolSizes.Unlock()
+	if ok && time.Since(cached.timestamp) < roundDuration {
+		return cached.size
+	}
+
+	// the pool is fetched outside the lock, so the estimations for the other shards are not delayed
+	poolSize := 0
+	txPool, err := tp.getTxPoolForShard(shardID, feeEstimationPoolFields)
+	if err != nil {
+		log.Debug("cannot get the pool size for the fee estimation", "shard", shardID, "error", err)
+	} else {
+		poolSize = len(txPool.RegularTransactions)
+	}
+
+	tp.mutPoolSizes.Lock()
+	tp.poolSizes[shardID] = &cachedPoolSize{
+		size:      poolSize,
+		timestamp: time.Now(),
+	}
+	tp.mutPoolSizes.Unlock()
+
+	return poolSize
+}
+
+func computeRoundDuration(networkConfig *data.NetworkConfig) time.Duration {
+	if networkConfig.Config.RoundDuration == 0 {
+		return defaultRoundDuration
+	}
+
+	return time.Duration(networkConfig.Config.RoundDuration) * time.Millisecond
+}
+
+func computeMoveBalanceGasUnits(tx *data.Transaction, gasConfigs *data.TxCostGasConfigs) uint64 {
+	gasUnits := gasConfigs.MinGasLimit + gasConfigs.GasPerDataByte*uint64(len(tx.Data))
+	if len(tx.GuardianAddr) > 0 {
+		gasUnits += gasConfigs.ExtraGasLimitGuardedTx
+	}
+
+	return gasUnits
+}
+
+// computeTransactionFee returns the fee of a transaction, the gas units used for processing, above the ones needed
+// by a move balance, being paid with the gas price adjusted by the gas price modifier
+func computeTransactionFee(gasUnits uint64, moveBalanceGasUnits uint64, gasPrice uint64, gasPriceModifier float64) *big.Int {
+	if gasUnits < moveBalanceGasUnits {
+		moveBalanceGasUnits = gasUnits
+	}
+
+	processingGasPrice := uint64(float64(gasPrice) * gasPriceModifier)
+	moveBalanceFee := big.NewInt(0).Mul(big.NewInt(0).SetUint64(moveBalanceGasUnits), big.NewInt(0).SetUint64(gasPrice))
+	processingFee := big.NewInt(0).Mul(big.NewInt(0).SetUint64(gasUnits-moveBalanceGasUnits), big.NewInt(0).SetUint64(processingGasPrice))
+
+	return moveBalanceFee.Add(moveBalanceFee, processingFee)
+}
+
+// parseGasPriceModifier returns the gas price modifier of the network config, defaulting to 1 when missing, so the
+// fees are rather overestimated
+func parseGasPriceModifier(value string) (float64, error) {
+	if len(value) == 0 {
+		return 1, nil
+	}
+
+	gasPriceModifier, err := strconv.ParseFloat(value, 64)
+	if err != nil || gasPriceModifier < 0 || gasPriceModifier > 1 {
+		return 0, fmt.Errorf("%w: %s", ErrInvalidGasPriceModifier, value)
+	}
+
+	return gasPriceModifier, nil
+}
+
+// formatAmountWithDecimals returns the amount as a decimal number, trimming the trailing zeros of the fractional part
+func formatAmountWithDecimals(amount *big.Int, decimals int) string {
+	if decimals <= 0 {
+		return amount.String()
+	}
+
+	sign := ""
+	absAmount := big.NewInt(0).Abs(amount)
+	if amount.Sign() < 0 {
+		sign = "-"
+	}
+
+	digits := absAmount.String()
+	if len(digits) <= decimals {
+		digits = strings.Repeat("0", decimals-len(digits)+1) + digits
+	}
+
+	integerPart := digits[:len(digits)-decimals]
+	fractionalPart := strings.TrimRight(digits[len(digits)-decimals:], "0")
+	if len(fractionalPart) == 0 {
+		return sign + integerPart
+	}
+
+	return sign + integerPart + "." + fractionalPart
+}
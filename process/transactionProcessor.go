@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"math/big"
 	"net/http"
+	"sync"
 
 	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/core/check"
@@ -69,7 +70,11 @@ type TransactionProcessor struct {
 	marshalizer                  marshal.Marshalizer
 	newTxCostProcessor           func() (TransactionCostHandler, error)
 	mergeLogsHandler             LogsMergerHandler
+	networkConfigsHandler        NetworkConfigsHandler
 	shouldAllowEntireTxPoolFetch bool
+
+	mutPoolSizes sync.Mutex
+	poolSizes    map[uint32]*cachedPoolSize
 }
 
 // NewTransactionProcessor creates a new instance of TransactionProcessor
@@ -80,6 +85,7 @@ func NewTransactionProcessor(
 	marshalizer marshal.Marshalizer,
 	newTxCostProcessor func() (TransactionCostHandler, error),
 	logsMerger LogsMergerHandler,
+	networkConfigsHandler NetworkConfigsHandler,
 	allowEntireTxPoolFetch bool,
 ) (*TransactionProcessor, error) {
 	if check.IfNil(proc) {
@@ -100,6 +106,9 @@ func NewTransactionProcessor(
 	if check.IfNil(logsMerger) {
 		return nil, ErrNilLogsMerger
 	}
+	if check.IfNil(networkConfigsHandler) {
+		return nil, ErrNilNetworkConfigsHandler
+	}
 
 	return &TransactionProcessor{
 		proc:                         proc,
@@ -108,7 +117,9 @@ func NewTransactionProcessor(
 		marshalizer:                  marshalizer,
 		newTxCostProcessor:           newTxCostProcessor,
 		mergeLogsHandler:             logsMerger,
+		networkConfigsHandler:        networkConfigsHandler,
 		shouldAllowEntireTxPoolFetch: allowEntireTxPoolFetch,
+		poolSizes:                    make(map[uint32]*cachedPoolSize),
 	}, nil
 }
 
@@ -962,16 +973,23 @@ func (tp *TransactionProcessor) getShardObserversForSender(sender string, observ
 	return observers, sndShardID, nil
 }
 
+// getTxPool fetches the pools of all the shards concurrently. The shards whose pool could not be fetched are reported
+// as missing
 func (tp *TransactionProcessor) getTxPool(fields string) (*data.TransactionsPool, error) {
 	shardIDs := tp.proc.GetShardIDs()
+	shardsResponse := tp.proc.QueryShardsBestEffort(shardIDs, func(_ int, shardID uint32) (interface{}, error) {
+		return tp.getTxPoolForShard(shardID, fields)
+	})
+
 	txs := &data.TransactionsPool{
 		RegularTransactions:  make([]data.WrappedTransaction, 0),
 		SmartContractResults: make([]data.WrappedTransaction, 0),
 		Rewards:              make([]data.WrappedTransaction, 0),
+		MissingShards:        shardsResponse.MissingShards,
 	}
-	for _, shard := range shardIDs {
-		intraShardTxs, err := tp.getTxPoolForShard(shard, fields)
-		if err != nil {
+	for _, response := range shardsResponse.Responses {
+		intraShardTxs, ok := response.(*data.TransactionsPool)
+		if !ok {
 			continue
 		}
 
@@ -90,6 +90,7 @@ func createTestProcessorFromScenarioData(testData *scenarioData) *process.Transa
 		marshalizer,
 		funcNewTxCostHandler,
 		logsMerger,
+		&mock.NetworkConfigsHandlerStub{},
 		false,
 	)
 
@@ -99,7 +100,7 @@ func createTestProcessorFromScenarioData(testData *scenarioData) *process.Transa
 func TestNewTransactionProcessor_NilCoreProcessorShouldErr(t *testing.T) {
 	t.Parallel()
 
-	tp, err := process.NewTransactionProcessor(nil, &mock.PubKeyConverterMock{}, hasher, marshalizer, funcNewTxCostHandler, logsMerger, true)
+	tp, err := process.NewTransactionProcessor(nil, &mock.PubKeyConverterMock{}, hasher, marshalizer, funcNewTxCostHandler, logsMerger, &mock.NetworkConfigsHandlerStub{}, true)
 
 	require.Nil(t, tp)
 	require.Equal(t, process.ErrNilCoreProcessor, err)
@@ -108,7 +109,7 @@ func TestNewTransactionProcessor_NilCoreProcessorShouldErr(t *testing.T) {
 func TestNewTransactionProcessor_NilPubKeyConverterShouldErr(t *testing.T) {
 	t.Parallel()
 
-	tp, err := process.NewTransactionProcessor(&mock.ProcessorStub{}, nil, hasher, marshalizer, funcNewTxCostHandler, logsMerger, true)
+	tp, err := process.NewTransactionProcessor(&mock.ProcessorStub{}, nil, hasher, marshalizer, funcNewTxCostHandler, logsMerger, &mock.NetworkConfigsHandlerStub{}, true)
 
 	require.Nil(t, tp)
 	require.Equal(t, process.ErrNilPubKeyConverter, err)
@@ -117,7 +118,7 @@ func TestNewTransactionProcessor_NilPubKeyConverterShouldErr(t *testing.T) {
 func TestNewTransactionProcessor_NilHasherShouldErr(t *testing.T) {
 	t.Parallel()
 
-	tp, err := process.NewTransactionProcessor(&mock.ProcessorStub{}, &mock.PubKeyConverterMock{}, nil, marshalizer, funcNewTxCostHandler, logsMerger, true)
+	tp, err := process.NewTransactionProcessor(&mock.ProcessorStub{}, &mock.PubKeyConverterMock{}, nil, marshalizer, funcNewTxCostHandler, logsMerger, &mock.NetworkConfigsHandlerStub{}, true)
 
 	require.Nil(t, tp)
 	require.Equal(t, process.ErrNilHasher, err)
@@ -126,7 +127,7 @@ func TestNewTransactionProcessor_NilHasherShouldErr(t *testing.T) {
 func TestNewTransactionProcessor_NilMarshalizerShouldErr(t *testing.T) {
 	t.Parallel()
 
-	tp, err := process.NewTransactionProcessor(&mock.ProcessorStub{}, &mock.PubKeyConverterMock{}, hasher, nil, funcNewTxCostHandler, logsMerger, true)
+	tp, err := process.NewTransactionProcessor(&mock.ProcessorStub{}, &mock.PubKeyConverterMock{}, hasher, nil, funcNewTxCostHandler, logsMerger, &mock.NetworkConfigsHandlerStub{}, true)
 
 	require.Nil(t, tp)
 	require.Equal(t, process.ErrNilMarshalizer, err)
@@ -135,16 +136,25 @@ func TestNewTransactionProcessor_NilMarshalizerShouldErr(t *testing.T) {
 func TestNewTransactionProcessor_NilLogsMergerShouldErr(t *testing.T) {
 	t.Parallel()
 
-	tp, err := process.NewTransactionProcessor(&mock.ProcessorStub{}, &mock.PubKeyConverterMock{}, hasher, marshalizer, funcNewTxCostHandler, nil, true)
+	tp, err := process.NewTransactionProcessor(&mock.ProcessorStub{}, &mock.PubKeyConverterMock{}, hasher, marshalizer, funcNewTxCostHandler, nil, &mock.NetworkConfigsHandlerStub{}, true)
 
 	require.Nil(t, tp)
 	require.Equal(t, process.ErrNilLogsMerger, err)
 }
 
+func TestNewTransactionProcessor_NilNetworkConfigsHandlerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	tp, err := process.NewTransactionProcessor(&mock.ProcessorStub{}, &mock.PubKeyConverterMock{}, hasher, marshalizer, funcNewTxCostHandler, logsMerger, nil, true)
+
+	require.Nil(t, tp)
+	require.Equal(t, process.ErrNilNetworkConfigsHandler, err)
+}
+
 func TestNewTransactionProcessor_OkValuesShouldWork(t *testing.T) {
 	t.Parallel()
 
-	tp, err := process.NewTransactionProcessor(&mock.ProcessorStub{}, &mock.PubKeyConverterMock{}, hasher, marshalizer, funcNewTxCostHandler, logsMerger, true)
+	tp, err := process.NewTransactionProcessor(&mock.ProcessorStub{}, &mock.PubKeyConverterMock{}, hasher, marshalizer, funcNewTxCostHandler, logsMerger, &mock.NetworkConfigsHandlerStub{}, true)
 
 	require.NotNil(t, tp)
 	require.Nil(t, err)
@@ -155,7 +165,7 @@ func TestNewTransactionProcessor_OkValuesShouldWork(t *testing.T) {
 func TestTransactionProcessor_SendTransactionInvalidHexAdressShouldErr(t *testing.T) {
 	t.Parallel()
 
-	tp, _ := process.NewTransactionProcessor(&mock.ProcessorStub{}, &mock.PubKeyConverterMock{}, hasher, marshalizer, funcNewTxCostHandler, logsMerger, true)
+	tp, _ := process.NewTransactionProcessor(&mock.ProcessorStub{}, &mock.PubKeyConverterMock{}, hasher, marshalizer, funcNewTxCostHandler, logsMerger, &mock.NetworkConfigsHandlerStub{}, true)
 	rc, txHash, err := tp.SendTransaction(&data.Transaction{
 		Sender: "invalid hex number",
 	})
@@ -169,7 +179,7 @@ func TestTransactionProcessor_SendTransactionInvalidHexAdressShouldErr(t *testin
 func TestTransactionProcessor_SendTransactionNoChainIDShouldErr(t *testing.T) {
 	t.Parallel()
 
-	tp, _ := process.NewTransactionProcessor(&mock.ProcessorStub{}, &mock.PubKeyConverterMock{}, hasher, marshalizer, funcNewTxCostHandler, logsMerger, true)
+	tp, _ := process.NewTransactionProcessor(&mock.ProcessorStub{}, &mock.PubKeyConverterMock{}, hasher, marshalizer, funcNewTxCostHandler, logsMerger, &mock.NetworkConfigsHandlerStub{}, true)
 	rc, txHash, err := tp.SendTransaction(&data.Transaction{})
 
 	require.Empty(t, txHash)
@@ -181,7 +191,7 @@ func TestTransactionProcessor_SendTransactionNoChainIDShouldErr(t *testing.T) {
 func TestTransactionProcessor_SendTransactionNoVersionShouldErr(t *testing.T) {
 	t.Parallel()
 
-	tp, _ := process.NewTransactionProcessor(&mock.ProcessorStub{}, &mock.PubKeyConverterMock{}, hasher, marshalizer, funcNewTxCostHandler, logsMerger, true)
+	tp, _ := process.NewTransactionProcessor(&mock.ProcessorStub{}, &mock.PubKeyConverterMock{}, hasher, marshalizer, funcNewTxCostHandler, logsMerger, &mock.NetworkConfigsHandlerStub{}, true)
 	rc, txHash, err := tp.SendTransaction(&data.Transaction{
 		ChainID: "chainID",
 	})
@@ -207,6 +217,7 @@ func TestTransactionProcessor_SendTransactionComputeShardIdFailsShouldErr(t *tes
 		marshalizer,
 		funcNewTxCostHandler,
 		logsMerger,
+		&mock.NetworkConfigsHandlerStub{},
 		true,
 	)
 	rc, txHash, err := tp.SendTransaction(&data.Transaction{
@@ -237,6 +248,7 @@ func TestTransactionProcessor_SendTransactionGetObserversFailsShouldErr(t *testi
 		marshalizer,
 		funcNewTxCostHandler,
 		logsMerger,
+		&mock.NetworkConfigsHandlerStub{},
 		true,
 	)
 	address := "DEADBEEF"
@@ -275,6 +287,7 @@ func TestTransactionProcessor_SendTransactionSendingFailsOnAllObserversShouldErr
 		marshalizer,
 		funcNewTxCostHandler,
 		logsMerger,
+		&mock.NetworkConfigsHandlerStub{},
 		true,
 	)
 	address := "DEADBEEF"
@@ -316,6 +329,7 @@ func TestTransactionProcessor_SendTransactionSendingFailsOnFirstObserverShouldSt
 		marshalizer,
 		funcNewTxCostHandler,
 		logsMerger,
+		&mock.NetworkConfigsHandlerStub{},
 		true,
 	)
 	address := "DEADBEEF"
@@ -367,6 +381,7 @@ func TestTransactionProcessor_SendMultipleTransactionsShouldWork(t *testing.T) {
 		marshalizer,
 		funcNewTxCostHandler,
 		logsMerger,
+		&mock.NetworkConfigsHandlerStub{},
 		true,
 	)
 
@@ -440,6 +455,7 @@ func TestTransactionProcessor_SendMultipleTransactionsShouldWorkAndSendTxsByShar
 		marshalizer,
 		funcNewTxCostHandler,
 		logsMerger,
+		&mock.NetworkConfigsHandlerStub{},
 		true,
 	)
 
@@ -484,6 +500,7 @@ func TestTransactionProcessor_SimulateTransactionShouldWork(t *testing.T) {
 		marshalizer,
 		funcNewTxCostHandler,
 		logsMerger,
+		&mock.NetworkConfigsHandlerStub{},
 		true,
 	)
 
@@ -539,6 +556,7 @@ func TestTransactionProcessor_SimulateTransactionCrossShardOkOnSenderFailOnRecei
 		marshalizer,
 		funcNewTxCostHandler,
 		logsMerger,
+		&mock.NetworkConfigsHandlerStub{},
 		true,
 	)
 
@@ -609,6 +627,7 @@ func TestTransactionProcessor_GetTransactionStatusIntraShardTransaction(t *testi
 		marshalizer,
 		funcNewTxCostHandler,
 		logsMerger,
+		&mock.NetworkConfigsHandlerStub{},
 		true,
 	)
 
@@ -664,6 +683,7 @@ func TestTransactionProcessor_GetTransactionStatusCrossShardTransaction(t *testi
 		marshalizer,
 		funcNewTxCostHandler,
 		logsMerger,
+		&mock.NetworkConfigsHandlerStub{},
 		true,
 	)
 
@@ -732,6 +752,7 @@ func TestTransactionProcessor_GetTransactionStatusCrossShardTransactionDestinati
 		marshalizer,
 		funcNewTxCostHandler,
 		logsMerger,
+		&mock.NetworkConfigsHandlerStub{},
 		true,
 	)
 
@@ -801,6 +822,7 @@ func TestTransactionProcessor_GetTransactionStatusWithSenderAddressCrossShard(t
 		marshalizer,
 		funcNewTxCostHandler,
 		logsMerger,
+		&mock.NetworkConfigsHandlerStub{},
 		true,
 	)
 
@@ -823,6 +845,7 @@ func TestTransactionProcessor_GetTransactionStatusWithSenderInvaidSender(t *test
 		hasher,
 		marshalizer, funcNewTxCostHandler,
 		logsMerger,
+		&mock.NetworkConfigsHandlerStub{},
 		true,
 	)
 
@@ -879,6 +902,7 @@ func TestTransactionProcessor_GetTransactionStatusWithSenderAddressIntraShard(t
 		marshalizer,
 		funcNewTxCostHandler,
 		logsMerger,
+		&mock.NetworkConfigsHandlerStub{},
 		true,
 	)
 
@@ -904,7 +928,7 @@ func TestTransactionProcessor_ComputeTransactionInvalidTransactionValue(t *testi
 	}
 
 	pubKeyConv := &mock.PubKeyConverterMock{}
-	tp, _ := process.NewTransactionProcessor(&mock.ProcessorStub{}, pubKeyConv, hasher, marshalizer, funcNewTxCostHandler, logsMerger, true)
+	tp, _ := process.NewTransactionProcessor(&mock.ProcessorStub{}, pubKeyConv, hasher, marshalizer, funcNewTxCostHandler, logsMerger, &mock.NetworkConfigsHandlerStub{}, true)
 
 	_, err := tp.ComputeTransactionHash(tx)
 	assert.Equal(t, process.ErrInvalidTransactionValueField, err)
@@ -927,7 +951,7 @@ func TestTransactionProcessor_ComputeTransactionInvalidReceiverAddress(t *testin
 	}
 
 	pubKeyConv := &mock.PubKeyConverterMock{}
-	tp, _ := process.NewTransactionProcessor(&mock.ProcessorStub{}, pubKeyConv, hasher, marshalizer, funcNewTxCostHandler, logsMerger, true)
+	tp, _ := process.NewTransactionProcessor(&mock.ProcessorStub{}, pubKeyConv, hasher, marshalizer, funcNewTxCostHandler, logsMerger, &mock.NetworkConfigsHandlerStub{}, true)
 
 	_, err := tp.ComputeTransactionHash(tx)
 	assert.Equal(t, process.ErrInvalidAddress, err)
@@ -949,7 +973,7 @@ func TestTransactionProcessor_ComputeTransactionInvalidSenderAddress(t *testing.
 		Version:   1,
 	}
 	pubKeyConv := &mock.PubKeyConverterMock{}
-	tp, _ := process.NewTransactionProcessor(&mock.ProcessorStub{}, pubKeyConv, hasher, marshalizer, funcNewTxCostHandler, logsMerger, true)
+	tp, _ := process.NewTransactionProcessor(&mock.ProcessorStub{}, pubKeyConv, hasher, marshalizer, funcNewTxCostHandler, logsMerger, &mock.NetworkConfigsHandlerStub{}, true)
 
 	_, err := tp.ComputeTransactionHash(tx)
 	assert.Equal(t, process.ErrInvalidAddress, err)
@@ -971,7 +995,7 @@ func TestTransactionProcessor_ComputeTransactionInvalidSignaturesBytes(t *testin
 		Version:   1,
 	}
 	pubKeyConv := &mock.PubKeyConverterMock{}
-	tp, _ := process.NewTransactionProcessor(&mock.ProcessorStub{}, pubKeyConv, hasher, marshalizer, funcNewTxCostHandler, logsMerger, true)
+	tp, _ := process.NewTransactionProcessor(&mock.ProcessorStub{}, pubKeyConv, hasher, marshalizer, funcNewTxCostHandler, logsMerger, &mock.NetworkConfigsHandlerStub{}, true)
 
 	_, err := tp.ComputeTransactionHash(tx)
 	assert.Equal(t, process.ErrInvalidSignatureBytes, err)
@@ -994,7 +1018,7 @@ func TestTransactionProcessor_ComputeTransactionShouldWork1(t *testing.T) {
 	}
 
 	pubKeyConv := &mock.PubKeyConverterMock{}
-	tp, _ := process.NewTransactionProcessor(&mock.ProcessorStub{}, pubKeyConv, hasher, marshalizer, funcNewTxCostHandler, logsMerger, true)
+	tp, _ := process.NewTransactionProcessor(&mock.ProcessorStub{}, pubKeyConv, hasher, marshalizer, funcNewTxCostHandler, logsMerger, &mock.NetworkConfigsHandlerStub{}, true)
 
 	txHashHex := "891694ae6307ee9f17f861816187a6729268397f8fabc055d5b334f552cd3cfb"
 	txHash, err := tp.ComputeTransactionHash(tx)
@@ -1021,7 +1045,7 @@ func TestTransactionProcessor_ComputeTransactionShouldWork2(t *testing.T) {
 	protoTxHash := hex.EncodeToString(protoTxHashBytes)
 
 	pubKeyConv := &mock.PubKeyConverterMock{}
-	tp, _ := process.NewTransactionProcessor(&mock.ProcessorStub{}, pubKeyConv, hasher, marshalizer, funcNewTxCostHandler, logsMerger, true)
+	tp, _ := process.NewTransactionProcessor(&mock.ProcessorStub{}, pubKeyConv, hasher, marshalizer, funcNewTxCostHandler, logsMerger, &mock.NetworkConfigsHandlerStub{}, true)
 
 	txHash, err := tp.ComputeTransactionHash(&data.Transaction{
 		Nonce:     protoTx.Nonce,
@@ -1097,6 +1121,7 @@ func TestTransactionProcessor_GetTransactionShouldWork(t *testing.T) {
 		marshalizer,
 		funcNewTxCostHandler,
 		logsMerger,
+		&mock.NetworkConfigsHandlerStub{},
 		true,
 	)
 
@@ -1147,6 +1172,7 @@ func TestTransactionProcessor_GetTransactionShouldCallOtherObserverInShardIfHttp
 		marshalizer,
 		funcNewTxCostHandler,
 		logsMerger,
+		&mock.NetworkConfigsHandlerStub{},
 		true,
 	)
 
@@ -1193,6 +1219,7 @@ func TestTransactionProcessor_GetTransactionShouldNotCallOtherObserverInShardIfN
 		marshalizer,
 		funcNewTxCostHandler,
 		logsMerger,
+		&mock.NetworkConfigsHandlerStub{},
 		true,
 	)
 
@@ -1302,6 +1329,7 @@ func TestTransactionProcessor_GetTransactionWithEventsFirstFromDstShardAndAfterS
 		marshalizer,
 		funcNewTxCostHandler,
 		logsMerger,
+		&mock.NetworkConfigsHandlerStub{},
 		true,
 	)
 
@@ -1318,7 +1346,7 @@ func TestTransactionProcessor_GetTransactionPool(t *testing.T) {
 	t.Run("GetTransactionsPool, flag not enabled", func(t *testing.T) {
 		t.Parallel()
 
-		tp, _ := process.NewTransactionProcessor(&mock.ProcessorStub{}, &mock.PubKeyConverterMock{}, hasher, marshalizer, funcNewTxCostHandler, logsMerger, false)
+		tp, _ := process.NewTransactionProcessor(&mock.ProcessorStub{}, &mock.PubKeyConverterMock{}, hasher, marshalizer, funcNewTxCostHandler, logsMerger, &mock.NetworkConfigsHandlerStub{}, false)
 		require.NotNil(t, tp)
 
 		txs, err := tp.GetTransactionsPool("")
@@ -1359,14 +1387,14 @@ func TestTransactionProcessor_GetTransactionPool(t *testing.T) {
 
 				return http.StatusOK, nil
 			},
-		}, &mock.PubKeyConverterMock{}, hasher, marshalizer, funcNewTxCostHandler, logsMerger, true)
+		}, &mock.PubKeyConverterMock{}, hasher, marshalizer, funcNewTxCostHandler, logsMerger, &mock.NetworkConfigsHandlerStub{}, true)
 		require.NotNil(t, tp)
 
 		txs, err := tp.GetTransactionsPool("sender,nonce")
 		require.NotNil(t, txs)
 		assert.NoError(t, err)
 	})
-	t.Run("GetTransactionsPool, txs in 2 shards, but none in 3rd", func(t *testing.T) {
+	t.Run("GetTransactionsPool, txs in 2 shards, 3rd one missing", func(t *testing.T) {
 		t.Parallel()
 
 		sndrShard0 := hex.EncodeToString([]byte("aaaa"))
@@ -1472,13 +1500,14 @@ func TestTransactionProcessor_GetTransactionPool(t *testing.T) {
 
 				return http.StatusBadGateway, nil
 			},
-		}, &mock.PubKeyConverterMock{}, hasher, marshalizer, funcNewTxCostHandler, logsMerger, true)
+		}, &mock.PubKeyConverterMock{}, hasher, marshalizer, funcNewTxCostHandler, logsMerger, &mock.NetworkConfigsHandlerStub{}, true)
 		require.NotNil(t, tp)
 
 		expectedResponse := &data.TransactionsPool{
 			RegularTransactions:  []data.WrappedTransaction{regularTxSh0, regularTxSh1},
 			SmartContractResults: []data.WrappedTransaction{scrTxSh0, scrTxSh1},
 			Rewards:              []data.WrappedTransaction{rewardsTxSh0, rewardsTxSh1},
+			MissingShards:        []uint32{2},
 		}
 		txs, err := tp.GetTransactionsPool("sender,nonce")
 		require.Nil(t, err)
@@ -1489,7 +1518,7 @@ func TestTransactionProcessor_GetTransactionPool(t *testing.T) {
 	t.Run("GetTransactionsPoolForShard, flag not enabled", func(t *testing.T) {
 		t.Parallel()
 
-		tp, _ := process.NewTransactionProcessor(&mock.ProcessorStub{}, &mock.PubKeyConverterMock{}, hasher, marshalizer, funcNewTxCostHandler, logsMerger, false)
+		tp, _ := process.NewTransactionProcessor(&mock.ProcessorStub{}, &mock.PubKeyConverterMock{}, hasher, marshalizer, funcNewTxCostHandler, logsMerger, &mock.NetworkConfigsHandlerStub{}, false)
 		require.NotNil(t, tp)
 
 		txs, err := tp.GetTransactionsPoolForShard(0, "")
@@ -1522,7 +1551,7 @@ func TestTransactionProcessor_GetTransactionPool(t *testing.T) {
 
 				return http.StatusOK, nil
 			},
-		}, &mock.PubKeyConverterMock{}, hasher, marshalizer, funcNewTxCostHandler, logsMerger, true)
+		}, &mock.PubKeyConverterMock{}, hasher, marshalizer, funcNewTxCostHandler, logsMerger, &mock.NetworkConfigsHandlerStub{}, true)
 		require.NotNil(t, tp)
 
 		txs, err := tp.GetTransactionsPoolForShard(0, "sender,nonce")
@@ -1617,7 +1646,7 @@ func TestTransactionProcessor_GetTransactionPool(t *testing.T) {
 
 				return http.StatusBadGateway, nil
 			},
-		}, &mock.PubKeyConverterMock{}, hasher, marshalizer, funcNewTxCostHandler, logsMerger, true)
+		}, &mock.PubKeyConverterMock{}, hasher, marshalizer, funcNewTxCostHandler, logsMerger, &mock.NetworkConfigsHandlerStub{}, true)
 		require.NotNil(t, tp)
 
 		expectedResponse := &data.TransactionsPool{
@@ -1666,7 +1695,7 @@ func TestTransactionProcessor_GetTransactionPool(t *testing.T) {
 
 				return http.StatusOK, nil
 			},
-		}, providedPubKeyConverter, hasher, marshalizer, funcNewTxCostHandler, logsMerger, true)
+		}, providedPubKeyConverter, hasher, marshalizer, funcNewTxCostHandler, logsMerger, &mock.NetworkConfigsHandlerStub{}, true)
 		require.NotNil(t, tp)
 
 		txs, err := tp.GetTransactionsPoolForSender(providedSenderStr, "sender,nonce")
@@ -1744,7 +1773,7 @@ func TestTransactionProcessor_GetTransactionPool(t *testing.T) {
 
 				return http.StatusOK, nil
 			},
-		}, providedPubKeyConverter, hasher, marshalizer, funcNewTxCostHandler, logsMerger, true)
+		}, providedPubKeyConverter, hasher, marshalizer, funcNewTxCostHandler, logsMerger, &mock.NetworkConfigsHandlerStub{}, true)
 		require.NotNil(t, tp)
 
 		txs, err := tp.GetTransactionsPoolForSender(providedSenderStr, "sender,nonce")
@@ -1967,6 +1996,7 @@ func TestTransactionProcessor_GetProcessedTransactionStatus(t *testing.T) {
 		marshalizer,
 		funcNewTxCostHandler,
 		logsMerger,
+		&mock.NetworkConfigsHandlerStub{},
 		true,
 	)
 
@@ -1974,3 +2004,148 @@ func TestTransactionProcessor_GetProcessedTransactionStatus(t *testing.T) {
 	assert.Nil(t, err)
 	assert.Equal(t, string(transaction.TxStatusPending), status) // not a move balance tx with missing finish markers
 }
+
+func TestTransactionProcessor_GetTransactionFeeEstimate(t *testing.T) {
+	t.Parallel()
+
+	sender := "05702a5fd947a9ddb861ce7ffebfea86c2ca8906df3065ae295f283477ae4e43"
+	receiver := "a5fd947a9ddb861ce7ffebfea86c2ca8906df3065ae295f283477ae4e4305702"
+	networkConfig := &data.NetworkConfig{}
+	networkConfig.Config.ChainID = "T"
+	networkConfig.Config.MinTransactionVersion = 2
+	networkConfig.Config.MinGasPrice = 1000000000
+	networkConfig.Config.GasPriceModifier = "0.01"
+	networkConfig.Config.RoundDuration = 60000
+	gasConfigs := &data.TxCostGasConfigs{
+		MinGasLimit:    50000,
+		GasPerDataByte: 1500,
+	}
+	createProcessor := func(txCostResponse *data.TxCostResponseData, poolSize int, numPoolRequests *int32) *process.TransactionProcessor {
+		coreProc := &mock.ProcessorStub{
+			GetObserversCalled: func(shardId uint32) ([]*data.NodeData, error) {
+				return []*data.NodeData{{Address: "observer", ShardId: shardId}}, nil
+			},
+			ComputeShardIdCalled: func(addressBuff []byte) (uint32, error) {
+				return 0, nil
+			},
+			CallGetRestEndPointCalled: func(address string, path string, value interface{}) (int, error) {
+				require.True(t, strings.HasPrefix(path, process.TransactionsPoolPath))
+				atomic.AddInt32(numPoolRequests, 1)
+				response := value.(*data.TransactionsPoolApiResponse)
+				response.Data.Transactions.RegularTransactions = make([]data.WrappedTransaction, poolSize)
+				return http.StatusOK, nil
+			},
+		}
+		txCostHandlerCreator := func() (process.TransactionCostHandler, error) {
+			return &mock.TransactionCostHandlerStub{
+				RezolveCostRequestCalled: func(tx *data.Transaction) (*data.TxCostResponseData, error) {
+					require.Equal(t, "T", tx.ChainID)
+					require.Equal(t, uint32(2), tx.Version)
+					return txCostResponse, nil
+				},
+			}, nil
+		}
+		networkConfigsHandler := &mock.NetworkConfigsHandlerStub{
+			GetNetworkConfigCalled: func() (*data.NetworkConfig, error) {
+				return networkConfig, nil
+			},
+			GetGasConfigsCalled: func() (*data.TxCostGasConfigs, error) {
+				return gasConfigs, nil
+			},
+		}
+
+		tp, err := process.NewTransactionProcessor(
+			coreProc,
+			&mock.PubKeyConverterMock{},
+			hasher,
+			marshalizer,
+			txCostHandlerCreator,
+			logsMerger,
+			networkConfigsHandler,
+			false,
+		)
+		require.Nil(t, err)
+
+		return tp
+	}
+
+	t.Run("invalid sender should err", func(t *testing.T) {
+		t.Parallel()
+
+		numPoolRequests := int32(0)
+		tp := createProcessor(&data.TxCostResponseData{TxCost: 50000}, 0, &numPoolRequests)
+		feeEstimate, err := tp.GetTransactionFeeEstimate(&data.Transaction{Sender: "not hex", Receiver: receiver})
+		require.Nil(t, feeEstimate)
+		require.True(t, errors.Is(err, data.ErrCannotEstimateTransactionFee))
+	})
+
+	t.Run("cost request failing should err", func(t *testing.T) {
+		t.Parallel()
+
+		numPoolRequests := int32(0)
+		tp := createProcessor(&data.TxCostResponseData{RetMessage: "insufficient funds"}, 0, &numPoolRequests)
+		feeEstimate, err := tp.GetTransactionFeeEstimate(&data.Transaction{Sender: sender, Receiver: receiver})
+		require.Nil(t, feeEstimate)
+		require.True(t, errors.Is(err, data.ErrCannotEstimateTransactionFee))
+		require.Contains(t, err.Error(), "insufficient funds")
+	})
+
+	t.Run("empty pool should suggest the minimum gas price", func(t *testing.T) {
+		t.Parallel()
+
+		numPoolRequests := int32(0)
+		tp := createProcessor(&data.TxCostResponseData{TxCost: 50000, EstimationMethod: data.TxCostEstimationMethodLocal}, 0, &numPoolRequests)
+		feeEstimate, err := tp.GetTransactionFeeEstimate(&data.Transaction{Sender: sender, Receiver: receiver})
+		require.Nil(t, err)
+
+		expectedSuggestion := &data.GasPriceSuggestion{GasPrice: 1000000000, Fee: "50000000000000", FeeDenominated: "0.00005"}
+		require.Equal(t, &data.TransactionFeeEstimate{
+			GasUnits:         50000,
+			EstimationMethod: data.TxCostEstimationMethodLocal,
+			PoolSize:         0,
+			Low:              expectedSuggestion,
+			Normal:           expectedSuggestion,
+			Fast:             expectedSuggestion,
+		}, feeEstimate)
+	})
+
+	t.Run("busy pool should increase the suggested gas prices", func(t *testing.T) {
+		t.Parallel()
+
+		numPoolRequests := int32(0)
+		tp := createProcessor(&data.TxCostResponseData{TxCost: 100000, EstimationMethod: data.TxCostEstimationMethodObservers}, 10000, &numPoolRequests)
+		feeEstimate, err := tp.GetTransactionFeeEstimate(&data.Transaction{Sender: sender, Receiver: receiver, Data: []byte("hello")})
+		require.Nil(t, err)
+
+		require.Equal(t, uint64(100000), feeEstimate.GasUnits)
+		require.Equal(t, data.TxCostEstimationMethodObservers, feeEstimate.EstimationMethod)
+		require.Equal(t, 10000, feeEstimate.PoolSize)
+		require.Equal(t, &data.GasPriceSuggestion{GasPrice: 1000000000, Fee: "57925000000000", FeeDenominated: "0.000057925"}, feeEstimate.Low)
+		require.Equal(t, &data.GasPriceSuggestion{GasPrice: 1250000000, Fee: "72406250000000", FeeDenominated: "0.00007240625"}, feeEstimate.Normal)
+		require.Equal(t, &data.GasPriceSuggestion{GasPrice: 1500000000, Fee: "86887500000000", FeeDenominated: "0.0000868875"}, feeEstimate.Fast)
+	})
+
+	t.Run("pool size should be fetched once per round", func(t *testing.T) {
+		t.Parallel()
+
+		numPoolRequests := int32(0)
+		tp := createProcessor(&data.TxCostResponseData{TxCost: 50000}, 10, &numPoolRequests)
+		for i := 0; i < 3; i++ {
+			feeEstimate, err := tp.GetTransactionFeeEstimate(&data.Transaction{Sender: sender, Receiver: receiver})
+			require.Nil(t, err)
+			require.Equal(t, 10, feeEstimate.PoolSize)
+		}
+		require.Equal(t, int32(1), atomic.LoadInt32(&numPoolRequests))
+	})
+}
+
+func TestFormatAmountWithDecimals(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t, "0", process.FormatAmountWithDecimals(big.NewInt(0), 18))
+	require.Equal(t, "0.00005", process.FormatAmountWithDecimals(big.NewInt(50000000000000), 18))
+	require.Equal(t, "1.5", process.FormatAmountWithDecimals(big.NewInt(1500), 3))
+	require.Equal(t, "12", process.FormatAmountWithDecimals(big.NewInt(12000), 3))
+	require.Equal(t, "-0.012", process.FormatAmountWithDecimals(big.NewInt(-12), 3))
+	require.Equal(t, "42", process.FormatAmountWithDecimals(big.NewInt(42), 0))
+}
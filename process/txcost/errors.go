@@ -10,3 +10,9 @@ var ErrNilCoreProcessor = errors.New("nil core processor")
 
 // ErrSendingRequest signals that sending the request failed on all observers
 var ErrSendingRequest = errors.New("sending request error")
+
+// ErrNilGasConfigsHandler signals that a nil gas configs handler has been provided
+var ErrNilGasConfigsHandler = errors.New("nil gas configs handler")
+
+// ErrInvalidCacheValidityDuration signals that an invalid cache validity duration has been provided
+var ErrInvalidCacheValidityDuration = errors.New("invalid cache validity duration")
@@ -0,0 +1,123 @@
+package txcost
+
+import (
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/multiversx/mx-chain-proxy-go/process"
+)
+
+type gasConfigsCache struct {
+	proc          process.Processor
+	cacheValidity time.Duration
+
+	mutGasConfigs sync.Mutex
+	networkConfig *data.NetworkConfig
+	gasConfigs    *data.TxCostGasConfigs
+	lastUpdate    time.Time
+}
+
+// NewGasConfigsCache returns a component which fetches the network config and the gas configs from the observers and
+// keeps them for the given duration. The stale values are served while none of the observers respond
+func NewGasConfigsCache(proc process.Processor, cacheValidity time.Duration) (*gasConfigsCache, error) {
+	if check.IfNil(proc) {
+		return nil, ErrNilCoreProcessor
+	}
+	if cacheValidity <= 0 {
+		return nil, ErrInvalidCacheValidityDuration
+	}
+
+	return &gasConfigsCache{
+		proc:          proc,
+		cacheValidity: cacheValidity,
+	}, nil
+}
+
+// GetGasConfigs returns the cached gas configs, refreshing them if they expired
+func (gcc *gasConfigsCache) GetGasConfigs() (*data.TxCostGasConfigs, error) {
+	gcc.mutGasConfigs.Lock()
+	defer gcc.mutGasConfigs.Unlock()
+
+	err := gcc.refreshIfExpiredUnprotected()
+	if err != nil {
+		return nil, err
+	}
+
+	return gcc.gasConfigs, nil
+}
+
+// GetNetworkConfig returns the cached network config, refreshing it if it expired
+func (gcc *gasConfigsCache) GetNetworkConfig() (*data.NetworkConfig, error) {
+	gcc.mutGasConfigs.Lock()
+	defer gcc.mutGasConfigs.Unlock()
+
+	err := gcc.refreshIfExpiredUnprotected()
+	if err != nil {
+		return nil, err
+	}
+
+	return gcc.networkConfig, nil
+}
+
+func (gcc *gasConfigsCache) refreshIfExpiredUnprotected() error {
+	if gcc.gasConfigs != nil && time.Since(gcc.lastUpdate) < gcc.cacheValidity {
+		return nil
+	}
+
+	networkConfig, gasConfigs, err := gcc.fetchConfigs()
+	if err != nil {
+		if gcc.gasConfigs == nil {
+			return err
+		}
+
+		log.Debug("cannot refresh the gas configs, using the cached ones", "error", err)
+		return nil
+	}
+
+	gcc.networkConfig = networkConfig
+	gcc.gasConfigs = gasConfigs
+	gcc.lastUpdate = time.Now()
+
+	return nil
+}
+
+func (gcc *gasConfigsCache) fetchConfigs() (*data.NetworkConfig, *data.TxCostGasConfigs, error) {
+	observers, err := gcc.proc.GetAllObservers()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	for _, observer := range observers {
+		networkConfigResponse := &data.NetworkConfigApiResponse{}
+		respCode, err := gcc.proc.CallGetRestEndPoint(observer.Address, process.NetworkConfigPath, networkConfigResponse)
+		if err != nil || respCode != http.StatusOK {
+			log.Trace("cannot get the network config", "observer", observer.Address, "error", err)
+			continue
+		}
+
+		gasConfigsResponse := &data.GasConfigsApiResponse{}
+		respCode, err = gcc.proc.CallGetRestEndPoint(observer.Address, process.GasConfigsPath, gasConfigsResponse)
+		if err != nil || respCode != http.StatusOK {
+			log.Trace("cannot get the gas configs", "observer", observer.Address, "error", err)
+			continue
+		}
+
+		networkConfig := networkConfigResponse.Data.Config
+		return &networkConfigResponse.Data, &data.TxCostGasConfigs{
+			MinGasLimit:            networkConfig.MinGasLimit,
+			GasPerDataByte:         networkConfig.GasPerDataByte,
+			ExtraGasLimitGuardedTx: networkConfig.ExtraGasLimitGuardedTx,
+			BuiltInCost:            gasConfigsResponse.Data.GasConfigs.BuiltInCost,
+		}, nil
+	}
+
+	return nil, nil, ErrSendingRequest
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (gcc *gasConfigsCache) IsInterfaceNil() bool {
+	return gcc == nil
+}
@@ -0,0 +1,132 @@
+package txcost
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/multiversx/mx-chain-proxy-go/process"
+	"github.com/multiversx/mx-chain-proxy-go/process/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func createGasConfigsProcessorStub(numCalls *int, failCalls *bool) *mock.ProcessorStub {
+	return &mock.ProcessorStub{
+		GetAllObserversCalled: func() ([]*data.NodeData, error) {
+			return []*data.NodeData{{Address: "observer0"}, {Address: "observer1"}}, nil
+		},
+		CallGetRestEndPointCalled: func(address string, path string, value interface{}) (int, error) {
+			*numCalls++
+			if *failCalls || address == "observer0" {
+				return http.StatusInternalServerError, errors.New("observer down")
+			}
+
+			switch path {
+			case process.NetworkConfigPath:
+				response := value.(*data.NetworkConfigApiResponse)
+				response.Data.Config.MinGasLimit = 50000
+				response.Data.Config.GasPerDataByte = 1500
+				response.Data.Config.ExtraGasLimitGuardedTx = 50000
+				response.Data.Config.ChainID = "T"
+			case process.GasConfigsPath:
+				response := value.(*data.GasConfigsApiResponse)
+				response.Data.GasConfigs.BuiltInCost = map[string]uint64{esdtTransferFunction: 200000}
+			}
+
+			return http.StatusOK, nil
+		},
+	}
+}
+
+func TestNewGasConfigsCache(t *testing.T) {
+	t.Parallel()
+
+	gcc, err := NewGasConfigsCache(nil, time.Second)
+	require.Nil(t, gcc)
+	require.Equal(t, ErrNilCoreProcessor, err)
+
+	gcc, err = NewGasConfigsCache(&mock.ProcessorStub{}, 0)
+	require.Nil(t, gcc)
+	require.Equal(t, ErrInvalidCacheValidityDuration, err)
+
+	gcc, err = NewGasConfigsCache(&mock.ProcessorStub{}, time.Second)
+	require.Nil(t, err)
+	require.False(t, gcc.IsInterfaceNil())
+}
+
+func TestGasConfigsCache_GetGasConfigs(t *testing.T) {
+	t.Parallel()
+
+	expectedGasConfigs := &data.TxCostGasConfigs{
+		MinGasLimit:            50000,
+		GasPerDataByte:         1500,
+		ExtraGasLimitGuardedTx: 50000,
+		BuiltInCost:            map[string]uint64{esdtTransferFunction: 200000},
+	}
+
+	t.Run("all observers failing should err", func(t *testing.T) {
+		t.Parallel()
+
+		numCalls := 0
+		failCalls := true
+		gcc, _ := NewGasConfigsCache(createGasConfigsProcessorStub(&numCalls, &failCalls), time.Minute)
+
+		gasConfigs, err := gcc.GetGasConfigs()
+		require.Nil(t, gasConfigs)
+		require.Equal(t, ErrSendingRequest, err)
+
+		networkConfig, err := gcc.GetNetworkConfig()
+		require.Nil(t, networkConfig)
+		require.Equal(t, ErrSendingRequest, err)
+	})
+
+	t.Run("should cache the gas configs", func(t *testing.T) {
+		t.Parallel()
+
+		numCalls := 0
+		failCalls := false
+		gcc, _ := NewGasConfigsCache(createGasConfigsProcessorStub(&numCalls, &failCalls), time.Minute)
+
+		gasConfigs, err := gcc.GetGasConfigs()
+		require.Nil(t, err)
+		require.Equal(t, expectedGasConfigs, gasConfigs)
+		require.Equal(t, 3, numCalls)
+
+		gasConfigs, err = gcc.GetGasConfigs()
+		require.Nil(t, err)
+		require.Equal(t, expectedGasConfigs, gasConfigs)
+		require.Equal(t, 3, numCalls)
+
+		networkConfig, err := gcc.GetNetworkConfig()
+		require.Nil(t, err)
+		require.Equal(t, "T", networkConfig.Config.ChainID)
+		require.Equal(t, uint64(50000), networkConfig.Config.MinGasLimit)
+		require.Equal(t, 3, numCalls)
+	})
+
+	t.Run("expired gas configs should be refreshed, or served while the observers fail", func(t *testing.T) {
+		t.Parallel()
+
+		numCalls := 0
+		failCalls := false
+		gcc, _ := NewGasConfigsCache(createGasConfigsProcessorStub(&numCalls, &failCalls), time.Millisecond)
+
+		_, err := gcc.GetGasConfigs()
+		require.Nil(t, err)
+		require.Equal(t, 3, numCalls)
+
+		time.Sleep(5 * time.Millisecond)
+		_, err = gcc.GetGasConfigs()
+		require.Nil(t, err)
+		require.Equal(t, 6, numCalls)
+
+		time.Sleep(5 * time.Millisecond)
+		failCalls = true
+		gasConfigs, err := gcc.GetGasConfigs()
+		require.Nil(t, err)
+		require.Equal(t, expectedGasConfigs, gasConfigs)
+		require.Equal(t, 8, numCalls)
+	})
+}
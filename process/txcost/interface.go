@@ -0,0 +1,9 @@
+package txcost
+
+import "github.com/multiversx/mx-chain-proxy-go/data"
+
+// GasConfigsHandler defines what a component able to provide the network's gas configs should do
+type GasConfigsHandler interface {
+	GetGasConfigs() (*data.TxCostGasConfigs, error)
+	IsInterfaceNil() bool
+}
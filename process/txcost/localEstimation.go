@@ -0,0 +1,104 @@
+package txcost
+
+import (
+	"encoding/hex"
+	"math/big"
+	"strings"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+const (
+	esdtTransferFunction         = "ESDTTransfer"
+	esdtNFTTransferFunction      = "ESDTNFTTransfer"
+	multiESDTNFTTransferFunction = "MultiESDTNFTTransfer"
+	relayedTxPrefix              = "relayedTx"
+
+	numESDTTransferArgs                = 2
+	numESDTNFTTransferArgs             = 4
+	numMultiESDTNFTTransferFixedArgs   = 2
+	numMultiESDTNFTTransferArgsPerESDT = 3
+)
+
+// estimateLocally computes the cost of the move balance and ESDT transfer transactions from the gas configs, without
+// calling the observers. It returns false for the transactions that have to be executed by the observers, such as the
+// smart contract calls, or when the gas configs are not available
+func (tcp *transactionCostProcessor) estimateLocally(tx *data.Transaction) (uint64, bool) {
+	receiverBytes, err := tcp.pubKeyConverter.Decode(tx.Receiver)
+	if err != nil || core.IsSmartContractAddress(receiverBytes) {
+		return 0, false
+	}
+
+	dataField := string(tx.Data)
+	if strings.HasPrefix(dataField, relayedTxPrefix) {
+		return 0, false
+	}
+
+	gasConfigs, err := tcp.gasConfigsHandler.GetGasConfigs()
+	if err != nil {
+		log.Debug("cannot estimate the transaction cost locally", "error", err)
+		return 0, false
+	}
+
+	moveBalanceCost := gasConfigs.MinGasLimit + gasConfigs.GasPerDataByte*uint64(len(tx.Data))
+	if len(tx.GuardianAddr) > 0 {
+		moveBalanceCost += gasConfigs.ExtraGasLimitGuardedTx
+	}
+
+	tokens := strings.Split(dataField, argsSeparator)
+	function, args := tokens[0], tokens[1:]
+	builtInFunctionCost, isBuiltInFunction := gasConfigs.BuiltInCost[function]
+	if !isBuiltInFunction {
+		return moveBalanceCost, true
+	}
+
+	numTransfers, ok := tcp.computeNumESDTTransfers(tx, function, args)
+	if !ok {
+		return 0, false
+	}
+
+	return moveBalanceCost + builtInFunctionCost*numTransfers, true
+}
+
+// computeNumESDTTransfers returns the number of tokens transferred to a user account by an ESDT transfer. It returns
+// false for the other built-in functions and for the transfers followed by a smart contract call
+func (tcp *transactionCostProcessor) computeNumESDTTransfers(tx *data.Transaction, function string, args []string) (uint64, bool) {
+	switch function {
+	case esdtTransferFunction:
+		return 1, len(args) == numESDTTransferArgs
+	case esdtNFTTransferFunction:
+		if tx.Sender != tx.Receiver || len(args) != numESDTNFTTransferArgs {
+			return 0, false
+		}
+
+		return 1, isUserAddressArgument(args[numESDTNFTTransferArgs-1])
+	case multiESDTNFTTransferFunction:
+		if tx.Sender != tx.Receiver || len(args) < numMultiESDTNFTTransferFixedArgs {
+			return 0, false
+		}
+
+		numTransfers, ok := big.NewInt(0).SetString(args[1], 16)
+		if !ok || !numTransfers.IsUint64() || numTransfers.Uint64() == 0 {
+			return 0, false
+		}
+
+		expectedNumArgs := uint64(numMultiESDTNFTTransferFixedArgs) + numTransfers.Uint64()*numMultiESDTNFTTransferArgsPerESDT
+		if uint64(len(args)) != expectedNumArgs {
+			return 0, false
+		}
+
+		return numTransfers.Uint64(), isUserAddressArgument(args[0])
+	default:
+		return 0, false
+	}
+}
+
+func isUserAddressArgument(arg string) bool {
+	address, err := hex.DecodeString(arg)
+	if err != nil || len(address) == 0 {
+		return false
+	}
+
+	return !core.IsSmartContractAddress(address)
+}
@@ -0,0 +1,153 @@
+package txcost
+
+import (
+	"encoding/hex"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/multiversx/mx-chain-proxy-go/process/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testUserAddress     = "05702a5fd947a9ddb861ce7ffebfea86c2ca8906df3065ae295f283477ae4e43"
+	testOtherAddress    = "a5fd947a9ddb861ce7ffebfea86c2ca8906df3065ae295f283477ae4e4305702"
+	testContractAddress = "00000000000000000500a5fd947a9ddb861ce7ffebfea86c2ca8906df3065ae2"
+)
+
+func createLocalEstimationTxCostProcessor(t *testing.T, observersCalled *bool) *transactionCostProcessor {
+	coreProc := &mock.ProcessorStub{
+		GetObserversCalled: func(shardId uint32) ([]*data.NodeData, error) {
+			return []*data.NodeData{{}}, nil
+		},
+		ComputeShardIdCalled: func(addressBuff []byte) (uint32, error) {
+			return 0, nil
+		},
+		CallPostRestEndPointCalled: func(address string, path string, req interface{}, response interface{}) (int, error) {
+			*observersCalled = true
+			response.(*data.ResponseTxCost).Data.TxCost = 1000000
+			return http.StatusOK, nil
+		},
+	}
+	gasConfigsHandler := &mock.GasConfigsHandlerStub{
+		GetGasConfigsCalled: func() (*data.TxCostGasConfigs, error) {
+			return &data.TxCostGasConfigs{
+				MinGasLimit:            50000,
+				GasPerDataByte:         1500,
+				ExtraGasLimitGuardedTx: 50000,
+				BuiltInCost: map[string]uint64{
+					esdtTransferFunction:         200000,
+					esdtNFTTransferFunction:      200000,
+					multiESDTNFTTransferFunction: 200000,
+					"SaveKeyValue":               100000,
+				},
+			}, nil
+		},
+	}
+
+	tcp, err := NewTransactionCostProcessor(coreProc, &mock.PubKeyConverterMock{}, gasConfigsHandler)
+	require.Nil(t, err)
+
+	return tcp
+}
+
+func TestNewTransactionCostProcessor_NilGasConfigsHandlerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	tcp, err := NewTransactionCostProcessor(&mock.ProcessorStub{}, &mock.PubKeyConverterMock{}, nil)
+	require.Nil(t, tcp)
+	require.Equal(t, ErrNilGasConfigsHandler, err)
+}
+
+func TestTransactionCostProcessor_ResolveCostRequestLocally(t *testing.T) {
+	t.Parallel()
+
+	hexArg := func(arg string) string {
+		return hex.EncodeToString([]byte(arg))
+	}
+
+	testLocalEstimation := func(t *testing.T, tx *data.Transaction, expectedCost uint64) {
+		observersCalled := false
+		tcp := createLocalEstimationTxCostProcessor(t, &observersCalled)
+
+		res, err := tcp.ResolveCostRequest(tx)
+		require.Nil(t, err)
+		require.Equal(t, expectedCost, res.TxCost)
+		require.Equal(t, data.TxCostEstimationMethodLocal, res.EstimationMethod)
+		require.False(t, observersCalled)
+	}
+	testObserversEstimation := func(t *testing.T, tx *data.Transaction) {
+		observersCalled := false
+		tcp := createLocalEstimationTxCostProcessor(t, &observersCalled)
+
+		res, err := tcp.ResolveCostRequest(tx)
+		require.Nil(t, err)
+		require.Equal(t, uint64(1000000), res.TxCost)
+		require.Equal(t, data.TxCostEstimationMethodObservers, res.EstimationMethod)
+		require.True(t, observersCalled)
+	}
+
+	t.Run("move balance", func(t *testing.T) {
+		t.Parallel()
+
+		testLocalEstimation(t, &data.Transaction{Sender: testUserAddress, Receiver: testOtherAddress}, 50000)
+		testLocalEstimation(t, &data.Transaction{Sender: testUserAddress, Receiver: testOtherAddress, Data: []byte("hello")}, 50000+5*1500)
+		guardedTx := &data.Transaction{Sender: testUserAddress, Receiver: testOtherAddress, GuardianAddr: testOtherAddress}
+		testLocalEstimation(t, guardedTx, 50000+50000)
+	})
+
+	t.Run("ESDT transfers", func(t *testing.T) {
+		t.Parallel()
+
+		dataField := "ESDTTransfer@" + hexArg("TKN-abcdef") + "@0a"
+		tx := &data.Transaction{Sender: testUserAddress, Receiver: testOtherAddress, Data: []byte(dataField)}
+		testLocalEstimation(t, tx, 50000+uint64(len(dataField))*1500+200000)
+
+		dataField = "ESDTNFTTransfer@" + hexArg("NFT-abcdef") + "@01@01@" + testOtherAddress
+		tx = &data.Transaction{Sender: testUserAddress, Receiver: testUserAddress, Data: []byte(dataField)}
+		testLocalEstimation(t, tx, 50000+uint64(len(dataField))*1500+200000)
+
+		dataField = "MultiESDTNFTTransfer@" + testOtherAddress + "@02@" + hexArg("TKN-abcdef") + "@@0a@" + hexArg("NFT-abcdef") + "@01@01"
+		tx = &data.Transaction{Sender: testUserAddress, Receiver: testUserAddress, Data: []byte(dataField)}
+		testLocalEstimation(t, tx, 50000+uint64(len(dataField))*1500+2*200000)
+	})
+
+	t.Run("contract calls should be estimated by the observers", func(t *testing.T) {
+		t.Parallel()
+
+		testObserversEstimation(t, &data.Transaction{Sender: testUserAddress, Receiver: testContractAddress, Data: []byte("claim")})
+
+		dataField := "ESDTTransfer@" + hexArg("TKN-abcdef") + "@0a@" + hexArg("stake")
+		testObserversEstimation(t, &data.Transaction{Sender: testUserAddress, Receiver: testOtherAddress, Data: []byte(dataField)})
+
+		dataField = "ESDTNFTTransfer@" + hexArg("NFT-abcdef") + "@01@01@" + testContractAddress
+		testObserversEstimation(t, &data.Transaction{Sender: testUserAddress, Receiver: testUserAddress, Data: []byte(dataField)})
+
+		dataField = "MultiESDTNFTTransfer@" + testOtherAddress + "@02@" + hexArg("TKN-abcdef") + "@@0a"
+		testObserversEstimation(t, &data.Transaction{Sender: testUserAddress, Receiver: testUserAddress, Data: []byte(dataField)})
+
+		testObserversEstimation(t, &data.Transaction{Sender: testUserAddress, Receiver: testUserAddress, Data: []byte("SaveKeyValue@01@02")})
+
+		dataField = "relayedTx@" + hexArg("{}")
+		testObserversEstimation(t, &data.Transaction{Sender: testUserAddress, Receiver: testOtherAddress, Data: []byte(dataField)})
+	})
+
+	t.Run("gas configs not available should use the observers", func(t *testing.T) {
+		t.Parallel()
+
+		observersCalled := false
+		tcp := createLocalEstimationTxCostProcessor(t, &observersCalled)
+		tcp.gasConfigsHandler = &mock.GasConfigsHandlerStub{
+			GetGasConfigsCalled: func() (*data.TxCostGasConfigs, error) {
+				return nil, errors.New("observers down")
+			},
+		}
+
+		res, err := tcp.ResolveCostRequest(&data.Transaction{Sender: testUserAddress, Receiver: testOtherAddress})
+		require.Nil(t, err)
+		require.Equal(t, data.TxCostEstimationMethodObservers, res.EstimationMethod)
+		require.True(t, observersCalled)
+	})
+}
@@ -17,16 +17,18 @@ const TransactionCostPath = "/transaction/cost"
 var log = logger.GetOrCreate("process/txcost")
 
 type transactionCostProcessor struct {
-	proc            process.Processor
-	pubKeyConverter core.PubkeyConverter
-	responses       []*data.ResponseTxCost
-	txsFromSCR      []*data.Transaction
+	proc              process.Processor
+	pubKeyConverter   core.PubkeyConverter
+	gasConfigsHandler GasConfigsHandler
+	responses         []*data.ResponseTxCost
+	txsFromSCR        []*data.Transaction
 }
 
 // NewTransactionCostProcessor will create a new instance of the transactionCostProcessor
 func NewTransactionCostProcessor(
 	proc process.Processor,
 	pubKeyConverter core.PubkeyConverter,
+	gasConfigsHandler GasConfigsHandler,
 ) (*transactionCostProcessor, error) {
 	if check.IfNil(proc) {
 		return nil, ErrNilCoreProcessor
@@ -34,17 +36,41 @@ func NewTransactionCostProcessor(
 	if check.IfNil(pubKeyConverter) {
 		return nil, ErrNilPubKeyConverter
 	}
+	if check.IfNil(gasConfigsHandler) {
+		return nil, ErrNilGasConfigsHandler
+	}
 
 	return &transactionCostProcessor{
-		proc:            proc,
-		pubKeyConverter: pubKeyConverter,
-		responses:       make([]*data.ResponseTxCost, 0),
-		txsFromSCR:      make([]*data.Transaction, 0),
+		proc:              proc,
+		pubKeyConverter:   pubKeyConverter,
+		gasConfigsHandler: gasConfigsHandler,
+		responses:         make([]*data.ResponseTxCost, 0),
+		txsFromSCR:        make([]*data.Transaction, 0),
 	}, nil
 }
 
-// ResolveCostRequest will resolve the transaction cost request
+// ResolveCostRequest will resolve the transaction cost request. The cost of the move balance and ESDT transfer
+// transactions is computed locally, while the other transactions are executed by the observers
 func (tcp *transactionCostProcessor) ResolveCostRequest(tx *data.Transaction) (*data.TxCostResponseData, error) {
+	txCost, ok := tcp.estimateLocally(tx)
+	if ok {
+		return &data.TxCostResponseData{
+			TxCost:           txCost,
+			EstimationMethod: data.TxCostEstimationMethodLocal,
+		}, nil
+	}
+
+	res, err := tcp.resolveCostRequestOnObservers(tx)
+	if err != nil {
+		return nil, err
+	}
+
+	res.EstimationMethod = data.TxCostEstimationMethodObservers
+
+	return res, nil
+}
+
+func (tcp *transactionCostProcessor) resolveCostRequestOnObservers(tx *data.Transaction) (*data.TxCostResponseData, error) {
 	senderShardID, receiverShardID, err := tcp.computeSenderAndReceiverShardID(tx.Sender, tx.Receiver)
 	if err != nil {
 		return nil, err
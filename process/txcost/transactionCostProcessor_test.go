@@ -120,7 +120,7 @@ func TestTransactionCostProcessor_RezolveCostRequestWith3LevelsOfAsyncCalls(t *t
 	}
 
 	newTxCostProcessor, _ := NewTransactionCostProcessor(
-		coreProc, &mock.PubKeyConverterMock{})
+		coreProc, &mock.PubKeyConverterMock{}, &mock.GasConfigsHandlerStub{})
 
 	tx := &data.Transaction{
 		Data:     []byte("scCall1@first"),
@@ -48,7 +48,10 @@ func NewValidatorStatisticsProcessor(
 func (vsp *ValidatorStatisticsProcessor) GetValidatorStatistics() (*data.ValidatorStatisticsResponse, error) {
 	valStatsToReturn, err := vsp.cacher.LoadValStats()
 	if err == nil {
-		return &data.ValidatorStatisticsResponse{Statistics: valStatsToReturn}, nil
+		return &data.ValidatorStatisticsResponse{
+			Statistics:  valStatsToReturn,
+			CacheAgeSec: uint64(vsp.cacher.GetCacheAge().Seconds()),
+		}, nil
 	}
 
 	log.Info("validator statistics: cannot get from cache. Will fetch from API", "error", err.Error())
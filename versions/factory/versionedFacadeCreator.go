@@ -29,6 +29,11 @@ type FacadeArgs struct {
 	ESDTSuppliesProcessor        facade.ESDTSupplyProcessor
 	StatusProcessor              facade.StatusProcessor
 	AboutInfoProcessor           facade.AboutInfoProcessor
+	ABIProcessor                 facade.ABIProcessor
+	EventsProcessor              facade.EventsProcessor
+	BlocksRangeProcessor         facade.BlocksRangeProcessor
+	HyperblockProcessor          facade.HyperblockProcessor
+	TokensProcessor              facade.TokensProcessor
 }
 
 // CreateVersionsRegistry creates the version registry instances and populates it with the versions and their handlers
@@ -111,6 +116,11 @@ func createVersionV1_0Facade(facadeArgs FacadeArgs) (*facadeVersions.ProxyFacade
 		ESDTSuppliesProcessor:        facadeArgs.ESDTSuppliesProcessor,
 		StatusProcessor:              facadeArgs.StatusProcessor,
 		AboutInfoProcessor:           facadeArgs.AboutInfoProcessor,
+		ABIProcessor:                 facadeArgs.ABIProcessor,
+		EventsProcessor:              facadeArgs.EventsProcessor,
+		BlocksRangeProcessor:         facadeArgs.BlocksRangeProcessor,
+		HyperblockProcessor:          facadeArgs.HyperblockProcessor,
+		TokensProcessor:              facadeArgs.TokensProcessor,
 	}
 
 	commonFacade, err := createVersionedFacade(v1_0HandlerArgs)
@@ -208,5 +218,10 @@ func createVersionedFacade(args FacadeArgs) (data.FacadeHandler, error) {
 		args.ESDTSuppliesProcessor,
 		args.StatusProcessor,
 		args.AboutInfoProcessor,
+		args.ABIProcessor,
+		args.EventsProcessor,
+		args.BlocksRangeProcessor,
+		args.HyperblockProcessor,
+		args.TokensProcessor,
 	)
 }